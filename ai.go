@@ -1,4 +1,5 @@
 //go:generate go run ./cmd/gs1aigen -out airegistry.go -package gs1 -struct-name "ApplicationIdentifier" -disable-struct-gen
+//go:generate go run ./cmd/gs1aigen -out aitypes.go -package gs1 -typed
 package gs1
 
 import (
@@ -38,6 +39,19 @@ type SymbologyIdentifier struct {
 	Mode int
 }
 
+// String returns the symbology identifier as transmitted by scanners, e.g. ]d2.
+func (s SymbologyIdentifier) String() string {
+	return fmt.Sprintf("%c%s%d", symbologyFlag, s.Type, s.Mode)
+}
+
+// ParseSymbologyIdentifier parses a symbology identifier as transmitted by scanners, e.g. ]d2.
+func ParseSymbologyIdentifier(s string) (SymbologyIdentifier, error) {
+	if len(s) != 3 || s[0] != symbologyFlag || s[2] < '0' || s[2] > '9' {
+		return SymbologyIdentifier{}, fmt.Errorf("invalid symbology identifier %q: must be in the form ]Xm, e.g. ]d2", s)
+	}
+	return SymbologyIdentifier{Type: SymbologyType(s[1:2]), Mode: int(s[2] - '0')}, nil
+}
+
 // MessageSyntaxType describes the syntax type when it has been parsed from input, e.g by using [ParseMessage].
 type MessageSyntaxType string
 
@@ -46,20 +60,41 @@ const (
 	ElementStringSyntax    MessageSyntaxType = "ElementStringSyntax"
 	BarcodeMessageFormat   MessageSyntaxType = "BarcodeMessageFormat"
 	BarcodeMessageScanData MessageSyntaxType = "BarcodeMessageScanData"
+	DigitalLinkURI         MessageSyntaxType = "DigitalLinkURI"
+	EPCPureIdentityURI     MessageSyntaxType = "EPCPureIdentityURI"
+	EPCBinaryEncoding      MessageSyntaxType = "EPCBinaryEncoding"
 )
 
-// SymbologyType as defined in GS1 General Specification v25.0, chapter 5.1.3.
+// SymbologyType is the symbology character of a symbology identifier as defined in GS1 General Specification v25.0,
+// chapter 5.1.3. It denotes a family of symbologies, the mode of the identifier selects the symbology within the
+// family, e.g. ]d2 is GS1 DataMatrix and ]d1 Data Matrix without FNC1. See [SymbologyIdentifier.Info].
 type SymbologyType string
 
 const (
-	UnknownSymbology   SymbologyType = ""
-	GS1128             SymbologyType = "C"
-	GS1DataBar         SymbologyType = "e"
-	GS1Composite       SymbologyType = "e"
-	GS1DataMatrix      SymbologyType = "d"
-	GS1QRCode          SymbologyType = "Q"
-	GS1DotCode         SymbologyType = "J"
-	GSDataMatrixECC200 SymbologyType = "d"
+	UnknownSymbology SymbologyType = ""
+	// GS1128 is the symbology character of Code 128, ]C1 denotes GS1-128.
+	GS1128 SymbologyType = "C"
+	// GS1DataBar is the symbology character of GS1 DataBar and GS1 Composite, both transmitted as ]e0.
+	GS1DataBar SymbologyType = "e"
+	// GS1DataMatrix is the symbology character of Data Matrix, ]d2 denotes GS1 DataMatrix.
+	GS1DataMatrix SymbologyType = "d"
+	// GS1QRCode is the symbology character of QR Code, ]Q3 denotes GS1 QR Code.
+	GS1QRCode SymbologyType = "Q"
+	// GS1DotCode is the symbology character of DotCode, ]J1 denotes GS1 DotCode.
+	GS1DotCode SymbologyType = "J"
+	// EANUPC is the symbology character of EAN/UPC symbols and their add-ons.
+	EANUPC SymbologyType = "E"
+	// ITF is the symbology character of Interleaved 2 of 5, ]I1 denotes ITF-14 with its check digit transmitted.
+	ITF SymbologyType = "I"
+
+	// GS1Composite is the symbology character of GS1 Composite.
+	//
+	// Deprecated: GS1 Composite shares the symbology identifier ]e0 with GS1 DataBar, use [GS1DataBar].
+	GS1Composite = GS1DataBar
+	// GSDataMatrixECC200 is the symbology character of Data Matrix ECC 200.
+	//
+	// Deprecated: Data Matrix ECC 200 is distinguished by the mode of the symbology identifier, use [GS1DataMatrix].
+	GSDataMatrixECC200 = GS1DataMatrix
 )
 
 // Message describes a GS1 message together with its Symbology, SyntaxType and the Elements describing the message.
@@ -72,6 +107,9 @@ type Message struct {
 	SyntaxType MessageSyntaxType
 	// Elements are the AIs describing the message's actual payload.
 	Elements []ElementString
+	// AddOn is the 2- or 5-digit add-on of an EAN/UPC symbol, e.g. the issue number of a periodical. Add-ons carry no
+	// AI and are not part of the element string syntax.
+	AddOn string
 }
 
 // AsElementString returns the Message in the element string syntax, e.g. (01)01234567890128(15)057072.
@@ -86,6 +124,59 @@ func (d Message) AsElementString() string {
 	return builder.String()
 }
 
+// AsBarcodeMessage returns the Message in the barcode message format, e.g. 010123456789012810ABC123\x1d21456. AIs
+// without predefined length are terminated by FNC1 unless they are the last element.
+func (d Message) AsBarcodeMessage() string {
+	builder := strings.Builder{}
+	for i, datum := range d.Elements {
+		builder.WriteString(datum.AI)
+		builder.WriteString(datum.DataField)
+		if datum.IsFNC1Separated() && i < len(d.Elements)-1 {
+			builder.WriteRune(fnc1)
+		}
+	}
+	return builder.String()
+}
+
+// AsScanData returns the Message as barcode message scan data preceded by the symbology identifier, e.g.
+// ]d20101234567890128. EAN/UPC symbologies transmit the GTIN and add-on without AI, e.g. ]E04006381333931, ITF-14
+// the GTIN-14, e.g. ]I114012345678908.
+func (d Message) AsScanData(symbology SymbologyIdentifier) string {
+	switch symbology.Type {
+	case EANUPC:
+		return symbology.String() + d.eanUPCScanData(symbology.Mode)
+	case ITF:
+		el, _ := d.element("01")
+		return symbology.String() + el.DataField
+	}
+	return symbology.String() + d.AsBarcodeMessage()
+}
+
+// HRI returns the human readable interpretation of the elements, one line per element, e.g. (01) 01234567890128.
+func (d Message) HRI() []string {
+	lines := make([]string, 0, len(d.Elements))
+	for _, datum := range d.Elements {
+		lines = append(lines, fmt.Sprintf("(%s) %s", datum.AI, datum.DataField))
+	}
+	return lines
+}
+
+// element returns the first element string using the given AI.
+func (d Message) element(ai string) (ElementString, bool) {
+	for _, el := range d.Elements {
+		if el.AI == ai {
+			return el, true
+		}
+	}
+	return ElementString{}, false
+}
+
+// data returns the data field of the AI or an empty string.
+func (d Message) data(ai string) string {
+	el, _ := d.element(ai)
+	return el.DataField
+}
+
 // ApplicationIdentifier represents an GS1 AI together with its description required for parsing and generating a valid
 // GS1 [Message]. An instance of an AI is an [ElementString].
 type ApplicationIdentifier struct {
@@ -133,35 +224,10 @@ func (ai ApplicationIdentifier) Length() int {
 }
 
 // DigitalLinkSpec extracts the [DigitalLinkSpec] required to correctly use the AI within the GS1 Digital Link URI.
-func (ai ApplicationIdentifier) DigitalLinkSpec() (d DigitalLinkSpec) {
-	if !strings.ContainsRune(ai.Flags, isValidDLAttrFlag) {
-		return DigitalLinkSpec{}
-	} else {
-		d.IsValidDataAttribute = true
-	}
-	allowedQualifiers, ok := ai.getAttribute(dlPrimaryKeyAttrName)
-	if !ok {
-		d.IsValidPrimaryKey = false
-		return d
-	} else {
-		d.IsValidPrimaryKey = true
-	}
-	if allowedQualifiers == "" {
-		return d
-	}
-	allowedQualifierSequences := strings.Split(allowedQualifiers, string(pairingORSeparator))
-	for _, sequence := range allowedQualifierSequences {
-		seqAIs := []ApplicationIdentifier{}
-		seqElements := strings.Split(sequence, ",")
-		for _, seqEl := range seqElements {
-			aiEl, ok := AIRegistry[seqEl]
-			if !ok {
-				panic("Declared attribute is an unknown AI: " + seqEl)
-			}
-			seqAIs = append(seqAIs, aiEl)
-		}
-		d.AllowedQualifiers = append(d.AllowedQualifiers, seqAIs)
-	}
+// Key qualifiers are resolved using the [DefaultRegistry], unknown key qualifiers are omitted. Use
+// [Registry.DigitalLinkSpec] to resolve them using another registry and to detect unknown key qualifiers.
+func (ai ApplicationIdentifier) DigitalLinkSpec() DigitalLinkSpec {
+	d, _ := DefaultRegistry().DigitalLinkSpec(ai)
 	return d
 }
 
@@ -140,3 +140,23 @@ func TestApplicationIdentifier_DigitalLinkSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestMessage_AsBarcodeMessage(t *testing.T) {
+	msg := Message{Elements: []ElementString{
+		NewElementString(AI01, "01234567890128"),
+		NewElementString(AI10, "ABC123"),
+		NewElementString(AI17, "250521"),
+		NewElementString(AI21, "456DEF"),
+	}}
+	want := "010123456789012810ABC123\x1d1725052121456DEF"
+	if got := msg.AsBarcodeMessage(); got != want {
+		t.Errorf("AsBarcodeMessage() = %q, want %q", got, want)
+	}
+	if got := msg.AsScanData(SymbologyIdentifier{Type: GS1DataMatrix, Mode: 2}); got != "]d2"+want {
+		t.Errorf("AsScanData() = %q, want %q", got, "]d2"+want)
+	}
+	wantHRI := []string{"(01) 01234567890128", "(10) ABC123", "(17) 250521", "(21) 456DEF"}
+	if got := msg.HRI(); !reflect.DeepEqual(got, wantHRI) {
+		t.Errorf("HRI() = %v, want %v", got, wantHRI)
+	}
+}
@@ -3,6 +3,9 @@
 
 package gs1
 
+// SyntaxDictionaryRelease is the GS1 Syntax Dictionary release the AI descriptions are generated from.
+const SyntaxDictionaryRelease = "2025-01-30"
+
 // Go descriptions for the GS1 Application Identifier. Enable seamless parsing and validation of GS1 messages.
 var (
 	// AI00 describes a SSCC. See also https://ref.gs1.org/ai/00.
@@ -133,7 +136,7 @@ var (
 		Attributes:    []string{"req=01,02,8006,8026"},
 		Title:         "ADDITIONAL ID",
 	}
-	// AI241 describes a CUST. PART No.. See also https://ref.gs1.org/ai/241.
+	// AI241 describes a CUST. PART No. See also https://ref.gs1.org/ai/241.
 	AI241 = ApplicationIdentifier{
 		AI:            "241",
 		Flags:         "?",
@@ -3221,7 +3224,7 @@ var (
 		Attributes:    []string{},
 		Title:         "SHIP FOR LOC",
 	}
-	// AI414 describes a LOC No.. See also https://ref.gs1.org/ai/414.
+	// AI414 describes a LOC No. See also https://ref.gs1.org/ai/414.
 	AI414 = ApplicationIdentifier{
 		AI:            "414",
 		Flags:         "*?",
@@ -3533,7 +3536,7 @@ var (
 		Attributes:    []string{"req=00"},
 		Title:         "REL DATE",
 	}
-	// AI4330 describes a MAX TEMP F.. See also https://ref.gs1.org/ai/4330.
+	// AI4330 describes a MAX TEMP F. See also https://ref.gs1.org/ai/4330.
 	AI4330 = ApplicationIdentifier{
 		AI:            "4330",
 		Flags:         "?",
@@ -3541,7 +3544,7 @@ var (
 		Attributes:    []string{"req=00", "ex=4331"},
 		Title:         "MAX TEMP F.",
 	}
-	// AI4331 describes a MAX TEMP C.. See also https://ref.gs1.org/ai/4331.
+	// AI4331 describes a MAX TEMP C. See also https://ref.gs1.org/ai/4331.
 	AI4331 = ApplicationIdentifier{
 		AI:            "4331",
 		Flags:         "?",
@@ -3549,7 +3552,7 @@ var (
 		Attributes:    []string{"req=00", "ex=4330"},
 		Title:         "MAX TEMP C.",
 	}
-	// AI4332 describes a MIN TEMP F.. See also https://ref.gs1.org/ai/4332.
+	// AI4332 describes a MIN TEMP F. See also https://ref.gs1.org/ai/4332.
 	AI4332 = ApplicationIdentifier{
 		AI:            "4332",
 		Flags:         "?",
@@ -3557,7 +3560,7 @@ var (
 		Attributes:    []string{"req=00", "ex=4333"},
 		Title:         "MIN TEMP F.",
 	}
-	// AI4333 describes a MIN TEMP C.. See also https://ref.gs1.org/ai/4333.
+	// AI4333 describes a MIN TEMP C. See also https://ref.gs1.org/ai/4333.
 	AI4333 = ApplicationIdentifier{
 		AI:            "4333",
 		Flags:         "?",
@@ -4029,7 +4032,7 @@ var (
 		Attributes:    []string{"req=01"},
 		Title:         "DIMENSIONS",
 	}
-	// AI8002 describes a CMT No.. See also https://ref.gs1.org/ai/8002.
+	// AI8002 describes a CMT No. See also https://ref.gs1.org/ai/8002.
 	AI8002 = ApplicationIdentifier{
 		AI:            "8002",
 		Flags:         "?",
@@ -4157,7 +4160,7 @@ var (
 		Attributes:    []string{"req=8017,8018"},
 		Title:         "SRIN",
 	}
-	// AI8020 describes a REF No.. See also https://ref.gs1.org/ai/8020.
+	// AI8020 describes a REF No. See also https://ref.gs1.org/ai/8020.
 	AI8020 = ApplicationIdentifier{
 		AI:            "8020",
 		Flags:         "",
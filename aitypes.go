@@ -0,0 +1,8058 @@
+// DO NOT EDIT.
+// Code generated by github.com/adippel/go-gs1engine/gs1aigen.
+
+package gs1
+
+import "time"
+
+// Codes of the GS1 Application Identifiers of Syntax Dictionary release 2025-01-30.
+const (
+	// SSCCCode is the code of AI (00) SSCC.
+	SSCCCode = "00"
+	// GTINCode is the code of AI (01) GTIN.
+	GTINCode = "01"
+	// ContentCode is the code of AI (02) CONTENT.
+	ContentCode = "02"
+	// MTOGTINCode is the code of AI (03) MTO GTIN.
+	MTOGTINCode = "03"
+	// BatchLotCode is the code of AI (10) BATCH/LOT.
+	BatchLotCode = "10"
+	// ProdDateCode is the code of AI (11) PROD DATE.
+	ProdDateCode = "11"
+	// DueDateCode is the code of AI (12) DUE DATE.
+	DueDateCode = "12"
+	// PackDateCode is the code of AI (13) PACK DATE.
+	PackDateCode = "13"
+	// BestBeforeDateCode is the code of AI (15) BEST BEFORE or BEST BY.
+	BestBeforeDateCode = "15"
+	// SellByCode is the code of AI (16) SELL BY.
+	SellByCode = "16"
+	// ExpiryDateCode is the code of AI (17) USE BY or EXPIRY.
+	ExpiryDateCode = "17"
+	// VariantCode is the code of AI (20) VARIANT.
+	VariantCode = "20"
+	// SerialCode is the code of AI (21) SERIAL.
+	SerialCode = "21"
+	// CPVCode is the code of AI (22) CPV.
+	CPVCode = "22"
+	// TPXCode is the code of AI (235) TPX.
+	TPXCode = "235"
+	// AdditionalIDCode is the code of AI (240) ADDITIONAL ID.
+	AdditionalIDCode = "240"
+	// CustPartNoCode is the code of AI (241) CUST. PART No.
+	CustPartNoCode = "241"
+	// MTOVariantCode is the code of AI (242) MTO VARIANT.
+	MTOVariantCode = "242"
+	// PCNCode is the code of AI (243) PCN.
+	PCNCode = "243"
+	// SecondarySerialCode is the code of AI (250) SECONDARY SERIAL.
+	SecondarySerialCode = "250"
+	// RefToSourceCode is the code of AI (251) REF. TO SOURCE.
+	RefToSourceCode = "251"
+	// GDTICode is the code of AI (253) GDTI.
+	GDTICode = "253"
+	// GLNExtensionComponentCode is the code of AI (254) GLN EXTENSION COMPONENT.
+	GLNExtensionComponentCode = "254"
+	// GCNCode is the code of AI (255) GCN.
+	GCNCode = "255"
+	// VarCountCode is the code of AI (30) VAR. COUNT.
+	VarCountCode = "30"
+	// NetWeightKg3100Code is the code of AI (3100) NET WEIGHT (kg).
+	NetWeightKg3100Code = "3100"
+	// NetWeightKg3101Code is the code of AI (3101) NET WEIGHT (kg).
+	NetWeightKg3101Code = "3101"
+	// NetWeightKg3102Code is the code of AI (3102) NET WEIGHT (kg).
+	NetWeightKg3102Code = "3102"
+	// NetWeightKg3103Code is the code of AI (3103) NET WEIGHT (kg).
+	NetWeightKg3103Code = "3103"
+	// NetWeightKg3104Code is the code of AI (3104) NET WEIGHT (kg).
+	NetWeightKg3104Code = "3104"
+	// NetWeightKg3105Code is the code of AI (3105) NET WEIGHT (kg).
+	NetWeightKg3105Code = "3105"
+	// LengthM3110Code is the code of AI (3110) LENGTH (m).
+	LengthM3110Code = "3110"
+	// LengthM3111Code is the code of AI (3111) LENGTH (m).
+	LengthM3111Code = "3111"
+	// LengthM3112Code is the code of AI (3112) LENGTH (m).
+	LengthM3112Code = "3112"
+	// LengthM3113Code is the code of AI (3113) LENGTH (m).
+	LengthM3113Code = "3113"
+	// LengthM3114Code is the code of AI (3114) LENGTH (m).
+	LengthM3114Code = "3114"
+	// LengthM3115Code is the code of AI (3115) LENGTH (m).
+	LengthM3115Code = "3115"
+	// WidthM3120Code is the code of AI (3120) WIDTH (m).
+	WidthM3120Code = "3120"
+	// WidthM3121Code is the code of AI (3121) WIDTH (m).
+	WidthM3121Code = "3121"
+	// WidthM3122Code is the code of AI (3122) WIDTH (m).
+	WidthM3122Code = "3122"
+	// WidthM3123Code is the code of AI (3123) WIDTH (m).
+	WidthM3123Code = "3123"
+	// WidthM3124Code is the code of AI (3124) WIDTH (m).
+	WidthM3124Code = "3124"
+	// WidthM3125Code is the code of AI (3125) WIDTH (m).
+	WidthM3125Code = "3125"
+	// HeightM3130Code is the code of AI (3130) HEIGHT (m).
+	HeightM3130Code = "3130"
+	// HeightM3131Code is the code of AI (3131) HEIGHT (m).
+	HeightM3131Code = "3131"
+	// HeightM3132Code is the code of AI (3132) HEIGHT (m).
+	HeightM3132Code = "3132"
+	// HeightM3133Code is the code of AI (3133) HEIGHT (m).
+	HeightM3133Code = "3133"
+	// HeightM3134Code is the code of AI (3134) HEIGHT (m).
+	HeightM3134Code = "3134"
+	// HeightM3135Code is the code of AI (3135) HEIGHT (m).
+	HeightM3135Code = "3135"
+	// AreaM23140Code is the code of AI (3140) AREA (m²).
+	AreaM23140Code = "3140"
+	// AreaM23141Code is the code of AI (3141) AREA (m²).
+	AreaM23141Code = "3141"
+	// AreaM23142Code is the code of AI (3142) AREA (m²).
+	AreaM23142Code = "3142"
+	// AreaM23143Code is the code of AI (3143) AREA (m²).
+	AreaM23143Code = "3143"
+	// AreaM23144Code is the code of AI (3144) AREA (m²).
+	AreaM23144Code = "3144"
+	// AreaM23145Code is the code of AI (3145) AREA (m²).
+	AreaM23145Code = "3145"
+	// NetVolumeL3150Code is the code of AI (3150) NET VOLUME (l).
+	NetVolumeL3150Code = "3150"
+	// NetVolumeL3151Code is the code of AI (3151) NET VOLUME (l).
+	NetVolumeL3151Code = "3151"
+	// NetVolumeL3152Code is the code of AI (3152) NET VOLUME (l).
+	NetVolumeL3152Code = "3152"
+	// NetVolumeL3153Code is the code of AI (3153) NET VOLUME (l).
+	NetVolumeL3153Code = "3153"
+	// NetVolumeL3154Code is the code of AI (3154) NET VOLUME (l).
+	NetVolumeL3154Code = "3154"
+	// NetVolumeL3155Code is the code of AI (3155) NET VOLUME (l).
+	NetVolumeL3155Code = "3155"
+	// NetVolumeM33160Code is the code of AI (3160) NET VOLUME (m³).
+	NetVolumeM33160Code = "3160"
+	// NetVolumeM33161Code is the code of AI (3161) NET VOLUME (m³).
+	NetVolumeM33161Code = "3161"
+	// NetVolumeM33162Code is the code of AI (3162) NET VOLUME (m³).
+	NetVolumeM33162Code = "3162"
+	// NetVolumeM33163Code is the code of AI (3163) NET VOLUME (m³).
+	NetVolumeM33163Code = "3163"
+	// NetVolumeM33164Code is the code of AI (3164) NET VOLUME (m³).
+	NetVolumeM33164Code = "3164"
+	// NetVolumeM33165Code is the code of AI (3165) NET VOLUME (m³).
+	NetVolumeM33165Code = "3165"
+	// NetWeightLb3200Code is the code of AI (3200) NET WEIGHT (lb).
+	NetWeightLb3200Code = "3200"
+	// NetWeightLb3201Code is the code of AI (3201) NET WEIGHT (lb).
+	NetWeightLb3201Code = "3201"
+	// NetWeightLb3202Code is the code of AI (3202) NET WEIGHT (lb).
+	NetWeightLb3202Code = "3202"
+	// NetWeightLb3203Code is the code of AI (3203) NET WEIGHT (lb).
+	NetWeightLb3203Code = "3203"
+	// NetWeightLb3204Code is the code of AI (3204) NET WEIGHT (lb).
+	NetWeightLb3204Code = "3204"
+	// NetWeightLb3205Code is the code of AI (3205) NET WEIGHT (lb).
+	NetWeightLb3205Code = "3205"
+	// LengthIn3210Code is the code of AI (3210) LENGTH (in).
+	LengthIn3210Code = "3210"
+	// LengthIn3211Code is the code of AI (3211) LENGTH (in).
+	LengthIn3211Code = "3211"
+	// LengthIn3212Code is the code of AI (3212) LENGTH (in).
+	LengthIn3212Code = "3212"
+	// LengthIn3213Code is the code of AI (3213) LENGTH (in).
+	LengthIn3213Code = "3213"
+	// LengthIn3214Code is the code of AI (3214) LENGTH (in).
+	LengthIn3214Code = "3214"
+	// LengthIn3215Code is the code of AI (3215) LENGTH (in).
+	LengthIn3215Code = "3215"
+	// LengthFt3220Code is the code of AI (3220) LENGTH (ft).
+	LengthFt3220Code = "3220"
+	// LengthFt3221Code is the code of AI (3221) LENGTH (ft).
+	LengthFt3221Code = "3221"
+	// LengthFt3222Code is the code of AI (3222) LENGTH (ft).
+	LengthFt3222Code = "3222"
+	// LengthFt3223Code is the code of AI (3223) LENGTH (ft).
+	LengthFt3223Code = "3223"
+	// LengthFt3224Code is the code of AI (3224) LENGTH (ft).
+	LengthFt3224Code = "3224"
+	// LengthFt3225Code is the code of AI (3225) LENGTH (ft).
+	LengthFt3225Code = "3225"
+	// LengthYd3230Code is the code of AI (3230) LENGTH (yd).
+	LengthYd3230Code = "3230"
+	// LengthYd3231Code is the code of AI (3231) LENGTH (yd).
+	LengthYd3231Code = "3231"
+	// LengthYd3232Code is the code of AI (3232) LENGTH (yd).
+	LengthYd3232Code = "3232"
+	// LengthYd3233Code is the code of AI (3233) LENGTH (yd).
+	LengthYd3233Code = "3233"
+	// LengthYd3234Code is the code of AI (3234) LENGTH (yd).
+	LengthYd3234Code = "3234"
+	// LengthYd3235Code is the code of AI (3235) LENGTH (yd).
+	LengthYd3235Code = "3235"
+	// WidthIn3240Code is the code of AI (3240) WIDTH (in).
+	WidthIn3240Code = "3240"
+	// WidthIn3241Code is the code of AI (3241) WIDTH (in).
+	WidthIn3241Code = "3241"
+	// WidthIn3242Code is the code of AI (3242) WIDTH (in).
+	WidthIn3242Code = "3242"
+	// WidthIn3243Code is the code of AI (3243) WIDTH (in).
+	WidthIn3243Code = "3243"
+	// WidthIn3244Code is the code of AI (3244) WIDTH (in).
+	WidthIn3244Code = "3244"
+	// WidthIn3245Code is the code of AI (3245) WIDTH (in).
+	WidthIn3245Code = "3245"
+	// WidthFt3250Code is the code of AI (3250) WIDTH (ft).
+	WidthFt3250Code = "3250"
+	// WidthFt3251Code is the code of AI (3251) WIDTH (ft).
+	WidthFt3251Code = "3251"
+	// WidthFt3252Code is the code of AI (3252) WIDTH (ft).
+	WidthFt3252Code = "3252"
+	// WidthFt3253Code is the code of AI (3253) WIDTH (ft).
+	WidthFt3253Code = "3253"
+	// WidthFt3254Code is the code of AI (3254) WIDTH (ft).
+	WidthFt3254Code = "3254"
+	// WidthFt3255Code is the code of AI (3255) WIDTH (ft).
+	WidthFt3255Code = "3255"
+	// WidthYd3260Code is the code of AI (3260) WIDTH (yd).
+	WidthYd3260Code = "3260"
+	// WidthYd3261Code is the code of AI (3261) WIDTH (yd).
+	WidthYd3261Code = "3261"
+	// WidthYd3262Code is the code of AI (3262) WIDTH (yd).
+	WidthYd3262Code = "3262"
+	// WidthYd3263Code is the code of AI (3263) WIDTH (yd).
+	WidthYd3263Code = "3263"
+	// WidthYd3264Code is the code of AI (3264) WIDTH (yd).
+	WidthYd3264Code = "3264"
+	// WidthYd3265Code is the code of AI (3265) WIDTH (yd).
+	WidthYd3265Code = "3265"
+	// HeightIn3270Code is the code of AI (3270) HEIGHT (in).
+	HeightIn3270Code = "3270"
+	// HeightIn3271Code is the code of AI (3271) HEIGHT (in).
+	HeightIn3271Code = "3271"
+	// HeightIn3272Code is the code of AI (3272) HEIGHT (in).
+	HeightIn3272Code = "3272"
+	// HeightIn3273Code is the code of AI (3273) HEIGHT (in).
+	HeightIn3273Code = "3273"
+	// HeightIn3274Code is the code of AI (3274) HEIGHT (in).
+	HeightIn3274Code = "3274"
+	// HeightIn3275Code is the code of AI (3275) HEIGHT (in).
+	HeightIn3275Code = "3275"
+	// HeightFt3280Code is the code of AI (3280) HEIGHT (ft).
+	HeightFt3280Code = "3280"
+	// HeightFt3281Code is the code of AI (3281) HEIGHT (ft).
+	HeightFt3281Code = "3281"
+	// HeightFt3282Code is the code of AI (3282) HEIGHT (ft).
+	HeightFt3282Code = "3282"
+	// HeightFt3283Code is the code of AI (3283) HEIGHT (ft).
+	HeightFt3283Code = "3283"
+	// HeightFt3284Code is the code of AI (3284) HEIGHT (ft).
+	HeightFt3284Code = "3284"
+	// HeightFt3285Code is the code of AI (3285) HEIGHT (ft).
+	HeightFt3285Code = "3285"
+	// HeightYd3290Code is the code of AI (3290) HEIGHT (yd).
+	HeightYd3290Code = "3290"
+	// HeightYd3291Code is the code of AI (3291) HEIGHT (yd).
+	HeightYd3291Code = "3291"
+	// HeightYd3292Code is the code of AI (3292) HEIGHT (yd).
+	HeightYd3292Code = "3292"
+	// HeightYd3293Code is the code of AI (3293) HEIGHT (yd).
+	HeightYd3293Code = "3293"
+	// HeightYd3294Code is the code of AI (3294) HEIGHT (yd).
+	HeightYd3294Code = "3294"
+	// HeightYd3295Code is the code of AI (3295) HEIGHT (yd).
+	HeightYd3295Code = "3295"
+	// GrossWeightKg3300Code is the code of AI (3300) GROSS WEIGHT (kg).
+	GrossWeightKg3300Code = "3300"
+	// GrossWeightKg3301Code is the code of AI (3301) GROSS WEIGHT (kg).
+	GrossWeightKg3301Code = "3301"
+	// GrossWeightKg3302Code is the code of AI (3302) GROSS WEIGHT (kg).
+	GrossWeightKg3302Code = "3302"
+	// GrossWeightKg3303Code is the code of AI (3303) GROSS WEIGHT (kg).
+	GrossWeightKg3303Code = "3303"
+	// GrossWeightKg3304Code is the code of AI (3304) GROSS WEIGHT (kg).
+	GrossWeightKg3304Code = "3304"
+	// GrossWeightKg3305Code is the code of AI (3305) GROSS WEIGHT (kg).
+	GrossWeightKg3305Code = "3305"
+	// LengthMLog3310Code is the code of AI (3310) LENGTH (m), log.
+	LengthMLog3310Code = "3310"
+	// LengthMLog3311Code is the code of AI (3311) LENGTH (m), log.
+	LengthMLog3311Code = "3311"
+	// LengthMLog3312Code is the code of AI (3312) LENGTH (m), log.
+	LengthMLog3312Code = "3312"
+	// LengthMLog3313Code is the code of AI (3313) LENGTH (m), log.
+	LengthMLog3313Code = "3313"
+	// LengthMLog3314Code is the code of AI (3314) LENGTH (m), log.
+	LengthMLog3314Code = "3314"
+	// LengthMLog3315Code is the code of AI (3315) LENGTH (m), log.
+	LengthMLog3315Code = "3315"
+	// WidthMLog3320Code is the code of AI (3320) WIDTH (m), log.
+	WidthMLog3320Code = "3320"
+	// WidthMLog3321Code is the code of AI (3321) WIDTH (m), log.
+	WidthMLog3321Code = "3321"
+	// WidthMLog3322Code is the code of AI (3322) WIDTH (m), log.
+	WidthMLog3322Code = "3322"
+	// WidthMLog3323Code is the code of AI (3323) WIDTH (m), log.
+	WidthMLog3323Code = "3323"
+	// WidthMLog3324Code is the code of AI (3324) WIDTH (m), log.
+	WidthMLog3324Code = "3324"
+	// WidthMLog3325Code is the code of AI (3325) WIDTH (m), log.
+	WidthMLog3325Code = "3325"
+	// HeightMLog3330Code is the code of AI (3330) HEIGHT (m), log.
+	HeightMLog3330Code = "3330"
+	// HeightMLog3331Code is the code of AI (3331) HEIGHT (m), log.
+	HeightMLog3331Code = "3331"
+	// HeightMLog3332Code is the code of AI (3332) HEIGHT (m), log.
+	HeightMLog3332Code = "3332"
+	// HeightMLog3333Code is the code of AI (3333) HEIGHT (m), log.
+	HeightMLog3333Code = "3333"
+	// HeightMLog3334Code is the code of AI (3334) HEIGHT (m), log.
+	HeightMLog3334Code = "3334"
+	// HeightMLog3335Code is the code of AI (3335) HEIGHT (m), log.
+	HeightMLog3335Code = "3335"
+	// AreaM2Log3340Code is the code of AI (3340) AREA (m²), log.
+	AreaM2Log3340Code = "3340"
+	// AreaM2Log3341Code is the code of AI (3341) AREA (m²), log.
+	AreaM2Log3341Code = "3341"
+	// AreaM2Log3342Code is the code of AI (3342) AREA (m²), log.
+	AreaM2Log3342Code = "3342"
+	// AreaM2Log3343Code is the code of AI (3343) AREA (m²), log.
+	AreaM2Log3343Code = "3343"
+	// AreaM2Log3344Code is the code of AI (3344) AREA (m²), log.
+	AreaM2Log3344Code = "3344"
+	// AreaM2Log3345Code is the code of AI (3345) AREA (m²), log.
+	AreaM2Log3345Code = "3345"
+	// VolumeLLog3350Code is the code of AI (3350) VOLUME (l), log.
+	VolumeLLog3350Code = "3350"
+	// VolumeLLog3351Code is the code of AI (3351) VOLUME (l), log.
+	VolumeLLog3351Code = "3351"
+	// VolumeLLog3352Code is the code of AI (3352) VOLUME (l), log.
+	VolumeLLog3352Code = "3352"
+	// VolumeLLog3353Code is the code of AI (3353) VOLUME (l), log.
+	VolumeLLog3353Code = "3353"
+	// VolumeLLog3354Code is the code of AI (3354) VOLUME (l), log.
+	VolumeLLog3354Code = "3354"
+	// VolumeLLog3355Code is the code of AI (3355) VOLUME (l), log.
+	VolumeLLog3355Code = "3355"
+	// VolumeM3Log3360Code is the code of AI (3360) VOLUME (m³), log.
+	VolumeM3Log3360Code = "3360"
+	// VolumeM3Log3361Code is the code of AI (3361) VOLUME (m³), log.
+	VolumeM3Log3361Code = "3361"
+	// VolumeM3Log3362Code is the code of AI (3362) VOLUME (m³), log.
+	VolumeM3Log3362Code = "3362"
+	// VolumeM3Log3363Code is the code of AI (3363) VOLUME (m³), log.
+	VolumeM3Log3363Code = "3363"
+	// VolumeM3Log3364Code is the code of AI (3364) VOLUME (m³), log.
+	VolumeM3Log3364Code = "3364"
+	// VolumeM3Log3365Code is the code of AI (3365) VOLUME (m³), log.
+	VolumeM3Log3365Code = "3365"
+	// KgPerM23370Code is the code of AI (3370) KG PER m².
+	KgPerM23370Code = "3370"
+	// KgPerM23371Code is the code of AI (3371) KG PER m².
+	KgPerM23371Code = "3371"
+	// KgPerM23372Code is the code of AI (3372) KG PER m².
+	KgPerM23372Code = "3372"
+	// KgPerM23373Code is the code of AI (3373) KG PER m².
+	KgPerM23373Code = "3373"
+	// KgPerM23374Code is the code of AI (3374) KG PER m².
+	KgPerM23374Code = "3374"
+	// KgPerM23375Code is the code of AI (3375) KG PER m².
+	KgPerM23375Code = "3375"
+	// GrossWeightLb3400Code is the code of AI (3400) GROSS WEIGHT (lb).
+	GrossWeightLb3400Code = "3400"
+	// GrossWeightLb3401Code is the code of AI (3401) GROSS WEIGHT (lb).
+	GrossWeightLb3401Code = "3401"
+	// GrossWeightLb3402Code is the code of AI (3402) GROSS WEIGHT (lb).
+	GrossWeightLb3402Code = "3402"
+	// GrossWeightLb3403Code is the code of AI (3403) GROSS WEIGHT (lb).
+	GrossWeightLb3403Code = "3403"
+	// GrossWeightLb3404Code is the code of AI (3404) GROSS WEIGHT (lb).
+	GrossWeightLb3404Code = "3404"
+	// GrossWeightLb3405Code is the code of AI (3405) GROSS WEIGHT (lb).
+	GrossWeightLb3405Code = "3405"
+	// LengthInLog3410Code is the code of AI (3410) LENGTH (in), log.
+	LengthInLog3410Code = "3410"
+	// LengthInLog3411Code is the code of AI (3411) LENGTH (in), log.
+	LengthInLog3411Code = "3411"
+	// LengthInLog3412Code is the code of AI (3412) LENGTH (in), log.
+	LengthInLog3412Code = "3412"
+	// LengthInLog3413Code is the code of AI (3413) LENGTH (in), log.
+	LengthInLog3413Code = "3413"
+	// LengthInLog3414Code is the code of AI (3414) LENGTH (in), log.
+	LengthInLog3414Code = "3414"
+	// LengthInLog3415Code is the code of AI (3415) LENGTH (in), log.
+	LengthInLog3415Code = "3415"
+	// LengthFtLog3420Code is the code of AI (3420) LENGTH (ft), log.
+	LengthFtLog3420Code = "3420"
+	// LengthFtLog3421Code is the code of AI (3421) LENGTH (ft), log.
+	LengthFtLog3421Code = "3421"
+	// LengthFtLog3422Code is the code of AI (3422) LENGTH (ft), log.
+	LengthFtLog3422Code = "3422"
+	// LengthFtLog3423Code is the code of AI (3423) LENGTH (ft), log.
+	LengthFtLog3423Code = "3423"
+	// LengthFtLog3424Code is the code of AI (3424) LENGTH (ft), log.
+	LengthFtLog3424Code = "3424"
+	// LengthFtLog3425Code is the code of AI (3425) LENGTH (ft), log.
+	LengthFtLog3425Code = "3425"
+	// LengthYdLog3430Code is the code of AI (3430) LENGTH (yd), log.
+	LengthYdLog3430Code = "3430"
+	// LengthYdLog3431Code is the code of AI (3431) LENGTH (yd), log.
+	LengthYdLog3431Code = "3431"
+	// LengthYdLog3432Code is the code of AI (3432) LENGTH (yd), log.
+	LengthYdLog3432Code = "3432"
+	// LengthYdLog3433Code is the code of AI (3433) LENGTH (yd), log.
+	LengthYdLog3433Code = "3433"
+	// LengthYdLog3434Code is the code of AI (3434) LENGTH (yd), log.
+	LengthYdLog3434Code = "3434"
+	// LengthYdLog3435Code is the code of AI (3435) LENGTH (yd), log.
+	LengthYdLog3435Code = "3435"
+	// WidthInLog3440Code is the code of AI (3440) WIDTH (in), log.
+	WidthInLog3440Code = "3440"
+	// WidthInLog3441Code is the code of AI (3441) WIDTH (in), log.
+	WidthInLog3441Code = "3441"
+	// WidthInLog3442Code is the code of AI (3442) WIDTH (in), log.
+	WidthInLog3442Code = "3442"
+	// WidthInLog3443Code is the code of AI (3443) WIDTH (in), log.
+	WidthInLog3443Code = "3443"
+	// WidthInLog3444Code is the code of AI (3444) WIDTH (in), log.
+	WidthInLog3444Code = "3444"
+	// WidthInLog3445Code is the code of AI (3445) WIDTH (in), log.
+	WidthInLog3445Code = "3445"
+	// WidthFtLog3450Code is the code of AI (3450) WIDTH (ft), log.
+	WidthFtLog3450Code = "3450"
+	// WidthFtLog3451Code is the code of AI (3451) WIDTH (ft), log.
+	WidthFtLog3451Code = "3451"
+	// WidthFtLog3452Code is the code of AI (3452) WIDTH (ft), log.
+	WidthFtLog3452Code = "3452"
+	// WidthFtLog3453Code is the code of AI (3453) WIDTH (ft), log.
+	WidthFtLog3453Code = "3453"
+	// WidthFtLog3454Code is the code of AI (3454) WIDTH (ft), log.
+	WidthFtLog3454Code = "3454"
+	// WidthFtLog3455Code is the code of AI (3455) WIDTH (ft), log.
+	WidthFtLog3455Code = "3455"
+	// WidthYdLog3460Code is the code of AI (3460) WIDTH (yd), log.
+	WidthYdLog3460Code = "3460"
+	// WidthYdLog3461Code is the code of AI (3461) WIDTH (yd), log.
+	WidthYdLog3461Code = "3461"
+	// WidthYdLog3462Code is the code of AI (3462) WIDTH (yd), log.
+	WidthYdLog3462Code = "3462"
+	// WidthYdLog3463Code is the code of AI (3463) WIDTH (yd), log.
+	WidthYdLog3463Code = "3463"
+	// WidthYdLog3464Code is the code of AI (3464) WIDTH (yd), log.
+	WidthYdLog3464Code = "3464"
+	// WidthYdLog3465Code is the code of AI (3465) WIDTH (yd), log.
+	WidthYdLog3465Code = "3465"
+	// HeightInLog3470Code is the code of AI (3470) HEIGHT (in), log.
+	HeightInLog3470Code = "3470"
+	// HeightInLog3471Code is the code of AI (3471) HEIGHT (in), log.
+	HeightInLog3471Code = "3471"
+	// HeightInLog3472Code is the code of AI (3472) HEIGHT (in), log.
+	HeightInLog3472Code = "3472"
+	// HeightInLog3473Code is the code of AI (3473) HEIGHT (in), log.
+	HeightInLog3473Code = "3473"
+	// HeightInLog3474Code is the code of AI (3474) HEIGHT (in), log.
+	HeightInLog3474Code = "3474"
+	// HeightInLog3475Code is the code of AI (3475) HEIGHT (in), log.
+	HeightInLog3475Code = "3475"
+	// HeightFtLog3480Code is the code of AI (3480) HEIGHT (ft), log.
+	HeightFtLog3480Code = "3480"
+	// HeightFtLog3481Code is the code of AI (3481) HEIGHT (ft), log.
+	HeightFtLog3481Code = "3481"
+	// HeightFtLog3482Code is the code of AI (3482) HEIGHT (ft), log.
+	HeightFtLog3482Code = "3482"
+	// HeightFtLog3483Code is the code of AI (3483) HEIGHT (ft), log.
+	HeightFtLog3483Code = "3483"
+	// HeightFtLog3484Code is the code of AI (3484) HEIGHT (ft), log.
+	HeightFtLog3484Code = "3484"
+	// HeightFtLog3485Code is the code of AI (3485) HEIGHT (ft), log.
+	HeightFtLog3485Code = "3485"
+	// HeightYdLog3490Code is the code of AI (3490) HEIGHT (yd), log.
+	HeightYdLog3490Code = "3490"
+	// HeightYdLog3491Code is the code of AI (3491) HEIGHT (yd), log.
+	HeightYdLog3491Code = "3491"
+	// HeightYdLog3492Code is the code of AI (3492) HEIGHT (yd), log.
+	HeightYdLog3492Code = "3492"
+	// HeightYdLog3493Code is the code of AI (3493) HEIGHT (yd), log.
+	HeightYdLog3493Code = "3493"
+	// HeightYdLog3494Code is the code of AI (3494) HEIGHT (yd), log.
+	HeightYdLog3494Code = "3494"
+	// HeightYdLog3495Code is the code of AI (3495) HEIGHT (yd), log.
+	HeightYdLog3495Code = "3495"
+	// AreaIn23500Code is the code of AI (3500) AREA (in²).
+	AreaIn23500Code = "3500"
+	// AreaIn23501Code is the code of AI (3501) AREA (in²).
+	AreaIn23501Code = "3501"
+	// AreaIn23502Code is the code of AI (3502) AREA (in²).
+	AreaIn23502Code = "3502"
+	// AreaIn23503Code is the code of AI (3503) AREA (in²).
+	AreaIn23503Code = "3503"
+	// AreaIn23504Code is the code of AI (3504) AREA (in²).
+	AreaIn23504Code = "3504"
+	// AreaIn23505Code is the code of AI (3505) AREA (in²).
+	AreaIn23505Code = "3505"
+	// AreaFt23510Code is the code of AI (3510) AREA (ft²).
+	AreaFt23510Code = "3510"
+	// AreaFt23511Code is the code of AI (3511) AREA (ft²).
+	AreaFt23511Code = "3511"
+	// AreaFt23512Code is the code of AI (3512) AREA (ft²).
+	AreaFt23512Code = "3512"
+	// AreaFt23513Code is the code of AI (3513) AREA (ft²).
+	AreaFt23513Code = "3513"
+	// AreaFt23514Code is the code of AI (3514) AREA (ft²).
+	AreaFt23514Code = "3514"
+	// AreaFt23515Code is the code of AI (3515) AREA (ft²).
+	AreaFt23515Code = "3515"
+	// AreaYd23520Code is the code of AI (3520) AREA (yd²).
+	AreaYd23520Code = "3520"
+	// AreaYd23521Code is the code of AI (3521) AREA (yd²).
+	AreaYd23521Code = "3521"
+	// AreaYd23522Code is the code of AI (3522) AREA (yd²).
+	AreaYd23522Code = "3522"
+	// AreaYd23523Code is the code of AI (3523) AREA (yd²).
+	AreaYd23523Code = "3523"
+	// AreaYd23524Code is the code of AI (3524) AREA (yd²).
+	AreaYd23524Code = "3524"
+	// AreaYd23525Code is the code of AI (3525) AREA (yd²).
+	AreaYd23525Code = "3525"
+	// AreaIn2Log3530Code is the code of AI (3530) AREA (in²), log.
+	AreaIn2Log3530Code = "3530"
+	// AreaIn2Log3531Code is the code of AI (3531) AREA (in²), log.
+	AreaIn2Log3531Code = "3531"
+	// AreaIn2Log3532Code is the code of AI (3532) AREA (in²), log.
+	AreaIn2Log3532Code = "3532"
+	// AreaIn2Log3533Code is the code of AI (3533) AREA (in²), log.
+	AreaIn2Log3533Code = "3533"
+	// AreaIn2Log3534Code is the code of AI (3534) AREA (in²), log.
+	AreaIn2Log3534Code = "3534"
+	// AreaIn2Log3535Code is the code of AI (3535) AREA (in²), log.
+	AreaIn2Log3535Code = "3535"
+	// AreaFt2Log3540Code is the code of AI (3540) AREA (ft²), log.
+	AreaFt2Log3540Code = "3540"
+	// AreaFt2Log3541Code is the code of AI (3541) AREA (ft²), log.
+	AreaFt2Log3541Code = "3541"
+	// AreaFt2Log3542Code is the code of AI (3542) AREA (ft²), log.
+	AreaFt2Log3542Code = "3542"
+	// AreaFt2Log3543Code is the code of AI (3543) AREA (ft²), log.
+	AreaFt2Log3543Code = "3543"
+	// AreaFt2Log3544Code is the code of AI (3544) AREA (ft²), log.
+	AreaFt2Log3544Code = "3544"
+	// AreaFt2Log3545Code is the code of AI (3545) AREA (ft²), log.
+	AreaFt2Log3545Code = "3545"
+	// AreaYd2Log3550Code is the code of AI (3550) AREA (yd²), log.
+	AreaYd2Log3550Code = "3550"
+	// AreaYd2Log3551Code is the code of AI (3551) AREA (yd²), log.
+	AreaYd2Log3551Code = "3551"
+	// AreaYd2Log3552Code is the code of AI (3552) AREA (yd²), log.
+	AreaYd2Log3552Code = "3552"
+	// AreaYd2Log3553Code is the code of AI (3553) AREA (yd²), log.
+	AreaYd2Log3553Code = "3553"
+	// AreaYd2Log3554Code is the code of AI (3554) AREA (yd²), log.
+	AreaYd2Log3554Code = "3554"
+	// AreaYd2Log3555Code is the code of AI (3555) AREA (yd²), log.
+	AreaYd2Log3555Code = "3555"
+	// NetWeightTOz3560Code is the code of AI (3560) NET WEIGHT (t oz).
+	NetWeightTOz3560Code = "3560"
+	// NetWeightTOz3561Code is the code of AI (3561) NET WEIGHT (t oz).
+	NetWeightTOz3561Code = "3561"
+	// NetWeightTOz3562Code is the code of AI (3562) NET WEIGHT (t oz).
+	NetWeightTOz3562Code = "3562"
+	// NetWeightTOz3563Code is the code of AI (3563) NET WEIGHT (t oz).
+	NetWeightTOz3563Code = "3563"
+	// NetWeightTOz3564Code is the code of AI (3564) NET WEIGHT (t oz).
+	NetWeightTOz3564Code = "3564"
+	// NetWeightTOz3565Code is the code of AI (3565) NET WEIGHT (t oz).
+	NetWeightTOz3565Code = "3565"
+	// NetVolumeOz3570Code is the code of AI (3570) NET VOLUME (oz).
+	NetVolumeOz3570Code = "3570"
+	// NetVolumeOz3571Code is the code of AI (3571) NET VOLUME (oz).
+	NetVolumeOz3571Code = "3571"
+	// NetVolumeOz3572Code is the code of AI (3572) NET VOLUME (oz).
+	NetVolumeOz3572Code = "3572"
+	// NetVolumeOz3573Code is the code of AI (3573) NET VOLUME (oz).
+	NetVolumeOz3573Code = "3573"
+	// NetVolumeOz3574Code is the code of AI (3574) NET VOLUME (oz).
+	NetVolumeOz3574Code = "3574"
+	// NetVolumeOz3575Code is the code of AI (3575) NET VOLUME (oz).
+	NetVolumeOz3575Code = "3575"
+	// NetVolumeQt3600Code is the code of AI (3600) NET VOLUME (qt).
+	NetVolumeQt3600Code = "3600"
+	// NetVolumeQt3601Code is the code of AI (3601) NET VOLUME (qt).
+	NetVolumeQt3601Code = "3601"
+	// NetVolumeQt3602Code is the code of AI (3602) NET VOLUME (qt).
+	NetVolumeQt3602Code = "3602"
+	// NetVolumeQt3603Code is the code of AI (3603) NET VOLUME (qt).
+	NetVolumeQt3603Code = "3603"
+	// NetVolumeQt3604Code is the code of AI (3604) NET VOLUME (qt).
+	NetVolumeQt3604Code = "3604"
+	// NetVolumeQt3605Code is the code of AI (3605) NET VOLUME (qt).
+	NetVolumeQt3605Code = "3605"
+	// NetVolumeGal3610Code is the code of AI (3610) NET VOLUME (gal.).
+	NetVolumeGal3610Code = "3610"
+	// NetVolumeGal3611Code is the code of AI (3611) NET VOLUME (gal.).
+	NetVolumeGal3611Code = "3611"
+	// NetVolumeGal3612Code is the code of AI (3612) NET VOLUME (gal.).
+	NetVolumeGal3612Code = "3612"
+	// NetVolumeGal3613Code is the code of AI (3613) NET VOLUME (gal.).
+	NetVolumeGal3613Code = "3613"
+	// NetVolumeGal3614Code is the code of AI (3614) NET VOLUME (gal.).
+	NetVolumeGal3614Code = "3614"
+	// NetVolumeGal3615Code is the code of AI (3615) NET VOLUME (gal.).
+	NetVolumeGal3615Code = "3615"
+	// VolumeQtLog3620Code is the code of AI (3620) VOLUME (qt), log.
+	VolumeQtLog3620Code = "3620"
+	// VolumeQtLog3621Code is the code of AI (3621) VOLUME (qt), log.
+	VolumeQtLog3621Code = "3621"
+	// VolumeQtLog3622Code is the code of AI (3622) VOLUME (qt), log.
+	VolumeQtLog3622Code = "3622"
+	// VolumeQtLog3623Code is the code of AI (3623) VOLUME (qt), log.
+	VolumeQtLog3623Code = "3623"
+	// VolumeQtLog3624Code is the code of AI (3624) VOLUME (qt), log.
+	VolumeQtLog3624Code = "3624"
+	// VolumeQtLog3625Code is the code of AI (3625) VOLUME (qt), log.
+	VolumeQtLog3625Code = "3625"
+	// VolumeGalLog3630Code is the code of AI (3630) VOLUME (gal.), log.
+	VolumeGalLog3630Code = "3630"
+	// VolumeGalLog3631Code is the code of AI (3631) VOLUME (gal.), log.
+	VolumeGalLog3631Code = "3631"
+	// VolumeGalLog3632Code is the code of AI (3632) VOLUME (gal.), log.
+	VolumeGalLog3632Code = "3632"
+	// VolumeGalLog3633Code is the code of AI (3633) VOLUME (gal.), log.
+	VolumeGalLog3633Code = "3633"
+	// VolumeGalLog3634Code is the code of AI (3634) VOLUME (gal.), log.
+	VolumeGalLog3634Code = "3634"
+	// VolumeGalLog3635Code is the code of AI (3635) VOLUME (gal.), log.
+	VolumeGalLog3635Code = "3635"
+	// VolumeIn33640Code is the code of AI (3640) VOLUME (in³).
+	VolumeIn33640Code = "3640"
+	// VolumeIn33641Code is the code of AI (3641) VOLUME (in³).
+	VolumeIn33641Code = "3641"
+	// VolumeIn33642Code is the code of AI (3642) VOLUME (in³).
+	VolumeIn33642Code = "3642"
+	// VolumeIn33643Code is the code of AI (3643) VOLUME (in³).
+	VolumeIn33643Code = "3643"
+	// VolumeIn33644Code is the code of AI (3644) VOLUME (in³).
+	VolumeIn33644Code = "3644"
+	// VolumeIn33645Code is the code of AI (3645) VOLUME (in³).
+	VolumeIn33645Code = "3645"
+	// VolumeFt33650Code is the code of AI (3650) VOLUME (ft³).
+	VolumeFt33650Code = "3650"
+	// VolumeFt33651Code is the code of AI (3651) VOLUME (ft³).
+	VolumeFt33651Code = "3651"
+	// VolumeFt33652Code is the code of AI (3652) VOLUME (ft³).
+	VolumeFt33652Code = "3652"
+	// VolumeFt33653Code is the code of AI (3653) VOLUME (ft³).
+	VolumeFt33653Code = "3653"
+	// VolumeFt33654Code is the code of AI (3654) VOLUME (ft³).
+	VolumeFt33654Code = "3654"
+	// VolumeFt33655Code is the code of AI (3655) VOLUME (ft³).
+	VolumeFt33655Code = "3655"
+	// VolumeYd33660Code is the code of AI (3660) VOLUME (yd³).
+	VolumeYd33660Code = "3660"
+	// VolumeYd33661Code is the code of AI (3661) VOLUME (yd³).
+	VolumeYd33661Code = "3661"
+	// VolumeYd33662Code is the code of AI (3662) VOLUME (yd³).
+	VolumeYd33662Code = "3662"
+	// VolumeYd33663Code is the code of AI (3663) VOLUME (yd³).
+	VolumeYd33663Code = "3663"
+	// VolumeYd33664Code is the code of AI (3664) VOLUME (yd³).
+	VolumeYd33664Code = "3664"
+	// VolumeYd33665Code is the code of AI (3665) VOLUME (yd³).
+	VolumeYd33665Code = "3665"
+	// VolumeIn3Log3670Code is the code of AI (3670) VOLUME (in³), log.
+	VolumeIn3Log3670Code = "3670"
+	// VolumeIn3Log3671Code is the code of AI (3671) VOLUME (in³), log.
+	VolumeIn3Log3671Code = "3671"
+	// VolumeIn3Log3672Code is the code of AI (3672) VOLUME (in³), log.
+	VolumeIn3Log3672Code = "3672"
+	// VolumeIn3Log3673Code is the code of AI (3673) VOLUME (in³), log.
+	VolumeIn3Log3673Code = "3673"
+	// VolumeIn3Log3674Code is the code of AI (3674) VOLUME (in³), log.
+	VolumeIn3Log3674Code = "3674"
+	// VolumeIn3Log3675Code is the code of AI (3675) VOLUME (in³), log.
+	VolumeIn3Log3675Code = "3675"
+	// VolumeFt3Log3680Code is the code of AI (3680) VOLUME (ft³), log.
+	VolumeFt3Log3680Code = "3680"
+	// VolumeFt3Log3681Code is the code of AI (3681) VOLUME (ft³), log.
+	VolumeFt3Log3681Code = "3681"
+	// VolumeFt3Log3682Code is the code of AI (3682) VOLUME (ft³), log.
+	VolumeFt3Log3682Code = "3682"
+	// VolumeFt3Log3683Code is the code of AI (3683) VOLUME (ft³), log.
+	VolumeFt3Log3683Code = "3683"
+	// VolumeFt3Log3684Code is the code of AI (3684) VOLUME (ft³), log.
+	VolumeFt3Log3684Code = "3684"
+	// VolumeFt3Log3685Code is the code of AI (3685) VOLUME (ft³), log.
+	VolumeFt3Log3685Code = "3685"
+	// VolumeYd3Log3690Code is the code of AI (3690) VOLUME (yd³), log.
+	VolumeYd3Log3690Code = "3690"
+	// VolumeYd3Log3691Code is the code of AI (3691) VOLUME (yd³), log.
+	VolumeYd3Log3691Code = "3691"
+	// VolumeYd3Log3692Code is the code of AI (3692) VOLUME (yd³), log.
+	VolumeYd3Log3692Code = "3692"
+	// VolumeYd3Log3693Code is the code of AI (3693) VOLUME (yd³), log.
+	VolumeYd3Log3693Code = "3693"
+	// VolumeYd3Log3694Code is the code of AI (3694) VOLUME (yd³), log.
+	VolumeYd3Log3694Code = "3694"
+	// VolumeYd3Log3695Code is the code of AI (3695) VOLUME (yd³), log.
+	VolumeYd3Log3695Code = "3695"
+	// CountCode is the code of AI (37) COUNT.
+	CountCode = "37"
+	// Amount3900Code is the code of AI (3900) AMOUNT.
+	Amount3900Code = "3900"
+	// Amount3901Code is the code of AI (3901) AMOUNT.
+	Amount3901Code = "3901"
+	// Amount3902Code is the code of AI (3902) AMOUNT.
+	Amount3902Code = "3902"
+	// Amount3903Code is the code of AI (3903) AMOUNT.
+	Amount3903Code = "3903"
+	// Amount3904Code is the code of AI (3904) AMOUNT.
+	Amount3904Code = "3904"
+	// Amount3905Code is the code of AI (3905) AMOUNT.
+	Amount3905Code = "3905"
+	// Amount3906Code is the code of AI (3906) AMOUNT.
+	Amount3906Code = "3906"
+	// Amount3907Code is the code of AI (3907) AMOUNT.
+	Amount3907Code = "3907"
+	// Amount3908Code is the code of AI (3908) AMOUNT.
+	Amount3908Code = "3908"
+	// Amount3909Code is the code of AI (3909) AMOUNT.
+	Amount3909Code = "3909"
+	// Amount3910Code is the code of AI (3910) AMOUNT.
+	Amount3910Code = "3910"
+	// Amount3911Code is the code of AI (3911) AMOUNT.
+	Amount3911Code = "3911"
+	// Amount3912Code is the code of AI (3912) AMOUNT.
+	Amount3912Code = "3912"
+	// Amount3913Code is the code of AI (3913) AMOUNT.
+	Amount3913Code = "3913"
+	// Amount3914Code is the code of AI (3914) AMOUNT.
+	Amount3914Code = "3914"
+	// Amount3915Code is the code of AI (3915) AMOUNT.
+	Amount3915Code = "3915"
+	// Amount3916Code is the code of AI (3916) AMOUNT.
+	Amount3916Code = "3916"
+	// Amount3917Code is the code of AI (3917) AMOUNT.
+	Amount3917Code = "3917"
+	// Amount3918Code is the code of AI (3918) AMOUNT.
+	Amount3918Code = "3918"
+	// Amount3919Code is the code of AI (3919) AMOUNT.
+	Amount3919Code = "3919"
+	// Price3920Code is the code of AI (3920) PRICE.
+	Price3920Code = "3920"
+	// Price3921Code is the code of AI (3921) PRICE.
+	Price3921Code = "3921"
+	// Price3922Code is the code of AI (3922) PRICE.
+	Price3922Code = "3922"
+	// Price3923Code is the code of AI (3923) PRICE.
+	Price3923Code = "3923"
+	// Price3924Code is the code of AI (3924) PRICE.
+	Price3924Code = "3924"
+	// Price3925Code is the code of AI (3925) PRICE.
+	Price3925Code = "3925"
+	// Price3926Code is the code of AI (3926) PRICE.
+	Price3926Code = "3926"
+	// Price3927Code is the code of AI (3927) PRICE.
+	Price3927Code = "3927"
+	// Price3928Code is the code of AI (3928) PRICE.
+	Price3928Code = "3928"
+	// Price3929Code is the code of AI (3929) PRICE.
+	Price3929Code = "3929"
+	// Price3930Code is the code of AI (3930) PRICE.
+	Price3930Code = "3930"
+	// Price3931Code is the code of AI (3931) PRICE.
+	Price3931Code = "3931"
+	// Price3932Code is the code of AI (3932) PRICE.
+	Price3932Code = "3932"
+	// Price3933Code is the code of AI (3933) PRICE.
+	Price3933Code = "3933"
+	// Price3934Code is the code of AI (3934) PRICE.
+	Price3934Code = "3934"
+	// Price3935Code is the code of AI (3935) PRICE.
+	Price3935Code = "3935"
+	// Price3936Code is the code of AI (3936) PRICE.
+	Price3936Code = "3936"
+	// Price3937Code is the code of AI (3937) PRICE.
+	Price3937Code = "3937"
+	// Price3938Code is the code of AI (3938) PRICE.
+	Price3938Code = "3938"
+	// Price3939Code is the code of AI (3939) PRICE.
+	Price3939Code = "3939"
+	// PrcntOff3940Code is the code of AI (3940) PRCNT OFF.
+	PrcntOff3940Code = "3940"
+	// PrcntOff3941Code is the code of AI (3941) PRCNT OFF.
+	PrcntOff3941Code = "3941"
+	// PrcntOff3942Code is the code of AI (3942) PRCNT OFF.
+	PrcntOff3942Code = "3942"
+	// PrcntOff3943Code is the code of AI (3943) PRCNT OFF.
+	PrcntOff3943Code = "3943"
+	// PriceUoM3950Code is the code of AI (3950) PRICE/UoM.
+	PriceUoM3950Code = "3950"
+	// PriceUoM3951Code is the code of AI (3951) PRICE/UoM.
+	PriceUoM3951Code = "3951"
+	// PriceUoM3952Code is the code of AI (3952) PRICE/UoM.
+	PriceUoM3952Code = "3952"
+	// PriceUoM3953Code is the code of AI (3953) PRICE/UoM.
+	PriceUoM3953Code = "3953"
+	// PriceUoM3954Code is the code of AI (3954) PRICE/UoM.
+	PriceUoM3954Code = "3954"
+	// PriceUoM3955Code is the code of AI (3955) PRICE/UoM.
+	PriceUoM3955Code = "3955"
+	// OrderNumberCode is the code of AI (400) ORDER NUMBER.
+	OrderNumberCode = "400"
+	// GINCCode is the code of AI (401) GINC.
+	GINCCode = "401"
+	// GSINCode is the code of AI (402) GSIN.
+	GSINCode = "402"
+	// RouteCode is the code of AI (403) ROUTE.
+	RouteCode = "403"
+	// ShipToLoc410Code is the code of AI (410) SHIP TO LOC.
+	ShipToLoc410Code = "410"
+	// BillToCode is the code of AI (411) BILL TO.
+	BillToCode = "411"
+	// PurchaseFromCode is the code of AI (412) PURCHASE FROM.
+	PurchaseFromCode = "412"
+	// ShipForLocCode is the code of AI (413) SHIP FOR LOC.
+	ShipForLocCode = "413"
+	// LocNoCode is the code of AI (414) LOC No.
+	LocNoCode = "414"
+	// PayToCode is the code of AI (415) PAY TO.
+	PayToCode = "415"
+	// ProdServLocCode is the code of AI (416) PROD/SERV LOC.
+	ProdServLocCode = "416"
+	// PartyCode is the code of AI (417) PARTY.
+	PartyCode = "417"
+	// ShipToPost420Code is the code of AI (420) SHIP TO POST.
+	ShipToPost420Code = "420"
+	// ShipToPost421Code is the code of AI (421) SHIP TO POST.
+	ShipToPost421Code = "421"
+	// OriginCode is the code of AI (422) ORIGIN.
+	OriginCode = "422"
+	// CountryInitialProcessCode is the code of AI (423) COUNTRY - INITIAL PROCESS.
+	CountryInitialProcessCode = "423"
+	// CountryProcessCode is the code of AI (424) COUNTRY - PROCESS.
+	CountryProcessCode = "424"
+	// CountryDisassemblyCode is the code of AI (425) COUNTRY - DISASSEMBLY.
+	CountryDisassemblyCode = "425"
+	// CountryFullProcessCode is the code of AI (426) COUNTRY - FULL PROCESS.
+	CountryFullProcessCode = "426"
+	// OriginSubdivisionCode is the code of AI (427) ORIGIN SUBDIVISION.
+	OriginSubdivisionCode = "427"
+	// ShipToCompCode is the code of AI (4300) SHIP TO COMP.
+	ShipToCompCode = "4300"
+	// ShipToNameCode is the code of AI (4301) SHIP TO NAME.
+	ShipToNameCode = "4301"
+	// ShipToAdd1Code is the code of AI (4302) SHIP TO ADD1.
+	ShipToAdd1Code = "4302"
+	// ShipToAdd2Code is the code of AI (4303) SHIP TO ADD2.
+	ShipToAdd2Code = "4303"
+	// ShipToSubCode is the code of AI (4304) SHIP TO SUB.
+	ShipToSubCode = "4304"
+	// ShipToLoc4305Code is the code of AI (4305) SHIP TO LOC.
+	ShipToLoc4305Code = "4305"
+	// ShipToRegCode is the code of AI (4306) SHIP TO REG.
+	ShipToRegCode = "4306"
+	// ShipToCountryCode is the code of AI (4307) SHIP TO COUNTRY.
+	ShipToCountryCode = "4307"
+	// ShipToPhoneCode is the code of AI (4308) SHIP TO PHONE.
+	ShipToPhoneCode = "4308"
+	// ShipToGeoCode is the code of AI (4309) SHIP TO GEO.
+	ShipToGeoCode = "4309"
+	// RTNToCompCode is the code of AI (4310) RTN TO COMP.
+	RTNToCompCode = "4310"
+	// RTNToNameCode is the code of AI (4311) RTN TO NAME.
+	RTNToNameCode = "4311"
+	// RTNToAdd1Code is the code of AI (4312) RTN TO ADD1.
+	RTNToAdd1Code = "4312"
+	// RTNToAdd2Code is the code of AI (4313) RTN TO ADD2.
+	RTNToAdd2Code = "4313"
+	// RTNToSubCode is the code of AI (4314) RTN TO SUB.
+	RTNToSubCode = "4314"
+	// RTNToLocCode is the code of AI (4315) RTN TO LOC.
+	RTNToLocCode = "4315"
+	// RTNToRegCode is the code of AI (4316) RTN TO REG.
+	RTNToRegCode = "4316"
+	// RTNToCountryCode is the code of AI (4317) RTN TO COUNTRY.
+	RTNToCountryCode = "4317"
+	// RTNToPostCode is the code of AI (4318) RTN TO POST.
+	RTNToPostCode = "4318"
+	// RTNToPhoneCode is the code of AI (4319) RTN TO PHONE.
+	RTNToPhoneCode = "4319"
+	// SrvDescriptionCode is the code of AI (4320) SRV DESCRIPTION.
+	SrvDescriptionCode = "4320"
+	// DangerousGoodsCode is the code of AI (4321) DANGEROUS GOODS.
+	DangerousGoodsCode = "4321"
+	// AuthToLeaveCode is the code of AI (4322) AUTH TO LEAVE.
+	AuthToLeaveCode = "4322"
+	// SigRequiredCode is the code of AI (4323) SIG REQUIRED.
+	SigRequiredCode = "4323"
+	// NotBefDelDtCode is the code of AI (4324) NOT BEF DEL DT.
+	NotBefDelDtCode = "4324"
+	// NotAftDelDtCode is the code of AI (4325) NOT AFT DEL DT.
+	NotAftDelDtCode = "4325"
+	// RelDateCode is the code of AI (4326) REL DATE.
+	RelDateCode = "4326"
+	// MaxTempFCode is the code of AI (4330) MAX TEMP F.
+	MaxTempFCode = "4330"
+	// MaxTempCCode is the code of AI (4331) MAX TEMP C.
+	MaxTempCCode = "4331"
+	// MinTempFCode is the code of AI (4332) MIN TEMP F.
+	MinTempFCode = "4332"
+	// MinTempCCode is the code of AI (4333) MIN TEMP C.
+	MinTempCCode = "4333"
+	// NSNCode is the code of AI (7001) NSN.
+	NSNCode = "7001"
+	// MeatCutCode is the code of AI (7002) MEAT CUT.
+	MeatCutCode = "7002"
+	// ExpiryTimeCode is the code of AI (7003) EXPIRY TIME.
+	ExpiryTimeCode = "7003"
+	// ActivePotencyCode is the code of AI (7004) ACTIVE POTENCY.
+	ActivePotencyCode = "7004"
+	// CatchAreaCode is the code of AI (7005) CATCH AREA.
+	CatchAreaCode = "7005"
+	// FirstFreezeDateCode is the code of AI (7006) FIRST FREEZE DATE.
+	FirstFreezeDateCode = "7006"
+	// HarvestDateCode is the code of AI (7007) HARVEST DATE.
+	HarvestDateCode = "7007"
+	// AquaticSpeciesCode is the code of AI (7008) AQUATIC SPECIES.
+	AquaticSpeciesCode = "7008"
+	// FishingGearTypeCode is the code of AI (7009) FISHING GEAR TYPE.
+	FishingGearTypeCode = "7009"
+	// ProdMethodCode is the code of AI (7010) PROD METHOD.
+	ProdMethodCode = "7010"
+	// TestByDateCode is the code of AI (7011) TEST BY DATE.
+	TestByDateCode = "7011"
+	// RefurbLotCode is the code of AI (7020) REFURB LOT.
+	RefurbLotCode = "7020"
+	// FuncStatCode is the code of AI (7021) FUNC STAT.
+	FuncStatCode = "7021"
+	// RevStatCode is the code of AI (7022) REV STAT.
+	RevStatCode = "7022"
+	// GIAIAssemblyCode is the code of AI (7023) GIAI - ASSEMBLY.
+	GIAIAssemblyCode = "7023"
+	// Processor0Code is the code of AI (7030) PROCESSOR # 0.
+	Processor0Code = "7030"
+	// Processor1Code is the code of AI (7031) PROCESSOR # 1.
+	Processor1Code = "7031"
+	// Processor2Code is the code of AI (7032) PROCESSOR # 2.
+	Processor2Code = "7032"
+	// Processor3Code is the code of AI (7033) PROCESSOR # 3.
+	Processor3Code = "7033"
+	// Processor4Code is the code of AI (7034) PROCESSOR # 4.
+	Processor4Code = "7034"
+	// Processor5Code is the code of AI (7035) PROCESSOR # 5.
+	Processor5Code = "7035"
+	// Processor6Code is the code of AI (7036) PROCESSOR # 6.
+	Processor6Code = "7036"
+	// Processor7Code is the code of AI (7037) PROCESSOR # 7.
+	Processor7Code = "7037"
+	// Processor8Code is the code of AI (7038) PROCESSOR # 8.
+	Processor8Code = "7038"
+	// Processor9Code is the code of AI (7039) PROCESSOR # 9.
+	Processor9Code = "7039"
+	// UICEXTCode is the code of AI (7040) UIC+EXT.
+	UICEXTCode = "7040"
+	// UFRGTUnitTypeCode is the code of AI (7041) UFRGT UNIT TYPE.
+	UFRGTUnitTypeCode = "7041"
+	// NHRNPZNCode is the code of AI (710) NHRN PZN.
+	NHRNPZNCode = "710"
+	// NHRNCIPCode is the code of AI (711) NHRN CIP.
+	NHRNCIPCode = "711"
+	// NHRNCNCode is the code of AI (712) NHRN CN.
+	NHRNCNCode = "712"
+	// NHRNDRNCode is the code of AI (713) NHRN DRN.
+	NHRNDRNCode = "713"
+	// NHRNAimCode is the code of AI (714) NHRN AIM.
+	NHRNAimCode = "714"
+	// NHRNNDCCode is the code of AI (715) NHRN NDC.
+	NHRNNDCCode = "715"
+	// NHRNAICCode is the code of AI (716) NHRN AIC.
+	NHRNAICCode = "716"
+	// Cert1Code is the code of AI (7230) CERT # 1.
+	Cert1Code = "7230"
+	// Cert2Code is the code of AI (7231) CERT # 2.
+	Cert2Code = "7231"
+	// Cert3Code is the code of AI (7232) CERT # 3.
+	Cert3Code = "7232"
+	// Cert4Code is the code of AI (7233) CERT # 4.
+	Cert4Code = "7233"
+	// Cert5Code is the code of AI (7234) CERT # 5.
+	Cert5Code = "7234"
+	// Cert6Code is the code of AI (7235) CERT # 6.
+	Cert6Code = "7235"
+	// Cert7Code is the code of AI (7236) CERT # 7.
+	Cert7Code = "7236"
+	// Cert8Code is the code of AI (7237) CERT # 8.
+	Cert8Code = "7237"
+	// Cert9Code is the code of AI (7238) CERT # 9.
+	Cert9Code = "7238"
+	// Cert10Code is the code of AI (7239) CERT # 10.
+	Cert10Code = "7239"
+	// ProtocolCode is the code of AI (7240) PROTOCOL.
+	ProtocolCode = "7240"
+	// AIDCMediaTypeCode is the code of AI (7241) AIDC MEDIA TYPE.
+	AIDCMediaTypeCode = "7241"
+	// VCNCode is the code of AI (7242) VCN.
+	VCNCode = "7242"
+	// DOBCode is the code of AI (7250) DOB.
+	DOBCode = "7250"
+	// DOBTimeCode is the code of AI (7251) DOB TIME.
+	DOBTimeCode = "7251"
+	// BioSexCode is the code of AI (7252) BIO SEX.
+	BioSexCode = "7252"
+	// FamilyNameCode is the code of AI (7253) FAMILY NAME.
+	FamilyNameCode = "7253"
+	// GivenNameCode is the code of AI (7254) GIVEN NAME.
+	GivenNameCode = "7254"
+	// SuffixCode is the code of AI (7255) SUFFIX.
+	SuffixCode = "7255"
+	// FullNameCode is the code of AI (7256) FULL NAME.
+	FullNameCode = "7256"
+	// PersonAddrCode is the code of AI (7257) PERSON ADDR.
+	PersonAddrCode = "7257"
+	// BirthSequenceCode is the code of AI (7258) BIRTH SEQUENCE.
+	BirthSequenceCode = "7258"
+	// BabyCode is the code of AI (7259) BABY.
+	BabyCode = "7259"
+	// DimensionsCode is the code of AI (8001) DIMENSIONS.
+	DimensionsCode = "8001"
+	// CmtNoCode is the code of AI (8002) CMT No.
+	CmtNoCode = "8002"
+	// GRAICode is the code of AI (8003) GRAI.
+	GRAICode = "8003"
+	// GIAICode is the code of AI (8004) GIAI.
+	GIAICode = "8004"
+	// PricePerUnitCode is the code of AI (8005) PRICE PER UNIT.
+	PricePerUnitCode = "8005"
+	// ITIPCode is the code of AI (8006) ITIP.
+	ITIPCode = "8006"
+	// IBANCode is the code of AI (8007) IBAN.
+	IBANCode = "8007"
+	// ProdTimeCode is the code of AI (8008) PROD TIME.
+	ProdTimeCode = "8008"
+	// OptsenCode is the code of AI (8009) OPTSEN.
+	OptsenCode = "8009"
+	// CPIDCode is the code of AI (8010) CPID.
+	CPIDCode = "8010"
+	// CPIDSerialCode is the code of AI (8011) CPID SERIAL.
+	CPIDSerialCode = "8011"
+	// VersionCode is the code of AI (8012) VERSION.
+	VersionCode = "8012"
+	// GMNCode is the code of AI (8013) GMN.
+	GMNCode = "8013"
+	// MUDICode is the code of AI (8014) MUDI.
+	MUDICode = "8014"
+	// GSRNProviderCode is the code of AI (8017) GSRN - PROVIDER.
+	GSRNProviderCode = "8017"
+	// GSRNRecipientCode is the code of AI (8018) GSRN - RECIPIENT.
+	GSRNRecipientCode = "8018"
+	// SRINCode is the code of AI (8019) SRIN.
+	SRINCode = "8019"
+	// RefNoCode is the code of AI (8020) REF No.
+	RefNoCode = "8020"
+	// ITIPContentCode is the code of AI (8026) ITIP CONTENT.
+	ITIPContentCode = "8026"
+	// DigsigCode is the code of AI (8030) DIGSIG.
+	DigsigCode = "8030"
+	// AI8110Code is the code of AI (8110) .
+	AI8110Code = "8110"
+	// PointsCode is the code of AI (8111) POINTS.
+	PointsCode = "8111"
+	// AI8112Code is the code of AI (8112) .
+	AI8112Code = "8112"
+	// ProductURLCode is the code of AI (8200) PRODUCT URL.
+	ProductURLCode = "8200"
+	// Internal90Code is the code of AI (90) INTERNAL.
+	Internal90Code = "90"
+	// Internal91Code is the code of AI (91) INTERNAL.
+	Internal91Code = "91"
+	// Internal92Code is the code of AI (92) INTERNAL.
+	Internal92Code = "92"
+	// Internal93Code is the code of AI (93) INTERNAL.
+	Internal93Code = "93"
+	// Internal94Code is the code of AI (94) INTERNAL.
+	Internal94Code = "94"
+	// Internal95Code is the code of AI (95) INTERNAL.
+	Internal95Code = "95"
+	// Internal96Code is the code of AI (96) INTERNAL.
+	Internal96Code = "96"
+	// Internal97Code is the code of AI (97) INTERNAL.
+	Internal97Code = "97"
+	// Internal98Code is the code of AI (98) INTERNAL.
+	Internal98Code = "98"
+	// Internal99Code is the code of AI (99) INTERNAL.
+	Internal99Code = "99"
+)
+
+// aiComponents are the precomputed specification components of the AIs.
+var aiComponents = map[string][]SpecComponent{
+	"00":   {{Charset: 'N', MinLength: 18, MaxLength: 18, Linters: []string{"csum", "keyoff1"}}},
+	"01":   {{Charset: 'N', MinLength: 14, MaxLength: 14, Linters: []string{"csum", "keyoff1"}}},
+	"02":   {{Charset: 'N', MinLength: 14, MaxLength: 14, Linters: []string{"csum", "keyoff1"}}},
+	"03":   {{Charset: 'N', MinLength: 14, MaxLength: 14, Linters: []string{"csum", "keyoff1"}}},
+	"10":   {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"11":   {{Charset: 'N', MinLength: 6, MaxLength: 6, Linters: []string{"yymmd0"}}},
+	"12":   {{Charset: 'N', MinLength: 6, MaxLength: 6, Linters: []string{"yymmd0"}}},
+	"13":   {{Charset: 'N', MinLength: 6, MaxLength: 6, Linters: []string{"yymmd0"}}},
+	"15":   {{Charset: 'N', MinLength: 6, MaxLength: 6, Linters: []string{"yymmd0"}}},
+	"16":   {{Charset: 'N', MinLength: 6, MaxLength: 6, Linters: []string{"yymmd0"}}},
+	"17":   {{Charset: 'N', MinLength: 6, MaxLength: 6, Linters: []string{"yymmd0"}}},
+	"20":   {{Charset: 'N', MinLength: 2, MaxLength: 2}},
+	"21":   {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"22":   {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"235":  {{Charset: 'X', MinLength: 1, MaxLength: 28}},
+	"240":  {{Charset: 'X', MinLength: 1, MaxLength: 30}},
+	"241":  {{Charset: 'X', MinLength: 1, MaxLength: 30}},
+	"242":  {{Charset: 'N', MinLength: 1, MaxLength: 6}},
+	"243":  {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"250":  {{Charset: 'X', MinLength: 1, MaxLength: 30}},
+	"251":  {{Charset: 'X', MinLength: 1, MaxLength: 30}},
+	"253":  {{Charset: 'N', MinLength: 13, MaxLength: 13, Linters: []string{"csum", "key"}}, {Charset: 'X', MinLength: 1, MaxLength: 17, Optional: true}},
+	"254":  {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"255":  {{Charset: 'N', MinLength: 13, MaxLength: 13, Linters: []string{"csum", "key"}}, {Charset: 'N', MinLength: 1, MaxLength: 12, Optional: true}},
+	"30":   {{Charset: 'N', MinLength: 1, MaxLength: 8}},
+	"3100": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3101": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3102": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3103": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3104": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3105": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3110": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3111": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3112": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3113": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3114": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3115": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3120": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3121": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3122": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3123": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3124": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3125": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3130": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3131": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3132": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3133": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3134": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3135": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3140": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3141": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3142": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3143": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3144": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3145": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3150": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3151": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3152": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3153": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3154": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3155": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3160": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3161": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3162": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3163": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3164": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3165": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3200": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3201": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3202": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3203": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3204": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3205": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3210": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3211": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3212": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3213": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3214": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3215": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3220": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3221": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3222": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3223": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3224": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3225": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3230": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3231": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3232": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3233": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3234": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3235": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3240": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3241": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3242": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3243": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3244": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3245": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3250": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3251": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3252": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3253": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3254": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3255": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3260": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3261": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3262": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3263": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3264": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3265": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3270": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3271": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3272": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3273": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3274": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3275": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3280": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3281": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3282": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3283": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3284": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3285": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3290": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3291": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3292": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3293": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3294": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3295": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3300": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3301": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3302": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3303": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3304": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3305": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3310": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3311": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3312": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3313": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3314": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3315": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3320": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3321": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3322": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3323": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3324": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3325": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3330": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3331": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3332": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3333": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3334": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3335": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3340": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3341": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3342": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3343": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3344": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3345": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3350": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3351": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3352": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3353": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3354": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3355": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3360": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3361": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3362": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3363": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3364": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3365": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3370": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3371": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3372": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3373": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3374": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3375": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3400": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3401": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3402": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3403": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3404": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3405": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3410": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3411": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3412": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3413": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3414": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3415": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3420": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3421": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3422": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3423": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3424": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3425": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3430": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3431": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3432": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3433": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3434": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3435": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3440": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3441": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3442": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3443": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3444": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3445": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3450": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3451": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3452": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3453": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3454": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3455": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3460": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3461": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3462": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3463": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3464": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3465": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3470": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3471": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3472": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3473": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3474": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3475": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3480": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3481": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3482": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3483": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3484": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3485": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3490": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3491": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3492": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3493": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3494": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3495": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3500": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3501": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3502": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3503": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3504": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3505": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3510": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3511": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3512": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3513": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3514": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3515": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3520": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3521": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3522": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3523": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3524": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3525": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3530": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3531": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3532": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3533": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3534": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3535": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3540": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3541": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3542": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3543": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3544": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3545": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3550": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3551": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3552": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3553": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3554": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3555": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3560": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3561": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3562": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3563": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3564": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3565": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3570": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3571": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3572": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3573": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3574": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3575": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3600": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3601": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3602": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3603": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3604": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3605": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3610": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3611": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3612": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3613": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3614": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3615": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3620": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3621": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3622": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3623": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3624": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3625": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3630": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3631": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3632": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3633": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3634": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3635": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3640": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3641": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3642": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3643": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3644": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3645": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3650": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3651": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3652": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3653": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3654": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3655": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3660": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3661": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3662": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3663": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3664": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3665": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3670": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3671": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3672": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3673": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3674": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3675": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3680": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3681": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3682": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3683": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3684": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3685": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3690": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3691": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3692": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3693": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3694": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3695": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"37":   {{Charset: 'N', MinLength: 1, MaxLength: 8}},
+	"3900": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3901": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3902": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3903": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3904": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3905": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3906": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3907": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3908": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3909": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3910": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3911": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3912": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3913": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3914": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3915": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3916": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3917": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3918": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3919": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3920": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3921": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3922": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3923": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3924": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3925": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3926": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3927": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3928": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3929": {{Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3930": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3931": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3932": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3933": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3934": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3935": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3936": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3937": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3938": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3939": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso4217"}}, {Charset: 'N', MinLength: 1, MaxLength: 15}},
+	"3940": {{Charset: 'N', MinLength: 4, MaxLength: 4}},
+	"3941": {{Charset: 'N', MinLength: 4, MaxLength: 4}},
+	"3942": {{Charset: 'N', MinLength: 4, MaxLength: 4}},
+	"3943": {{Charset: 'N', MinLength: 4, MaxLength: 4}},
+	"3950": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3951": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3952": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3953": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3954": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"3955": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"400":  {{Charset: 'X', MinLength: 1, MaxLength: 30}},
+	"401":  {{Charset: 'X', MinLength: 1, MaxLength: 30, Linters: []string{"key"}}},
+	"402":  {{Charset: 'N', MinLength: 17, MaxLength: 17, Linters: []string{"csum", "key"}}},
+	"403":  {{Charset: 'X', MinLength: 1, MaxLength: 30}},
+	"410":  {{Charset: 'N', MinLength: 13, MaxLength: 13, Linters: []string{"csum", "key"}}},
+	"411":  {{Charset: 'N', MinLength: 13, MaxLength: 13, Linters: []string{"csum", "key"}}},
+	"412":  {{Charset: 'N', MinLength: 13, MaxLength: 13, Linters: []string{"csum", "key"}}},
+	"413":  {{Charset: 'N', MinLength: 13, MaxLength: 13, Linters: []string{"csum", "key"}}},
+	"414":  {{Charset: 'N', MinLength: 13, MaxLength: 13, Linters: []string{"csum", "key"}}},
+	"415":  {{Charset: 'N', MinLength: 13, MaxLength: 13, Linters: []string{"csum", "key"}}},
+	"416":  {{Charset: 'N', MinLength: 13, MaxLength: 13, Linters: []string{"csum", "key"}}},
+	"417":  {{Charset: 'N', MinLength: 13, MaxLength: 13, Linters: []string{"csum", "key"}}},
+	"420":  {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"421":  {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166"}}, {Charset: 'X', MinLength: 1, MaxLength: 9}},
+	"422":  {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166"}}},
+	"423":  {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166"}}, {Charset: 'N', MinLength: 3, MaxLength: 3, Optional: true, Linters: []string{"iso3166"}}, {Charset: 'N', MinLength: 3, MaxLength: 3, Optional: true, Linters: []string{"iso3166"}}, {Charset: 'N', MinLength: 3, MaxLength: 3, Optional: true, Linters: []string{"iso3166"}}, {Charset: 'N', MinLength: 3, MaxLength: 3, Optional: true, Linters: []string{"iso3166"}}},
+	"424":  {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166"}}},
+	"425":  {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166"}}, {Charset: 'N', MinLength: 3, MaxLength: 3, Optional: true, Linters: []string{"iso3166"}}, {Charset: 'N', MinLength: 3, MaxLength: 3, Optional: true, Linters: []string{"iso3166"}}, {Charset: 'N', MinLength: 3, MaxLength: 3, Optional: true, Linters: []string{"iso3166"}}, {Charset: 'N', MinLength: 3, MaxLength: 3, Optional: true, Linters: []string{"iso3166"}}},
+	"426":  {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166"}}},
+	"427":  {{Charset: 'X', MinLength: 1, MaxLength: 3}},
+	"4300": {{Charset: 'X', MinLength: 1, MaxLength: 35, Linters: []string{"pcenc"}}},
+	"4301": {{Charset: 'X', MinLength: 1, MaxLength: 35, Linters: []string{"pcenc"}}},
+	"4302": {{Charset: 'X', MinLength: 1, MaxLength: 70, Linters: []string{"pcenc"}}},
+	"4303": {{Charset: 'X', MinLength: 1, MaxLength: 70, Linters: []string{"pcenc"}}},
+	"4304": {{Charset: 'X', MinLength: 1, MaxLength: 70, Linters: []string{"pcenc"}}},
+	"4305": {{Charset: 'X', MinLength: 1, MaxLength: 70, Linters: []string{"pcenc"}}},
+	"4306": {{Charset: 'X', MinLength: 1, MaxLength: 70, Linters: []string{"pcenc"}}},
+	"4307": {{Charset: 'X', MinLength: 2, MaxLength: 2, Linters: []string{"iso3166alpha2"}}},
+	"4308": {{Charset: 'X', MinLength: 1, MaxLength: 30}},
+	"4309": {{Charset: 'N', MinLength: 10, MaxLength: 10, Linters: []string{"latitude"}}, {Charset: 'N', MinLength: 10, MaxLength: 10, Linters: []string{"longitude"}}},
+	"4310": {{Charset: 'X', MinLength: 1, MaxLength: 35, Linters: []string{"pcenc"}}},
+	"4311": {{Charset: 'X', MinLength: 1, MaxLength: 35, Linters: []string{"pcenc"}}},
+	"4312": {{Charset: 'X', MinLength: 1, MaxLength: 70, Linters: []string{"pcenc"}}},
+	"4313": {{Charset: 'X', MinLength: 1, MaxLength: 70, Linters: []string{"pcenc"}}},
+	"4314": {{Charset: 'X', MinLength: 1, MaxLength: 70, Linters: []string{"pcenc"}}},
+	"4315": {{Charset: 'X', MinLength: 1, MaxLength: 70, Linters: []string{"pcenc"}}},
+	"4316": {{Charset: 'X', MinLength: 1, MaxLength: 70, Linters: []string{"pcenc"}}},
+	"4317": {{Charset: 'X', MinLength: 2, MaxLength: 2, Linters: []string{"iso3166alpha2"}}},
+	"4318": {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"4319": {{Charset: 'X', MinLength: 1, MaxLength: 30}},
+	"4320": {{Charset: 'X', MinLength: 1, MaxLength: 35, Linters: []string{"pcenc"}}},
+	"4321": {{Charset: 'N', MinLength: 1, MaxLength: 1, Linters: []string{"yesno"}}},
+	"4322": {{Charset: 'N', MinLength: 1, MaxLength: 1, Linters: []string{"yesno"}}},
+	"4323": {{Charset: 'N', MinLength: 1, MaxLength: 1, Linters: []string{"yesno"}}},
+	"4324": {{Charset: 'N', MinLength: 6, MaxLength: 6, Linters: []string{"yymmd0"}}, {Charset: 'N', MinLength: 4, MaxLength: 4, Linters: []string{"hhmi"}}},
+	"4325": {{Charset: 'N', MinLength: 6, MaxLength: 6, Linters: []string{"yymmd0"}}, {Charset: 'N', MinLength: 4, MaxLength: 4, Linters: []string{"hhmi"}}},
+	"4326": {{Charset: 'N', MinLength: 6, MaxLength: 6, Linters: []string{"yymmdd"}}},
+	"4330": {{Charset: 'N', MinLength: 6, MaxLength: 6}, {Charset: 'X', MinLength: 1, MaxLength: 1, Optional: true, Linters: []string{"hyphen"}}},
+	"4331": {{Charset: 'N', MinLength: 6, MaxLength: 6}, {Charset: 'X', MinLength: 1, MaxLength: 1, Optional: true, Linters: []string{"hyphen"}}},
+	"4332": {{Charset: 'N', MinLength: 6, MaxLength: 6}, {Charset: 'X', MinLength: 1, MaxLength: 1, Optional: true, Linters: []string{"hyphen"}}},
+	"4333": {{Charset: 'N', MinLength: 6, MaxLength: 6}, {Charset: 'X', MinLength: 1, MaxLength: 1, Optional: true, Linters: []string{"hyphen"}}},
+	"7001": {{Charset: 'N', MinLength: 13, MaxLength: 13}},
+	"7002": {{Charset: 'X', MinLength: 1, MaxLength: 30}},
+	"7003": {{Charset: 'N', MinLength: 6, MaxLength: 6, Linters: []string{"yymmdd"}}, {Charset: 'N', MinLength: 4, MaxLength: 4, Linters: []string{"hhmi"}}},
+	"7004": {{Charset: 'N', MinLength: 1, MaxLength: 4}},
+	"7005": {{Charset: 'X', MinLength: 1, MaxLength: 12}},
+	"7006": {{Charset: 'N', MinLength: 6, MaxLength: 6, Linters: []string{"yymmdd"}}},
+	"7007": {{Charset: 'N', MinLength: 6, MaxLength: 6, Linters: []string{"yymmdd"}}, {Charset: 'N', MinLength: 6, MaxLength: 6, Optional: true, Linters: []string{"yymmdd"}}},
+	"7008": {{Charset: 'X', MinLength: 1, MaxLength: 3}},
+	"7009": {{Charset: 'X', MinLength: 1, MaxLength: 10}},
+	"7010": {{Charset: 'X', MinLength: 1, MaxLength: 2}},
+	"7011": {{Charset: 'N', MinLength: 6, MaxLength: 6, Linters: []string{"yymmdd"}}, {Charset: 'N', MinLength: 4, MaxLength: 4, Optional: true, Linters: []string{"hhmi"}}},
+	"7020": {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"7021": {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"7022": {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"7023": {{Charset: 'X', MinLength: 1, MaxLength: 30, Linters: []string{"key"}}},
+	"7030": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166999"}}, {Charset: 'X', MinLength: 1, MaxLength: 27}},
+	"7031": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166999"}}, {Charset: 'X', MinLength: 1, MaxLength: 27}},
+	"7032": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166999"}}, {Charset: 'X', MinLength: 1, MaxLength: 27}},
+	"7033": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166999"}}, {Charset: 'X', MinLength: 1, MaxLength: 27}},
+	"7034": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166999"}}, {Charset: 'X', MinLength: 1, MaxLength: 27}},
+	"7035": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166999"}}, {Charset: 'X', MinLength: 1, MaxLength: 27}},
+	"7036": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166999"}}, {Charset: 'X', MinLength: 1, MaxLength: 27}},
+	"7037": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166999"}}, {Charset: 'X', MinLength: 1, MaxLength: 27}},
+	"7038": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166999"}}, {Charset: 'X', MinLength: 1, MaxLength: 27}},
+	"7039": {{Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"iso3166999"}}, {Charset: 'X', MinLength: 1, MaxLength: 27}},
+	"7040": {{Charset: 'N', MinLength: 1, MaxLength: 1}, {Charset: 'X', MinLength: 1, MaxLength: 1}, {Charset: 'X', MinLength: 1, MaxLength: 1}, {Charset: 'X', MinLength: 1, MaxLength: 1, Linters: []string{"importeridx"}}},
+	"7041": {{Charset: 'X', MinLength: 1, MaxLength: 4, Linters: []string{"packagetype"}}},
+	"710":  {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"711":  {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"712":  {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"713":  {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"714":  {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"715":  {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"716":  {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"7230": {{Charset: 'X', MinLength: 2, MaxLength: 2}, {Charset: 'X', MinLength: 1, MaxLength: 28}},
+	"7231": {{Charset: 'X', MinLength: 2, MaxLength: 2}, {Charset: 'X', MinLength: 1, MaxLength: 28}},
+	"7232": {{Charset: 'X', MinLength: 2, MaxLength: 2}, {Charset: 'X', MinLength: 1, MaxLength: 28}},
+	"7233": {{Charset: 'X', MinLength: 2, MaxLength: 2}, {Charset: 'X', MinLength: 1, MaxLength: 28}},
+	"7234": {{Charset: 'X', MinLength: 2, MaxLength: 2}, {Charset: 'X', MinLength: 1, MaxLength: 28}},
+	"7235": {{Charset: 'X', MinLength: 2, MaxLength: 2}, {Charset: 'X', MinLength: 1, MaxLength: 28}},
+	"7236": {{Charset: 'X', MinLength: 2, MaxLength: 2}, {Charset: 'X', MinLength: 1, MaxLength: 28}},
+	"7237": {{Charset: 'X', MinLength: 2, MaxLength: 2}, {Charset: 'X', MinLength: 1, MaxLength: 28}},
+	"7238": {{Charset: 'X', MinLength: 2, MaxLength: 2}, {Charset: 'X', MinLength: 1, MaxLength: 28}},
+	"7239": {{Charset: 'X', MinLength: 2, MaxLength: 2}, {Charset: 'X', MinLength: 1, MaxLength: 28}},
+	"7240": {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"7241": {{Charset: 'N', MinLength: 2, MaxLength: 2, Linters: []string{"mediatype"}}},
+	"7242": {{Charset: 'X', MinLength: 1, MaxLength: 25}},
+	"7250": {{Charset: 'N', MinLength: 8, MaxLength: 8, Linters: []string{"yyyymmdd"}}},
+	"7251": {{Charset: 'N', MinLength: 8, MaxLength: 8, Linters: []string{"yyyymmdd"}}, {Charset: 'N', MinLength: 4, MaxLength: 4, Linters: []string{"hhmi"}}},
+	"7252": {{Charset: 'N', MinLength: 1, MaxLength: 1, Linters: []string{"iso5218"}}},
+	"7253": {{Charset: 'X', MinLength: 1, MaxLength: 40, Linters: []string{"pcenc"}}},
+	"7254": {{Charset: 'X', MinLength: 1, MaxLength: 40, Linters: []string{"pcenc"}}},
+	"7255": {{Charset: 'X', MinLength: 1, MaxLength: 10}},
+	"7256": {{Charset: 'X', MinLength: 1, MaxLength: 90, Linters: []string{"pcenc"}}},
+	"7257": {{Charset: 'X', MinLength: 1, MaxLength: 70, Linters: []string{"pcenc"}}},
+	"7258": {{Charset: 'X', MinLength: 3, MaxLength: 3, Linters: []string{"posinseqslash"}}},
+	"7259": {{Charset: 'X', MinLength: 1, MaxLength: 40, Linters: []string{"pcenc"}}},
+	"8001": {{Charset: 'N', MinLength: 4, MaxLength: 4, Linters: []string{"nonzero"}}, {Charset: 'N', MinLength: 5, MaxLength: 5, Linters: []string{"nonzero"}}, {Charset: 'N', MinLength: 3, MaxLength: 3, Linters: []string{"nonzero"}}, {Charset: 'N', MinLength: 1, MaxLength: 1, Linters: []string{"winding"}}, {Charset: 'N', MinLength: 1, MaxLength: 1}},
+	"8002": {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"8003": {{Charset: 'N', MinLength: 1, MaxLength: 1, Linters: []string{"zero"}}, {Charset: 'N', MinLength: 13, MaxLength: 13, Linters: []string{"csum", "key"}}, {Charset: 'X', MinLength: 1, MaxLength: 16, Optional: true}},
+	"8004": {{Charset: 'X', MinLength: 1, MaxLength: 30, Linters: []string{"key"}}},
+	"8005": {{Charset: 'N', MinLength: 6, MaxLength: 6}},
+	"8006": {{Charset: 'N', MinLength: 14, MaxLength: 14, Linters: []string{"csum"}}, {Charset: 'N', MinLength: 4, MaxLength: 4, Linters: []string{"pieceoftotal"}}},
+	"8007": {{Charset: 'X', MinLength: 1, MaxLength: 34, Linters: []string{"iban"}}},
+	"8008": {{Charset: 'N', MinLength: 6, MaxLength: 6, Linters: []string{"yymmdd"}}, {Charset: 'N', MinLength: 2, MaxLength: 2, Linters: []string{"hh"}}, {Charset: 'N', MinLength: 2, MaxLength: 2, Optional: true, Linters: []string{"mi"}}, {Charset: 'N', MinLength: 2, MaxLength: 2, Optional: true, Linters: []string{"ss"}}},
+	"8009": {{Charset: 'X', MinLength: 1, MaxLength: 50}},
+	"8010": {{Charset: 'Y', MinLength: 1, MaxLength: 30, Linters: []string{"key"}}},
+	"8011": {{Charset: 'N', MinLength: 1, MaxLength: 12, Linters: []string{"nozeroprefix"}}},
+	"8012": {{Charset: 'X', MinLength: 1, MaxLength: 20}},
+	"8013": {{Charset: 'X', MinLength: 1, MaxLength: 25, Linters: []string{"csumalpha", "key"}}},
+	"8014": {{Charset: 'X', MinLength: 1, MaxLength: 25, Linters: []string{"csumalpha", "key", "hasnondigit"}}},
+	"8017": {{Charset: 'N', MinLength: 18, MaxLength: 18, Linters: []string{"csum", "key"}}},
+	"8018": {{Charset: 'N', MinLength: 18, MaxLength: 18, Linters: []string{"csum", "key"}}},
+	"8019": {{Charset: 'N', MinLength: 1, MaxLength: 10}},
+	"8020": {{Charset: 'X', MinLength: 1, MaxLength: 25}},
+	"8026": {{Charset: 'N', MinLength: 14, MaxLength: 14, Linters: []string{"csum"}}, {Charset: 'N', MinLength: 4, MaxLength: 4, Linters: []string{"pieceoftotal"}}},
+	"8030": {{Charset: 'Z', MinLength: 1, MaxLength: 90}},
+	"8110": {{Charset: 'X', MinLength: 1, MaxLength: 70, Linters: []string{"couponcode"}}},
+	"8111": {{Charset: 'N', MinLength: 4, MaxLength: 4}},
+	"8112": {{Charset: 'X', MinLength: 1, MaxLength: 70, Linters: []string{"couponposoffer"}}},
+	"8200": {{Charset: 'X', MinLength: 1, MaxLength: 70}},
+	"90":   {{Charset: 'X', MinLength: 1, MaxLength: 30}},
+	"91":   {{Charset: 'X', MinLength: 1, MaxLength: 90}},
+	"92":   {{Charset: 'X', MinLength: 1, MaxLength: 90}},
+	"93":   {{Charset: 'X', MinLength: 1, MaxLength: 90}},
+	"94":   {{Charset: 'X', MinLength: 1, MaxLength: 90}},
+	"95":   {{Charset: 'X', MinLength: 1, MaxLength: 90}},
+	"96":   {{Charset: 'X', MinLength: 1, MaxLength: 90}},
+	"97":   {{Charset: 'X', MinLength: 1, MaxLength: 90}},
+	"98":   {{Charset: 'X', MinLength: 1, MaxLength: 90}},
+	"99":   {{Charset: 'X', MinLength: 1, MaxLength: 90}},
+}
+
+// aiDigitalLinkSpecs are the precomputed Digital Link specs of the AIs.
+var aiDigitalLinkSpecs = map[string]DigitalLinkSpec{
+	"00":   {IsValidDataAttribute: true, IsValidPrimaryKey: true},
+	"01":   {IsValidDataAttribute: true, IsValidPrimaryKey: true, AllowedQualifiers: [][]ApplicationIdentifier{{AI22, AI10, AI21}, {AI235}}},
+	"02":   {IsValidDataAttribute: true},
+	"03":   {},
+	"10":   {IsValidDataAttribute: true},
+	"11":   {IsValidDataAttribute: true},
+	"12":   {IsValidDataAttribute: true},
+	"13":   {IsValidDataAttribute: true},
+	"15":   {IsValidDataAttribute: true},
+	"16":   {IsValidDataAttribute: true},
+	"17":   {IsValidDataAttribute: true},
+	"20":   {IsValidDataAttribute: true},
+	"21":   {},
+	"22":   {},
+	"235":  {},
+	"240":  {IsValidDataAttribute: true},
+	"241":  {IsValidDataAttribute: true},
+	"242":  {IsValidDataAttribute: true},
+	"243":  {IsValidDataAttribute: true},
+	"250":  {IsValidDataAttribute: true},
+	"251":  {IsValidDataAttribute: true},
+	"253":  {IsValidDataAttribute: true, IsValidPrimaryKey: true},
+	"254":  {},
+	"255":  {IsValidDataAttribute: true, IsValidPrimaryKey: true},
+	"30":   {IsValidDataAttribute: true},
+	"3100": {IsValidDataAttribute: true},
+	"3101": {IsValidDataAttribute: true},
+	"3102": {IsValidDataAttribute: true},
+	"3103": {IsValidDataAttribute: true},
+	"3104": {IsValidDataAttribute: true},
+	"3105": {IsValidDataAttribute: true},
+	"3110": {IsValidDataAttribute: true},
+	"3111": {IsValidDataAttribute: true},
+	"3112": {IsValidDataAttribute: true},
+	"3113": {IsValidDataAttribute: true},
+	"3114": {IsValidDataAttribute: true},
+	"3115": {IsValidDataAttribute: true},
+	"3120": {IsValidDataAttribute: true},
+	"3121": {IsValidDataAttribute: true},
+	"3122": {IsValidDataAttribute: true},
+	"3123": {IsValidDataAttribute: true},
+	"3124": {IsValidDataAttribute: true},
+	"3125": {IsValidDataAttribute: true},
+	"3130": {IsValidDataAttribute: true},
+	"3131": {IsValidDataAttribute: true},
+	"3132": {IsValidDataAttribute: true},
+	"3133": {IsValidDataAttribute: true},
+	"3134": {IsValidDataAttribute: true},
+	"3135": {IsValidDataAttribute: true},
+	"3140": {IsValidDataAttribute: true},
+	"3141": {IsValidDataAttribute: true},
+	"3142": {IsValidDataAttribute: true},
+	"3143": {IsValidDataAttribute: true},
+	"3144": {IsValidDataAttribute: true},
+	"3145": {IsValidDataAttribute: true},
+	"3150": {IsValidDataAttribute: true},
+	"3151": {IsValidDataAttribute: true},
+	"3152": {IsValidDataAttribute: true},
+	"3153": {IsValidDataAttribute: true},
+	"3154": {IsValidDataAttribute: true},
+	"3155": {IsValidDataAttribute: true},
+	"3160": {IsValidDataAttribute: true},
+	"3161": {IsValidDataAttribute: true},
+	"3162": {IsValidDataAttribute: true},
+	"3163": {IsValidDataAttribute: true},
+	"3164": {IsValidDataAttribute: true},
+	"3165": {IsValidDataAttribute: true},
+	"3200": {IsValidDataAttribute: true},
+	"3201": {IsValidDataAttribute: true},
+	"3202": {IsValidDataAttribute: true},
+	"3203": {IsValidDataAttribute: true},
+	"3204": {IsValidDataAttribute: true},
+	"3205": {IsValidDataAttribute: true},
+	"3210": {IsValidDataAttribute: true},
+	"3211": {IsValidDataAttribute: true},
+	"3212": {IsValidDataAttribute: true},
+	"3213": {IsValidDataAttribute: true},
+	"3214": {IsValidDataAttribute: true},
+	"3215": {IsValidDataAttribute: true},
+	"3220": {IsValidDataAttribute: true},
+	"3221": {IsValidDataAttribute: true},
+	"3222": {IsValidDataAttribute: true},
+	"3223": {IsValidDataAttribute: true},
+	"3224": {IsValidDataAttribute: true},
+	"3225": {IsValidDataAttribute: true},
+	"3230": {IsValidDataAttribute: true},
+	"3231": {IsValidDataAttribute: true},
+	"3232": {IsValidDataAttribute: true},
+	"3233": {IsValidDataAttribute: true},
+	"3234": {IsValidDataAttribute: true},
+	"3235": {IsValidDataAttribute: true},
+	"3240": {IsValidDataAttribute: true},
+	"3241": {IsValidDataAttribute: true},
+	"3242": {IsValidDataAttribute: true},
+	"3243": {IsValidDataAttribute: true},
+	"3244": {IsValidDataAttribute: true},
+	"3245": {IsValidDataAttribute: true},
+	"3250": {IsValidDataAttribute: true},
+	"3251": {IsValidDataAttribute: true},
+	"3252": {IsValidDataAttribute: true},
+	"3253": {IsValidDataAttribute: true},
+	"3254": {IsValidDataAttribute: true},
+	"3255": {IsValidDataAttribute: true},
+	"3260": {IsValidDataAttribute: true},
+	"3261": {IsValidDataAttribute: true},
+	"3262": {IsValidDataAttribute: true},
+	"3263": {IsValidDataAttribute: true},
+	"3264": {IsValidDataAttribute: true},
+	"3265": {IsValidDataAttribute: true},
+	"3270": {IsValidDataAttribute: true},
+	"3271": {IsValidDataAttribute: true},
+	"3272": {IsValidDataAttribute: true},
+	"3273": {IsValidDataAttribute: true},
+	"3274": {IsValidDataAttribute: true},
+	"3275": {IsValidDataAttribute: true},
+	"3280": {IsValidDataAttribute: true},
+	"3281": {IsValidDataAttribute: true},
+	"3282": {IsValidDataAttribute: true},
+	"3283": {IsValidDataAttribute: true},
+	"3284": {IsValidDataAttribute: true},
+	"3285": {IsValidDataAttribute: true},
+	"3290": {IsValidDataAttribute: true},
+	"3291": {IsValidDataAttribute: true},
+	"3292": {IsValidDataAttribute: true},
+	"3293": {IsValidDataAttribute: true},
+	"3294": {IsValidDataAttribute: true},
+	"3295": {IsValidDataAttribute: true},
+	"3300": {IsValidDataAttribute: true},
+	"3301": {IsValidDataAttribute: true},
+	"3302": {IsValidDataAttribute: true},
+	"3303": {IsValidDataAttribute: true},
+	"3304": {IsValidDataAttribute: true},
+	"3305": {IsValidDataAttribute: true},
+	"3310": {IsValidDataAttribute: true},
+	"3311": {IsValidDataAttribute: true},
+	"3312": {IsValidDataAttribute: true},
+	"3313": {IsValidDataAttribute: true},
+	"3314": {IsValidDataAttribute: true},
+	"3315": {IsValidDataAttribute: true},
+	"3320": {IsValidDataAttribute: true},
+	"3321": {IsValidDataAttribute: true},
+	"3322": {IsValidDataAttribute: true},
+	"3323": {IsValidDataAttribute: true},
+	"3324": {IsValidDataAttribute: true},
+	"3325": {IsValidDataAttribute: true},
+	"3330": {IsValidDataAttribute: true},
+	"3331": {IsValidDataAttribute: true},
+	"3332": {IsValidDataAttribute: true},
+	"3333": {IsValidDataAttribute: true},
+	"3334": {IsValidDataAttribute: true},
+	"3335": {IsValidDataAttribute: true},
+	"3340": {IsValidDataAttribute: true},
+	"3341": {IsValidDataAttribute: true},
+	"3342": {IsValidDataAttribute: true},
+	"3343": {IsValidDataAttribute: true},
+	"3344": {IsValidDataAttribute: true},
+	"3345": {IsValidDataAttribute: true},
+	"3350": {IsValidDataAttribute: true},
+	"3351": {IsValidDataAttribute: true},
+	"3352": {IsValidDataAttribute: true},
+	"3353": {IsValidDataAttribute: true},
+	"3354": {IsValidDataAttribute: true},
+	"3355": {IsValidDataAttribute: true},
+	"3360": {IsValidDataAttribute: true},
+	"3361": {IsValidDataAttribute: true},
+	"3362": {IsValidDataAttribute: true},
+	"3363": {IsValidDataAttribute: true},
+	"3364": {IsValidDataAttribute: true},
+	"3365": {IsValidDataAttribute: true},
+	"3370": {IsValidDataAttribute: true},
+	"3371": {IsValidDataAttribute: true},
+	"3372": {IsValidDataAttribute: true},
+	"3373": {IsValidDataAttribute: true},
+	"3374": {IsValidDataAttribute: true},
+	"3375": {IsValidDataAttribute: true},
+	"3400": {IsValidDataAttribute: true},
+	"3401": {IsValidDataAttribute: true},
+	"3402": {IsValidDataAttribute: true},
+	"3403": {IsValidDataAttribute: true},
+	"3404": {IsValidDataAttribute: true},
+	"3405": {IsValidDataAttribute: true},
+	"3410": {IsValidDataAttribute: true},
+	"3411": {IsValidDataAttribute: true},
+	"3412": {IsValidDataAttribute: true},
+	"3413": {IsValidDataAttribute: true},
+	"3414": {IsValidDataAttribute: true},
+	"3415": {IsValidDataAttribute: true},
+	"3420": {IsValidDataAttribute: true},
+	"3421": {IsValidDataAttribute: true},
+	"3422": {IsValidDataAttribute: true},
+	"3423": {IsValidDataAttribute: true},
+	"3424": {IsValidDataAttribute: true},
+	"3425": {IsValidDataAttribute: true},
+	"3430": {IsValidDataAttribute: true},
+	"3431": {IsValidDataAttribute: true},
+	"3432": {IsValidDataAttribute: true},
+	"3433": {IsValidDataAttribute: true},
+	"3434": {IsValidDataAttribute: true},
+	"3435": {IsValidDataAttribute: true},
+	"3440": {IsValidDataAttribute: true},
+	"3441": {IsValidDataAttribute: true},
+	"3442": {IsValidDataAttribute: true},
+	"3443": {IsValidDataAttribute: true},
+	"3444": {IsValidDataAttribute: true},
+	"3445": {IsValidDataAttribute: true},
+	"3450": {IsValidDataAttribute: true},
+	"3451": {IsValidDataAttribute: true},
+	"3452": {IsValidDataAttribute: true},
+	"3453": {IsValidDataAttribute: true},
+	"3454": {IsValidDataAttribute: true},
+	"3455": {IsValidDataAttribute: true},
+	"3460": {IsValidDataAttribute: true},
+	"3461": {IsValidDataAttribute: true},
+	"3462": {IsValidDataAttribute: true},
+	"3463": {IsValidDataAttribute: true},
+	"3464": {IsValidDataAttribute: true},
+	"3465": {IsValidDataAttribute: true},
+	"3470": {IsValidDataAttribute: true},
+	"3471": {IsValidDataAttribute: true},
+	"3472": {IsValidDataAttribute: true},
+	"3473": {IsValidDataAttribute: true},
+	"3474": {IsValidDataAttribute: true},
+	"3475": {IsValidDataAttribute: true},
+	"3480": {IsValidDataAttribute: true},
+	"3481": {IsValidDataAttribute: true},
+	"3482": {IsValidDataAttribute: true},
+	"3483": {IsValidDataAttribute: true},
+	"3484": {IsValidDataAttribute: true},
+	"3485": {IsValidDataAttribute: true},
+	"3490": {IsValidDataAttribute: true},
+	"3491": {IsValidDataAttribute: true},
+	"3492": {IsValidDataAttribute: true},
+	"3493": {IsValidDataAttribute: true},
+	"3494": {IsValidDataAttribute: true},
+	"3495": {IsValidDataAttribute: true},
+	"3500": {IsValidDataAttribute: true},
+	"3501": {IsValidDataAttribute: true},
+	"3502": {IsValidDataAttribute: true},
+	"3503": {IsValidDataAttribute: true},
+	"3504": {IsValidDataAttribute: true},
+	"3505": {IsValidDataAttribute: true},
+	"3510": {IsValidDataAttribute: true},
+	"3511": {IsValidDataAttribute: true},
+	"3512": {IsValidDataAttribute: true},
+	"3513": {IsValidDataAttribute: true},
+	"3514": {IsValidDataAttribute: true},
+	"3515": {IsValidDataAttribute: true},
+	"3520": {IsValidDataAttribute: true},
+	"3521": {IsValidDataAttribute: true},
+	"3522": {IsValidDataAttribute: true},
+	"3523": {IsValidDataAttribute: true},
+	"3524": {IsValidDataAttribute: true},
+	"3525": {IsValidDataAttribute: true},
+	"3530": {IsValidDataAttribute: true},
+	"3531": {IsValidDataAttribute: true},
+	"3532": {IsValidDataAttribute: true},
+	"3533": {IsValidDataAttribute: true},
+	"3534": {IsValidDataAttribute: true},
+	"3535": {IsValidDataAttribute: true},
+	"3540": {IsValidDataAttribute: true},
+	"3541": {IsValidDataAttribute: true},
+	"3542": {IsValidDataAttribute: true},
+	"3543": {IsValidDataAttribute: true},
+	"3544": {IsValidDataAttribute: true},
+	"3545": {IsValidDataAttribute: true},
+	"3550": {IsValidDataAttribute: true},
+	"3551": {IsValidDataAttribute: true},
+	"3552": {IsValidDataAttribute: true},
+	"3553": {IsValidDataAttribute: true},
+	"3554": {IsValidDataAttribute: true},
+	"3555": {IsValidDataAttribute: true},
+	"3560": {IsValidDataAttribute: true},
+	"3561": {IsValidDataAttribute: true},
+	"3562": {IsValidDataAttribute: true},
+	"3563": {IsValidDataAttribute: true},
+	"3564": {IsValidDataAttribute: true},
+	"3565": {IsValidDataAttribute: true},
+	"3570": {IsValidDataAttribute: true},
+	"3571": {IsValidDataAttribute: true},
+	"3572": {IsValidDataAttribute: true},
+	"3573": {IsValidDataAttribute: true},
+	"3574": {IsValidDataAttribute: true},
+	"3575": {IsValidDataAttribute: true},
+	"3600": {IsValidDataAttribute: true},
+	"3601": {IsValidDataAttribute: true},
+	"3602": {IsValidDataAttribute: true},
+	"3603": {IsValidDataAttribute: true},
+	"3604": {IsValidDataAttribute: true},
+	"3605": {IsValidDataAttribute: true},
+	"3610": {IsValidDataAttribute: true},
+	"3611": {IsValidDataAttribute: true},
+	"3612": {IsValidDataAttribute: true},
+	"3613": {IsValidDataAttribute: true},
+	"3614": {IsValidDataAttribute: true},
+	"3615": {IsValidDataAttribute: true},
+	"3620": {IsValidDataAttribute: true},
+	"3621": {IsValidDataAttribute: true},
+	"3622": {IsValidDataAttribute: true},
+	"3623": {IsValidDataAttribute: true},
+	"3624": {IsValidDataAttribute: true},
+	"3625": {IsValidDataAttribute: true},
+	"3630": {IsValidDataAttribute: true},
+	"3631": {IsValidDataAttribute: true},
+	"3632": {IsValidDataAttribute: true},
+	"3633": {IsValidDataAttribute: true},
+	"3634": {IsValidDataAttribute: true},
+	"3635": {IsValidDataAttribute: true},
+	"3640": {IsValidDataAttribute: true},
+	"3641": {IsValidDataAttribute: true},
+	"3642": {IsValidDataAttribute: true},
+	"3643": {IsValidDataAttribute: true},
+	"3644": {IsValidDataAttribute: true},
+	"3645": {IsValidDataAttribute: true},
+	"3650": {IsValidDataAttribute: true},
+	"3651": {IsValidDataAttribute: true},
+	"3652": {IsValidDataAttribute: true},
+	"3653": {IsValidDataAttribute: true},
+	"3654": {IsValidDataAttribute: true},
+	"3655": {IsValidDataAttribute: true},
+	"3660": {IsValidDataAttribute: true},
+	"3661": {IsValidDataAttribute: true},
+	"3662": {IsValidDataAttribute: true},
+	"3663": {IsValidDataAttribute: true},
+	"3664": {IsValidDataAttribute: true},
+	"3665": {IsValidDataAttribute: true},
+	"3670": {IsValidDataAttribute: true},
+	"3671": {IsValidDataAttribute: true},
+	"3672": {IsValidDataAttribute: true},
+	"3673": {IsValidDataAttribute: true},
+	"3674": {IsValidDataAttribute: true},
+	"3675": {IsValidDataAttribute: true},
+	"3680": {IsValidDataAttribute: true},
+	"3681": {IsValidDataAttribute: true},
+	"3682": {IsValidDataAttribute: true},
+	"3683": {IsValidDataAttribute: true},
+	"3684": {IsValidDataAttribute: true},
+	"3685": {IsValidDataAttribute: true},
+	"3690": {IsValidDataAttribute: true},
+	"3691": {IsValidDataAttribute: true},
+	"3692": {IsValidDataAttribute: true},
+	"3693": {IsValidDataAttribute: true},
+	"3694": {IsValidDataAttribute: true},
+	"3695": {IsValidDataAttribute: true},
+	"37":   {IsValidDataAttribute: true},
+	"3900": {IsValidDataAttribute: true},
+	"3901": {IsValidDataAttribute: true},
+	"3902": {IsValidDataAttribute: true},
+	"3903": {IsValidDataAttribute: true},
+	"3904": {IsValidDataAttribute: true},
+	"3905": {IsValidDataAttribute: true},
+	"3906": {IsValidDataAttribute: true},
+	"3907": {IsValidDataAttribute: true},
+	"3908": {IsValidDataAttribute: true},
+	"3909": {IsValidDataAttribute: true},
+	"3910": {IsValidDataAttribute: true},
+	"3911": {IsValidDataAttribute: true},
+	"3912": {IsValidDataAttribute: true},
+	"3913": {IsValidDataAttribute: true},
+	"3914": {IsValidDataAttribute: true},
+	"3915": {IsValidDataAttribute: true},
+	"3916": {IsValidDataAttribute: true},
+	"3917": {IsValidDataAttribute: true},
+	"3918": {IsValidDataAttribute: true},
+	"3919": {IsValidDataAttribute: true},
+	"3920": {IsValidDataAttribute: true},
+	"3921": {IsValidDataAttribute: true},
+	"3922": {IsValidDataAttribute: true},
+	"3923": {IsValidDataAttribute: true},
+	"3924": {IsValidDataAttribute: true},
+	"3925": {IsValidDataAttribute: true},
+	"3926": {IsValidDataAttribute: true},
+	"3927": {IsValidDataAttribute: true},
+	"3928": {IsValidDataAttribute: true},
+	"3929": {IsValidDataAttribute: true},
+	"3930": {IsValidDataAttribute: true},
+	"3931": {IsValidDataAttribute: true},
+	"3932": {IsValidDataAttribute: true},
+	"3933": {IsValidDataAttribute: true},
+	"3934": {IsValidDataAttribute: true},
+	"3935": {IsValidDataAttribute: true},
+	"3936": {IsValidDataAttribute: true},
+	"3937": {IsValidDataAttribute: true},
+	"3938": {IsValidDataAttribute: true},
+	"3939": {IsValidDataAttribute: true},
+	"3940": {IsValidDataAttribute: true},
+	"3941": {IsValidDataAttribute: true},
+	"3942": {IsValidDataAttribute: true},
+	"3943": {IsValidDataAttribute: true},
+	"3950": {IsValidDataAttribute: true},
+	"3951": {IsValidDataAttribute: true},
+	"3952": {IsValidDataAttribute: true},
+	"3953": {IsValidDataAttribute: true},
+	"3954": {IsValidDataAttribute: true},
+	"3955": {IsValidDataAttribute: true},
+	"400":  {IsValidDataAttribute: true},
+	"401":  {IsValidDataAttribute: true, IsValidPrimaryKey: true},
+	"402":  {IsValidDataAttribute: true, IsValidPrimaryKey: true},
+	"403":  {IsValidDataAttribute: true},
+	"410":  {IsValidDataAttribute: true},
+	"411":  {IsValidDataAttribute: true},
+	"412":  {IsValidDataAttribute: true},
+	"413":  {IsValidDataAttribute: true},
+	"414":  {IsValidDataAttribute: true, IsValidPrimaryKey: true, AllowedQualifiers: [][]ApplicationIdentifier{{AI254}, {AI7040}}},
+	"415":  {IsValidDataAttribute: true, IsValidPrimaryKey: true, AllowedQualifiers: [][]ApplicationIdentifier{{AI8020}}},
+	"416":  {IsValidDataAttribute: true},
+	"417":  {IsValidDataAttribute: true, IsValidPrimaryKey: true, AllowedQualifiers: [][]ApplicationIdentifier{{AI7040}}},
+	"420":  {IsValidDataAttribute: true},
+	"421":  {IsValidDataAttribute: true},
+	"422":  {IsValidDataAttribute: true},
+	"423":  {IsValidDataAttribute: true},
+	"424":  {IsValidDataAttribute: true},
+	"425":  {IsValidDataAttribute: true},
+	"426":  {IsValidDataAttribute: true},
+	"427":  {IsValidDataAttribute: true},
+	"4300": {IsValidDataAttribute: true},
+	"4301": {IsValidDataAttribute: true},
+	"4302": {IsValidDataAttribute: true},
+	"4303": {IsValidDataAttribute: true},
+	"4304": {IsValidDataAttribute: true},
+	"4305": {IsValidDataAttribute: true},
+	"4306": {IsValidDataAttribute: true},
+	"4307": {IsValidDataAttribute: true},
+	"4308": {IsValidDataAttribute: true},
+	"4309": {IsValidDataAttribute: true},
+	"4310": {IsValidDataAttribute: true},
+	"4311": {IsValidDataAttribute: true},
+	"4312": {IsValidDataAttribute: true},
+	"4313": {IsValidDataAttribute: true},
+	"4314": {IsValidDataAttribute: true},
+	"4315": {IsValidDataAttribute: true},
+	"4316": {IsValidDataAttribute: true},
+	"4317": {IsValidDataAttribute: true},
+	"4318": {IsValidDataAttribute: true},
+	"4319": {IsValidDataAttribute: true},
+	"4320": {IsValidDataAttribute: true},
+	"4321": {IsValidDataAttribute: true},
+	"4322": {IsValidDataAttribute: true},
+	"4323": {IsValidDataAttribute: true},
+	"4324": {IsValidDataAttribute: true},
+	"4325": {IsValidDataAttribute: true},
+	"4326": {IsValidDataAttribute: true},
+	"4330": {IsValidDataAttribute: true},
+	"4331": {IsValidDataAttribute: true},
+	"4332": {IsValidDataAttribute: true},
+	"4333": {IsValidDataAttribute: true},
+	"7001": {IsValidDataAttribute: true},
+	"7002": {IsValidDataAttribute: true},
+	"7003": {IsValidDataAttribute: true},
+	"7004": {IsValidDataAttribute: true},
+	"7005": {IsValidDataAttribute: true},
+	"7006": {IsValidDataAttribute: true},
+	"7007": {IsValidDataAttribute: true},
+	"7008": {IsValidDataAttribute: true},
+	"7009": {IsValidDataAttribute: true},
+	"7010": {IsValidDataAttribute: true},
+	"7011": {IsValidDataAttribute: true},
+	"7020": {IsValidDataAttribute: true},
+	"7021": {IsValidDataAttribute: true},
+	"7022": {IsValidDataAttribute: true},
+	"7023": {IsValidDataAttribute: true},
+	"7030": {IsValidDataAttribute: true},
+	"7031": {IsValidDataAttribute: true},
+	"7032": {IsValidDataAttribute: true},
+	"7033": {IsValidDataAttribute: true},
+	"7034": {IsValidDataAttribute: true},
+	"7035": {IsValidDataAttribute: true},
+	"7036": {IsValidDataAttribute: true},
+	"7037": {IsValidDataAttribute: true},
+	"7038": {IsValidDataAttribute: true},
+	"7039": {IsValidDataAttribute: true},
+	"7040": {},
+	"7041": {},
+	"710":  {IsValidDataAttribute: true},
+	"711":  {IsValidDataAttribute: true},
+	"712":  {IsValidDataAttribute: true},
+	"713":  {IsValidDataAttribute: true},
+	"714":  {IsValidDataAttribute: true},
+	"715":  {IsValidDataAttribute: true},
+	"716":  {IsValidDataAttribute: true},
+	"7230": {IsValidDataAttribute: true},
+	"7231": {IsValidDataAttribute: true},
+	"7232": {IsValidDataAttribute: true},
+	"7233": {IsValidDataAttribute: true},
+	"7234": {IsValidDataAttribute: true},
+	"7235": {IsValidDataAttribute: true},
+	"7236": {IsValidDataAttribute: true},
+	"7237": {IsValidDataAttribute: true},
+	"7238": {IsValidDataAttribute: true},
+	"7239": {IsValidDataAttribute: true},
+	"7240": {IsValidDataAttribute: true},
+	"7241": {IsValidDataAttribute: true},
+	"7242": {IsValidDataAttribute: true},
+	"7250": {IsValidDataAttribute: true},
+	"7251": {IsValidDataAttribute: true},
+	"7252": {IsValidDataAttribute: true},
+	"7253": {IsValidDataAttribute: true},
+	"7254": {IsValidDataAttribute: true},
+	"7255": {IsValidDataAttribute: true},
+	"7256": {IsValidDataAttribute: true},
+	"7257": {IsValidDataAttribute: true},
+	"7258": {IsValidDataAttribute: true},
+	"7259": {IsValidDataAttribute: true},
+	"8001": {IsValidDataAttribute: true},
+	"8002": {IsValidDataAttribute: true},
+	"8003": {IsValidDataAttribute: true, IsValidPrimaryKey: true},
+	"8004": {IsValidDataAttribute: true, IsValidPrimaryKey: true, AllowedQualifiers: [][]ApplicationIdentifier{{AI7040}}},
+	"8005": {IsValidDataAttribute: true},
+	"8006": {IsValidDataAttribute: true, IsValidPrimaryKey: true, AllowedQualifiers: [][]ApplicationIdentifier{{AI22, AI10, AI21}}},
+	"8007": {IsValidDataAttribute: true},
+	"8008": {IsValidDataAttribute: true},
+	"8009": {IsValidDataAttribute: true},
+	"8010": {IsValidDataAttribute: true, IsValidPrimaryKey: true, AllowedQualifiers: [][]ApplicationIdentifier{{AI8011}}},
+	"8011": {},
+	"8012": {IsValidDataAttribute: true},
+	"8013": {IsValidDataAttribute: true, IsValidPrimaryKey: true},
+	"8014": {},
+	"8017": {IsValidDataAttribute: true, IsValidPrimaryKey: true, AllowedQualifiers: [][]ApplicationIdentifier{{AI8019}}},
+	"8018": {IsValidDataAttribute: true, IsValidPrimaryKey: true, AllowedQualifiers: [][]ApplicationIdentifier{{AI8019}}},
+	"8019": {},
+	"8020": {},
+	"8026": {IsValidDataAttribute: true},
+	"8030": {IsValidDataAttribute: true},
+	"8110": {IsValidDataAttribute: true},
+	"8111": {IsValidDataAttribute: true},
+	"8112": {IsValidDataAttribute: true},
+	"8200": {},
+	"90":   {IsValidDataAttribute: true},
+	"91":   {IsValidDataAttribute: true},
+	"92":   {IsValidDataAttribute: true},
+	"93":   {IsValidDataAttribute: true},
+	"94":   {IsValidDataAttribute: true},
+	"95":   {IsValidDataAttribute: true},
+	"96":   {IsValidDataAttribute: true},
+	"97":   {IsValidDataAttribute: true},
+	"98":   {IsValidDataAttribute: true},
+	"99":   {IsValidDataAttribute: true},
+}
+
+// NewSSCC returns the element string of AI (00) SSCC. The value is checked against the
+// specification N18,csum,keyoff1 including its linters.
+func NewSSCC(value string) (ElementString, error) {
+	return newCheckedElementString(AI00, value)
+}
+
+// SSCC returns the data of AI (00) SSCC if present.
+func (d Message) SSCC() (string, bool) {
+	return d.lookupData(SSCCCode)
+}
+
+// NewGTIN returns the element string of AI (01) GTIN. The value is checked against the
+// specification N14,csum,keyoff1 including its linters.
+func NewGTIN(value string) (ElementString, error) {
+	return newCheckedElementString(AI01, value)
+}
+
+// GTIN returns the data of AI (01) GTIN if present.
+func (d Message) GTIN() (string, bool) {
+	return d.lookupData(GTINCode)
+}
+
+// NewContent returns the element string of AI (02) CONTENT. The value is checked against the
+// specification N14,csum,keyoff1 including its linters.
+func NewContent(value string) (ElementString, error) {
+	return newCheckedElementString(AI02, value)
+}
+
+// Content returns the data of AI (02) CONTENT if present.
+func (d Message) Content() (string, bool) {
+	return d.lookupData(ContentCode)
+}
+
+// NewMTOGTIN returns the element string of AI (03) MTO GTIN. The value is checked against the
+// specification N14,csum,keyoff1 including its linters.
+func NewMTOGTIN(value string) (ElementString, error) {
+	return newCheckedElementString(AI03, value)
+}
+
+// MTOGTIN returns the data of AI (03) MTO GTIN if present.
+func (d Message) MTOGTIN() (string, bool) {
+	return d.lookupData(MTOGTINCode)
+}
+
+// NewBatchLot returns the element string of AI (10) BATCH/LOT. The value is checked against the
+// specification X..20.
+func NewBatchLot(value string) (ElementString, error) {
+	return newCheckedElementString(AI10, value)
+}
+
+// BatchLot returns the data of AI (10) BATCH/LOT if present.
+func (d Message) BatchLot() (string, bool) {
+	return d.lookupData(BatchLotCode)
+}
+
+// NewProdDate returns the element string of AI (11) PROD DATE for the given date.
+func NewProdDate(date time.Time) ElementString {
+	return NewElementString(AI11, formatGS1Date(date))
+}
+
+// ProdDate returns the date of AI (11) PROD DATE. It returns false if the AI is missing or its data is not
+// a valid date.
+func (d Message) ProdDate() (time.Time, bool) {
+	return d.dateData(ProdDateCode)
+}
+
+// NewDueDate returns the element string of AI (12) DUE DATE for the given date.
+func NewDueDate(date time.Time) ElementString {
+	return NewElementString(AI12, formatGS1Date(date))
+}
+
+// DueDate returns the date of AI (12) DUE DATE. It returns false if the AI is missing or its data is not
+// a valid date.
+func (d Message) DueDate() (time.Time, bool) {
+	return d.dateData(DueDateCode)
+}
+
+// NewPackDate returns the element string of AI (13) PACK DATE for the given date.
+func NewPackDate(date time.Time) ElementString {
+	return NewElementString(AI13, formatGS1Date(date))
+}
+
+// PackDate returns the date of AI (13) PACK DATE. It returns false if the AI is missing or its data is not
+// a valid date.
+func (d Message) PackDate() (time.Time, bool) {
+	return d.dateData(PackDateCode)
+}
+
+// NewBestBeforeDate returns the element string of AI (15) BEST BEFORE or BEST BY for the given date.
+func NewBestBeforeDate(date time.Time) ElementString {
+	return NewElementString(AI15, formatGS1Date(date))
+}
+
+// BestBeforeDate returns the date of AI (15) BEST BEFORE or BEST BY. It returns false if the AI is missing or its data is not
+// a valid date.
+func (d Message) BestBeforeDate() (time.Time, bool) {
+	return d.dateData(BestBeforeDateCode)
+}
+
+// NewSellBy returns the element string of AI (16) SELL BY for the given date.
+func NewSellBy(date time.Time) ElementString {
+	return NewElementString(AI16, formatGS1Date(date))
+}
+
+// SellBy returns the date of AI (16) SELL BY. It returns false if the AI is missing or its data is not
+// a valid date.
+func (d Message) SellBy() (time.Time, bool) {
+	return d.dateData(SellByCode)
+}
+
+// NewExpiryDate returns the element string of AI (17) USE BY or EXPIRY for the given date.
+func NewExpiryDate(date time.Time) ElementString {
+	return NewElementString(AI17, formatGS1Date(date))
+}
+
+// ExpiryDate returns the date of AI (17) USE BY or EXPIRY. It returns false if the AI is missing or its data is not
+// a valid date.
+func (d Message) ExpiryDate() (time.Time, bool) {
+	return d.dateData(ExpiryDateCode)
+}
+
+// NewVariant returns the element string of AI (20) VARIANT. The value is checked against the
+// specification N2.
+func NewVariant(value string) (ElementString, error) {
+	return newCheckedElementString(AI20, value)
+}
+
+// Variant returns the data of AI (20) VARIANT if present.
+func (d Message) Variant() (string, bool) {
+	return d.lookupData(VariantCode)
+}
+
+// NewSerial returns the element string of AI (21) SERIAL. The value is checked against the
+// specification X..20.
+func NewSerial(value string) (ElementString, error) {
+	return newCheckedElementString(AI21, value)
+}
+
+// Serial returns the data of AI (21) SERIAL if present.
+func (d Message) Serial() (string, bool) {
+	return d.lookupData(SerialCode)
+}
+
+// NewCPV returns the element string of AI (22) CPV. The value is checked against the
+// specification X..20.
+func NewCPV(value string) (ElementString, error) {
+	return newCheckedElementString(AI22, value)
+}
+
+// CPV returns the data of AI (22) CPV if present.
+func (d Message) CPV() (string, bool) {
+	return d.lookupData(CPVCode)
+}
+
+// NewTPX returns the element string of AI (235) TPX. The value is checked against the
+// specification X..28.
+func NewTPX(value string) (ElementString, error) {
+	return newCheckedElementString(AI235, value)
+}
+
+// TPX returns the data of AI (235) TPX if present.
+func (d Message) TPX() (string, bool) {
+	return d.lookupData(TPXCode)
+}
+
+// NewAdditionalID returns the element string of AI (240) ADDITIONAL ID. The value is checked against the
+// specification X..30.
+func NewAdditionalID(value string) (ElementString, error) {
+	return newCheckedElementString(AI240, value)
+}
+
+// AdditionalID returns the data of AI (240) ADDITIONAL ID if present.
+func (d Message) AdditionalID() (string, bool) {
+	return d.lookupData(AdditionalIDCode)
+}
+
+// NewCustPartNo returns the element string of AI (241) CUST. PART No. The value is checked against the
+// specification X..30.
+func NewCustPartNo(value string) (ElementString, error) {
+	return newCheckedElementString(AI241, value)
+}
+
+// CustPartNo returns the data of AI (241) CUST. PART No. if present.
+func (d Message) CustPartNo() (string, bool) {
+	return d.lookupData(CustPartNoCode)
+}
+
+// NewMTOVariant returns the element string of AI (242) MTO VARIANT. The value is checked against the
+// specification N..6.
+func NewMTOVariant(value string) (ElementString, error) {
+	return newCheckedElementString(AI242, value)
+}
+
+// MTOVariant returns the data of AI (242) MTO VARIANT if present.
+func (d Message) MTOVariant() (string, bool) {
+	return d.lookupData(MTOVariantCode)
+}
+
+// NewPCN returns the element string of AI (243) PCN. The value is checked against the
+// specification X..20.
+func NewPCN(value string) (ElementString, error) {
+	return newCheckedElementString(AI243, value)
+}
+
+// PCN returns the data of AI (243) PCN if present.
+func (d Message) PCN() (string, bool) {
+	return d.lookupData(PCNCode)
+}
+
+// NewSecondarySerial returns the element string of AI (250) SECONDARY SERIAL. The value is checked against the
+// specification X..30.
+func NewSecondarySerial(value string) (ElementString, error) {
+	return newCheckedElementString(AI250, value)
+}
+
+// SecondarySerial returns the data of AI (250) SECONDARY SERIAL if present.
+func (d Message) SecondarySerial() (string, bool) {
+	return d.lookupData(SecondarySerialCode)
+}
+
+// NewRefToSource returns the element string of AI (251) REF. TO SOURCE. The value is checked against the
+// specification X..30.
+func NewRefToSource(value string) (ElementString, error) {
+	return newCheckedElementString(AI251, value)
+}
+
+// RefToSource returns the data of AI (251) REF. TO SOURCE if present.
+func (d Message) RefToSource() (string, bool) {
+	return d.lookupData(RefToSourceCode)
+}
+
+// NewGDTI returns the element string of AI (253) GDTI. The value is checked against the
+// specification N13,csum,key [X..17] including its linters.
+func NewGDTI(value string) (ElementString, error) {
+	return newCheckedElementString(AI253, value)
+}
+
+// GDTI returns the data of AI (253) GDTI if present.
+func (d Message) GDTI() (string, bool) {
+	return d.lookupData(GDTICode)
+}
+
+// NewGLNExtensionComponent returns the element string of AI (254) GLN EXTENSION COMPONENT. The value is checked against the
+// specification X..20.
+func NewGLNExtensionComponent(value string) (ElementString, error) {
+	return newCheckedElementString(AI254, value)
+}
+
+// GLNExtensionComponent returns the data of AI (254) GLN EXTENSION COMPONENT if present.
+func (d Message) GLNExtensionComponent() (string, bool) {
+	return d.lookupData(GLNExtensionComponentCode)
+}
+
+// NewGCN returns the element string of AI (255) GCN. The value is checked against the
+// specification N13,csum,key [N..12] including its linters.
+func NewGCN(value string) (ElementString, error) {
+	return newCheckedElementString(AI255, value)
+}
+
+// GCN returns the data of AI (255) GCN if present.
+func (d Message) GCN() (string, bool) {
+	return d.lookupData(GCNCode)
+}
+
+// NewVarCount returns the element string of AI (30) VAR. COUNT. The value is checked against the
+// specification N..8.
+func NewVarCount(value string) (ElementString, error) {
+	return newCheckedElementString(AI30, value)
+}
+
+// VarCount returns the data of AI (30) VAR. COUNT if present.
+func (d Message) VarCount() (string, bool) {
+	return d.lookupData(VarCountCode)
+}
+
+// NewNetWeightKg3100 returns the element string of AI (3100) NET WEIGHT (kg). The value is checked against the
+// specification N6.
+func NewNetWeightKg3100(value string) (ElementString, error) {
+	return newCheckedElementString(AI3100, value)
+}
+
+// NetWeightKg3100 returns the data of AI (3100) NET WEIGHT (kg) if present.
+func (d Message) NetWeightKg3100() (string, bool) {
+	return d.lookupData(NetWeightKg3100Code)
+}
+
+// NewNetWeightKg3101 returns the element string of AI (3101) NET WEIGHT (kg). The value is checked against the
+// specification N6.
+func NewNetWeightKg3101(value string) (ElementString, error) {
+	return newCheckedElementString(AI3101, value)
+}
+
+// NetWeightKg3101 returns the data of AI (3101) NET WEIGHT (kg) if present.
+func (d Message) NetWeightKg3101() (string, bool) {
+	return d.lookupData(NetWeightKg3101Code)
+}
+
+// NewNetWeightKg3102 returns the element string of AI (3102) NET WEIGHT (kg). The value is checked against the
+// specification N6.
+func NewNetWeightKg3102(value string) (ElementString, error) {
+	return newCheckedElementString(AI3102, value)
+}
+
+// NetWeightKg3102 returns the data of AI (3102) NET WEIGHT (kg) if present.
+func (d Message) NetWeightKg3102() (string, bool) {
+	return d.lookupData(NetWeightKg3102Code)
+}
+
+// NewNetWeightKg3103 returns the element string of AI (3103) NET WEIGHT (kg). The value is checked against the
+// specification N6.
+func NewNetWeightKg3103(value string) (ElementString, error) {
+	return newCheckedElementString(AI3103, value)
+}
+
+// NetWeightKg3103 returns the data of AI (3103) NET WEIGHT (kg) if present.
+func (d Message) NetWeightKg3103() (string, bool) {
+	return d.lookupData(NetWeightKg3103Code)
+}
+
+// NewNetWeightKg3104 returns the element string of AI (3104) NET WEIGHT (kg). The value is checked against the
+// specification N6.
+func NewNetWeightKg3104(value string) (ElementString, error) {
+	return newCheckedElementString(AI3104, value)
+}
+
+// NetWeightKg3104 returns the data of AI (3104) NET WEIGHT (kg) if present.
+func (d Message) NetWeightKg3104() (string, bool) {
+	return d.lookupData(NetWeightKg3104Code)
+}
+
+// NewNetWeightKg3105 returns the element string of AI (3105) NET WEIGHT (kg). The value is checked against the
+// specification N6.
+func NewNetWeightKg3105(value string) (ElementString, error) {
+	return newCheckedElementString(AI3105, value)
+}
+
+// NetWeightKg3105 returns the data of AI (3105) NET WEIGHT (kg) if present.
+func (d Message) NetWeightKg3105() (string, bool) {
+	return d.lookupData(NetWeightKg3105Code)
+}
+
+// NewLengthM3110 returns the element string of AI (3110) LENGTH (m). The value is checked against the
+// specification N6.
+func NewLengthM3110(value string) (ElementString, error) {
+	return newCheckedElementString(AI3110, value)
+}
+
+// LengthM3110 returns the data of AI (3110) LENGTH (m) if present.
+func (d Message) LengthM3110() (string, bool) {
+	return d.lookupData(LengthM3110Code)
+}
+
+// NewLengthM3111 returns the element string of AI (3111) LENGTH (m). The value is checked against the
+// specification N6.
+func NewLengthM3111(value string) (ElementString, error) {
+	return newCheckedElementString(AI3111, value)
+}
+
+// LengthM3111 returns the data of AI (3111) LENGTH (m) if present.
+func (d Message) LengthM3111() (string, bool) {
+	return d.lookupData(LengthM3111Code)
+}
+
+// NewLengthM3112 returns the element string of AI (3112) LENGTH (m). The value is checked against the
+// specification N6.
+func NewLengthM3112(value string) (ElementString, error) {
+	return newCheckedElementString(AI3112, value)
+}
+
+// LengthM3112 returns the data of AI (3112) LENGTH (m) if present.
+func (d Message) LengthM3112() (string, bool) {
+	return d.lookupData(LengthM3112Code)
+}
+
+// NewLengthM3113 returns the element string of AI (3113) LENGTH (m). The value is checked against the
+// specification N6.
+func NewLengthM3113(value string) (ElementString, error) {
+	return newCheckedElementString(AI3113, value)
+}
+
+// LengthM3113 returns the data of AI (3113) LENGTH (m) if present.
+func (d Message) LengthM3113() (string, bool) {
+	return d.lookupData(LengthM3113Code)
+}
+
+// NewLengthM3114 returns the element string of AI (3114) LENGTH (m). The value is checked against the
+// specification N6.
+func NewLengthM3114(value string) (ElementString, error) {
+	return newCheckedElementString(AI3114, value)
+}
+
+// LengthM3114 returns the data of AI (3114) LENGTH (m) if present.
+func (d Message) LengthM3114() (string, bool) {
+	return d.lookupData(LengthM3114Code)
+}
+
+// NewLengthM3115 returns the element string of AI (3115) LENGTH (m). The value is checked against the
+// specification N6.
+func NewLengthM3115(value string) (ElementString, error) {
+	return newCheckedElementString(AI3115, value)
+}
+
+// LengthM3115 returns the data of AI (3115) LENGTH (m) if present.
+func (d Message) LengthM3115() (string, bool) {
+	return d.lookupData(LengthM3115Code)
+}
+
+// NewWidthM3120 returns the element string of AI (3120) WIDTH (m). The value is checked against the
+// specification N6.
+func NewWidthM3120(value string) (ElementString, error) {
+	return newCheckedElementString(AI3120, value)
+}
+
+// WidthM3120 returns the data of AI (3120) WIDTH (m) if present.
+func (d Message) WidthM3120() (string, bool) {
+	return d.lookupData(WidthM3120Code)
+}
+
+// NewWidthM3121 returns the element string of AI (3121) WIDTH (m). The value is checked against the
+// specification N6.
+func NewWidthM3121(value string) (ElementString, error) {
+	return newCheckedElementString(AI3121, value)
+}
+
+// WidthM3121 returns the data of AI (3121) WIDTH (m) if present.
+func (d Message) WidthM3121() (string, bool) {
+	return d.lookupData(WidthM3121Code)
+}
+
+// NewWidthM3122 returns the element string of AI (3122) WIDTH (m). The value is checked against the
+// specification N6.
+func NewWidthM3122(value string) (ElementString, error) {
+	return newCheckedElementString(AI3122, value)
+}
+
+// WidthM3122 returns the data of AI (3122) WIDTH (m) if present.
+func (d Message) WidthM3122() (string, bool) {
+	return d.lookupData(WidthM3122Code)
+}
+
+// NewWidthM3123 returns the element string of AI (3123) WIDTH (m). The value is checked against the
+// specification N6.
+func NewWidthM3123(value string) (ElementString, error) {
+	return newCheckedElementString(AI3123, value)
+}
+
+// WidthM3123 returns the data of AI (3123) WIDTH (m) if present.
+func (d Message) WidthM3123() (string, bool) {
+	return d.lookupData(WidthM3123Code)
+}
+
+// NewWidthM3124 returns the element string of AI (3124) WIDTH (m). The value is checked against the
+// specification N6.
+func NewWidthM3124(value string) (ElementString, error) {
+	return newCheckedElementString(AI3124, value)
+}
+
+// WidthM3124 returns the data of AI (3124) WIDTH (m) if present.
+func (d Message) WidthM3124() (string, bool) {
+	return d.lookupData(WidthM3124Code)
+}
+
+// NewWidthM3125 returns the element string of AI (3125) WIDTH (m). The value is checked against the
+// specification N6.
+func NewWidthM3125(value string) (ElementString, error) {
+	return newCheckedElementString(AI3125, value)
+}
+
+// WidthM3125 returns the data of AI (3125) WIDTH (m) if present.
+func (d Message) WidthM3125() (string, bool) {
+	return d.lookupData(WidthM3125Code)
+}
+
+// NewHeightM3130 returns the element string of AI (3130) HEIGHT (m). The value is checked against the
+// specification N6.
+func NewHeightM3130(value string) (ElementString, error) {
+	return newCheckedElementString(AI3130, value)
+}
+
+// HeightM3130 returns the data of AI (3130) HEIGHT (m) if present.
+func (d Message) HeightM3130() (string, bool) {
+	return d.lookupData(HeightM3130Code)
+}
+
+// NewHeightM3131 returns the element string of AI (3131) HEIGHT (m). The value is checked against the
+// specification N6.
+func NewHeightM3131(value string) (ElementString, error) {
+	return newCheckedElementString(AI3131, value)
+}
+
+// HeightM3131 returns the data of AI (3131) HEIGHT (m) if present.
+func (d Message) HeightM3131() (string, bool) {
+	return d.lookupData(HeightM3131Code)
+}
+
+// NewHeightM3132 returns the element string of AI (3132) HEIGHT (m). The value is checked against the
+// specification N6.
+func NewHeightM3132(value string) (ElementString, error) {
+	return newCheckedElementString(AI3132, value)
+}
+
+// HeightM3132 returns the data of AI (3132) HEIGHT (m) if present.
+func (d Message) HeightM3132() (string, bool) {
+	return d.lookupData(HeightM3132Code)
+}
+
+// NewHeightM3133 returns the element string of AI (3133) HEIGHT (m). The value is checked against the
+// specification N6.
+func NewHeightM3133(value string) (ElementString, error) {
+	return newCheckedElementString(AI3133, value)
+}
+
+// HeightM3133 returns the data of AI (3133) HEIGHT (m) if present.
+func (d Message) HeightM3133() (string, bool) {
+	return d.lookupData(HeightM3133Code)
+}
+
+// NewHeightM3134 returns the element string of AI (3134) HEIGHT (m). The value is checked against the
+// specification N6.
+func NewHeightM3134(value string) (ElementString, error) {
+	return newCheckedElementString(AI3134, value)
+}
+
+// HeightM3134 returns the data of AI (3134) HEIGHT (m) if present.
+func (d Message) HeightM3134() (string, bool) {
+	return d.lookupData(HeightM3134Code)
+}
+
+// NewHeightM3135 returns the element string of AI (3135) HEIGHT (m). The value is checked against the
+// specification N6.
+func NewHeightM3135(value string) (ElementString, error) {
+	return newCheckedElementString(AI3135, value)
+}
+
+// HeightM3135 returns the data of AI (3135) HEIGHT (m) if present.
+func (d Message) HeightM3135() (string, bool) {
+	return d.lookupData(HeightM3135Code)
+}
+
+// NewAreaM23140 returns the element string of AI (3140) AREA (m²). The value is checked against the
+// specification N6.
+func NewAreaM23140(value string) (ElementString, error) {
+	return newCheckedElementString(AI3140, value)
+}
+
+// AreaM23140 returns the data of AI (3140) AREA (m²) if present.
+func (d Message) AreaM23140() (string, bool) {
+	return d.lookupData(AreaM23140Code)
+}
+
+// NewAreaM23141 returns the element string of AI (3141) AREA (m²). The value is checked against the
+// specification N6.
+func NewAreaM23141(value string) (ElementString, error) {
+	return newCheckedElementString(AI3141, value)
+}
+
+// AreaM23141 returns the data of AI (3141) AREA (m²) if present.
+func (d Message) AreaM23141() (string, bool) {
+	return d.lookupData(AreaM23141Code)
+}
+
+// NewAreaM23142 returns the element string of AI (3142) AREA (m²). The value is checked against the
+// specification N6.
+func NewAreaM23142(value string) (ElementString, error) {
+	return newCheckedElementString(AI3142, value)
+}
+
+// AreaM23142 returns the data of AI (3142) AREA (m²) if present.
+func (d Message) AreaM23142() (string, bool) {
+	return d.lookupData(AreaM23142Code)
+}
+
+// NewAreaM23143 returns the element string of AI (3143) AREA (m²). The value is checked against the
+// specification N6.
+func NewAreaM23143(value string) (ElementString, error) {
+	return newCheckedElementString(AI3143, value)
+}
+
+// AreaM23143 returns the data of AI (3143) AREA (m²) if present.
+func (d Message) AreaM23143() (string, bool) {
+	return d.lookupData(AreaM23143Code)
+}
+
+// NewAreaM23144 returns the element string of AI (3144) AREA (m²). The value is checked against the
+// specification N6.
+func NewAreaM23144(value string) (ElementString, error) {
+	return newCheckedElementString(AI3144, value)
+}
+
+// AreaM23144 returns the data of AI (3144) AREA (m²) if present.
+func (d Message) AreaM23144() (string, bool) {
+	return d.lookupData(AreaM23144Code)
+}
+
+// NewAreaM23145 returns the element string of AI (3145) AREA (m²). The value is checked against the
+// specification N6.
+func NewAreaM23145(value string) (ElementString, error) {
+	return newCheckedElementString(AI3145, value)
+}
+
+// AreaM23145 returns the data of AI (3145) AREA (m²) if present.
+func (d Message) AreaM23145() (string, bool) {
+	return d.lookupData(AreaM23145Code)
+}
+
+// NewNetVolumeL3150 returns the element string of AI (3150) NET VOLUME (l). The value is checked against the
+// specification N6.
+func NewNetVolumeL3150(value string) (ElementString, error) {
+	return newCheckedElementString(AI3150, value)
+}
+
+// NetVolumeL3150 returns the data of AI (3150) NET VOLUME (l) if present.
+func (d Message) NetVolumeL3150() (string, bool) {
+	return d.lookupData(NetVolumeL3150Code)
+}
+
+// NewNetVolumeL3151 returns the element string of AI (3151) NET VOLUME (l). The value is checked against the
+// specification N6.
+func NewNetVolumeL3151(value string) (ElementString, error) {
+	return newCheckedElementString(AI3151, value)
+}
+
+// NetVolumeL3151 returns the data of AI (3151) NET VOLUME (l) if present.
+func (d Message) NetVolumeL3151() (string, bool) {
+	return d.lookupData(NetVolumeL3151Code)
+}
+
+// NewNetVolumeL3152 returns the element string of AI (3152) NET VOLUME (l). The value is checked against the
+// specification N6.
+func NewNetVolumeL3152(value string) (ElementString, error) {
+	return newCheckedElementString(AI3152, value)
+}
+
+// NetVolumeL3152 returns the data of AI (3152) NET VOLUME (l) if present.
+func (d Message) NetVolumeL3152() (string, bool) {
+	return d.lookupData(NetVolumeL3152Code)
+}
+
+// NewNetVolumeL3153 returns the element string of AI (3153) NET VOLUME (l). The value is checked against the
+// specification N6.
+func NewNetVolumeL3153(value string) (ElementString, error) {
+	return newCheckedElementString(AI3153, value)
+}
+
+// NetVolumeL3153 returns the data of AI (3153) NET VOLUME (l) if present.
+func (d Message) NetVolumeL3153() (string, bool) {
+	return d.lookupData(NetVolumeL3153Code)
+}
+
+// NewNetVolumeL3154 returns the element string of AI (3154) NET VOLUME (l). The value is checked against the
+// specification N6.
+func NewNetVolumeL3154(value string) (ElementString, error) {
+	return newCheckedElementString(AI3154, value)
+}
+
+// NetVolumeL3154 returns the data of AI (3154) NET VOLUME (l) if present.
+func (d Message) NetVolumeL3154() (string, bool) {
+	return d.lookupData(NetVolumeL3154Code)
+}
+
+// NewNetVolumeL3155 returns the element string of AI (3155) NET VOLUME (l). The value is checked against the
+// specification N6.
+func NewNetVolumeL3155(value string) (ElementString, error) {
+	return newCheckedElementString(AI3155, value)
+}
+
+// NetVolumeL3155 returns the data of AI (3155) NET VOLUME (l) if present.
+func (d Message) NetVolumeL3155() (string, bool) {
+	return d.lookupData(NetVolumeL3155Code)
+}
+
+// NewNetVolumeM33160 returns the element string of AI (3160) NET VOLUME (m³). The value is checked against the
+// specification N6.
+func NewNetVolumeM33160(value string) (ElementString, error) {
+	return newCheckedElementString(AI3160, value)
+}
+
+// NetVolumeM33160 returns the data of AI (3160) NET VOLUME (m³) if present.
+func (d Message) NetVolumeM33160() (string, bool) {
+	return d.lookupData(NetVolumeM33160Code)
+}
+
+// NewNetVolumeM33161 returns the element string of AI (3161) NET VOLUME (m³). The value is checked against the
+// specification N6.
+func NewNetVolumeM33161(value string) (ElementString, error) {
+	return newCheckedElementString(AI3161, value)
+}
+
+// NetVolumeM33161 returns the data of AI (3161) NET VOLUME (m³) if present.
+func (d Message) NetVolumeM33161() (string, bool) {
+	return d.lookupData(NetVolumeM33161Code)
+}
+
+// NewNetVolumeM33162 returns the element string of AI (3162) NET VOLUME (m³). The value is checked against the
+// specification N6.
+func NewNetVolumeM33162(value string) (ElementString, error) {
+	return newCheckedElementString(AI3162, value)
+}
+
+// NetVolumeM33162 returns the data of AI (3162) NET VOLUME (m³) if present.
+func (d Message) NetVolumeM33162() (string, bool) {
+	return d.lookupData(NetVolumeM33162Code)
+}
+
+// NewNetVolumeM33163 returns the element string of AI (3163) NET VOLUME (m³). The value is checked against the
+// specification N6.
+func NewNetVolumeM33163(value string) (ElementString, error) {
+	return newCheckedElementString(AI3163, value)
+}
+
+// NetVolumeM33163 returns the data of AI (3163) NET VOLUME (m³) if present.
+func (d Message) NetVolumeM33163() (string, bool) {
+	return d.lookupData(NetVolumeM33163Code)
+}
+
+// NewNetVolumeM33164 returns the element string of AI (3164) NET VOLUME (m³). The value is checked against the
+// specification N6.
+func NewNetVolumeM33164(value string) (ElementString, error) {
+	return newCheckedElementString(AI3164, value)
+}
+
+// NetVolumeM33164 returns the data of AI (3164) NET VOLUME (m³) if present.
+func (d Message) NetVolumeM33164() (string, bool) {
+	return d.lookupData(NetVolumeM33164Code)
+}
+
+// NewNetVolumeM33165 returns the element string of AI (3165) NET VOLUME (m³). The value is checked against the
+// specification N6.
+func NewNetVolumeM33165(value string) (ElementString, error) {
+	return newCheckedElementString(AI3165, value)
+}
+
+// NetVolumeM33165 returns the data of AI (3165) NET VOLUME (m³) if present.
+func (d Message) NetVolumeM33165() (string, bool) {
+	return d.lookupData(NetVolumeM33165Code)
+}
+
+// NewNetWeightLb3200 returns the element string of AI (3200) NET WEIGHT (lb). The value is checked against the
+// specification N6.
+func NewNetWeightLb3200(value string) (ElementString, error) {
+	return newCheckedElementString(AI3200, value)
+}
+
+// NetWeightLb3200 returns the data of AI (3200) NET WEIGHT (lb) if present.
+func (d Message) NetWeightLb3200() (string, bool) {
+	return d.lookupData(NetWeightLb3200Code)
+}
+
+// NewNetWeightLb3201 returns the element string of AI (3201) NET WEIGHT (lb). The value is checked against the
+// specification N6.
+func NewNetWeightLb3201(value string) (ElementString, error) {
+	return newCheckedElementString(AI3201, value)
+}
+
+// NetWeightLb3201 returns the data of AI (3201) NET WEIGHT (lb) if present.
+func (d Message) NetWeightLb3201() (string, bool) {
+	return d.lookupData(NetWeightLb3201Code)
+}
+
+// NewNetWeightLb3202 returns the element string of AI (3202) NET WEIGHT (lb). The value is checked against the
+// specification N6.
+func NewNetWeightLb3202(value string) (ElementString, error) {
+	return newCheckedElementString(AI3202, value)
+}
+
+// NetWeightLb3202 returns the data of AI (3202) NET WEIGHT (lb) if present.
+func (d Message) NetWeightLb3202() (string, bool) {
+	return d.lookupData(NetWeightLb3202Code)
+}
+
+// NewNetWeightLb3203 returns the element string of AI (3203) NET WEIGHT (lb). The value is checked against the
+// specification N6.
+func NewNetWeightLb3203(value string) (ElementString, error) {
+	return newCheckedElementString(AI3203, value)
+}
+
+// NetWeightLb3203 returns the data of AI (3203) NET WEIGHT (lb) if present.
+func (d Message) NetWeightLb3203() (string, bool) {
+	return d.lookupData(NetWeightLb3203Code)
+}
+
+// NewNetWeightLb3204 returns the element string of AI (3204) NET WEIGHT (lb). The value is checked against the
+// specification N6.
+func NewNetWeightLb3204(value string) (ElementString, error) {
+	return newCheckedElementString(AI3204, value)
+}
+
+// NetWeightLb3204 returns the data of AI (3204) NET WEIGHT (lb) if present.
+func (d Message) NetWeightLb3204() (string, bool) {
+	return d.lookupData(NetWeightLb3204Code)
+}
+
+// NewNetWeightLb3205 returns the element string of AI (3205) NET WEIGHT (lb). The value is checked against the
+// specification N6.
+func NewNetWeightLb3205(value string) (ElementString, error) {
+	return newCheckedElementString(AI3205, value)
+}
+
+// NetWeightLb3205 returns the data of AI (3205) NET WEIGHT (lb) if present.
+func (d Message) NetWeightLb3205() (string, bool) {
+	return d.lookupData(NetWeightLb3205Code)
+}
+
+// NewLengthIn3210 returns the element string of AI (3210) LENGTH (in). The value is checked against the
+// specification N6.
+func NewLengthIn3210(value string) (ElementString, error) {
+	return newCheckedElementString(AI3210, value)
+}
+
+// LengthIn3210 returns the data of AI (3210) LENGTH (in) if present.
+func (d Message) LengthIn3210() (string, bool) {
+	return d.lookupData(LengthIn3210Code)
+}
+
+// NewLengthIn3211 returns the element string of AI (3211) LENGTH (in). The value is checked against the
+// specification N6.
+func NewLengthIn3211(value string) (ElementString, error) {
+	return newCheckedElementString(AI3211, value)
+}
+
+// LengthIn3211 returns the data of AI (3211) LENGTH (in) if present.
+func (d Message) LengthIn3211() (string, bool) {
+	return d.lookupData(LengthIn3211Code)
+}
+
+// NewLengthIn3212 returns the element string of AI (3212) LENGTH (in). The value is checked against the
+// specification N6.
+func NewLengthIn3212(value string) (ElementString, error) {
+	return newCheckedElementString(AI3212, value)
+}
+
+// LengthIn3212 returns the data of AI (3212) LENGTH (in) if present.
+func (d Message) LengthIn3212() (string, bool) {
+	return d.lookupData(LengthIn3212Code)
+}
+
+// NewLengthIn3213 returns the element string of AI (3213) LENGTH (in). The value is checked against the
+// specification N6.
+func NewLengthIn3213(value string) (ElementString, error) {
+	return newCheckedElementString(AI3213, value)
+}
+
+// LengthIn3213 returns the data of AI (3213) LENGTH (in) if present.
+func (d Message) LengthIn3213() (string, bool) {
+	return d.lookupData(LengthIn3213Code)
+}
+
+// NewLengthIn3214 returns the element string of AI (3214) LENGTH (in). The value is checked against the
+// specification N6.
+func NewLengthIn3214(value string) (ElementString, error) {
+	return newCheckedElementString(AI3214, value)
+}
+
+// LengthIn3214 returns the data of AI (3214) LENGTH (in) if present.
+func (d Message) LengthIn3214() (string, bool) {
+	return d.lookupData(LengthIn3214Code)
+}
+
+// NewLengthIn3215 returns the element string of AI (3215) LENGTH (in). The value is checked against the
+// specification N6.
+func NewLengthIn3215(value string) (ElementString, error) {
+	return newCheckedElementString(AI3215, value)
+}
+
+// LengthIn3215 returns the data of AI (3215) LENGTH (in) if present.
+func (d Message) LengthIn3215() (string, bool) {
+	return d.lookupData(LengthIn3215Code)
+}
+
+// NewLengthFt3220 returns the element string of AI (3220) LENGTH (ft). The value is checked against the
+// specification N6.
+func NewLengthFt3220(value string) (ElementString, error) {
+	return newCheckedElementString(AI3220, value)
+}
+
+// LengthFt3220 returns the data of AI (3220) LENGTH (ft) if present.
+func (d Message) LengthFt3220() (string, bool) {
+	return d.lookupData(LengthFt3220Code)
+}
+
+// NewLengthFt3221 returns the element string of AI (3221) LENGTH (ft). The value is checked against the
+// specification N6.
+func NewLengthFt3221(value string) (ElementString, error) {
+	return newCheckedElementString(AI3221, value)
+}
+
+// LengthFt3221 returns the data of AI (3221) LENGTH (ft) if present.
+func (d Message) LengthFt3221() (string, bool) {
+	return d.lookupData(LengthFt3221Code)
+}
+
+// NewLengthFt3222 returns the element string of AI (3222) LENGTH (ft). The value is checked against the
+// specification N6.
+func NewLengthFt3222(value string) (ElementString, error) {
+	return newCheckedElementString(AI3222, value)
+}
+
+// LengthFt3222 returns the data of AI (3222) LENGTH (ft) if present.
+func (d Message) LengthFt3222() (string, bool) {
+	return d.lookupData(LengthFt3222Code)
+}
+
+// NewLengthFt3223 returns the element string of AI (3223) LENGTH (ft). The value is checked against the
+// specification N6.
+func NewLengthFt3223(value string) (ElementString, error) {
+	return newCheckedElementString(AI3223, value)
+}
+
+// LengthFt3223 returns the data of AI (3223) LENGTH (ft) if present.
+func (d Message) LengthFt3223() (string, bool) {
+	return d.lookupData(LengthFt3223Code)
+}
+
+// NewLengthFt3224 returns the element string of AI (3224) LENGTH (ft). The value is checked against the
+// specification N6.
+func NewLengthFt3224(value string) (ElementString, error) {
+	return newCheckedElementString(AI3224, value)
+}
+
+// LengthFt3224 returns the data of AI (3224) LENGTH (ft) if present.
+func (d Message) LengthFt3224() (string, bool) {
+	return d.lookupData(LengthFt3224Code)
+}
+
+// NewLengthFt3225 returns the element string of AI (3225) LENGTH (ft). The value is checked against the
+// specification N6.
+func NewLengthFt3225(value string) (ElementString, error) {
+	return newCheckedElementString(AI3225, value)
+}
+
+// LengthFt3225 returns the data of AI (3225) LENGTH (ft) if present.
+func (d Message) LengthFt3225() (string, bool) {
+	return d.lookupData(LengthFt3225Code)
+}
+
+// NewLengthYd3230 returns the element string of AI (3230) LENGTH (yd). The value is checked against the
+// specification N6.
+func NewLengthYd3230(value string) (ElementString, error) {
+	return newCheckedElementString(AI3230, value)
+}
+
+// LengthYd3230 returns the data of AI (3230) LENGTH (yd) if present.
+func (d Message) LengthYd3230() (string, bool) {
+	return d.lookupData(LengthYd3230Code)
+}
+
+// NewLengthYd3231 returns the element string of AI (3231) LENGTH (yd). The value is checked against the
+// specification N6.
+func NewLengthYd3231(value string) (ElementString, error) {
+	return newCheckedElementString(AI3231, value)
+}
+
+// LengthYd3231 returns the data of AI (3231) LENGTH (yd) if present.
+func (d Message) LengthYd3231() (string, bool) {
+	return d.lookupData(LengthYd3231Code)
+}
+
+// NewLengthYd3232 returns the element string of AI (3232) LENGTH (yd). The value is checked against the
+// specification N6.
+func NewLengthYd3232(value string) (ElementString, error) {
+	return newCheckedElementString(AI3232, value)
+}
+
+// LengthYd3232 returns the data of AI (3232) LENGTH (yd) if present.
+func (d Message) LengthYd3232() (string, bool) {
+	return d.lookupData(LengthYd3232Code)
+}
+
+// NewLengthYd3233 returns the element string of AI (3233) LENGTH (yd). The value is checked against the
+// specification N6.
+func NewLengthYd3233(value string) (ElementString, error) {
+	return newCheckedElementString(AI3233, value)
+}
+
+// LengthYd3233 returns the data of AI (3233) LENGTH (yd) if present.
+func (d Message) LengthYd3233() (string, bool) {
+	return d.lookupData(LengthYd3233Code)
+}
+
+// NewLengthYd3234 returns the element string of AI (3234) LENGTH (yd). The value is checked against the
+// specification N6.
+func NewLengthYd3234(value string) (ElementString, error) {
+	return newCheckedElementString(AI3234, value)
+}
+
+// LengthYd3234 returns the data of AI (3234) LENGTH (yd) if present.
+func (d Message) LengthYd3234() (string, bool) {
+	return d.lookupData(LengthYd3234Code)
+}
+
+// NewLengthYd3235 returns the element string of AI (3235) LENGTH (yd). The value is checked against the
+// specification N6.
+func NewLengthYd3235(value string) (ElementString, error) {
+	return newCheckedElementString(AI3235, value)
+}
+
+// LengthYd3235 returns the data of AI (3235) LENGTH (yd) if present.
+func (d Message) LengthYd3235() (string, bool) {
+	return d.lookupData(LengthYd3235Code)
+}
+
+// NewWidthIn3240 returns the element string of AI (3240) WIDTH (in). The value is checked against the
+// specification N6.
+func NewWidthIn3240(value string) (ElementString, error) {
+	return newCheckedElementString(AI3240, value)
+}
+
+// WidthIn3240 returns the data of AI (3240) WIDTH (in) if present.
+func (d Message) WidthIn3240() (string, bool) {
+	return d.lookupData(WidthIn3240Code)
+}
+
+// NewWidthIn3241 returns the element string of AI (3241) WIDTH (in). The value is checked against the
+// specification N6.
+func NewWidthIn3241(value string) (ElementString, error) {
+	return newCheckedElementString(AI3241, value)
+}
+
+// WidthIn3241 returns the data of AI (3241) WIDTH (in) if present.
+func (d Message) WidthIn3241() (string, bool) {
+	return d.lookupData(WidthIn3241Code)
+}
+
+// NewWidthIn3242 returns the element string of AI (3242) WIDTH (in). The value is checked against the
+// specification N6.
+func NewWidthIn3242(value string) (ElementString, error) {
+	return newCheckedElementString(AI3242, value)
+}
+
+// WidthIn3242 returns the data of AI (3242) WIDTH (in) if present.
+func (d Message) WidthIn3242() (string, bool) {
+	return d.lookupData(WidthIn3242Code)
+}
+
+// NewWidthIn3243 returns the element string of AI (3243) WIDTH (in). The value is checked against the
+// specification N6.
+func NewWidthIn3243(value string) (ElementString, error) {
+	return newCheckedElementString(AI3243, value)
+}
+
+// WidthIn3243 returns the data of AI (3243) WIDTH (in) if present.
+func (d Message) WidthIn3243() (string, bool) {
+	return d.lookupData(WidthIn3243Code)
+}
+
+// NewWidthIn3244 returns the element string of AI (3244) WIDTH (in). The value is checked against the
+// specification N6.
+func NewWidthIn3244(value string) (ElementString, error) {
+	return newCheckedElementString(AI3244, value)
+}
+
+// WidthIn3244 returns the data of AI (3244) WIDTH (in) if present.
+func (d Message) WidthIn3244() (string, bool) {
+	return d.lookupData(WidthIn3244Code)
+}
+
+// NewWidthIn3245 returns the element string of AI (3245) WIDTH (in). The value is checked against the
+// specification N6.
+func NewWidthIn3245(value string) (ElementString, error) {
+	return newCheckedElementString(AI3245, value)
+}
+
+// WidthIn3245 returns the data of AI (3245) WIDTH (in) if present.
+func (d Message) WidthIn3245() (string, bool) {
+	return d.lookupData(WidthIn3245Code)
+}
+
+// NewWidthFt3250 returns the element string of AI (3250) WIDTH (ft). The value is checked against the
+// specification N6.
+func NewWidthFt3250(value string) (ElementString, error) {
+	return newCheckedElementString(AI3250, value)
+}
+
+// WidthFt3250 returns the data of AI (3250) WIDTH (ft) if present.
+func (d Message) WidthFt3250() (string, bool) {
+	return d.lookupData(WidthFt3250Code)
+}
+
+// NewWidthFt3251 returns the element string of AI (3251) WIDTH (ft). The value is checked against the
+// specification N6.
+func NewWidthFt3251(value string) (ElementString, error) {
+	return newCheckedElementString(AI3251, value)
+}
+
+// WidthFt3251 returns the data of AI (3251) WIDTH (ft) if present.
+func (d Message) WidthFt3251() (string, bool) {
+	return d.lookupData(WidthFt3251Code)
+}
+
+// NewWidthFt3252 returns the element string of AI (3252) WIDTH (ft). The value is checked against the
+// specification N6.
+func NewWidthFt3252(value string) (ElementString, error) {
+	return newCheckedElementString(AI3252, value)
+}
+
+// WidthFt3252 returns the data of AI (3252) WIDTH (ft) if present.
+func (d Message) WidthFt3252() (string, bool) {
+	return d.lookupData(WidthFt3252Code)
+}
+
+// NewWidthFt3253 returns the element string of AI (3253) WIDTH (ft). The value is checked against the
+// specification N6.
+func NewWidthFt3253(value string) (ElementString, error) {
+	return newCheckedElementString(AI3253, value)
+}
+
+// WidthFt3253 returns the data of AI (3253) WIDTH (ft) if present.
+func (d Message) WidthFt3253() (string, bool) {
+	return d.lookupData(WidthFt3253Code)
+}
+
+// NewWidthFt3254 returns the element string of AI (3254) WIDTH (ft). The value is checked against the
+// specification N6.
+func NewWidthFt3254(value string) (ElementString, error) {
+	return newCheckedElementString(AI3254, value)
+}
+
+// WidthFt3254 returns the data of AI (3254) WIDTH (ft) if present.
+func (d Message) WidthFt3254() (string, bool) {
+	return d.lookupData(WidthFt3254Code)
+}
+
+// NewWidthFt3255 returns the element string of AI (3255) WIDTH (ft). The value is checked against the
+// specification N6.
+func NewWidthFt3255(value string) (ElementString, error) {
+	return newCheckedElementString(AI3255, value)
+}
+
+// WidthFt3255 returns the data of AI (3255) WIDTH (ft) if present.
+func (d Message) WidthFt3255() (string, bool) {
+	return d.lookupData(WidthFt3255Code)
+}
+
+// NewWidthYd3260 returns the element string of AI (3260) WIDTH (yd). The value is checked against the
+// specification N6.
+func NewWidthYd3260(value string) (ElementString, error) {
+	return newCheckedElementString(AI3260, value)
+}
+
+// WidthYd3260 returns the data of AI (3260) WIDTH (yd) if present.
+func (d Message) WidthYd3260() (string, bool) {
+	return d.lookupData(WidthYd3260Code)
+}
+
+// NewWidthYd3261 returns the element string of AI (3261) WIDTH (yd). The value is checked against the
+// specification N6.
+func NewWidthYd3261(value string) (ElementString, error) {
+	return newCheckedElementString(AI3261, value)
+}
+
+// WidthYd3261 returns the data of AI (3261) WIDTH (yd) if present.
+func (d Message) WidthYd3261() (string, bool) {
+	return d.lookupData(WidthYd3261Code)
+}
+
+// NewWidthYd3262 returns the element string of AI (3262) WIDTH (yd). The value is checked against the
+// specification N6.
+func NewWidthYd3262(value string) (ElementString, error) {
+	return newCheckedElementString(AI3262, value)
+}
+
+// WidthYd3262 returns the data of AI (3262) WIDTH (yd) if present.
+func (d Message) WidthYd3262() (string, bool) {
+	return d.lookupData(WidthYd3262Code)
+}
+
+// NewWidthYd3263 returns the element string of AI (3263) WIDTH (yd). The value is checked against the
+// specification N6.
+func NewWidthYd3263(value string) (ElementString, error) {
+	return newCheckedElementString(AI3263, value)
+}
+
+// WidthYd3263 returns the data of AI (3263) WIDTH (yd) if present.
+func (d Message) WidthYd3263() (string, bool) {
+	return d.lookupData(WidthYd3263Code)
+}
+
+// NewWidthYd3264 returns the element string of AI (3264) WIDTH (yd). The value is checked against the
+// specification N6.
+func NewWidthYd3264(value string) (ElementString, error) {
+	return newCheckedElementString(AI3264, value)
+}
+
+// WidthYd3264 returns the data of AI (3264) WIDTH (yd) if present.
+func (d Message) WidthYd3264() (string, bool) {
+	return d.lookupData(WidthYd3264Code)
+}
+
+// NewWidthYd3265 returns the element string of AI (3265) WIDTH (yd). The value is checked against the
+// specification N6.
+func NewWidthYd3265(value string) (ElementString, error) {
+	return newCheckedElementString(AI3265, value)
+}
+
+// WidthYd3265 returns the data of AI (3265) WIDTH (yd) if present.
+func (d Message) WidthYd3265() (string, bool) {
+	return d.lookupData(WidthYd3265Code)
+}
+
+// NewHeightIn3270 returns the element string of AI (3270) HEIGHT (in). The value is checked against the
+// specification N6.
+func NewHeightIn3270(value string) (ElementString, error) {
+	return newCheckedElementString(AI3270, value)
+}
+
+// HeightIn3270 returns the data of AI (3270) HEIGHT (in) if present.
+func (d Message) HeightIn3270() (string, bool) {
+	return d.lookupData(HeightIn3270Code)
+}
+
+// NewHeightIn3271 returns the element string of AI (3271) HEIGHT (in). The value is checked against the
+// specification N6.
+func NewHeightIn3271(value string) (ElementString, error) {
+	return newCheckedElementString(AI3271, value)
+}
+
+// HeightIn3271 returns the data of AI (3271) HEIGHT (in) if present.
+func (d Message) HeightIn3271() (string, bool) {
+	return d.lookupData(HeightIn3271Code)
+}
+
+// NewHeightIn3272 returns the element string of AI (3272) HEIGHT (in). The value is checked against the
+// specification N6.
+func NewHeightIn3272(value string) (ElementString, error) {
+	return newCheckedElementString(AI3272, value)
+}
+
+// HeightIn3272 returns the data of AI (3272) HEIGHT (in) if present.
+func (d Message) HeightIn3272() (string, bool) {
+	return d.lookupData(HeightIn3272Code)
+}
+
+// NewHeightIn3273 returns the element string of AI (3273) HEIGHT (in). The value is checked against the
+// specification N6.
+func NewHeightIn3273(value string) (ElementString, error) {
+	return newCheckedElementString(AI3273, value)
+}
+
+// HeightIn3273 returns the data of AI (3273) HEIGHT (in) if present.
+func (d Message) HeightIn3273() (string, bool) {
+	return d.lookupData(HeightIn3273Code)
+}
+
+// NewHeightIn3274 returns the element string of AI (3274) HEIGHT (in). The value is checked against the
+// specification N6.
+func NewHeightIn3274(value string) (ElementString, error) {
+	return newCheckedElementString(AI3274, value)
+}
+
+// HeightIn3274 returns the data of AI (3274) HEIGHT (in) if present.
+func (d Message) HeightIn3274() (string, bool) {
+	return d.lookupData(HeightIn3274Code)
+}
+
+// NewHeightIn3275 returns the element string of AI (3275) HEIGHT (in). The value is checked against the
+// specification N6.
+func NewHeightIn3275(value string) (ElementString, error) {
+	return newCheckedElementString(AI3275, value)
+}
+
+// HeightIn3275 returns the data of AI (3275) HEIGHT (in) if present.
+func (d Message) HeightIn3275() (string, bool) {
+	return d.lookupData(HeightIn3275Code)
+}
+
+// NewHeightFt3280 returns the element string of AI (3280) HEIGHT (ft). The value is checked against the
+// specification N6.
+func NewHeightFt3280(value string) (ElementString, error) {
+	return newCheckedElementString(AI3280, value)
+}
+
+// HeightFt3280 returns the data of AI (3280) HEIGHT (ft) if present.
+func (d Message) HeightFt3280() (string, bool) {
+	return d.lookupData(HeightFt3280Code)
+}
+
+// NewHeightFt3281 returns the element string of AI (3281) HEIGHT (ft). The value is checked against the
+// specification N6.
+func NewHeightFt3281(value string) (ElementString, error) {
+	return newCheckedElementString(AI3281, value)
+}
+
+// HeightFt3281 returns the data of AI (3281) HEIGHT (ft) if present.
+func (d Message) HeightFt3281() (string, bool) {
+	return d.lookupData(HeightFt3281Code)
+}
+
+// NewHeightFt3282 returns the element string of AI (3282) HEIGHT (ft). The value is checked against the
+// specification N6.
+func NewHeightFt3282(value string) (ElementString, error) {
+	return newCheckedElementString(AI3282, value)
+}
+
+// HeightFt3282 returns the data of AI (3282) HEIGHT (ft) if present.
+func (d Message) HeightFt3282() (string, bool) {
+	return d.lookupData(HeightFt3282Code)
+}
+
+// NewHeightFt3283 returns the element string of AI (3283) HEIGHT (ft). The value is checked against the
+// specification N6.
+func NewHeightFt3283(value string) (ElementString, error) {
+	return newCheckedElementString(AI3283, value)
+}
+
+// HeightFt3283 returns the data of AI (3283) HEIGHT (ft) if present.
+func (d Message) HeightFt3283() (string, bool) {
+	return d.lookupData(HeightFt3283Code)
+}
+
+// NewHeightFt3284 returns the element string of AI (3284) HEIGHT (ft). The value is checked against the
+// specification N6.
+func NewHeightFt3284(value string) (ElementString, error) {
+	return newCheckedElementString(AI3284, value)
+}
+
+// HeightFt3284 returns the data of AI (3284) HEIGHT (ft) if present.
+func (d Message) HeightFt3284() (string, bool) {
+	return d.lookupData(HeightFt3284Code)
+}
+
+// NewHeightFt3285 returns the element string of AI (3285) HEIGHT (ft). The value is checked against the
+// specification N6.
+func NewHeightFt3285(value string) (ElementString, error) {
+	return newCheckedElementString(AI3285, value)
+}
+
+// HeightFt3285 returns the data of AI (3285) HEIGHT (ft) if present.
+func (d Message) HeightFt3285() (string, bool) {
+	return d.lookupData(HeightFt3285Code)
+}
+
+// NewHeightYd3290 returns the element string of AI (3290) HEIGHT (yd). The value is checked against the
+// specification N6.
+func NewHeightYd3290(value string) (ElementString, error) {
+	return newCheckedElementString(AI3290, value)
+}
+
+// HeightYd3290 returns the data of AI (3290) HEIGHT (yd) if present.
+func (d Message) HeightYd3290() (string, bool) {
+	return d.lookupData(HeightYd3290Code)
+}
+
+// NewHeightYd3291 returns the element string of AI (3291) HEIGHT (yd). The value is checked against the
+// specification N6.
+func NewHeightYd3291(value string) (ElementString, error) {
+	return newCheckedElementString(AI3291, value)
+}
+
+// HeightYd3291 returns the data of AI (3291) HEIGHT (yd) if present.
+func (d Message) HeightYd3291() (string, bool) {
+	return d.lookupData(HeightYd3291Code)
+}
+
+// NewHeightYd3292 returns the element string of AI (3292) HEIGHT (yd). The value is checked against the
+// specification N6.
+func NewHeightYd3292(value string) (ElementString, error) {
+	return newCheckedElementString(AI3292, value)
+}
+
+// HeightYd3292 returns the data of AI (3292) HEIGHT (yd) if present.
+func (d Message) HeightYd3292() (string, bool) {
+	return d.lookupData(HeightYd3292Code)
+}
+
+// NewHeightYd3293 returns the element string of AI (3293) HEIGHT (yd). The value is checked against the
+// specification N6.
+func NewHeightYd3293(value string) (ElementString, error) {
+	return newCheckedElementString(AI3293, value)
+}
+
+// HeightYd3293 returns the data of AI (3293) HEIGHT (yd) if present.
+func (d Message) HeightYd3293() (string, bool) {
+	return d.lookupData(HeightYd3293Code)
+}
+
+// NewHeightYd3294 returns the element string of AI (3294) HEIGHT (yd). The value is checked against the
+// specification N6.
+func NewHeightYd3294(value string) (ElementString, error) {
+	return newCheckedElementString(AI3294, value)
+}
+
+// HeightYd3294 returns the data of AI (3294) HEIGHT (yd) if present.
+func (d Message) HeightYd3294() (string, bool) {
+	return d.lookupData(HeightYd3294Code)
+}
+
+// NewHeightYd3295 returns the element string of AI (3295) HEIGHT (yd). The value is checked against the
+// specification N6.
+func NewHeightYd3295(value string) (ElementString, error) {
+	return newCheckedElementString(AI3295, value)
+}
+
+// HeightYd3295 returns the data of AI (3295) HEIGHT (yd) if present.
+func (d Message) HeightYd3295() (string, bool) {
+	return d.lookupData(HeightYd3295Code)
+}
+
+// NewGrossWeightKg3300 returns the element string of AI (3300) GROSS WEIGHT (kg). The value is checked against the
+// specification N6.
+func NewGrossWeightKg3300(value string) (ElementString, error) {
+	return newCheckedElementString(AI3300, value)
+}
+
+// GrossWeightKg3300 returns the data of AI (3300) GROSS WEIGHT (kg) if present.
+func (d Message) GrossWeightKg3300() (string, bool) {
+	return d.lookupData(GrossWeightKg3300Code)
+}
+
+// NewGrossWeightKg3301 returns the element string of AI (3301) GROSS WEIGHT (kg). The value is checked against the
+// specification N6.
+func NewGrossWeightKg3301(value string) (ElementString, error) {
+	return newCheckedElementString(AI3301, value)
+}
+
+// GrossWeightKg3301 returns the data of AI (3301) GROSS WEIGHT (kg) if present.
+func (d Message) GrossWeightKg3301() (string, bool) {
+	return d.lookupData(GrossWeightKg3301Code)
+}
+
+// NewGrossWeightKg3302 returns the element string of AI (3302) GROSS WEIGHT (kg). The value is checked against the
+// specification N6.
+func NewGrossWeightKg3302(value string) (ElementString, error) {
+	return newCheckedElementString(AI3302, value)
+}
+
+// GrossWeightKg3302 returns the data of AI (3302) GROSS WEIGHT (kg) if present.
+func (d Message) GrossWeightKg3302() (string, bool) {
+	return d.lookupData(GrossWeightKg3302Code)
+}
+
+// NewGrossWeightKg3303 returns the element string of AI (3303) GROSS WEIGHT (kg). The value is checked against the
+// specification N6.
+func NewGrossWeightKg3303(value string) (ElementString, error) {
+	return newCheckedElementString(AI3303, value)
+}
+
+// GrossWeightKg3303 returns the data of AI (3303) GROSS WEIGHT (kg) if present.
+func (d Message) GrossWeightKg3303() (string, bool) {
+	return d.lookupData(GrossWeightKg3303Code)
+}
+
+// NewGrossWeightKg3304 returns the element string of AI (3304) GROSS WEIGHT (kg). The value is checked against the
+// specification N6.
+func NewGrossWeightKg3304(value string) (ElementString, error) {
+	return newCheckedElementString(AI3304, value)
+}
+
+// GrossWeightKg3304 returns the data of AI (3304) GROSS WEIGHT (kg) if present.
+func (d Message) GrossWeightKg3304() (string, bool) {
+	return d.lookupData(GrossWeightKg3304Code)
+}
+
+// NewGrossWeightKg3305 returns the element string of AI (3305) GROSS WEIGHT (kg). The value is checked against the
+// specification N6.
+func NewGrossWeightKg3305(value string) (ElementString, error) {
+	return newCheckedElementString(AI3305, value)
+}
+
+// GrossWeightKg3305 returns the data of AI (3305) GROSS WEIGHT (kg) if present.
+func (d Message) GrossWeightKg3305() (string, bool) {
+	return d.lookupData(GrossWeightKg3305Code)
+}
+
+// NewLengthMLog3310 returns the element string of AI (3310) LENGTH (m), log. The value is checked against the
+// specification N6.
+func NewLengthMLog3310(value string) (ElementString, error) {
+	return newCheckedElementString(AI3310, value)
+}
+
+// LengthMLog3310 returns the data of AI (3310) LENGTH (m), log if present.
+func (d Message) LengthMLog3310() (string, bool) {
+	return d.lookupData(LengthMLog3310Code)
+}
+
+// NewLengthMLog3311 returns the element string of AI (3311) LENGTH (m), log. The value is checked against the
+// specification N6.
+func NewLengthMLog3311(value string) (ElementString, error) {
+	return newCheckedElementString(AI3311, value)
+}
+
+// LengthMLog3311 returns the data of AI (3311) LENGTH (m), log if present.
+func (d Message) LengthMLog3311() (string, bool) {
+	return d.lookupData(LengthMLog3311Code)
+}
+
+// NewLengthMLog3312 returns the element string of AI (3312) LENGTH (m), log. The value is checked against the
+// specification N6.
+func NewLengthMLog3312(value string) (ElementString, error) {
+	return newCheckedElementString(AI3312, value)
+}
+
+// LengthMLog3312 returns the data of AI (3312) LENGTH (m), log if present.
+func (d Message) LengthMLog3312() (string, bool) {
+	return d.lookupData(LengthMLog3312Code)
+}
+
+// NewLengthMLog3313 returns the element string of AI (3313) LENGTH (m), log. The value is checked against the
+// specification N6.
+func NewLengthMLog3313(value string) (ElementString, error) {
+	return newCheckedElementString(AI3313, value)
+}
+
+// LengthMLog3313 returns the data of AI (3313) LENGTH (m), log if present.
+func (d Message) LengthMLog3313() (string, bool) {
+	return d.lookupData(LengthMLog3313Code)
+}
+
+// NewLengthMLog3314 returns the element string of AI (3314) LENGTH (m), log. The value is checked against the
+// specification N6.
+func NewLengthMLog3314(value string) (ElementString, error) {
+	return newCheckedElementString(AI3314, value)
+}
+
+// LengthMLog3314 returns the data of AI (3314) LENGTH (m), log if present.
+func (d Message) LengthMLog3314() (string, bool) {
+	return d.lookupData(LengthMLog3314Code)
+}
+
+// NewLengthMLog3315 returns the element string of AI (3315) LENGTH (m), log. The value is checked against the
+// specification N6.
+func NewLengthMLog3315(value string) (ElementString, error) {
+	return newCheckedElementString(AI3315, value)
+}
+
+// LengthMLog3315 returns the data of AI (3315) LENGTH (m), log if present.
+func (d Message) LengthMLog3315() (string, bool) {
+	return d.lookupData(LengthMLog3315Code)
+}
+
+// NewWidthMLog3320 returns the element string of AI (3320) WIDTH (m), log. The value is checked against the
+// specification N6.
+func NewWidthMLog3320(value string) (ElementString, error) {
+	return newCheckedElementString(AI3320, value)
+}
+
+// WidthMLog3320 returns the data of AI (3320) WIDTH (m), log if present.
+func (d Message) WidthMLog3320() (string, bool) {
+	return d.lookupData(WidthMLog3320Code)
+}
+
+// NewWidthMLog3321 returns the element string of AI (3321) WIDTH (m), log. The value is checked against the
+// specification N6.
+func NewWidthMLog3321(value string) (ElementString, error) {
+	return newCheckedElementString(AI3321, value)
+}
+
+// WidthMLog3321 returns the data of AI (3321) WIDTH (m), log if present.
+func (d Message) WidthMLog3321() (string, bool) {
+	return d.lookupData(WidthMLog3321Code)
+}
+
+// NewWidthMLog3322 returns the element string of AI (3322) WIDTH (m), log. The value is checked against the
+// specification N6.
+func NewWidthMLog3322(value string) (ElementString, error) {
+	return newCheckedElementString(AI3322, value)
+}
+
+// WidthMLog3322 returns the data of AI (3322) WIDTH (m), log if present.
+func (d Message) WidthMLog3322() (string, bool) {
+	return d.lookupData(WidthMLog3322Code)
+}
+
+// NewWidthMLog3323 returns the element string of AI (3323) WIDTH (m), log. The value is checked against the
+// specification N6.
+func NewWidthMLog3323(value string) (ElementString, error) {
+	return newCheckedElementString(AI3323, value)
+}
+
+// WidthMLog3323 returns the data of AI (3323) WIDTH (m), log if present.
+func (d Message) WidthMLog3323() (string, bool) {
+	return d.lookupData(WidthMLog3323Code)
+}
+
+// NewWidthMLog3324 returns the element string of AI (3324) WIDTH (m), log. The value is checked against the
+// specification N6.
+func NewWidthMLog3324(value string) (ElementString, error) {
+	return newCheckedElementString(AI3324, value)
+}
+
+// WidthMLog3324 returns the data of AI (3324) WIDTH (m), log if present.
+func (d Message) WidthMLog3324() (string, bool) {
+	return d.lookupData(WidthMLog3324Code)
+}
+
+// NewWidthMLog3325 returns the element string of AI (3325) WIDTH (m), log. The value is checked against the
+// specification N6.
+func NewWidthMLog3325(value string) (ElementString, error) {
+	return newCheckedElementString(AI3325, value)
+}
+
+// WidthMLog3325 returns the data of AI (3325) WIDTH (m), log if present.
+func (d Message) WidthMLog3325() (string, bool) {
+	return d.lookupData(WidthMLog3325Code)
+}
+
+// NewHeightMLog3330 returns the element string of AI (3330) HEIGHT (m), log. The value is checked against the
+// specification N6.
+func NewHeightMLog3330(value string) (ElementString, error) {
+	return newCheckedElementString(AI3330, value)
+}
+
+// HeightMLog3330 returns the data of AI (3330) HEIGHT (m), log if present.
+func (d Message) HeightMLog3330() (string, bool) {
+	return d.lookupData(HeightMLog3330Code)
+}
+
+// NewHeightMLog3331 returns the element string of AI (3331) HEIGHT (m), log. The value is checked against the
+// specification N6.
+func NewHeightMLog3331(value string) (ElementString, error) {
+	return newCheckedElementString(AI3331, value)
+}
+
+// HeightMLog3331 returns the data of AI (3331) HEIGHT (m), log if present.
+func (d Message) HeightMLog3331() (string, bool) {
+	return d.lookupData(HeightMLog3331Code)
+}
+
+// NewHeightMLog3332 returns the element string of AI (3332) HEIGHT (m), log. The value is checked against the
+// specification N6.
+func NewHeightMLog3332(value string) (ElementString, error) {
+	return newCheckedElementString(AI3332, value)
+}
+
+// HeightMLog3332 returns the data of AI (3332) HEIGHT (m), log if present.
+func (d Message) HeightMLog3332() (string, bool) {
+	return d.lookupData(HeightMLog3332Code)
+}
+
+// NewHeightMLog3333 returns the element string of AI (3333) HEIGHT (m), log. The value is checked against the
+// specification N6.
+func NewHeightMLog3333(value string) (ElementString, error) {
+	return newCheckedElementString(AI3333, value)
+}
+
+// HeightMLog3333 returns the data of AI (3333) HEIGHT (m), log if present.
+func (d Message) HeightMLog3333() (string, bool) {
+	return d.lookupData(HeightMLog3333Code)
+}
+
+// NewHeightMLog3334 returns the element string of AI (3334) HEIGHT (m), log. The value is checked against the
+// specification N6.
+func NewHeightMLog3334(value string) (ElementString, error) {
+	return newCheckedElementString(AI3334, value)
+}
+
+// HeightMLog3334 returns the data of AI (3334) HEIGHT (m), log if present.
+func (d Message) HeightMLog3334() (string, bool) {
+	return d.lookupData(HeightMLog3334Code)
+}
+
+// NewHeightMLog3335 returns the element string of AI (3335) HEIGHT (m), log. The value is checked against the
+// specification N6.
+func NewHeightMLog3335(value string) (ElementString, error) {
+	return newCheckedElementString(AI3335, value)
+}
+
+// HeightMLog3335 returns the data of AI (3335) HEIGHT (m), log if present.
+func (d Message) HeightMLog3335() (string, bool) {
+	return d.lookupData(HeightMLog3335Code)
+}
+
+// NewAreaM2Log3340 returns the element string of AI (3340) AREA (m²), log. The value is checked against the
+// specification N6.
+func NewAreaM2Log3340(value string) (ElementString, error) {
+	return newCheckedElementString(AI3340, value)
+}
+
+// AreaM2Log3340 returns the data of AI (3340) AREA (m²), log if present.
+func (d Message) AreaM2Log3340() (string, bool) {
+	return d.lookupData(AreaM2Log3340Code)
+}
+
+// NewAreaM2Log3341 returns the element string of AI (3341) AREA (m²), log. The value is checked against the
+// specification N6.
+func NewAreaM2Log3341(value string) (ElementString, error) {
+	return newCheckedElementString(AI3341, value)
+}
+
+// AreaM2Log3341 returns the data of AI (3341) AREA (m²), log if present.
+func (d Message) AreaM2Log3341() (string, bool) {
+	return d.lookupData(AreaM2Log3341Code)
+}
+
+// NewAreaM2Log3342 returns the element string of AI (3342) AREA (m²), log. The value is checked against the
+// specification N6.
+func NewAreaM2Log3342(value string) (ElementString, error) {
+	return newCheckedElementString(AI3342, value)
+}
+
+// AreaM2Log3342 returns the data of AI (3342) AREA (m²), log if present.
+func (d Message) AreaM2Log3342() (string, bool) {
+	return d.lookupData(AreaM2Log3342Code)
+}
+
+// NewAreaM2Log3343 returns the element string of AI (3343) AREA (m²), log. The value is checked against the
+// specification N6.
+func NewAreaM2Log3343(value string) (ElementString, error) {
+	return newCheckedElementString(AI3343, value)
+}
+
+// AreaM2Log3343 returns the data of AI (3343) AREA (m²), log if present.
+func (d Message) AreaM2Log3343() (string, bool) {
+	return d.lookupData(AreaM2Log3343Code)
+}
+
+// NewAreaM2Log3344 returns the element string of AI (3344) AREA (m²), log. The value is checked against the
+// specification N6.
+func NewAreaM2Log3344(value string) (ElementString, error) {
+	return newCheckedElementString(AI3344, value)
+}
+
+// AreaM2Log3344 returns the data of AI (3344) AREA (m²), log if present.
+func (d Message) AreaM2Log3344() (string, bool) {
+	return d.lookupData(AreaM2Log3344Code)
+}
+
+// NewAreaM2Log3345 returns the element string of AI (3345) AREA (m²), log. The value is checked against the
+// specification N6.
+func NewAreaM2Log3345(value string) (ElementString, error) {
+	return newCheckedElementString(AI3345, value)
+}
+
+// AreaM2Log3345 returns the data of AI (3345) AREA (m²), log if present.
+func (d Message) AreaM2Log3345() (string, bool) {
+	return d.lookupData(AreaM2Log3345Code)
+}
+
+// NewVolumeLLog3350 returns the element string of AI (3350) VOLUME (l), log. The value is checked against the
+// specification N6.
+func NewVolumeLLog3350(value string) (ElementString, error) {
+	return newCheckedElementString(AI3350, value)
+}
+
+// VolumeLLog3350 returns the data of AI (3350) VOLUME (l), log if present.
+func (d Message) VolumeLLog3350() (string, bool) {
+	return d.lookupData(VolumeLLog3350Code)
+}
+
+// NewVolumeLLog3351 returns the element string of AI (3351) VOLUME (l), log. The value is checked against the
+// specification N6.
+func NewVolumeLLog3351(value string) (ElementString, error) {
+	return newCheckedElementString(AI3351, value)
+}
+
+// VolumeLLog3351 returns the data of AI (3351) VOLUME (l), log if present.
+func (d Message) VolumeLLog3351() (string, bool) {
+	return d.lookupData(VolumeLLog3351Code)
+}
+
+// NewVolumeLLog3352 returns the element string of AI (3352) VOLUME (l), log. The value is checked against the
+// specification N6.
+func NewVolumeLLog3352(value string) (ElementString, error) {
+	return newCheckedElementString(AI3352, value)
+}
+
+// VolumeLLog3352 returns the data of AI (3352) VOLUME (l), log if present.
+func (d Message) VolumeLLog3352() (string, bool) {
+	return d.lookupData(VolumeLLog3352Code)
+}
+
+// NewVolumeLLog3353 returns the element string of AI (3353) VOLUME (l), log. The value is checked against the
+// specification N6.
+func NewVolumeLLog3353(value string) (ElementString, error) {
+	return newCheckedElementString(AI3353, value)
+}
+
+// VolumeLLog3353 returns the data of AI (3353) VOLUME (l), log if present.
+func (d Message) VolumeLLog3353() (string, bool) {
+	return d.lookupData(VolumeLLog3353Code)
+}
+
+// NewVolumeLLog3354 returns the element string of AI (3354) VOLUME (l), log. The value is checked against the
+// specification N6.
+func NewVolumeLLog3354(value string) (ElementString, error) {
+	return newCheckedElementString(AI3354, value)
+}
+
+// VolumeLLog3354 returns the data of AI (3354) VOLUME (l), log if present.
+func (d Message) VolumeLLog3354() (string, bool) {
+	return d.lookupData(VolumeLLog3354Code)
+}
+
+// NewVolumeLLog3355 returns the element string of AI (3355) VOLUME (l), log. The value is checked against the
+// specification N6.
+func NewVolumeLLog3355(value string) (ElementString, error) {
+	return newCheckedElementString(AI3355, value)
+}
+
+// VolumeLLog3355 returns the data of AI (3355) VOLUME (l), log if present.
+func (d Message) VolumeLLog3355() (string, bool) {
+	return d.lookupData(VolumeLLog3355Code)
+}
+
+// NewVolumeM3Log3360 returns the element string of AI (3360) VOLUME (m³), log. The value is checked against the
+// specification N6.
+func NewVolumeM3Log3360(value string) (ElementString, error) {
+	return newCheckedElementString(AI3360, value)
+}
+
+// VolumeM3Log3360 returns the data of AI (3360) VOLUME (m³), log if present.
+func (d Message) VolumeM3Log3360() (string, bool) {
+	return d.lookupData(VolumeM3Log3360Code)
+}
+
+// NewVolumeM3Log3361 returns the element string of AI (3361) VOLUME (m³), log. The value is checked against the
+// specification N6.
+func NewVolumeM3Log3361(value string) (ElementString, error) {
+	return newCheckedElementString(AI3361, value)
+}
+
+// VolumeM3Log3361 returns the data of AI (3361) VOLUME (m³), log if present.
+func (d Message) VolumeM3Log3361() (string, bool) {
+	return d.lookupData(VolumeM3Log3361Code)
+}
+
+// NewVolumeM3Log3362 returns the element string of AI (3362) VOLUME (m³), log. The value is checked against the
+// specification N6.
+func NewVolumeM3Log3362(value string) (ElementString, error) {
+	return newCheckedElementString(AI3362, value)
+}
+
+// VolumeM3Log3362 returns the data of AI (3362) VOLUME (m³), log if present.
+func (d Message) VolumeM3Log3362() (string, bool) {
+	return d.lookupData(VolumeM3Log3362Code)
+}
+
+// NewVolumeM3Log3363 returns the element string of AI (3363) VOLUME (m³), log. The value is checked against the
+// specification N6.
+func NewVolumeM3Log3363(value string) (ElementString, error) {
+	return newCheckedElementString(AI3363, value)
+}
+
+// VolumeM3Log3363 returns the data of AI (3363) VOLUME (m³), log if present.
+func (d Message) VolumeM3Log3363() (string, bool) {
+	return d.lookupData(VolumeM3Log3363Code)
+}
+
+// NewVolumeM3Log3364 returns the element string of AI (3364) VOLUME (m³), log. The value is checked against the
+// specification N6.
+func NewVolumeM3Log3364(value string) (ElementString, error) {
+	return newCheckedElementString(AI3364, value)
+}
+
+// VolumeM3Log3364 returns the data of AI (3364) VOLUME (m³), log if present.
+func (d Message) VolumeM3Log3364() (string, bool) {
+	return d.lookupData(VolumeM3Log3364Code)
+}
+
+// NewVolumeM3Log3365 returns the element string of AI (3365) VOLUME (m³), log. The value is checked against the
+// specification N6.
+func NewVolumeM3Log3365(value string) (ElementString, error) {
+	return newCheckedElementString(AI3365, value)
+}
+
+// VolumeM3Log3365 returns the data of AI (3365) VOLUME (m³), log if present.
+func (d Message) VolumeM3Log3365() (string, bool) {
+	return d.lookupData(VolumeM3Log3365Code)
+}
+
+// NewKgPerM23370 returns the element string of AI (3370) KG PER m². The value is checked against the
+// specification N6.
+func NewKgPerM23370(value string) (ElementString, error) {
+	return newCheckedElementString(AI3370, value)
+}
+
+// KgPerM23370 returns the data of AI (3370) KG PER m² if present.
+func (d Message) KgPerM23370() (string, bool) {
+	return d.lookupData(KgPerM23370Code)
+}
+
+// NewKgPerM23371 returns the element string of AI (3371) KG PER m². The value is checked against the
+// specification N6.
+func NewKgPerM23371(value string) (ElementString, error) {
+	return newCheckedElementString(AI3371, value)
+}
+
+// KgPerM23371 returns the data of AI (3371) KG PER m² if present.
+func (d Message) KgPerM23371() (string, bool) {
+	return d.lookupData(KgPerM23371Code)
+}
+
+// NewKgPerM23372 returns the element string of AI (3372) KG PER m². The value is checked against the
+// specification N6.
+func NewKgPerM23372(value string) (ElementString, error) {
+	return newCheckedElementString(AI3372, value)
+}
+
+// KgPerM23372 returns the data of AI (3372) KG PER m² if present.
+func (d Message) KgPerM23372() (string, bool) {
+	return d.lookupData(KgPerM23372Code)
+}
+
+// NewKgPerM23373 returns the element string of AI (3373) KG PER m². The value is checked against the
+// specification N6.
+func NewKgPerM23373(value string) (ElementString, error) {
+	return newCheckedElementString(AI3373, value)
+}
+
+// KgPerM23373 returns the data of AI (3373) KG PER m² if present.
+func (d Message) KgPerM23373() (string, bool) {
+	return d.lookupData(KgPerM23373Code)
+}
+
+// NewKgPerM23374 returns the element string of AI (3374) KG PER m². The value is checked against the
+// specification N6.
+func NewKgPerM23374(value string) (ElementString, error) {
+	return newCheckedElementString(AI3374, value)
+}
+
+// KgPerM23374 returns the data of AI (3374) KG PER m² if present.
+func (d Message) KgPerM23374() (string, bool) {
+	return d.lookupData(KgPerM23374Code)
+}
+
+// NewKgPerM23375 returns the element string of AI (3375) KG PER m². The value is checked against the
+// specification N6.
+func NewKgPerM23375(value string) (ElementString, error) {
+	return newCheckedElementString(AI3375, value)
+}
+
+// KgPerM23375 returns the data of AI (3375) KG PER m² if present.
+func (d Message) KgPerM23375() (string, bool) {
+	return d.lookupData(KgPerM23375Code)
+}
+
+// NewGrossWeightLb3400 returns the element string of AI (3400) GROSS WEIGHT (lb). The value is checked against the
+// specification N6.
+func NewGrossWeightLb3400(value string) (ElementString, error) {
+	return newCheckedElementString(AI3400, value)
+}
+
+// GrossWeightLb3400 returns the data of AI (3400) GROSS WEIGHT (lb) if present.
+func (d Message) GrossWeightLb3400() (string, bool) {
+	return d.lookupData(GrossWeightLb3400Code)
+}
+
+// NewGrossWeightLb3401 returns the element string of AI (3401) GROSS WEIGHT (lb). The value is checked against the
+// specification N6.
+func NewGrossWeightLb3401(value string) (ElementString, error) {
+	return newCheckedElementString(AI3401, value)
+}
+
+// GrossWeightLb3401 returns the data of AI (3401) GROSS WEIGHT (lb) if present.
+func (d Message) GrossWeightLb3401() (string, bool) {
+	return d.lookupData(GrossWeightLb3401Code)
+}
+
+// NewGrossWeightLb3402 returns the element string of AI (3402) GROSS WEIGHT (lb). The value is checked against the
+// specification N6.
+func NewGrossWeightLb3402(value string) (ElementString, error) {
+	return newCheckedElementString(AI3402, value)
+}
+
+// GrossWeightLb3402 returns the data of AI (3402) GROSS WEIGHT (lb) if present.
+func (d Message) GrossWeightLb3402() (string, bool) {
+	return d.lookupData(GrossWeightLb3402Code)
+}
+
+// NewGrossWeightLb3403 returns the element string of AI (3403) GROSS WEIGHT (lb). The value is checked against the
+// specification N6.
+func NewGrossWeightLb3403(value string) (ElementString, error) {
+	return newCheckedElementString(AI3403, value)
+}
+
+// GrossWeightLb3403 returns the data of AI (3403) GROSS WEIGHT (lb) if present.
+func (d Message) GrossWeightLb3403() (string, bool) {
+	return d.lookupData(GrossWeightLb3403Code)
+}
+
+// NewGrossWeightLb3404 returns the element string of AI (3404) GROSS WEIGHT (lb). The value is checked against the
+// specification N6.
+func NewGrossWeightLb3404(value string) (ElementString, error) {
+	return newCheckedElementString(AI3404, value)
+}
+
+// GrossWeightLb3404 returns the data of AI (3404) GROSS WEIGHT (lb) if present.
+func (d Message) GrossWeightLb3404() (string, bool) {
+	return d.lookupData(GrossWeightLb3404Code)
+}
+
+// NewGrossWeightLb3405 returns the element string of AI (3405) GROSS WEIGHT (lb). The value is checked against the
+// specification N6.
+func NewGrossWeightLb3405(value string) (ElementString, error) {
+	return newCheckedElementString(AI3405, value)
+}
+
+// GrossWeightLb3405 returns the data of AI (3405) GROSS WEIGHT (lb) if present.
+func (d Message) GrossWeightLb3405() (string, bool) {
+	return d.lookupData(GrossWeightLb3405Code)
+}
+
+// NewLengthInLog3410 returns the element string of AI (3410) LENGTH (in), log. The value is checked against the
+// specification N6.
+func NewLengthInLog3410(value string) (ElementString, error) {
+	return newCheckedElementString(AI3410, value)
+}
+
+// LengthInLog3410 returns the data of AI (3410) LENGTH (in), log if present.
+func (d Message) LengthInLog3410() (string, bool) {
+	return d.lookupData(LengthInLog3410Code)
+}
+
+// NewLengthInLog3411 returns the element string of AI (3411) LENGTH (in), log. The value is checked against the
+// specification N6.
+func NewLengthInLog3411(value string) (ElementString, error) {
+	return newCheckedElementString(AI3411, value)
+}
+
+// LengthInLog3411 returns the data of AI (3411) LENGTH (in), log if present.
+func (d Message) LengthInLog3411() (string, bool) {
+	return d.lookupData(LengthInLog3411Code)
+}
+
+// NewLengthInLog3412 returns the element string of AI (3412) LENGTH (in), log. The value is checked against the
+// specification N6.
+func NewLengthInLog3412(value string) (ElementString, error) {
+	return newCheckedElementString(AI3412, value)
+}
+
+// LengthInLog3412 returns the data of AI (3412) LENGTH (in), log if present.
+func (d Message) LengthInLog3412() (string, bool) {
+	return d.lookupData(LengthInLog3412Code)
+}
+
+// NewLengthInLog3413 returns the element string of AI (3413) LENGTH (in), log. The value is checked against the
+// specification N6.
+func NewLengthInLog3413(value string) (ElementString, error) {
+	return newCheckedElementString(AI3413, value)
+}
+
+// LengthInLog3413 returns the data of AI (3413) LENGTH (in), log if present.
+func (d Message) LengthInLog3413() (string, bool) {
+	return d.lookupData(LengthInLog3413Code)
+}
+
+// NewLengthInLog3414 returns the element string of AI (3414) LENGTH (in), log. The value is checked against the
+// specification N6.
+func NewLengthInLog3414(value string) (ElementString, error) {
+	return newCheckedElementString(AI3414, value)
+}
+
+// LengthInLog3414 returns the data of AI (3414) LENGTH (in), log if present.
+func (d Message) LengthInLog3414() (string, bool) {
+	return d.lookupData(LengthInLog3414Code)
+}
+
+// NewLengthInLog3415 returns the element string of AI (3415) LENGTH (in), log. The value is checked against the
+// specification N6.
+func NewLengthInLog3415(value string) (ElementString, error) {
+	return newCheckedElementString(AI3415, value)
+}
+
+// LengthInLog3415 returns the data of AI (3415) LENGTH (in), log if present.
+func (d Message) LengthInLog3415() (string, bool) {
+	return d.lookupData(LengthInLog3415Code)
+}
+
+// NewLengthFtLog3420 returns the element string of AI (3420) LENGTH (ft), log. The value is checked against the
+// specification N6.
+func NewLengthFtLog3420(value string) (ElementString, error) {
+	return newCheckedElementString(AI3420, value)
+}
+
+// LengthFtLog3420 returns the data of AI (3420) LENGTH (ft), log if present.
+func (d Message) LengthFtLog3420() (string, bool) {
+	return d.lookupData(LengthFtLog3420Code)
+}
+
+// NewLengthFtLog3421 returns the element string of AI (3421) LENGTH (ft), log. The value is checked against the
+// specification N6.
+func NewLengthFtLog3421(value string) (ElementString, error) {
+	return newCheckedElementString(AI3421, value)
+}
+
+// LengthFtLog3421 returns the data of AI (3421) LENGTH (ft), log if present.
+func (d Message) LengthFtLog3421() (string, bool) {
+	return d.lookupData(LengthFtLog3421Code)
+}
+
+// NewLengthFtLog3422 returns the element string of AI (3422) LENGTH (ft), log. The value is checked against the
+// specification N6.
+func NewLengthFtLog3422(value string) (ElementString, error) {
+	return newCheckedElementString(AI3422, value)
+}
+
+// LengthFtLog3422 returns the data of AI (3422) LENGTH (ft), log if present.
+func (d Message) LengthFtLog3422() (string, bool) {
+	return d.lookupData(LengthFtLog3422Code)
+}
+
+// NewLengthFtLog3423 returns the element string of AI (3423) LENGTH (ft), log. The value is checked against the
+// specification N6.
+func NewLengthFtLog3423(value string) (ElementString, error) {
+	return newCheckedElementString(AI3423, value)
+}
+
+// LengthFtLog3423 returns the data of AI (3423) LENGTH (ft), log if present.
+func (d Message) LengthFtLog3423() (string, bool) {
+	return d.lookupData(LengthFtLog3423Code)
+}
+
+// NewLengthFtLog3424 returns the element string of AI (3424) LENGTH (ft), log. The value is checked against the
+// specification N6.
+func NewLengthFtLog3424(value string) (ElementString, error) {
+	return newCheckedElementString(AI3424, value)
+}
+
+// LengthFtLog3424 returns the data of AI (3424) LENGTH (ft), log if present.
+func (d Message) LengthFtLog3424() (string, bool) {
+	return d.lookupData(LengthFtLog3424Code)
+}
+
+// NewLengthFtLog3425 returns the element string of AI (3425) LENGTH (ft), log. The value is checked against the
+// specification N6.
+func NewLengthFtLog3425(value string) (ElementString, error) {
+	return newCheckedElementString(AI3425, value)
+}
+
+// LengthFtLog3425 returns the data of AI (3425) LENGTH (ft), log if present.
+func (d Message) LengthFtLog3425() (string, bool) {
+	return d.lookupData(LengthFtLog3425Code)
+}
+
+// NewLengthYdLog3430 returns the element string of AI (3430) LENGTH (yd), log. The value is checked against the
+// specification N6.
+func NewLengthYdLog3430(value string) (ElementString, error) {
+	return newCheckedElementString(AI3430, value)
+}
+
+// LengthYdLog3430 returns the data of AI (3430) LENGTH (yd), log if present.
+func (d Message) LengthYdLog3430() (string, bool) {
+	return d.lookupData(LengthYdLog3430Code)
+}
+
+// NewLengthYdLog3431 returns the element string of AI (3431) LENGTH (yd), log. The value is checked against the
+// specification N6.
+func NewLengthYdLog3431(value string) (ElementString, error) {
+	return newCheckedElementString(AI3431, value)
+}
+
+// LengthYdLog3431 returns the data of AI (3431) LENGTH (yd), log if present.
+func (d Message) LengthYdLog3431() (string, bool) {
+	return d.lookupData(LengthYdLog3431Code)
+}
+
+// NewLengthYdLog3432 returns the element string of AI (3432) LENGTH (yd), log. The value is checked against the
+// specification N6.
+func NewLengthYdLog3432(value string) (ElementString, error) {
+	return newCheckedElementString(AI3432, value)
+}
+
+// LengthYdLog3432 returns the data of AI (3432) LENGTH (yd), log if present.
+func (d Message) LengthYdLog3432() (string, bool) {
+	return d.lookupData(LengthYdLog3432Code)
+}
+
+// NewLengthYdLog3433 returns the element string of AI (3433) LENGTH (yd), log. The value is checked against the
+// specification N6.
+func NewLengthYdLog3433(value string) (ElementString, error) {
+	return newCheckedElementString(AI3433, value)
+}
+
+// LengthYdLog3433 returns the data of AI (3433) LENGTH (yd), log if present.
+func (d Message) LengthYdLog3433() (string, bool) {
+	return d.lookupData(LengthYdLog3433Code)
+}
+
+// NewLengthYdLog3434 returns the element string of AI (3434) LENGTH (yd), log. The value is checked against the
+// specification N6.
+func NewLengthYdLog3434(value string) (ElementString, error) {
+	return newCheckedElementString(AI3434, value)
+}
+
+// LengthYdLog3434 returns the data of AI (3434) LENGTH (yd), log if present.
+func (d Message) LengthYdLog3434() (string, bool) {
+	return d.lookupData(LengthYdLog3434Code)
+}
+
+// NewLengthYdLog3435 returns the element string of AI (3435) LENGTH (yd), log. The value is checked against the
+// specification N6.
+func NewLengthYdLog3435(value string) (ElementString, error) {
+	return newCheckedElementString(AI3435, value)
+}
+
+// LengthYdLog3435 returns the data of AI (3435) LENGTH (yd), log if present.
+func (d Message) LengthYdLog3435() (string, bool) {
+	return d.lookupData(LengthYdLog3435Code)
+}
+
+// NewWidthInLog3440 returns the element string of AI (3440) WIDTH (in), log. The value is checked against the
+// specification N6.
+func NewWidthInLog3440(value string) (ElementString, error) {
+	return newCheckedElementString(AI3440, value)
+}
+
+// WidthInLog3440 returns the data of AI (3440) WIDTH (in), log if present.
+func (d Message) WidthInLog3440() (string, bool) {
+	return d.lookupData(WidthInLog3440Code)
+}
+
+// NewWidthInLog3441 returns the element string of AI (3441) WIDTH (in), log. The value is checked against the
+// specification N6.
+func NewWidthInLog3441(value string) (ElementString, error) {
+	return newCheckedElementString(AI3441, value)
+}
+
+// WidthInLog3441 returns the data of AI (3441) WIDTH (in), log if present.
+func (d Message) WidthInLog3441() (string, bool) {
+	return d.lookupData(WidthInLog3441Code)
+}
+
+// NewWidthInLog3442 returns the element string of AI (3442) WIDTH (in), log. The value is checked against the
+// specification N6.
+func NewWidthInLog3442(value string) (ElementString, error) {
+	return newCheckedElementString(AI3442, value)
+}
+
+// WidthInLog3442 returns the data of AI (3442) WIDTH (in), log if present.
+func (d Message) WidthInLog3442() (string, bool) {
+	return d.lookupData(WidthInLog3442Code)
+}
+
+// NewWidthInLog3443 returns the element string of AI (3443) WIDTH (in), log. The value is checked against the
+// specification N6.
+func NewWidthInLog3443(value string) (ElementString, error) {
+	return newCheckedElementString(AI3443, value)
+}
+
+// WidthInLog3443 returns the data of AI (3443) WIDTH (in), log if present.
+func (d Message) WidthInLog3443() (string, bool) {
+	return d.lookupData(WidthInLog3443Code)
+}
+
+// NewWidthInLog3444 returns the element string of AI (3444) WIDTH (in), log. The value is checked against the
+// specification N6.
+func NewWidthInLog3444(value string) (ElementString, error) {
+	return newCheckedElementString(AI3444, value)
+}
+
+// WidthInLog3444 returns the data of AI (3444) WIDTH (in), log if present.
+func (d Message) WidthInLog3444() (string, bool) {
+	return d.lookupData(WidthInLog3444Code)
+}
+
+// NewWidthInLog3445 returns the element string of AI (3445) WIDTH (in), log. The value is checked against the
+// specification N6.
+func NewWidthInLog3445(value string) (ElementString, error) {
+	return newCheckedElementString(AI3445, value)
+}
+
+// WidthInLog3445 returns the data of AI (3445) WIDTH (in), log if present.
+func (d Message) WidthInLog3445() (string, bool) {
+	return d.lookupData(WidthInLog3445Code)
+}
+
+// NewWidthFtLog3450 returns the element string of AI (3450) WIDTH (ft), log. The value is checked against the
+// specification N6.
+func NewWidthFtLog3450(value string) (ElementString, error) {
+	return newCheckedElementString(AI3450, value)
+}
+
+// WidthFtLog3450 returns the data of AI (3450) WIDTH (ft), log if present.
+func (d Message) WidthFtLog3450() (string, bool) {
+	return d.lookupData(WidthFtLog3450Code)
+}
+
+// NewWidthFtLog3451 returns the element string of AI (3451) WIDTH (ft), log. The value is checked against the
+// specification N6.
+func NewWidthFtLog3451(value string) (ElementString, error) {
+	return newCheckedElementString(AI3451, value)
+}
+
+// WidthFtLog3451 returns the data of AI (3451) WIDTH (ft), log if present.
+func (d Message) WidthFtLog3451() (string, bool) {
+	return d.lookupData(WidthFtLog3451Code)
+}
+
+// NewWidthFtLog3452 returns the element string of AI (3452) WIDTH (ft), log. The value is checked against the
+// specification N6.
+func NewWidthFtLog3452(value string) (ElementString, error) {
+	return newCheckedElementString(AI3452, value)
+}
+
+// WidthFtLog3452 returns the data of AI (3452) WIDTH (ft), log if present.
+func (d Message) WidthFtLog3452() (string, bool) {
+	return d.lookupData(WidthFtLog3452Code)
+}
+
+// NewWidthFtLog3453 returns the element string of AI (3453) WIDTH (ft), log. The value is checked against the
+// specification N6.
+func NewWidthFtLog3453(value string) (ElementString, error) {
+	return newCheckedElementString(AI3453, value)
+}
+
+// WidthFtLog3453 returns the data of AI (3453) WIDTH (ft), log if present.
+func (d Message) WidthFtLog3453() (string, bool) {
+	return d.lookupData(WidthFtLog3453Code)
+}
+
+// NewWidthFtLog3454 returns the element string of AI (3454) WIDTH (ft), log. The value is checked against the
+// specification N6.
+func NewWidthFtLog3454(value string) (ElementString, error) {
+	return newCheckedElementString(AI3454, value)
+}
+
+// WidthFtLog3454 returns the data of AI (3454) WIDTH (ft), log if present.
+func (d Message) WidthFtLog3454() (string, bool) {
+	return d.lookupData(WidthFtLog3454Code)
+}
+
+// NewWidthFtLog3455 returns the element string of AI (3455) WIDTH (ft), log. The value is checked against the
+// specification N6.
+func NewWidthFtLog3455(value string) (ElementString, error) {
+	return newCheckedElementString(AI3455, value)
+}
+
+// WidthFtLog3455 returns the data of AI (3455) WIDTH (ft), log if present.
+func (d Message) WidthFtLog3455() (string, bool) {
+	return d.lookupData(WidthFtLog3455Code)
+}
+
+// NewWidthYdLog3460 returns the element string of AI (3460) WIDTH (yd), log. The value is checked against the
+// specification N6.
+func NewWidthYdLog3460(value string) (ElementString, error) {
+	return newCheckedElementString(AI3460, value)
+}
+
+// WidthYdLog3460 returns the data of AI (3460) WIDTH (yd), log if present.
+func (d Message) WidthYdLog3460() (string, bool) {
+	return d.lookupData(WidthYdLog3460Code)
+}
+
+// NewWidthYdLog3461 returns the element string of AI (3461) WIDTH (yd), log. The value is checked against the
+// specification N6.
+func NewWidthYdLog3461(value string) (ElementString, error) {
+	return newCheckedElementString(AI3461, value)
+}
+
+// WidthYdLog3461 returns the data of AI (3461) WIDTH (yd), log if present.
+func (d Message) WidthYdLog3461() (string, bool) {
+	return d.lookupData(WidthYdLog3461Code)
+}
+
+// NewWidthYdLog3462 returns the element string of AI (3462) WIDTH (yd), log. The value is checked against the
+// specification N6.
+func NewWidthYdLog3462(value string) (ElementString, error) {
+	return newCheckedElementString(AI3462, value)
+}
+
+// WidthYdLog3462 returns the data of AI (3462) WIDTH (yd), log if present.
+func (d Message) WidthYdLog3462() (string, bool) {
+	return d.lookupData(WidthYdLog3462Code)
+}
+
+// NewWidthYdLog3463 returns the element string of AI (3463) WIDTH (yd), log. The value is checked against the
+// specification N6.
+func NewWidthYdLog3463(value string) (ElementString, error) {
+	return newCheckedElementString(AI3463, value)
+}
+
+// WidthYdLog3463 returns the data of AI (3463) WIDTH (yd), log if present.
+func (d Message) WidthYdLog3463() (string, bool) {
+	return d.lookupData(WidthYdLog3463Code)
+}
+
+// NewWidthYdLog3464 returns the element string of AI (3464) WIDTH (yd), log. The value is checked against the
+// specification N6.
+func NewWidthYdLog3464(value string) (ElementString, error) {
+	return newCheckedElementString(AI3464, value)
+}
+
+// WidthYdLog3464 returns the data of AI (3464) WIDTH (yd), log if present.
+func (d Message) WidthYdLog3464() (string, bool) {
+	return d.lookupData(WidthYdLog3464Code)
+}
+
+// NewWidthYdLog3465 returns the element string of AI (3465) WIDTH (yd), log. The value is checked against the
+// specification N6.
+func NewWidthYdLog3465(value string) (ElementString, error) {
+	return newCheckedElementString(AI3465, value)
+}
+
+// WidthYdLog3465 returns the data of AI (3465) WIDTH (yd), log if present.
+func (d Message) WidthYdLog3465() (string, bool) {
+	return d.lookupData(WidthYdLog3465Code)
+}
+
+// NewHeightInLog3470 returns the element string of AI (3470) HEIGHT (in), log. The value is checked against the
+// specification N6.
+func NewHeightInLog3470(value string) (ElementString, error) {
+	return newCheckedElementString(AI3470, value)
+}
+
+// HeightInLog3470 returns the data of AI (3470) HEIGHT (in), log if present.
+func (d Message) HeightInLog3470() (string, bool) {
+	return d.lookupData(HeightInLog3470Code)
+}
+
+// NewHeightInLog3471 returns the element string of AI (3471) HEIGHT (in), log. The value is checked against the
+// specification N6.
+func NewHeightInLog3471(value string) (ElementString, error) {
+	return newCheckedElementString(AI3471, value)
+}
+
+// HeightInLog3471 returns the data of AI (3471) HEIGHT (in), log if present.
+func (d Message) HeightInLog3471() (string, bool) {
+	return d.lookupData(HeightInLog3471Code)
+}
+
+// NewHeightInLog3472 returns the element string of AI (3472) HEIGHT (in), log. The value is checked against the
+// specification N6.
+func NewHeightInLog3472(value string) (ElementString, error) {
+	return newCheckedElementString(AI3472, value)
+}
+
+// HeightInLog3472 returns the data of AI (3472) HEIGHT (in), log if present.
+func (d Message) HeightInLog3472() (string, bool) {
+	return d.lookupData(HeightInLog3472Code)
+}
+
+// NewHeightInLog3473 returns the element string of AI (3473) HEIGHT (in), log. The value is checked against the
+// specification N6.
+func NewHeightInLog3473(value string) (ElementString, error) {
+	return newCheckedElementString(AI3473, value)
+}
+
+// HeightInLog3473 returns the data of AI (3473) HEIGHT (in), log if present.
+func (d Message) HeightInLog3473() (string, bool) {
+	return d.lookupData(HeightInLog3473Code)
+}
+
+// NewHeightInLog3474 returns the element string of AI (3474) HEIGHT (in), log. The value is checked against the
+// specification N6.
+func NewHeightInLog3474(value string) (ElementString, error) {
+	return newCheckedElementString(AI3474, value)
+}
+
+// HeightInLog3474 returns the data of AI (3474) HEIGHT (in), log if present.
+func (d Message) HeightInLog3474() (string, bool) {
+	return d.lookupData(HeightInLog3474Code)
+}
+
+// NewHeightInLog3475 returns the element string of AI (3475) HEIGHT (in), log. The value is checked against the
+// specification N6.
+func NewHeightInLog3475(value string) (ElementString, error) {
+	return newCheckedElementString(AI3475, value)
+}
+
+// HeightInLog3475 returns the data of AI (3475) HEIGHT (in), log if present.
+func (d Message) HeightInLog3475() (string, bool) {
+	return d.lookupData(HeightInLog3475Code)
+}
+
+// NewHeightFtLog3480 returns the element string of AI (3480) HEIGHT (ft), log. The value is checked against the
+// specification N6.
+func NewHeightFtLog3480(value string) (ElementString, error) {
+	return newCheckedElementString(AI3480, value)
+}
+
+// HeightFtLog3480 returns the data of AI (3480) HEIGHT (ft), log if present.
+func (d Message) HeightFtLog3480() (string, bool) {
+	return d.lookupData(HeightFtLog3480Code)
+}
+
+// NewHeightFtLog3481 returns the element string of AI (3481) HEIGHT (ft), log. The value is checked against the
+// specification N6.
+func NewHeightFtLog3481(value string) (ElementString, error) {
+	return newCheckedElementString(AI3481, value)
+}
+
+// HeightFtLog3481 returns the data of AI (3481) HEIGHT (ft), log if present.
+func (d Message) HeightFtLog3481() (string, bool) {
+	return d.lookupData(HeightFtLog3481Code)
+}
+
+// NewHeightFtLog3482 returns the element string of AI (3482) HEIGHT (ft), log. The value is checked against the
+// specification N6.
+func NewHeightFtLog3482(value string) (ElementString, error) {
+	return newCheckedElementString(AI3482, value)
+}
+
+// HeightFtLog3482 returns the data of AI (3482) HEIGHT (ft), log if present.
+func (d Message) HeightFtLog3482() (string, bool) {
+	return d.lookupData(HeightFtLog3482Code)
+}
+
+// NewHeightFtLog3483 returns the element string of AI (3483) HEIGHT (ft), log. The value is checked against the
+// specification N6.
+func NewHeightFtLog3483(value string) (ElementString, error) {
+	return newCheckedElementString(AI3483, value)
+}
+
+// HeightFtLog3483 returns the data of AI (3483) HEIGHT (ft), log if present.
+func (d Message) HeightFtLog3483() (string, bool) {
+	return d.lookupData(HeightFtLog3483Code)
+}
+
+// NewHeightFtLog3484 returns the element string of AI (3484) HEIGHT (ft), log. The value is checked against the
+// specification N6.
+func NewHeightFtLog3484(value string) (ElementString, error) {
+	return newCheckedElementString(AI3484, value)
+}
+
+// HeightFtLog3484 returns the data of AI (3484) HEIGHT (ft), log if present.
+func (d Message) HeightFtLog3484() (string, bool) {
+	return d.lookupData(HeightFtLog3484Code)
+}
+
+// NewHeightFtLog3485 returns the element string of AI (3485) HEIGHT (ft), log. The value is checked against the
+// specification N6.
+func NewHeightFtLog3485(value string) (ElementString, error) {
+	return newCheckedElementString(AI3485, value)
+}
+
+// HeightFtLog3485 returns the data of AI (3485) HEIGHT (ft), log if present.
+func (d Message) HeightFtLog3485() (string, bool) {
+	return d.lookupData(HeightFtLog3485Code)
+}
+
+// NewHeightYdLog3490 returns the element string of AI (3490) HEIGHT (yd), log. The value is checked against the
+// specification N6.
+func NewHeightYdLog3490(value string) (ElementString, error) {
+	return newCheckedElementString(AI3490, value)
+}
+
+// HeightYdLog3490 returns the data of AI (3490) HEIGHT (yd), log if present.
+func (d Message) HeightYdLog3490() (string, bool) {
+	return d.lookupData(HeightYdLog3490Code)
+}
+
+// NewHeightYdLog3491 returns the element string of AI (3491) HEIGHT (yd), log. The value is checked against the
+// specification N6.
+func NewHeightYdLog3491(value string) (ElementString, error) {
+	return newCheckedElementString(AI3491, value)
+}
+
+// HeightYdLog3491 returns the data of AI (3491) HEIGHT (yd), log if present.
+func (d Message) HeightYdLog3491() (string, bool) {
+	return d.lookupData(HeightYdLog3491Code)
+}
+
+// NewHeightYdLog3492 returns the element string of AI (3492) HEIGHT (yd), log. The value is checked against the
+// specification N6.
+func NewHeightYdLog3492(value string) (ElementString, error) {
+	return newCheckedElementString(AI3492, value)
+}
+
+// HeightYdLog3492 returns the data of AI (3492) HEIGHT (yd), log if present.
+func (d Message) HeightYdLog3492() (string, bool) {
+	return d.lookupData(HeightYdLog3492Code)
+}
+
+// NewHeightYdLog3493 returns the element string of AI (3493) HEIGHT (yd), log. The value is checked against the
+// specification N6.
+func NewHeightYdLog3493(value string) (ElementString, error) {
+	return newCheckedElementString(AI3493, value)
+}
+
+// HeightYdLog3493 returns the data of AI (3493) HEIGHT (yd), log if present.
+func (d Message) HeightYdLog3493() (string, bool) {
+	return d.lookupData(HeightYdLog3493Code)
+}
+
+// NewHeightYdLog3494 returns the element string of AI (3494) HEIGHT (yd), log. The value is checked against the
+// specification N6.
+func NewHeightYdLog3494(value string) (ElementString, error) {
+	return newCheckedElementString(AI3494, value)
+}
+
+// HeightYdLog3494 returns the data of AI (3494) HEIGHT (yd), log if present.
+func (d Message) HeightYdLog3494() (string, bool) {
+	return d.lookupData(HeightYdLog3494Code)
+}
+
+// NewHeightYdLog3495 returns the element string of AI (3495) HEIGHT (yd), log. The value is checked against the
+// specification N6.
+func NewHeightYdLog3495(value string) (ElementString, error) {
+	return newCheckedElementString(AI3495, value)
+}
+
+// HeightYdLog3495 returns the data of AI (3495) HEIGHT (yd), log if present.
+func (d Message) HeightYdLog3495() (string, bool) {
+	return d.lookupData(HeightYdLog3495Code)
+}
+
+// NewAreaIn23500 returns the element string of AI (3500) AREA (in²). The value is checked against the
+// specification N6.
+func NewAreaIn23500(value string) (ElementString, error) {
+	return newCheckedElementString(AI3500, value)
+}
+
+// AreaIn23500 returns the data of AI (3500) AREA (in²) if present.
+func (d Message) AreaIn23500() (string, bool) {
+	return d.lookupData(AreaIn23500Code)
+}
+
+// NewAreaIn23501 returns the element string of AI (3501) AREA (in²). The value is checked against the
+// specification N6.
+func NewAreaIn23501(value string) (ElementString, error) {
+	return newCheckedElementString(AI3501, value)
+}
+
+// AreaIn23501 returns the data of AI (3501) AREA (in²) if present.
+func (d Message) AreaIn23501() (string, bool) {
+	return d.lookupData(AreaIn23501Code)
+}
+
+// NewAreaIn23502 returns the element string of AI (3502) AREA (in²). The value is checked against the
+// specification N6.
+func NewAreaIn23502(value string) (ElementString, error) {
+	return newCheckedElementString(AI3502, value)
+}
+
+// AreaIn23502 returns the data of AI (3502) AREA (in²) if present.
+func (d Message) AreaIn23502() (string, bool) {
+	return d.lookupData(AreaIn23502Code)
+}
+
+// NewAreaIn23503 returns the element string of AI (3503) AREA (in²). The value is checked against the
+// specification N6.
+func NewAreaIn23503(value string) (ElementString, error) {
+	return newCheckedElementString(AI3503, value)
+}
+
+// AreaIn23503 returns the data of AI (3503) AREA (in²) if present.
+func (d Message) AreaIn23503() (string, bool) {
+	return d.lookupData(AreaIn23503Code)
+}
+
+// NewAreaIn23504 returns the element string of AI (3504) AREA (in²). The value is checked against the
+// specification N6.
+func NewAreaIn23504(value string) (ElementString, error) {
+	return newCheckedElementString(AI3504, value)
+}
+
+// AreaIn23504 returns the data of AI (3504) AREA (in²) if present.
+func (d Message) AreaIn23504() (string, bool) {
+	return d.lookupData(AreaIn23504Code)
+}
+
+// NewAreaIn23505 returns the element string of AI (3505) AREA (in²). The value is checked against the
+// specification N6.
+func NewAreaIn23505(value string) (ElementString, error) {
+	return newCheckedElementString(AI3505, value)
+}
+
+// AreaIn23505 returns the data of AI (3505) AREA (in²) if present.
+func (d Message) AreaIn23505() (string, bool) {
+	return d.lookupData(AreaIn23505Code)
+}
+
+// NewAreaFt23510 returns the element string of AI (3510) AREA (ft²). The value is checked against the
+// specification N6.
+func NewAreaFt23510(value string) (ElementString, error) {
+	return newCheckedElementString(AI3510, value)
+}
+
+// AreaFt23510 returns the data of AI (3510) AREA (ft²) if present.
+func (d Message) AreaFt23510() (string, bool) {
+	return d.lookupData(AreaFt23510Code)
+}
+
+// NewAreaFt23511 returns the element string of AI (3511) AREA (ft²). The value is checked against the
+// specification N6.
+func NewAreaFt23511(value string) (ElementString, error) {
+	return newCheckedElementString(AI3511, value)
+}
+
+// AreaFt23511 returns the data of AI (3511) AREA (ft²) if present.
+func (d Message) AreaFt23511() (string, bool) {
+	return d.lookupData(AreaFt23511Code)
+}
+
+// NewAreaFt23512 returns the element string of AI (3512) AREA (ft²). The value is checked against the
+// specification N6.
+func NewAreaFt23512(value string) (ElementString, error) {
+	return newCheckedElementString(AI3512, value)
+}
+
+// AreaFt23512 returns the data of AI (3512) AREA (ft²) if present.
+func (d Message) AreaFt23512() (string, bool) {
+	return d.lookupData(AreaFt23512Code)
+}
+
+// NewAreaFt23513 returns the element string of AI (3513) AREA (ft²). The value is checked against the
+// specification N6.
+func NewAreaFt23513(value string) (ElementString, error) {
+	return newCheckedElementString(AI3513, value)
+}
+
+// AreaFt23513 returns the data of AI (3513) AREA (ft²) if present.
+func (d Message) AreaFt23513() (string, bool) {
+	return d.lookupData(AreaFt23513Code)
+}
+
+// NewAreaFt23514 returns the element string of AI (3514) AREA (ft²). The value is checked against the
+// specification N6.
+func NewAreaFt23514(value string) (ElementString, error) {
+	return newCheckedElementString(AI3514, value)
+}
+
+// AreaFt23514 returns the data of AI (3514) AREA (ft²) if present.
+func (d Message) AreaFt23514() (string, bool) {
+	return d.lookupData(AreaFt23514Code)
+}
+
+// NewAreaFt23515 returns the element string of AI (3515) AREA (ft²). The value is checked against the
+// specification N6.
+func NewAreaFt23515(value string) (ElementString, error) {
+	return newCheckedElementString(AI3515, value)
+}
+
+// AreaFt23515 returns the data of AI (3515) AREA (ft²) if present.
+func (d Message) AreaFt23515() (string, bool) {
+	return d.lookupData(AreaFt23515Code)
+}
+
+// NewAreaYd23520 returns the element string of AI (3520) AREA (yd²). The value is checked against the
+// specification N6.
+func NewAreaYd23520(value string) (ElementString, error) {
+	return newCheckedElementString(AI3520, value)
+}
+
+// AreaYd23520 returns the data of AI (3520) AREA (yd²) if present.
+func (d Message) AreaYd23520() (string, bool) {
+	return d.lookupData(AreaYd23520Code)
+}
+
+// NewAreaYd23521 returns the element string of AI (3521) AREA (yd²). The value is checked against the
+// specification N6.
+func NewAreaYd23521(value string) (ElementString, error) {
+	return newCheckedElementString(AI3521, value)
+}
+
+// AreaYd23521 returns the data of AI (3521) AREA (yd²) if present.
+func (d Message) AreaYd23521() (string, bool) {
+	return d.lookupData(AreaYd23521Code)
+}
+
+// NewAreaYd23522 returns the element string of AI (3522) AREA (yd²). The value is checked against the
+// specification N6.
+func NewAreaYd23522(value string) (ElementString, error) {
+	return newCheckedElementString(AI3522, value)
+}
+
+// AreaYd23522 returns the data of AI (3522) AREA (yd²) if present.
+func (d Message) AreaYd23522() (string, bool) {
+	return d.lookupData(AreaYd23522Code)
+}
+
+// NewAreaYd23523 returns the element string of AI (3523) AREA (yd²). The value is checked against the
+// specification N6.
+func NewAreaYd23523(value string) (ElementString, error) {
+	return newCheckedElementString(AI3523, value)
+}
+
+// AreaYd23523 returns the data of AI (3523) AREA (yd²) if present.
+func (d Message) AreaYd23523() (string, bool) {
+	return d.lookupData(AreaYd23523Code)
+}
+
+// NewAreaYd23524 returns the element string of AI (3524) AREA (yd²). The value is checked against the
+// specification N6.
+func NewAreaYd23524(value string) (ElementString, error) {
+	return newCheckedElementString(AI3524, value)
+}
+
+// AreaYd23524 returns the data of AI (3524) AREA (yd²) if present.
+func (d Message) AreaYd23524() (string, bool) {
+	return d.lookupData(AreaYd23524Code)
+}
+
+// NewAreaYd23525 returns the element string of AI (3525) AREA (yd²). The value is checked against the
+// specification N6.
+func NewAreaYd23525(value string) (ElementString, error) {
+	return newCheckedElementString(AI3525, value)
+}
+
+// AreaYd23525 returns the data of AI (3525) AREA (yd²) if present.
+func (d Message) AreaYd23525() (string, bool) {
+	return d.lookupData(AreaYd23525Code)
+}
+
+// NewAreaIn2Log3530 returns the element string of AI (3530) AREA (in²), log. The value is checked against the
+// specification N6.
+func NewAreaIn2Log3530(value string) (ElementString, error) {
+	return newCheckedElementString(AI3530, value)
+}
+
+// AreaIn2Log3530 returns the data of AI (3530) AREA (in²), log if present.
+func (d Message) AreaIn2Log3530() (string, bool) {
+	return d.lookupData(AreaIn2Log3530Code)
+}
+
+// NewAreaIn2Log3531 returns the element string of AI (3531) AREA (in²), log. The value is checked against the
+// specification N6.
+func NewAreaIn2Log3531(value string) (ElementString, error) {
+	return newCheckedElementString(AI3531, value)
+}
+
+// AreaIn2Log3531 returns the data of AI (3531) AREA (in²), log if present.
+func (d Message) AreaIn2Log3531() (string, bool) {
+	return d.lookupData(AreaIn2Log3531Code)
+}
+
+// NewAreaIn2Log3532 returns the element string of AI (3532) AREA (in²), log. The value is checked against the
+// specification N6.
+func NewAreaIn2Log3532(value string) (ElementString, error) {
+	return newCheckedElementString(AI3532, value)
+}
+
+// AreaIn2Log3532 returns the data of AI (3532) AREA (in²), log if present.
+func (d Message) AreaIn2Log3532() (string, bool) {
+	return d.lookupData(AreaIn2Log3532Code)
+}
+
+// NewAreaIn2Log3533 returns the element string of AI (3533) AREA (in²), log. The value is checked against the
+// specification N6.
+func NewAreaIn2Log3533(value string) (ElementString, error) {
+	return newCheckedElementString(AI3533, value)
+}
+
+// AreaIn2Log3533 returns the data of AI (3533) AREA (in²), log if present.
+func (d Message) AreaIn2Log3533() (string, bool) {
+	return d.lookupData(AreaIn2Log3533Code)
+}
+
+// NewAreaIn2Log3534 returns the element string of AI (3534) AREA (in²), log. The value is checked against the
+// specification N6.
+func NewAreaIn2Log3534(value string) (ElementString, error) {
+	return newCheckedElementString(AI3534, value)
+}
+
+// AreaIn2Log3534 returns the data of AI (3534) AREA (in²), log if present.
+func (d Message) AreaIn2Log3534() (string, bool) {
+	return d.lookupData(AreaIn2Log3534Code)
+}
+
+// NewAreaIn2Log3535 returns the element string of AI (3535) AREA (in²), log. The value is checked against the
+// specification N6.
+func NewAreaIn2Log3535(value string) (ElementString, error) {
+	return newCheckedElementString(AI3535, value)
+}
+
+// AreaIn2Log3535 returns the data of AI (3535) AREA (in²), log if present.
+func (d Message) AreaIn2Log3535() (string, bool) {
+	return d.lookupData(AreaIn2Log3535Code)
+}
+
+// NewAreaFt2Log3540 returns the element string of AI (3540) AREA (ft²), log. The value is checked against the
+// specification N6.
+func NewAreaFt2Log3540(value string) (ElementString, error) {
+	return newCheckedElementString(AI3540, value)
+}
+
+// AreaFt2Log3540 returns the data of AI (3540) AREA (ft²), log if present.
+func (d Message) AreaFt2Log3540() (string, bool) {
+	return d.lookupData(AreaFt2Log3540Code)
+}
+
+// NewAreaFt2Log3541 returns the element string of AI (3541) AREA (ft²), log. The value is checked against the
+// specification N6.
+func NewAreaFt2Log3541(value string) (ElementString, error) {
+	return newCheckedElementString(AI3541, value)
+}
+
+// AreaFt2Log3541 returns the data of AI (3541) AREA (ft²), log if present.
+func (d Message) AreaFt2Log3541() (string, bool) {
+	return d.lookupData(AreaFt2Log3541Code)
+}
+
+// NewAreaFt2Log3542 returns the element string of AI (3542) AREA (ft²), log. The value is checked against the
+// specification N6.
+func NewAreaFt2Log3542(value string) (ElementString, error) {
+	return newCheckedElementString(AI3542, value)
+}
+
+// AreaFt2Log3542 returns the data of AI (3542) AREA (ft²), log if present.
+func (d Message) AreaFt2Log3542() (string, bool) {
+	return d.lookupData(AreaFt2Log3542Code)
+}
+
+// NewAreaFt2Log3543 returns the element string of AI (3543) AREA (ft²), log. The value is checked against the
+// specification N6.
+func NewAreaFt2Log3543(value string) (ElementString, error) {
+	return newCheckedElementString(AI3543, value)
+}
+
+// AreaFt2Log3543 returns the data of AI (3543) AREA (ft²), log if present.
+func (d Message) AreaFt2Log3543() (string, bool) {
+	return d.lookupData(AreaFt2Log3543Code)
+}
+
+// NewAreaFt2Log3544 returns the element string of AI (3544) AREA (ft²), log. The value is checked against the
+// specification N6.
+func NewAreaFt2Log3544(value string) (ElementString, error) {
+	return newCheckedElementString(AI3544, value)
+}
+
+// AreaFt2Log3544 returns the data of AI (3544) AREA (ft²), log if present.
+func (d Message) AreaFt2Log3544() (string, bool) {
+	return d.lookupData(AreaFt2Log3544Code)
+}
+
+// NewAreaFt2Log3545 returns the element string of AI (3545) AREA (ft²), log. The value is checked against the
+// specification N6.
+func NewAreaFt2Log3545(value string) (ElementString, error) {
+	return newCheckedElementString(AI3545, value)
+}
+
+// AreaFt2Log3545 returns the data of AI (3545) AREA (ft²), log if present.
+func (d Message) AreaFt2Log3545() (string, bool) {
+	return d.lookupData(AreaFt2Log3545Code)
+}
+
+// NewAreaYd2Log3550 returns the element string of AI (3550) AREA (yd²), log. The value is checked against the
+// specification N6.
+func NewAreaYd2Log3550(value string) (ElementString, error) {
+	return newCheckedElementString(AI3550, value)
+}
+
+// AreaYd2Log3550 returns the data of AI (3550) AREA (yd²), log if present.
+func (d Message) AreaYd2Log3550() (string, bool) {
+	return d.lookupData(AreaYd2Log3550Code)
+}
+
+// NewAreaYd2Log3551 returns the element string of AI (3551) AREA (yd²), log. The value is checked against the
+// specification N6.
+func NewAreaYd2Log3551(value string) (ElementString, error) {
+	return newCheckedElementString(AI3551, value)
+}
+
+// AreaYd2Log3551 returns the data of AI (3551) AREA (yd²), log if present.
+func (d Message) AreaYd2Log3551() (string, bool) {
+	return d.lookupData(AreaYd2Log3551Code)
+}
+
+// NewAreaYd2Log3552 returns the element string of AI (3552) AREA (yd²), log. The value is checked against the
+// specification N6.
+func NewAreaYd2Log3552(value string) (ElementString, error) {
+	return newCheckedElementString(AI3552, value)
+}
+
+// AreaYd2Log3552 returns the data of AI (3552) AREA (yd²), log if present.
+func (d Message) AreaYd2Log3552() (string, bool) {
+	return d.lookupData(AreaYd2Log3552Code)
+}
+
+// NewAreaYd2Log3553 returns the element string of AI (3553) AREA (yd²), log. The value is checked against the
+// specification N6.
+func NewAreaYd2Log3553(value string) (ElementString, error) {
+	return newCheckedElementString(AI3553, value)
+}
+
+// AreaYd2Log3553 returns the data of AI (3553) AREA (yd²), log if present.
+func (d Message) AreaYd2Log3553() (string, bool) {
+	return d.lookupData(AreaYd2Log3553Code)
+}
+
+// NewAreaYd2Log3554 returns the element string of AI (3554) AREA (yd²), log. The value is checked against the
+// specification N6.
+func NewAreaYd2Log3554(value string) (ElementString, error) {
+	return newCheckedElementString(AI3554, value)
+}
+
+// AreaYd2Log3554 returns the data of AI (3554) AREA (yd²), log if present.
+func (d Message) AreaYd2Log3554() (string, bool) {
+	return d.lookupData(AreaYd2Log3554Code)
+}
+
+// NewAreaYd2Log3555 returns the element string of AI (3555) AREA (yd²), log. The value is checked against the
+// specification N6.
+func NewAreaYd2Log3555(value string) (ElementString, error) {
+	return newCheckedElementString(AI3555, value)
+}
+
+// AreaYd2Log3555 returns the data of AI (3555) AREA (yd²), log if present.
+func (d Message) AreaYd2Log3555() (string, bool) {
+	return d.lookupData(AreaYd2Log3555Code)
+}
+
+// NewNetWeightTOz3560 returns the element string of AI (3560) NET WEIGHT (t oz). The value is checked against the
+// specification N6.
+func NewNetWeightTOz3560(value string) (ElementString, error) {
+	return newCheckedElementString(AI3560, value)
+}
+
+// NetWeightTOz3560 returns the data of AI (3560) NET WEIGHT (t oz) if present.
+func (d Message) NetWeightTOz3560() (string, bool) {
+	return d.lookupData(NetWeightTOz3560Code)
+}
+
+// NewNetWeightTOz3561 returns the element string of AI (3561) NET WEIGHT (t oz). The value is checked against the
+// specification N6.
+func NewNetWeightTOz3561(value string) (ElementString, error) {
+	return newCheckedElementString(AI3561, value)
+}
+
+// NetWeightTOz3561 returns the data of AI (3561) NET WEIGHT (t oz) if present.
+func (d Message) NetWeightTOz3561() (string, bool) {
+	return d.lookupData(NetWeightTOz3561Code)
+}
+
+// NewNetWeightTOz3562 returns the element string of AI (3562) NET WEIGHT (t oz). The value is checked against the
+// specification N6.
+func NewNetWeightTOz3562(value string) (ElementString, error) {
+	return newCheckedElementString(AI3562, value)
+}
+
+// NetWeightTOz3562 returns the data of AI (3562) NET WEIGHT (t oz) if present.
+func (d Message) NetWeightTOz3562() (string, bool) {
+	return d.lookupData(NetWeightTOz3562Code)
+}
+
+// NewNetWeightTOz3563 returns the element string of AI (3563) NET WEIGHT (t oz). The value is checked against the
+// specification N6.
+func NewNetWeightTOz3563(value string) (ElementString, error) {
+	return newCheckedElementString(AI3563, value)
+}
+
+// NetWeightTOz3563 returns the data of AI (3563) NET WEIGHT (t oz) if present.
+func (d Message) NetWeightTOz3563() (string, bool) {
+	return d.lookupData(NetWeightTOz3563Code)
+}
+
+// NewNetWeightTOz3564 returns the element string of AI (3564) NET WEIGHT (t oz). The value is checked against the
+// specification N6.
+func NewNetWeightTOz3564(value string) (ElementString, error) {
+	return newCheckedElementString(AI3564, value)
+}
+
+// NetWeightTOz3564 returns the data of AI (3564) NET WEIGHT (t oz) if present.
+func (d Message) NetWeightTOz3564() (string, bool) {
+	return d.lookupData(NetWeightTOz3564Code)
+}
+
+// NewNetWeightTOz3565 returns the element string of AI (3565) NET WEIGHT (t oz). The value is checked against the
+// specification N6.
+func NewNetWeightTOz3565(value string) (ElementString, error) {
+	return newCheckedElementString(AI3565, value)
+}
+
+// NetWeightTOz3565 returns the data of AI (3565) NET WEIGHT (t oz) if present.
+func (d Message) NetWeightTOz3565() (string, bool) {
+	return d.lookupData(NetWeightTOz3565Code)
+}
+
+// NewNetVolumeOz3570 returns the element string of AI (3570) NET VOLUME (oz). The value is checked against the
+// specification N6.
+func NewNetVolumeOz3570(value string) (ElementString, error) {
+	return newCheckedElementString(AI3570, value)
+}
+
+// NetVolumeOz3570 returns the data of AI (3570) NET VOLUME (oz) if present.
+func (d Message) NetVolumeOz3570() (string, bool) {
+	return d.lookupData(NetVolumeOz3570Code)
+}
+
+// NewNetVolumeOz3571 returns the element string of AI (3571) NET VOLUME (oz). The value is checked against the
+// specification N6.
+func NewNetVolumeOz3571(value string) (ElementString, error) {
+	return newCheckedElementString(AI3571, value)
+}
+
+// NetVolumeOz3571 returns the data of AI (3571) NET VOLUME (oz) if present.
+func (d Message) NetVolumeOz3571() (string, bool) {
+	return d.lookupData(NetVolumeOz3571Code)
+}
+
+// NewNetVolumeOz3572 returns the element string of AI (3572) NET VOLUME (oz). The value is checked against the
+// specification N6.
+func NewNetVolumeOz3572(value string) (ElementString, error) {
+	return newCheckedElementString(AI3572, value)
+}
+
+// NetVolumeOz3572 returns the data of AI (3572) NET VOLUME (oz) if present.
+func (d Message) NetVolumeOz3572() (string, bool) {
+	return d.lookupData(NetVolumeOz3572Code)
+}
+
+// NewNetVolumeOz3573 returns the element string of AI (3573) NET VOLUME (oz). The value is checked against the
+// specification N6.
+func NewNetVolumeOz3573(value string) (ElementString, error) {
+	return newCheckedElementString(AI3573, value)
+}
+
+// NetVolumeOz3573 returns the data of AI (3573) NET VOLUME (oz) if present.
+func (d Message) NetVolumeOz3573() (string, bool) {
+	return d.lookupData(NetVolumeOz3573Code)
+}
+
+// NewNetVolumeOz3574 returns the element string of AI (3574) NET VOLUME (oz). The value is checked against the
+// specification N6.
+func NewNetVolumeOz3574(value string) (ElementString, error) {
+	return newCheckedElementString(AI3574, value)
+}
+
+// NetVolumeOz3574 returns the data of AI (3574) NET VOLUME (oz) if present.
+func (d Message) NetVolumeOz3574() (string, bool) {
+	return d.lookupData(NetVolumeOz3574Code)
+}
+
+// NewNetVolumeOz3575 returns the element string of AI (3575) NET VOLUME (oz). The value is checked against the
+// specification N6.
+func NewNetVolumeOz3575(value string) (ElementString, error) {
+	return newCheckedElementString(AI3575, value)
+}
+
+// NetVolumeOz3575 returns the data of AI (3575) NET VOLUME (oz) if present.
+func (d Message) NetVolumeOz3575() (string, bool) {
+	return d.lookupData(NetVolumeOz3575Code)
+}
+
+// NewNetVolumeQt3600 returns the element string of AI (3600) NET VOLUME (qt). The value is checked against the
+// specification N6.
+func NewNetVolumeQt3600(value string) (ElementString, error) {
+	return newCheckedElementString(AI3600, value)
+}
+
+// NetVolumeQt3600 returns the data of AI (3600) NET VOLUME (qt) if present.
+func (d Message) NetVolumeQt3600() (string, bool) {
+	return d.lookupData(NetVolumeQt3600Code)
+}
+
+// NewNetVolumeQt3601 returns the element string of AI (3601) NET VOLUME (qt). The value is checked against the
+// specification N6.
+func NewNetVolumeQt3601(value string) (ElementString, error) {
+	return newCheckedElementString(AI3601, value)
+}
+
+// NetVolumeQt3601 returns the data of AI (3601) NET VOLUME (qt) if present.
+func (d Message) NetVolumeQt3601() (string, bool) {
+	return d.lookupData(NetVolumeQt3601Code)
+}
+
+// NewNetVolumeQt3602 returns the element string of AI (3602) NET VOLUME (qt). The value is checked against the
+// specification N6.
+func NewNetVolumeQt3602(value string) (ElementString, error) {
+	return newCheckedElementString(AI3602, value)
+}
+
+// NetVolumeQt3602 returns the data of AI (3602) NET VOLUME (qt) if present.
+func (d Message) NetVolumeQt3602() (string, bool) {
+	return d.lookupData(NetVolumeQt3602Code)
+}
+
+// NewNetVolumeQt3603 returns the element string of AI (3603) NET VOLUME (qt). The value is checked against the
+// specification N6.
+func NewNetVolumeQt3603(value string) (ElementString, error) {
+	return newCheckedElementString(AI3603, value)
+}
+
+// NetVolumeQt3603 returns the data of AI (3603) NET VOLUME (qt) if present.
+func (d Message) NetVolumeQt3603() (string, bool) {
+	return d.lookupData(NetVolumeQt3603Code)
+}
+
+// NewNetVolumeQt3604 returns the element string of AI (3604) NET VOLUME (qt). The value is checked against the
+// specification N6.
+func NewNetVolumeQt3604(value string) (ElementString, error) {
+	return newCheckedElementString(AI3604, value)
+}
+
+// NetVolumeQt3604 returns the data of AI (3604) NET VOLUME (qt) if present.
+func (d Message) NetVolumeQt3604() (string, bool) {
+	return d.lookupData(NetVolumeQt3604Code)
+}
+
+// NewNetVolumeQt3605 returns the element string of AI (3605) NET VOLUME (qt). The value is checked against the
+// specification N6.
+func NewNetVolumeQt3605(value string) (ElementString, error) {
+	return newCheckedElementString(AI3605, value)
+}
+
+// NetVolumeQt3605 returns the data of AI (3605) NET VOLUME (qt) if present.
+func (d Message) NetVolumeQt3605() (string, bool) {
+	return d.lookupData(NetVolumeQt3605Code)
+}
+
+// NewNetVolumeGal3610 returns the element string of AI (3610) NET VOLUME (gal.). The value is checked against the
+// specification N6.
+func NewNetVolumeGal3610(value string) (ElementString, error) {
+	return newCheckedElementString(AI3610, value)
+}
+
+// NetVolumeGal3610 returns the data of AI (3610) NET VOLUME (gal.) if present.
+func (d Message) NetVolumeGal3610() (string, bool) {
+	return d.lookupData(NetVolumeGal3610Code)
+}
+
+// NewNetVolumeGal3611 returns the element string of AI (3611) NET VOLUME (gal.). The value is checked against the
+// specification N6.
+func NewNetVolumeGal3611(value string) (ElementString, error) {
+	return newCheckedElementString(AI3611, value)
+}
+
+// NetVolumeGal3611 returns the data of AI (3611) NET VOLUME (gal.) if present.
+func (d Message) NetVolumeGal3611() (string, bool) {
+	return d.lookupData(NetVolumeGal3611Code)
+}
+
+// NewNetVolumeGal3612 returns the element string of AI (3612) NET VOLUME (gal.). The value is checked against the
+// specification N6.
+func NewNetVolumeGal3612(value string) (ElementString, error) {
+	return newCheckedElementString(AI3612, value)
+}
+
+// NetVolumeGal3612 returns the data of AI (3612) NET VOLUME (gal.) if present.
+func (d Message) NetVolumeGal3612() (string, bool) {
+	return d.lookupData(NetVolumeGal3612Code)
+}
+
+// NewNetVolumeGal3613 returns the element string of AI (3613) NET VOLUME (gal.). The value is checked against the
+// specification N6.
+func NewNetVolumeGal3613(value string) (ElementString, error) {
+	return newCheckedElementString(AI3613, value)
+}
+
+// NetVolumeGal3613 returns the data of AI (3613) NET VOLUME (gal.) if present.
+func (d Message) NetVolumeGal3613() (string, bool) {
+	return d.lookupData(NetVolumeGal3613Code)
+}
+
+// NewNetVolumeGal3614 returns the element string of AI (3614) NET VOLUME (gal.). The value is checked against the
+// specification N6.
+func NewNetVolumeGal3614(value string) (ElementString, error) {
+	return newCheckedElementString(AI3614, value)
+}
+
+// NetVolumeGal3614 returns the data of AI (3614) NET VOLUME (gal.) if present.
+func (d Message) NetVolumeGal3614() (string, bool) {
+	return d.lookupData(NetVolumeGal3614Code)
+}
+
+// NewNetVolumeGal3615 returns the element string of AI (3615) NET VOLUME (gal.). The value is checked against the
+// specification N6.
+func NewNetVolumeGal3615(value string) (ElementString, error) {
+	return newCheckedElementString(AI3615, value)
+}
+
+// NetVolumeGal3615 returns the data of AI (3615) NET VOLUME (gal.) if present.
+func (d Message) NetVolumeGal3615() (string, bool) {
+	return d.lookupData(NetVolumeGal3615Code)
+}
+
+// NewVolumeQtLog3620 returns the element string of AI (3620) VOLUME (qt), log. The value is checked against the
+// specification N6.
+func NewVolumeQtLog3620(value string) (ElementString, error) {
+	return newCheckedElementString(AI3620, value)
+}
+
+// VolumeQtLog3620 returns the data of AI (3620) VOLUME (qt), log if present.
+func (d Message) VolumeQtLog3620() (string, bool) {
+	return d.lookupData(VolumeQtLog3620Code)
+}
+
+// NewVolumeQtLog3621 returns the element string of AI (3621) VOLUME (qt), log. The value is checked against the
+// specification N6.
+func NewVolumeQtLog3621(value string) (ElementString, error) {
+	return newCheckedElementString(AI3621, value)
+}
+
+// VolumeQtLog3621 returns the data of AI (3621) VOLUME (qt), log if present.
+func (d Message) VolumeQtLog3621() (string, bool) {
+	return d.lookupData(VolumeQtLog3621Code)
+}
+
+// NewVolumeQtLog3622 returns the element string of AI (3622) VOLUME (qt), log. The value is checked against the
+// specification N6.
+func NewVolumeQtLog3622(value string) (ElementString, error) {
+	return newCheckedElementString(AI3622, value)
+}
+
+// VolumeQtLog3622 returns the data of AI (3622) VOLUME (qt), log if present.
+func (d Message) VolumeQtLog3622() (string, bool) {
+	return d.lookupData(VolumeQtLog3622Code)
+}
+
+// NewVolumeQtLog3623 returns the element string of AI (3623) VOLUME (qt), log. The value is checked against the
+// specification N6.
+func NewVolumeQtLog3623(value string) (ElementString, error) {
+	return newCheckedElementString(AI3623, value)
+}
+
+// VolumeQtLog3623 returns the data of AI (3623) VOLUME (qt), log if present.
+func (d Message) VolumeQtLog3623() (string, bool) {
+	return d.lookupData(VolumeQtLog3623Code)
+}
+
+// NewVolumeQtLog3624 returns the element string of AI (3624) VOLUME (qt), log. The value is checked against the
+// specification N6.
+func NewVolumeQtLog3624(value string) (ElementString, error) {
+	return newCheckedElementString(AI3624, value)
+}
+
+// VolumeQtLog3624 returns the data of AI (3624) VOLUME (qt), log if present.
+func (d Message) VolumeQtLog3624() (string, bool) {
+	return d.lookupData(VolumeQtLog3624Code)
+}
+
+// NewVolumeQtLog3625 returns the element string of AI (3625) VOLUME (qt), log. The value is checked against the
+// specification N6.
+func NewVolumeQtLog3625(value string) (ElementString, error) {
+	return newCheckedElementString(AI3625, value)
+}
+
+// VolumeQtLog3625 returns the data of AI (3625) VOLUME (qt), log if present.
+func (d Message) VolumeQtLog3625() (string, bool) {
+	return d.lookupData(VolumeQtLog3625Code)
+}
+
+// NewVolumeGalLog3630 returns the element string of AI (3630) VOLUME (gal.), log. The value is checked against the
+// specification N6.
+func NewVolumeGalLog3630(value string) (ElementString, error) {
+	return newCheckedElementString(AI3630, value)
+}
+
+// VolumeGalLog3630 returns the data of AI (3630) VOLUME (gal.), log if present.
+func (d Message) VolumeGalLog3630() (string, bool) {
+	return d.lookupData(VolumeGalLog3630Code)
+}
+
+// NewVolumeGalLog3631 returns the element string of AI (3631) VOLUME (gal.), log. The value is checked against the
+// specification N6.
+func NewVolumeGalLog3631(value string) (ElementString, error) {
+	return newCheckedElementString(AI3631, value)
+}
+
+// VolumeGalLog3631 returns the data of AI (3631) VOLUME (gal.), log if present.
+func (d Message) VolumeGalLog3631() (string, bool) {
+	return d.lookupData(VolumeGalLog3631Code)
+}
+
+// NewVolumeGalLog3632 returns the element string of AI (3632) VOLUME (gal.), log. The value is checked against the
+// specification N6.
+func NewVolumeGalLog3632(value string) (ElementString, error) {
+	return newCheckedElementString(AI3632, value)
+}
+
+// VolumeGalLog3632 returns the data of AI (3632) VOLUME (gal.), log if present.
+func (d Message) VolumeGalLog3632() (string, bool) {
+	return d.lookupData(VolumeGalLog3632Code)
+}
+
+// NewVolumeGalLog3633 returns the element string of AI (3633) VOLUME (gal.), log. The value is checked against the
+// specification N6.
+func NewVolumeGalLog3633(value string) (ElementString, error) {
+	return newCheckedElementString(AI3633, value)
+}
+
+// VolumeGalLog3633 returns the data of AI (3633) VOLUME (gal.), log if present.
+func (d Message) VolumeGalLog3633() (string, bool) {
+	return d.lookupData(VolumeGalLog3633Code)
+}
+
+// NewVolumeGalLog3634 returns the element string of AI (3634) VOLUME (gal.), log. The value is checked against the
+// specification N6.
+func NewVolumeGalLog3634(value string) (ElementString, error) {
+	return newCheckedElementString(AI3634, value)
+}
+
+// VolumeGalLog3634 returns the data of AI (3634) VOLUME (gal.), log if present.
+func (d Message) VolumeGalLog3634() (string, bool) {
+	return d.lookupData(VolumeGalLog3634Code)
+}
+
+// NewVolumeGalLog3635 returns the element string of AI (3635) VOLUME (gal.), log. The value is checked against the
+// specification N6.
+func NewVolumeGalLog3635(value string) (ElementString, error) {
+	return newCheckedElementString(AI3635, value)
+}
+
+// VolumeGalLog3635 returns the data of AI (3635) VOLUME (gal.), log if present.
+func (d Message) VolumeGalLog3635() (string, bool) {
+	return d.lookupData(VolumeGalLog3635Code)
+}
+
+// NewVolumeIn33640 returns the element string of AI (3640) VOLUME (in³). The value is checked against the
+// specification N6.
+func NewVolumeIn33640(value string) (ElementString, error) {
+	return newCheckedElementString(AI3640, value)
+}
+
+// VolumeIn33640 returns the data of AI (3640) VOLUME (in³) if present.
+func (d Message) VolumeIn33640() (string, bool) {
+	return d.lookupData(VolumeIn33640Code)
+}
+
+// NewVolumeIn33641 returns the element string of AI (3641) VOLUME (in³). The value is checked against the
+// specification N6.
+func NewVolumeIn33641(value string) (ElementString, error) {
+	return newCheckedElementString(AI3641, value)
+}
+
+// VolumeIn33641 returns the data of AI (3641) VOLUME (in³) if present.
+func (d Message) VolumeIn33641() (string, bool) {
+	return d.lookupData(VolumeIn33641Code)
+}
+
+// NewVolumeIn33642 returns the element string of AI (3642) VOLUME (in³). The value is checked against the
+// specification N6.
+func NewVolumeIn33642(value string) (ElementString, error) {
+	return newCheckedElementString(AI3642, value)
+}
+
+// VolumeIn33642 returns the data of AI (3642) VOLUME (in³) if present.
+func (d Message) VolumeIn33642() (string, bool) {
+	return d.lookupData(VolumeIn33642Code)
+}
+
+// NewVolumeIn33643 returns the element string of AI (3643) VOLUME (in³). The value is checked against the
+// specification N6.
+func NewVolumeIn33643(value string) (ElementString, error) {
+	return newCheckedElementString(AI3643, value)
+}
+
+// VolumeIn33643 returns the data of AI (3643) VOLUME (in³) if present.
+func (d Message) VolumeIn33643() (string, bool) {
+	return d.lookupData(VolumeIn33643Code)
+}
+
+// NewVolumeIn33644 returns the element string of AI (3644) VOLUME (in³). The value is checked against the
+// specification N6.
+func NewVolumeIn33644(value string) (ElementString, error) {
+	return newCheckedElementString(AI3644, value)
+}
+
+// VolumeIn33644 returns the data of AI (3644) VOLUME (in³) if present.
+func (d Message) VolumeIn33644() (string, bool) {
+	return d.lookupData(VolumeIn33644Code)
+}
+
+// NewVolumeIn33645 returns the element string of AI (3645) VOLUME (in³). The value is checked against the
+// specification N6.
+func NewVolumeIn33645(value string) (ElementString, error) {
+	return newCheckedElementString(AI3645, value)
+}
+
+// VolumeIn33645 returns the data of AI (3645) VOLUME (in³) if present.
+func (d Message) VolumeIn33645() (string, bool) {
+	return d.lookupData(VolumeIn33645Code)
+}
+
+// NewVolumeFt33650 returns the element string of AI (3650) VOLUME (ft³). The value is checked against the
+// specification N6.
+func NewVolumeFt33650(value string) (ElementString, error) {
+	return newCheckedElementString(AI3650, value)
+}
+
+// VolumeFt33650 returns the data of AI (3650) VOLUME (ft³) if present.
+func (d Message) VolumeFt33650() (string, bool) {
+	return d.lookupData(VolumeFt33650Code)
+}
+
+// NewVolumeFt33651 returns the element string of AI (3651) VOLUME (ft³). The value is checked against the
+// specification N6.
+func NewVolumeFt33651(value string) (ElementString, error) {
+	return newCheckedElementString(AI3651, value)
+}
+
+// VolumeFt33651 returns the data of AI (3651) VOLUME (ft³) if present.
+func (d Message) VolumeFt33651() (string, bool) {
+	return d.lookupData(VolumeFt33651Code)
+}
+
+// NewVolumeFt33652 returns the element string of AI (3652) VOLUME (ft³). The value is checked against the
+// specification N6.
+func NewVolumeFt33652(value string) (ElementString, error) {
+	return newCheckedElementString(AI3652, value)
+}
+
+// VolumeFt33652 returns the data of AI (3652) VOLUME (ft³) if present.
+func (d Message) VolumeFt33652() (string, bool) {
+	return d.lookupData(VolumeFt33652Code)
+}
+
+// NewVolumeFt33653 returns the element string of AI (3653) VOLUME (ft³). The value is checked against the
+// specification N6.
+func NewVolumeFt33653(value string) (ElementString, error) {
+	return newCheckedElementString(AI3653, value)
+}
+
+// VolumeFt33653 returns the data of AI (3653) VOLUME (ft³) if present.
+func (d Message) VolumeFt33653() (string, bool) {
+	return d.lookupData(VolumeFt33653Code)
+}
+
+// NewVolumeFt33654 returns the element string of AI (3654) VOLUME (ft³). The value is checked against the
+// specification N6.
+func NewVolumeFt33654(value string) (ElementString, error) {
+	return newCheckedElementString(AI3654, value)
+}
+
+// VolumeFt33654 returns the data of AI (3654) VOLUME (ft³) if present.
+func (d Message) VolumeFt33654() (string, bool) {
+	return d.lookupData(VolumeFt33654Code)
+}
+
+// NewVolumeFt33655 returns the element string of AI (3655) VOLUME (ft³). The value is checked against the
+// specification N6.
+func NewVolumeFt33655(value string) (ElementString, error) {
+	return newCheckedElementString(AI3655, value)
+}
+
+// VolumeFt33655 returns the data of AI (3655) VOLUME (ft³) if present.
+func (d Message) VolumeFt33655() (string, bool) {
+	return d.lookupData(VolumeFt33655Code)
+}
+
+// NewVolumeYd33660 returns the element string of AI (3660) VOLUME (yd³). The value is checked against the
+// specification N6.
+func NewVolumeYd33660(value string) (ElementString, error) {
+	return newCheckedElementString(AI3660, value)
+}
+
+// VolumeYd33660 returns the data of AI (3660) VOLUME (yd³) if present.
+func (d Message) VolumeYd33660() (string, bool) {
+	return d.lookupData(VolumeYd33660Code)
+}
+
+// NewVolumeYd33661 returns the element string of AI (3661) VOLUME (yd³). The value is checked against the
+// specification N6.
+func NewVolumeYd33661(value string) (ElementString, error) {
+	return newCheckedElementString(AI3661, value)
+}
+
+// VolumeYd33661 returns the data of AI (3661) VOLUME (yd³) if present.
+func (d Message) VolumeYd33661() (string, bool) {
+	return d.lookupData(VolumeYd33661Code)
+}
+
+// NewVolumeYd33662 returns the element string of AI (3662) VOLUME (yd³). The value is checked against the
+// specification N6.
+func NewVolumeYd33662(value string) (ElementString, error) {
+	return newCheckedElementString(AI3662, value)
+}
+
+// VolumeYd33662 returns the data of AI (3662) VOLUME (yd³) if present.
+func (d Message) VolumeYd33662() (string, bool) {
+	return d.lookupData(VolumeYd33662Code)
+}
+
+// NewVolumeYd33663 returns the element string of AI (3663) VOLUME (yd³). The value is checked against the
+// specification N6.
+func NewVolumeYd33663(value string) (ElementString, error) {
+	return newCheckedElementString(AI3663, value)
+}
+
+// VolumeYd33663 returns the data of AI (3663) VOLUME (yd³) if present.
+func (d Message) VolumeYd33663() (string, bool) {
+	return d.lookupData(VolumeYd33663Code)
+}
+
+// NewVolumeYd33664 returns the element string of AI (3664) VOLUME (yd³). The value is checked against the
+// specification N6.
+func NewVolumeYd33664(value string) (ElementString, error) {
+	return newCheckedElementString(AI3664, value)
+}
+
+// VolumeYd33664 returns the data of AI (3664) VOLUME (yd³) if present.
+func (d Message) VolumeYd33664() (string, bool) {
+	return d.lookupData(VolumeYd33664Code)
+}
+
+// NewVolumeYd33665 returns the element string of AI (3665) VOLUME (yd³). The value is checked against the
+// specification N6.
+func NewVolumeYd33665(value string) (ElementString, error) {
+	return newCheckedElementString(AI3665, value)
+}
+
+// VolumeYd33665 returns the data of AI (3665) VOLUME (yd³) if present.
+func (d Message) VolumeYd33665() (string, bool) {
+	return d.lookupData(VolumeYd33665Code)
+}
+
+// NewVolumeIn3Log3670 returns the element string of AI (3670) VOLUME (in³), log. The value is checked against the
+// specification N6.
+func NewVolumeIn3Log3670(value string) (ElementString, error) {
+	return newCheckedElementString(AI3670, value)
+}
+
+// VolumeIn3Log3670 returns the data of AI (3670) VOLUME (in³), log if present.
+func (d Message) VolumeIn3Log3670() (string, bool) {
+	return d.lookupData(VolumeIn3Log3670Code)
+}
+
+// NewVolumeIn3Log3671 returns the element string of AI (3671) VOLUME (in³), log. The value is checked against the
+// specification N6.
+func NewVolumeIn3Log3671(value string) (ElementString, error) {
+	return newCheckedElementString(AI3671, value)
+}
+
+// VolumeIn3Log3671 returns the data of AI (3671) VOLUME (in³), log if present.
+func (d Message) VolumeIn3Log3671() (string, bool) {
+	return d.lookupData(VolumeIn3Log3671Code)
+}
+
+// NewVolumeIn3Log3672 returns the element string of AI (3672) VOLUME (in³), log. The value is checked against the
+// specification N6.
+func NewVolumeIn3Log3672(value string) (ElementString, error) {
+	return newCheckedElementString(AI3672, value)
+}
+
+// VolumeIn3Log3672 returns the data of AI (3672) VOLUME (in³), log if present.
+func (d Message) VolumeIn3Log3672() (string, bool) {
+	return d.lookupData(VolumeIn3Log3672Code)
+}
+
+// NewVolumeIn3Log3673 returns the element string of AI (3673) VOLUME (in³), log. The value is checked against the
+// specification N6.
+func NewVolumeIn3Log3673(value string) (ElementString, error) {
+	return newCheckedElementString(AI3673, value)
+}
+
+// VolumeIn3Log3673 returns the data of AI (3673) VOLUME (in³), log if present.
+func (d Message) VolumeIn3Log3673() (string, bool) {
+	return d.lookupData(VolumeIn3Log3673Code)
+}
+
+// NewVolumeIn3Log3674 returns the element string of AI (3674) VOLUME (in³), log. The value is checked against the
+// specification N6.
+func NewVolumeIn3Log3674(value string) (ElementString, error) {
+	return newCheckedElementString(AI3674, value)
+}
+
+// VolumeIn3Log3674 returns the data of AI (3674) VOLUME (in³), log if present.
+func (d Message) VolumeIn3Log3674() (string, bool) {
+	return d.lookupData(VolumeIn3Log3674Code)
+}
+
+// NewVolumeIn3Log3675 returns the element string of AI (3675) VOLUME (in³), log. The value is checked against the
+// specification N6.
+func NewVolumeIn3Log3675(value string) (ElementString, error) {
+	return newCheckedElementString(AI3675, value)
+}
+
+// VolumeIn3Log3675 returns the data of AI (3675) VOLUME (in³), log if present.
+func (d Message) VolumeIn3Log3675() (string, bool) {
+	return d.lookupData(VolumeIn3Log3675Code)
+}
+
+// NewVolumeFt3Log3680 returns the element string of AI (3680) VOLUME (ft³), log. The value is checked against the
+// specification N6.
+func NewVolumeFt3Log3680(value string) (ElementString, error) {
+	return newCheckedElementString(AI3680, value)
+}
+
+// VolumeFt3Log3680 returns the data of AI (3680) VOLUME (ft³), log if present.
+func (d Message) VolumeFt3Log3680() (string, bool) {
+	return d.lookupData(VolumeFt3Log3680Code)
+}
+
+// NewVolumeFt3Log3681 returns the element string of AI (3681) VOLUME (ft³), log. The value is checked against the
+// specification N6.
+func NewVolumeFt3Log3681(value string) (ElementString, error) {
+	return newCheckedElementString(AI3681, value)
+}
+
+// VolumeFt3Log3681 returns the data of AI (3681) VOLUME (ft³), log if present.
+func (d Message) VolumeFt3Log3681() (string, bool) {
+	return d.lookupData(VolumeFt3Log3681Code)
+}
+
+// NewVolumeFt3Log3682 returns the element string of AI (3682) VOLUME (ft³), log. The value is checked against the
+// specification N6.
+func NewVolumeFt3Log3682(value string) (ElementString, error) {
+	return newCheckedElementString(AI3682, value)
+}
+
+// VolumeFt3Log3682 returns the data of AI (3682) VOLUME (ft³), log if present.
+func (d Message) VolumeFt3Log3682() (string, bool) {
+	return d.lookupData(VolumeFt3Log3682Code)
+}
+
+// NewVolumeFt3Log3683 returns the element string of AI (3683) VOLUME (ft³), log. The value is checked against the
+// specification N6.
+func NewVolumeFt3Log3683(value string) (ElementString, error) {
+	return newCheckedElementString(AI3683, value)
+}
+
+// VolumeFt3Log3683 returns the data of AI (3683) VOLUME (ft³), log if present.
+func (d Message) VolumeFt3Log3683() (string, bool) {
+	return d.lookupData(VolumeFt3Log3683Code)
+}
+
+// NewVolumeFt3Log3684 returns the element string of AI (3684) VOLUME (ft³), log. The value is checked against the
+// specification N6.
+func NewVolumeFt3Log3684(value string) (ElementString, error) {
+	return newCheckedElementString(AI3684, value)
+}
+
+// VolumeFt3Log3684 returns the data of AI (3684) VOLUME (ft³), log if present.
+func (d Message) VolumeFt3Log3684() (string, bool) {
+	return d.lookupData(VolumeFt3Log3684Code)
+}
+
+// NewVolumeFt3Log3685 returns the element string of AI (3685) VOLUME (ft³), log. The value is checked against the
+// specification N6.
+func NewVolumeFt3Log3685(value string) (ElementString, error) {
+	return newCheckedElementString(AI3685, value)
+}
+
+// VolumeFt3Log3685 returns the data of AI (3685) VOLUME (ft³), log if present.
+func (d Message) VolumeFt3Log3685() (string, bool) {
+	return d.lookupData(VolumeFt3Log3685Code)
+}
+
+// NewVolumeYd3Log3690 returns the element string of AI (3690) VOLUME (yd³), log. The value is checked against the
+// specification N6.
+func NewVolumeYd3Log3690(value string) (ElementString, error) {
+	return newCheckedElementString(AI3690, value)
+}
+
+// VolumeYd3Log3690 returns the data of AI (3690) VOLUME (yd³), log if present.
+func (d Message) VolumeYd3Log3690() (string, bool) {
+	return d.lookupData(VolumeYd3Log3690Code)
+}
+
+// NewVolumeYd3Log3691 returns the element string of AI (3691) VOLUME (yd³), log. The value is checked against the
+// specification N6.
+func NewVolumeYd3Log3691(value string) (ElementString, error) {
+	return newCheckedElementString(AI3691, value)
+}
+
+// VolumeYd3Log3691 returns the data of AI (3691) VOLUME (yd³), log if present.
+func (d Message) VolumeYd3Log3691() (string, bool) {
+	return d.lookupData(VolumeYd3Log3691Code)
+}
+
+// NewVolumeYd3Log3692 returns the element string of AI (3692) VOLUME (yd³), log. The value is checked against the
+// specification N6.
+func NewVolumeYd3Log3692(value string) (ElementString, error) {
+	return newCheckedElementString(AI3692, value)
+}
+
+// VolumeYd3Log3692 returns the data of AI (3692) VOLUME (yd³), log if present.
+func (d Message) VolumeYd3Log3692() (string, bool) {
+	return d.lookupData(VolumeYd3Log3692Code)
+}
+
+// NewVolumeYd3Log3693 returns the element string of AI (3693) VOLUME (yd³), log. The value is checked against the
+// specification N6.
+func NewVolumeYd3Log3693(value string) (ElementString, error) {
+	return newCheckedElementString(AI3693, value)
+}
+
+// VolumeYd3Log3693 returns the data of AI (3693) VOLUME (yd³), log if present.
+func (d Message) VolumeYd3Log3693() (string, bool) {
+	return d.lookupData(VolumeYd3Log3693Code)
+}
+
+// NewVolumeYd3Log3694 returns the element string of AI (3694) VOLUME (yd³), log. The value is checked against the
+// specification N6.
+func NewVolumeYd3Log3694(value string) (ElementString, error) {
+	return newCheckedElementString(AI3694, value)
+}
+
+// VolumeYd3Log3694 returns the data of AI (3694) VOLUME (yd³), log if present.
+func (d Message) VolumeYd3Log3694() (string, bool) {
+	return d.lookupData(VolumeYd3Log3694Code)
+}
+
+// NewVolumeYd3Log3695 returns the element string of AI (3695) VOLUME (yd³), log. The value is checked against the
+// specification N6.
+func NewVolumeYd3Log3695(value string) (ElementString, error) {
+	return newCheckedElementString(AI3695, value)
+}
+
+// VolumeYd3Log3695 returns the data of AI (3695) VOLUME (yd³), log if present.
+func (d Message) VolumeYd3Log3695() (string, bool) {
+	return d.lookupData(VolumeYd3Log3695Code)
+}
+
+// NewCount returns the element string of AI (37) COUNT. The value is checked against the
+// specification N..8.
+func NewCount(value string) (ElementString, error) {
+	return newCheckedElementString(AI37, value)
+}
+
+// Count returns the data of AI (37) COUNT if present.
+func (d Message) Count() (string, bool) {
+	return d.lookupData(CountCode)
+}
+
+// NewAmount3900 returns the element string of AI (3900) AMOUNT. The value is checked against the
+// specification N..15.
+func NewAmount3900(value string) (ElementString, error) {
+	return newCheckedElementString(AI3900, value)
+}
+
+// Amount3900 returns the data of AI (3900) AMOUNT if present.
+func (d Message) Amount3900() (string, bool) {
+	return d.lookupData(Amount3900Code)
+}
+
+// NewAmount3901 returns the element string of AI (3901) AMOUNT. The value is checked against the
+// specification N..15.
+func NewAmount3901(value string) (ElementString, error) {
+	return newCheckedElementString(AI3901, value)
+}
+
+// Amount3901 returns the data of AI (3901) AMOUNT if present.
+func (d Message) Amount3901() (string, bool) {
+	return d.lookupData(Amount3901Code)
+}
+
+// NewAmount3902 returns the element string of AI (3902) AMOUNT. The value is checked against the
+// specification N..15.
+func NewAmount3902(value string) (ElementString, error) {
+	return newCheckedElementString(AI3902, value)
+}
+
+// Amount3902 returns the data of AI (3902) AMOUNT if present.
+func (d Message) Amount3902() (string, bool) {
+	return d.lookupData(Amount3902Code)
+}
+
+// NewAmount3903 returns the element string of AI (3903) AMOUNT. The value is checked against the
+// specification N..15.
+func NewAmount3903(value string) (ElementString, error) {
+	return newCheckedElementString(AI3903, value)
+}
+
+// Amount3903 returns the data of AI (3903) AMOUNT if present.
+func (d Message) Amount3903() (string, bool) {
+	return d.lookupData(Amount3903Code)
+}
+
+// NewAmount3904 returns the element string of AI (3904) AMOUNT. The value is checked against the
+// specification N..15.
+func NewAmount3904(value string) (ElementString, error) {
+	return newCheckedElementString(AI3904, value)
+}
+
+// Amount3904 returns the data of AI (3904) AMOUNT if present.
+func (d Message) Amount3904() (string, bool) {
+	return d.lookupData(Amount3904Code)
+}
+
+// NewAmount3905 returns the element string of AI (3905) AMOUNT. The value is checked against the
+// specification N..15.
+func NewAmount3905(value string) (ElementString, error) {
+	return newCheckedElementString(AI3905, value)
+}
+
+// Amount3905 returns the data of AI (3905) AMOUNT if present.
+func (d Message) Amount3905() (string, bool) {
+	return d.lookupData(Amount3905Code)
+}
+
+// NewAmount3906 returns the element string of AI (3906) AMOUNT. The value is checked against the
+// specification N..15.
+func NewAmount3906(value string) (ElementString, error) {
+	return newCheckedElementString(AI3906, value)
+}
+
+// Amount3906 returns the data of AI (3906) AMOUNT if present.
+func (d Message) Amount3906() (string, bool) {
+	return d.lookupData(Amount3906Code)
+}
+
+// NewAmount3907 returns the element string of AI (3907) AMOUNT. The value is checked against the
+// specification N..15.
+func NewAmount3907(value string) (ElementString, error) {
+	return newCheckedElementString(AI3907, value)
+}
+
+// Amount3907 returns the data of AI (3907) AMOUNT if present.
+func (d Message) Amount3907() (string, bool) {
+	return d.lookupData(Amount3907Code)
+}
+
+// NewAmount3908 returns the element string of AI (3908) AMOUNT. The value is checked against the
+// specification N..15.
+func NewAmount3908(value string) (ElementString, error) {
+	return newCheckedElementString(AI3908, value)
+}
+
+// Amount3908 returns the data of AI (3908) AMOUNT if present.
+func (d Message) Amount3908() (string, bool) {
+	return d.lookupData(Amount3908Code)
+}
+
+// NewAmount3909 returns the element string of AI (3909) AMOUNT. The value is checked against the
+// specification N..15.
+func NewAmount3909(value string) (ElementString, error) {
+	return newCheckedElementString(AI3909, value)
+}
+
+// Amount3909 returns the data of AI (3909) AMOUNT if present.
+func (d Message) Amount3909() (string, bool) {
+	return d.lookupData(Amount3909Code)
+}
+
+// NewAmount3910 returns the element string of AI (3910) AMOUNT. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewAmount3910(value string) (ElementString, error) {
+	return newCheckedElementString(AI3910, value)
+}
+
+// Amount3910 returns the data of AI (3910) AMOUNT if present.
+func (d Message) Amount3910() (string, bool) {
+	return d.lookupData(Amount3910Code)
+}
+
+// NewAmount3911 returns the element string of AI (3911) AMOUNT. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewAmount3911(value string) (ElementString, error) {
+	return newCheckedElementString(AI3911, value)
+}
+
+// Amount3911 returns the data of AI (3911) AMOUNT if present.
+func (d Message) Amount3911() (string, bool) {
+	return d.lookupData(Amount3911Code)
+}
+
+// NewAmount3912 returns the element string of AI (3912) AMOUNT. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewAmount3912(value string) (ElementString, error) {
+	return newCheckedElementString(AI3912, value)
+}
+
+// Amount3912 returns the data of AI (3912) AMOUNT if present.
+func (d Message) Amount3912() (string, bool) {
+	return d.lookupData(Amount3912Code)
+}
+
+// NewAmount3913 returns the element string of AI (3913) AMOUNT. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewAmount3913(value string) (ElementString, error) {
+	return newCheckedElementString(AI3913, value)
+}
+
+// Amount3913 returns the data of AI (3913) AMOUNT if present.
+func (d Message) Amount3913() (string, bool) {
+	return d.lookupData(Amount3913Code)
+}
+
+// NewAmount3914 returns the element string of AI (3914) AMOUNT. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewAmount3914(value string) (ElementString, error) {
+	return newCheckedElementString(AI3914, value)
+}
+
+// Amount3914 returns the data of AI (3914) AMOUNT if present.
+func (d Message) Amount3914() (string, bool) {
+	return d.lookupData(Amount3914Code)
+}
+
+// NewAmount3915 returns the element string of AI (3915) AMOUNT. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewAmount3915(value string) (ElementString, error) {
+	return newCheckedElementString(AI3915, value)
+}
+
+// Amount3915 returns the data of AI (3915) AMOUNT if present.
+func (d Message) Amount3915() (string, bool) {
+	return d.lookupData(Amount3915Code)
+}
+
+// NewAmount3916 returns the element string of AI (3916) AMOUNT. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewAmount3916(value string) (ElementString, error) {
+	return newCheckedElementString(AI3916, value)
+}
+
+// Amount3916 returns the data of AI (3916) AMOUNT if present.
+func (d Message) Amount3916() (string, bool) {
+	return d.lookupData(Amount3916Code)
+}
+
+// NewAmount3917 returns the element string of AI (3917) AMOUNT. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewAmount3917(value string) (ElementString, error) {
+	return newCheckedElementString(AI3917, value)
+}
+
+// Amount3917 returns the data of AI (3917) AMOUNT if present.
+func (d Message) Amount3917() (string, bool) {
+	return d.lookupData(Amount3917Code)
+}
+
+// NewAmount3918 returns the element string of AI (3918) AMOUNT. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewAmount3918(value string) (ElementString, error) {
+	return newCheckedElementString(AI3918, value)
+}
+
+// Amount3918 returns the data of AI (3918) AMOUNT if present.
+func (d Message) Amount3918() (string, bool) {
+	return d.lookupData(Amount3918Code)
+}
+
+// NewAmount3919 returns the element string of AI (3919) AMOUNT. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewAmount3919(value string) (ElementString, error) {
+	return newCheckedElementString(AI3919, value)
+}
+
+// Amount3919 returns the data of AI (3919) AMOUNT if present.
+func (d Message) Amount3919() (string, bool) {
+	return d.lookupData(Amount3919Code)
+}
+
+// NewPrice3920 returns the element string of AI (3920) PRICE. The value is checked against the
+// specification N..15.
+func NewPrice3920(value string) (ElementString, error) {
+	return newCheckedElementString(AI3920, value)
+}
+
+// Price3920 returns the data of AI (3920) PRICE if present.
+func (d Message) Price3920() (string, bool) {
+	return d.lookupData(Price3920Code)
+}
+
+// NewPrice3921 returns the element string of AI (3921) PRICE. The value is checked against the
+// specification N..15.
+func NewPrice3921(value string) (ElementString, error) {
+	return newCheckedElementString(AI3921, value)
+}
+
+// Price3921 returns the data of AI (3921) PRICE if present.
+func (d Message) Price3921() (string, bool) {
+	return d.lookupData(Price3921Code)
+}
+
+// NewPrice3922 returns the element string of AI (3922) PRICE. The value is checked against the
+// specification N..15.
+func NewPrice3922(value string) (ElementString, error) {
+	return newCheckedElementString(AI3922, value)
+}
+
+// Price3922 returns the data of AI (3922) PRICE if present.
+func (d Message) Price3922() (string, bool) {
+	return d.lookupData(Price3922Code)
+}
+
+// NewPrice3923 returns the element string of AI (3923) PRICE. The value is checked against the
+// specification N..15.
+func NewPrice3923(value string) (ElementString, error) {
+	return newCheckedElementString(AI3923, value)
+}
+
+// Price3923 returns the data of AI (3923) PRICE if present.
+func (d Message) Price3923() (string, bool) {
+	return d.lookupData(Price3923Code)
+}
+
+// NewPrice3924 returns the element string of AI (3924) PRICE. The value is checked against the
+// specification N..15.
+func NewPrice3924(value string) (ElementString, error) {
+	return newCheckedElementString(AI3924, value)
+}
+
+// Price3924 returns the data of AI (3924) PRICE if present.
+func (d Message) Price3924() (string, bool) {
+	return d.lookupData(Price3924Code)
+}
+
+// NewPrice3925 returns the element string of AI (3925) PRICE. The value is checked against the
+// specification N..15.
+func NewPrice3925(value string) (ElementString, error) {
+	return newCheckedElementString(AI3925, value)
+}
+
+// Price3925 returns the data of AI (3925) PRICE if present.
+func (d Message) Price3925() (string, bool) {
+	return d.lookupData(Price3925Code)
+}
+
+// NewPrice3926 returns the element string of AI (3926) PRICE. The value is checked against the
+// specification N..15.
+func NewPrice3926(value string) (ElementString, error) {
+	return newCheckedElementString(AI3926, value)
+}
+
+// Price3926 returns the data of AI (3926) PRICE if present.
+func (d Message) Price3926() (string, bool) {
+	return d.lookupData(Price3926Code)
+}
+
+// NewPrice3927 returns the element string of AI (3927) PRICE. The value is checked against the
+// specification N..15.
+func NewPrice3927(value string) (ElementString, error) {
+	return newCheckedElementString(AI3927, value)
+}
+
+// Price3927 returns the data of AI (3927) PRICE if present.
+func (d Message) Price3927() (string, bool) {
+	return d.lookupData(Price3927Code)
+}
+
+// NewPrice3928 returns the element string of AI (3928) PRICE. The value is checked against the
+// specification N..15.
+func NewPrice3928(value string) (ElementString, error) {
+	return newCheckedElementString(AI3928, value)
+}
+
+// Price3928 returns the data of AI (3928) PRICE if present.
+func (d Message) Price3928() (string, bool) {
+	return d.lookupData(Price3928Code)
+}
+
+// NewPrice3929 returns the element string of AI (3929) PRICE. The value is checked against the
+// specification N..15.
+func NewPrice3929(value string) (ElementString, error) {
+	return newCheckedElementString(AI3929, value)
+}
+
+// Price3929 returns the data of AI (3929) PRICE if present.
+func (d Message) Price3929() (string, bool) {
+	return d.lookupData(Price3929Code)
+}
+
+// NewPrice3930 returns the element string of AI (3930) PRICE. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewPrice3930(value string) (ElementString, error) {
+	return newCheckedElementString(AI3930, value)
+}
+
+// Price3930 returns the data of AI (3930) PRICE if present.
+func (d Message) Price3930() (string, bool) {
+	return d.lookupData(Price3930Code)
+}
+
+// NewPrice3931 returns the element string of AI (3931) PRICE. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewPrice3931(value string) (ElementString, error) {
+	return newCheckedElementString(AI3931, value)
+}
+
+// Price3931 returns the data of AI (3931) PRICE if present.
+func (d Message) Price3931() (string, bool) {
+	return d.lookupData(Price3931Code)
+}
+
+// NewPrice3932 returns the element string of AI (3932) PRICE. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewPrice3932(value string) (ElementString, error) {
+	return newCheckedElementString(AI3932, value)
+}
+
+// Price3932 returns the data of AI (3932) PRICE if present.
+func (d Message) Price3932() (string, bool) {
+	return d.lookupData(Price3932Code)
+}
+
+// NewPrice3933 returns the element string of AI (3933) PRICE. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewPrice3933(value string) (ElementString, error) {
+	return newCheckedElementString(AI3933, value)
+}
+
+// Price3933 returns the data of AI (3933) PRICE if present.
+func (d Message) Price3933() (string, bool) {
+	return d.lookupData(Price3933Code)
+}
+
+// NewPrice3934 returns the element string of AI (3934) PRICE. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewPrice3934(value string) (ElementString, error) {
+	return newCheckedElementString(AI3934, value)
+}
+
+// Price3934 returns the data of AI (3934) PRICE if present.
+func (d Message) Price3934() (string, bool) {
+	return d.lookupData(Price3934Code)
+}
+
+// NewPrice3935 returns the element string of AI (3935) PRICE. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewPrice3935(value string) (ElementString, error) {
+	return newCheckedElementString(AI3935, value)
+}
+
+// Price3935 returns the data of AI (3935) PRICE if present.
+func (d Message) Price3935() (string, bool) {
+	return d.lookupData(Price3935Code)
+}
+
+// NewPrice3936 returns the element string of AI (3936) PRICE. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewPrice3936(value string) (ElementString, error) {
+	return newCheckedElementString(AI3936, value)
+}
+
+// Price3936 returns the data of AI (3936) PRICE if present.
+func (d Message) Price3936() (string, bool) {
+	return d.lookupData(Price3936Code)
+}
+
+// NewPrice3937 returns the element string of AI (3937) PRICE. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewPrice3937(value string) (ElementString, error) {
+	return newCheckedElementString(AI3937, value)
+}
+
+// Price3937 returns the data of AI (3937) PRICE if present.
+func (d Message) Price3937() (string, bool) {
+	return d.lookupData(Price3937Code)
+}
+
+// NewPrice3938 returns the element string of AI (3938) PRICE. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewPrice3938(value string) (ElementString, error) {
+	return newCheckedElementString(AI3938, value)
+}
+
+// Price3938 returns the data of AI (3938) PRICE if present.
+func (d Message) Price3938() (string, bool) {
+	return d.lookupData(Price3938Code)
+}
+
+// NewPrice3939 returns the element string of AI (3939) PRICE. The value is checked against the
+// specification N3,iso4217 N..15 including its linters.
+func NewPrice3939(value string) (ElementString, error) {
+	return newCheckedElementString(AI3939, value)
+}
+
+// Price3939 returns the data of AI (3939) PRICE if present.
+func (d Message) Price3939() (string, bool) {
+	return d.lookupData(Price3939Code)
+}
+
+// NewPrcntOff3940 returns the element string of AI (3940) PRCNT OFF. The value is checked against the
+// specification N4.
+func NewPrcntOff3940(value string) (ElementString, error) {
+	return newCheckedElementString(AI3940, value)
+}
+
+// PrcntOff3940 returns the data of AI (3940) PRCNT OFF if present.
+func (d Message) PrcntOff3940() (string, bool) {
+	return d.lookupData(PrcntOff3940Code)
+}
+
+// NewPrcntOff3941 returns the element string of AI (3941) PRCNT OFF. The value is checked against the
+// specification N4.
+func NewPrcntOff3941(value string) (ElementString, error) {
+	return newCheckedElementString(AI3941, value)
+}
+
+// PrcntOff3941 returns the data of AI (3941) PRCNT OFF if present.
+func (d Message) PrcntOff3941() (string, bool) {
+	return d.lookupData(PrcntOff3941Code)
+}
+
+// NewPrcntOff3942 returns the element string of AI (3942) PRCNT OFF. The value is checked against the
+// specification N4.
+func NewPrcntOff3942(value string) (ElementString, error) {
+	return newCheckedElementString(AI3942, value)
+}
+
+// PrcntOff3942 returns the data of AI (3942) PRCNT OFF if present.
+func (d Message) PrcntOff3942() (string, bool) {
+	return d.lookupData(PrcntOff3942Code)
+}
+
+// NewPrcntOff3943 returns the element string of AI (3943) PRCNT OFF. The value is checked against the
+// specification N4.
+func NewPrcntOff3943(value string) (ElementString, error) {
+	return newCheckedElementString(AI3943, value)
+}
+
+// PrcntOff3943 returns the data of AI (3943) PRCNT OFF if present.
+func (d Message) PrcntOff3943() (string, bool) {
+	return d.lookupData(PrcntOff3943Code)
+}
+
+// NewPriceUoM3950 returns the element string of AI (3950) PRICE/UoM. The value is checked against the
+// specification N6.
+func NewPriceUoM3950(value string) (ElementString, error) {
+	return newCheckedElementString(AI3950, value)
+}
+
+// PriceUoM3950 returns the data of AI (3950) PRICE/UoM if present.
+func (d Message) PriceUoM3950() (string, bool) {
+	return d.lookupData(PriceUoM3950Code)
+}
+
+// NewPriceUoM3951 returns the element string of AI (3951) PRICE/UoM. The value is checked against the
+// specification N6.
+func NewPriceUoM3951(value string) (ElementString, error) {
+	return newCheckedElementString(AI3951, value)
+}
+
+// PriceUoM3951 returns the data of AI (3951) PRICE/UoM if present.
+func (d Message) PriceUoM3951() (string, bool) {
+	return d.lookupData(PriceUoM3951Code)
+}
+
+// NewPriceUoM3952 returns the element string of AI (3952) PRICE/UoM. The value is checked against the
+// specification N6.
+func NewPriceUoM3952(value string) (ElementString, error) {
+	return newCheckedElementString(AI3952, value)
+}
+
+// PriceUoM3952 returns the data of AI (3952) PRICE/UoM if present.
+func (d Message) PriceUoM3952() (string, bool) {
+	return d.lookupData(PriceUoM3952Code)
+}
+
+// NewPriceUoM3953 returns the element string of AI (3953) PRICE/UoM. The value is checked against the
+// specification N6.
+func NewPriceUoM3953(value string) (ElementString, error) {
+	return newCheckedElementString(AI3953, value)
+}
+
+// PriceUoM3953 returns the data of AI (3953) PRICE/UoM if present.
+func (d Message) PriceUoM3953() (string, bool) {
+	return d.lookupData(PriceUoM3953Code)
+}
+
+// NewPriceUoM3954 returns the element string of AI (3954) PRICE/UoM. The value is checked against the
+// specification N6.
+func NewPriceUoM3954(value string) (ElementString, error) {
+	return newCheckedElementString(AI3954, value)
+}
+
+// PriceUoM3954 returns the data of AI (3954) PRICE/UoM if present.
+func (d Message) PriceUoM3954() (string, bool) {
+	return d.lookupData(PriceUoM3954Code)
+}
+
+// NewPriceUoM3955 returns the element string of AI (3955) PRICE/UoM. The value is checked against the
+// specification N6.
+func NewPriceUoM3955(value string) (ElementString, error) {
+	return newCheckedElementString(AI3955, value)
+}
+
+// PriceUoM3955 returns the data of AI (3955) PRICE/UoM if present.
+func (d Message) PriceUoM3955() (string, bool) {
+	return d.lookupData(PriceUoM3955Code)
+}
+
+// NewOrderNumber returns the element string of AI (400) ORDER NUMBER. The value is checked against the
+// specification X..30.
+func NewOrderNumber(value string) (ElementString, error) {
+	return newCheckedElementString(AI400, value)
+}
+
+// OrderNumber returns the data of AI (400) ORDER NUMBER if present.
+func (d Message) OrderNumber() (string, bool) {
+	return d.lookupData(OrderNumberCode)
+}
+
+// NewGINC returns the element string of AI (401) GINC. The value is checked against the
+// specification X..30,key including its linters.
+func NewGINC(value string) (ElementString, error) {
+	return newCheckedElementString(AI401, value)
+}
+
+// GINC returns the data of AI (401) GINC if present.
+func (d Message) GINC() (string, bool) {
+	return d.lookupData(GINCCode)
+}
+
+// NewGSIN returns the element string of AI (402) GSIN. The value is checked against the
+// specification N17,csum,key including its linters.
+func NewGSIN(value string) (ElementString, error) {
+	return newCheckedElementString(AI402, value)
+}
+
+// GSIN returns the data of AI (402) GSIN if present.
+func (d Message) GSIN() (string, bool) {
+	return d.lookupData(GSINCode)
+}
+
+// NewRoute returns the element string of AI (403) ROUTE. The value is checked against the
+// specification X..30.
+func NewRoute(value string) (ElementString, error) {
+	return newCheckedElementString(AI403, value)
+}
+
+// Route returns the data of AI (403) ROUTE if present.
+func (d Message) Route() (string, bool) {
+	return d.lookupData(RouteCode)
+}
+
+// NewShipToLoc410 returns the element string of AI (410) SHIP TO LOC. The value is checked against the
+// specification N13,csum,key including its linters.
+func NewShipToLoc410(value string) (ElementString, error) {
+	return newCheckedElementString(AI410, value)
+}
+
+// ShipToLoc410 returns the data of AI (410) SHIP TO LOC if present.
+func (d Message) ShipToLoc410() (string, bool) {
+	return d.lookupData(ShipToLoc410Code)
+}
+
+// NewBillTo returns the element string of AI (411) BILL TO. The value is checked against the
+// specification N13,csum,key including its linters.
+func NewBillTo(value string) (ElementString, error) {
+	return newCheckedElementString(AI411, value)
+}
+
+// BillTo returns the data of AI (411) BILL TO if present.
+func (d Message) BillTo() (string, bool) {
+	return d.lookupData(BillToCode)
+}
+
+// NewPurchaseFrom returns the element string of AI (412) PURCHASE FROM. The value is checked against the
+// specification N13,csum,key including its linters.
+func NewPurchaseFrom(value string) (ElementString, error) {
+	return newCheckedElementString(AI412, value)
+}
+
+// PurchaseFrom returns the data of AI (412) PURCHASE FROM if present.
+func (d Message) PurchaseFrom() (string, bool) {
+	return d.lookupData(PurchaseFromCode)
+}
+
+// NewShipForLoc returns the element string of AI (413) SHIP FOR LOC. The value is checked against the
+// specification N13,csum,key including its linters.
+func NewShipForLoc(value string) (ElementString, error) {
+	return newCheckedElementString(AI413, value)
+}
+
+// ShipForLoc returns the data of AI (413) SHIP FOR LOC if present.
+func (d Message) ShipForLoc() (string, bool) {
+	return d.lookupData(ShipForLocCode)
+}
+
+// NewLocNo returns the element string of AI (414) LOC No. The value is checked against the
+// specification N13,csum,key including its linters.
+func NewLocNo(value string) (ElementString, error) {
+	return newCheckedElementString(AI414, value)
+}
+
+// LocNo returns the data of AI (414) LOC No. if present.
+func (d Message) LocNo() (string, bool) {
+	return d.lookupData(LocNoCode)
+}
+
+// NewPayTo returns the element string of AI (415) PAY TO. The value is checked against the
+// specification N13,csum,key including its linters.
+func NewPayTo(value string) (ElementString, error) {
+	return newCheckedElementString(AI415, value)
+}
+
+// PayTo returns the data of AI (415) PAY TO if present.
+func (d Message) PayTo() (string, bool) {
+	return d.lookupData(PayToCode)
+}
+
+// NewProdServLoc returns the element string of AI (416) PROD/SERV LOC. The value is checked against the
+// specification N13,csum,key including its linters.
+func NewProdServLoc(value string) (ElementString, error) {
+	return newCheckedElementString(AI416, value)
+}
+
+// ProdServLoc returns the data of AI (416) PROD/SERV LOC if present.
+func (d Message) ProdServLoc() (string, bool) {
+	return d.lookupData(ProdServLocCode)
+}
+
+// NewParty returns the element string of AI (417) PARTY. The value is checked against the
+// specification N13,csum,key including its linters.
+func NewParty(value string) (ElementString, error) {
+	return newCheckedElementString(AI417, value)
+}
+
+// Party returns the data of AI (417) PARTY if present.
+func (d Message) Party() (string, bool) {
+	return d.lookupData(PartyCode)
+}
+
+// NewShipToPost420 returns the element string of AI (420) SHIP TO POST. The value is checked against the
+// specification X..20.
+func NewShipToPost420(value string) (ElementString, error) {
+	return newCheckedElementString(AI420, value)
+}
+
+// ShipToPost420 returns the data of AI (420) SHIP TO POST if present.
+func (d Message) ShipToPost420() (string, bool) {
+	return d.lookupData(ShipToPost420Code)
+}
+
+// NewShipToPost421 returns the element string of AI (421) SHIP TO POST. The value is checked against the
+// specification N3,iso3166 X..9 including its linters.
+func NewShipToPost421(value string) (ElementString, error) {
+	return newCheckedElementString(AI421, value)
+}
+
+// ShipToPost421 returns the data of AI (421) SHIP TO POST if present.
+func (d Message) ShipToPost421() (string, bool) {
+	return d.lookupData(ShipToPost421Code)
+}
+
+// NewOrigin returns the element string of AI (422) ORIGIN. The value is checked against the
+// specification N3,iso3166 including its linters.
+func NewOrigin(value string) (ElementString, error) {
+	return newCheckedElementString(AI422, value)
+}
+
+// Origin returns the data of AI (422) ORIGIN if present.
+func (d Message) Origin() (string, bool) {
+	return d.lookupData(OriginCode)
+}
+
+// NewCountryInitialProcess returns the element string of AI (423) COUNTRY - INITIAL PROCESS. The value is checked against the
+// specification N3,iso3166 [N3],iso3166 [N3],iso3166 [N3],iso3166 [N3],iso3166 including its linters.
+func NewCountryInitialProcess(value string) (ElementString, error) {
+	return newCheckedElementString(AI423, value)
+}
+
+// CountryInitialProcess returns the data of AI (423) COUNTRY - INITIAL PROCESS if present.
+func (d Message) CountryInitialProcess() (string, bool) {
+	return d.lookupData(CountryInitialProcessCode)
+}
+
+// NewCountryProcess returns the element string of AI (424) COUNTRY - PROCESS. The value is checked against the
+// specification N3,iso3166 including its linters.
+func NewCountryProcess(value string) (ElementString, error) {
+	return newCheckedElementString(AI424, value)
+}
+
+// CountryProcess returns the data of AI (424) COUNTRY - PROCESS if present.
+func (d Message) CountryProcess() (string, bool) {
+	return d.lookupData(CountryProcessCode)
+}
+
+// NewCountryDisassembly returns the element string of AI (425) COUNTRY - DISASSEMBLY. The value is checked against the
+// specification N3,iso3166 [N3],iso3166 [N3],iso3166 [N3],iso3166 [N3],iso3166 including its linters.
+func NewCountryDisassembly(value string) (ElementString, error) {
+	return newCheckedElementString(AI425, value)
+}
+
+// CountryDisassembly returns the data of AI (425) COUNTRY - DISASSEMBLY if present.
+func (d Message) CountryDisassembly() (string, bool) {
+	return d.lookupData(CountryDisassemblyCode)
+}
+
+// NewCountryFullProcess returns the element string of AI (426) COUNTRY - FULL PROCESS. The value is checked against the
+// specification N3,iso3166 including its linters.
+func NewCountryFullProcess(value string) (ElementString, error) {
+	return newCheckedElementString(AI426, value)
+}
+
+// CountryFullProcess returns the data of AI (426) COUNTRY - FULL PROCESS if present.
+func (d Message) CountryFullProcess() (string, bool) {
+	return d.lookupData(CountryFullProcessCode)
+}
+
+// NewOriginSubdivision returns the element string of AI (427) ORIGIN SUBDIVISION. The value is checked against the
+// specification X..3.
+func NewOriginSubdivision(value string) (ElementString, error) {
+	return newCheckedElementString(AI427, value)
+}
+
+// OriginSubdivision returns the data of AI (427) ORIGIN SUBDIVISION if present.
+func (d Message) OriginSubdivision() (string, bool) {
+	return d.lookupData(OriginSubdivisionCode)
+}
+
+// NewShipToComp returns the element string of AI (4300) SHIP TO COMP. The value is checked against the
+// specification X..35,pcenc including its linters.
+func NewShipToComp(value string) (ElementString, error) {
+	return newCheckedElementString(AI4300, value)
+}
+
+// ShipToComp returns the data of AI (4300) SHIP TO COMP if present.
+func (d Message) ShipToComp() (string, bool) {
+	return d.lookupData(ShipToCompCode)
+}
+
+// NewShipToName returns the element string of AI (4301) SHIP TO NAME. The value is checked against the
+// specification X..35,pcenc including its linters.
+func NewShipToName(value string) (ElementString, error) {
+	return newCheckedElementString(AI4301, value)
+}
+
+// ShipToName returns the data of AI (4301) SHIP TO NAME if present.
+func (d Message) ShipToName() (string, bool) {
+	return d.lookupData(ShipToNameCode)
+}
+
+// NewShipToAdd1 returns the element string of AI (4302) SHIP TO ADD1. The value is checked against the
+// specification X..70,pcenc including its linters.
+func NewShipToAdd1(value string) (ElementString, error) {
+	return newCheckedElementString(AI4302, value)
+}
+
+// ShipToAdd1 returns the data of AI (4302) SHIP TO ADD1 if present.
+func (d Message) ShipToAdd1() (string, bool) {
+	return d.lookupData(ShipToAdd1Code)
+}
+
+// NewShipToAdd2 returns the element string of AI (4303) SHIP TO ADD2. The value is checked against the
+// specification X..70,pcenc including its linters.
+func NewShipToAdd2(value string) (ElementString, error) {
+	return newCheckedElementString(AI4303, value)
+}
+
+// ShipToAdd2 returns the data of AI (4303) SHIP TO ADD2 if present.
+func (d Message) ShipToAdd2() (string, bool) {
+	return d.lookupData(ShipToAdd2Code)
+}
+
+// NewShipToSub returns the element string of AI (4304) SHIP TO SUB. The value is checked against the
+// specification X..70,pcenc including its linters.
+func NewShipToSub(value string) (ElementString, error) {
+	return newCheckedElementString(AI4304, value)
+}
+
+// ShipToSub returns the data of AI (4304) SHIP TO SUB if present.
+func (d Message) ShipToSub() (string, bool) {
+	return d.lookupData(ShipToSubCode)
+}
+
+// NewShipToLoc4305 returns the element string of AI (4305) SHIP TO LOC. The value is checked against the
+// specification X..70,pcenc including its linters.
+func NewShipToLoc4305(value string) (ElementString, error) {
+	return newCheckedElementString(AI4305, value)
+}
+
+// ShipToLoc4305 returns the data of AI (4305) SHIP TO LOC if present.
+func (d Message) ShipToLoc4305() (string, bool) {
+	return d.lookupData(ShipToLoc4305Code)
+}
+
+// NewShipToReg returns the element string of AI (4306) SHIP TO REG. The value is checked against the
+// specification X..70,pcenc including its linters.
+func NewShipToReg(value string) (ElementString, error) {
+	return newCheckedElementString(AI4306, value)
+}
+
+// ShipToReg returns the data of AI (4306) SHIP TO REG if present.
+func (d Message) ShipToReg() (string, bool) {
+	return d.lookupData(ShipToRegCode)
+}
+
+// NewShipToCountry returns the element string of AI (4307) SHIP TO COUNTRY. The value is checked against the
+// specification X2,iso3166alpha2 including its linters.
+func NewShipToCountry(value string) (ElementString, error) {
+	return newCheckedElementString(AI4307, value)
+}
+
+// ShipToCountry returns the data of AI (4307) SHIP TO COUNTRY if present.
+func (d Message) ShipToCountry() (string, bool) {
+	return d.lookupData(ShipToCountryCode)
+}
+
+// NewShipToPhone returns the element string of AI (4308) SHIP TO PHONE. The value is checked against the
+// specification X..30.
+func NewShipToPhone(value string) (ElementString, error) {
+	return newCheckedElementString(AI4308, value)
+}
+
+// ShipToPhone returns the data of AI (4308) SHIP TO PHONE if present.
+func (d Message) ShipToPhone() (string, bool) {
+	return d.lookupData(ShipToPhoneCode)
+}
+
+// NewShipToGeo returns the element string of AI (4309) SHIP TO GEO. The value is checked against the
+// specification N10,latitude N10,longitude including its linters.
+func NewShipToGeo(value string) (ElementString, error) {
+	return newCheckedElementString(AI4309, value)
+}
+
+// ShipToGeo returns the data of AI (4309) SHIP TO GEO if present.
+func (d Message) ShipToGeo() (string, bool) {
+	return d.lookupData(ShipToGeoCode)
+}
+
+// NewRTNToComp returns the element string of AI (4310) RTN TO COMP. The value is checked against the
+// specification X..35,pcenc including its linters.
+func NewRTNToComp(value string) (ElementString, error) {
+	return newCheckedElementString(AI4310, value)
+}
+
+// RTNToComp returns the data of AI (4310) RTN TO COMP if present.
+func (d Message) RTNToComp() (string, bool) {
+	return d.lookupData(RTNToCompCode)
+}
+
+// NewRTNToName returns the element string of AI (4311) RTN TO NAME. The value is checked against the
+// specification X..35,pcenc including its linters.
+func NewRTNToName(value string) (ElementString, error) {
+	return newCheckedElementString(AI4311, value)
+}
+
+// RTNToName returns the data of AI (4311) RTN TO NAME if present.
+func (d Message) RTNToName() (string, bool) {
+	return d.lookupData(RTNToNameCode)
+}
+
+// NewRTNToAdd1 returns the element string of AI (4312) RTN TO ADD1. The value is checked against the
+// specification X..70,pcenc including its linters.
+func NewRTNToAdd1(value string) (ElementString, error) {
+	return newCheckedElementString(AI4312, value)
+}
+
+// RTNToAdd1 returns the data of AI (4312) RTN TO ADD1 if present.
+func (d Message) RTNToAdd1() (string, bool) {
+	return d.lookupData(RTNToAdd1Code)
+}
+
+// NewRTNToAdd2 returns the element string of AI (4313) RTN TO ADD2. The value is checked against the
+// specification X..70,pcenc including its linters.
+func NewRTNToAdd2(value string) (ElementString, error) {
+	return newCheckedElementString(AI4313, value)
+}
+
+// RTNToAdd2 returns the data of AI (4313) RTN TO ADD2 if present.
+func (d Message) RTNToAdd2() (string, bool) {
+	return d.lookupData(RTNToAdd2Code)
+}
+
+// NewRTNToSub returns the element string of AI (4314) RTN TO SUB. The value is checked against the
+// specification X..70,pcenc including its linters.
+func NewRTNToSub(value string) (ElementString, error) {
+	return newCheckedElementString(AI4314, value)
+}
+
+// RTNToSub returns the data of AI (4314) RTN TO SUB if present.
+func (d Message) RTNToSub() (string, bool) {
+	return d.lookupData(RTNToSubCode)
+}
+
+// NewRTNToLoc returns the element string of AI (4315) RTN TO LOC. The value is checked against the
+// specification X..70,pcenc including its linters.
+func NewRTNToLoc(value string) (ElementString, error) {
+	return newCheckedElementString(AI4315, value)
+}
+
+// RTNToLoc returns the data of AI (4315) RTN TO LOC if present.
+func (d Message) RTNToLoc() (string, bool) {
+	return d.lookupData(RTNToLocCode)
+}
+
+// NewRTNToReg returns the element string of AI (4316) RTN TO REG. The value is checked against the
+// specification X..70,pcenc including its linters.
+func NewRTNToReg(value string) (ElementString, error) {
+	return newCheckedElementString(AI4316, value)
+}
+
+// RTNToReg returns the data of AI (4316) RTN TO REG if present.
+func (d Message) RTNToReg() (string, bool) {
+	return d.lookupData(RTNToRegCode)
+}
+
+// NewRTNToCountry returns the element string of AI (4317) RTN TO COUNTRY. The value is checked against the
+// specification X2,iso3166alpha2 including its linters.
+func NewRTNToCountry(value string) (ElementString, error) {
+	return newCheckedElementString(AI4317, value)
+}
+
+// RTNToCountry returns the data of AI (4317) RTN TO COUNTRY if present.
+func (d Message) RTNToCountry() (string, bool) {
+	return d.lookupData(RTNToCountryCode)
+}
+
+// NewRTNToPost returns the element string of AI (4318) RTN TO POST. The value is checked against the
+// specification X..20.
+func NewRTNToPost(value string) (ElementString, error) {
+	return newCheckedElementString(AI4318, value)
+}
+
+// RTNToPost returns the data of AI (4318) RTN TO POST if present.
+func (d Message) RTNToPost() (string, bool) {
+	return d.lookupData(RTNToPostCode)
+}
+
+// NewRTNToPhone returns the element string of AI (4319) RTN TO PHONE. The value is checked against the
+// specification X..30.
+func NewRTNToPhone(value string) (ElementString, error) {
+	return newCheckedElementString(AI4319, value)
+}
+
+// RTNToPhone returns the data of AI (4319) RTN TO PHONE if present.
+func (d Message) RTNToPhone() (string, bool) {
+	return d.lookupData(RTNToPhoneCode)
+}
+
+// NewSrvDescription returns the element string of AI (4320) SRV DESCRIPTION. The value is checked against the
+// specification X..35,pcenc including its linters.
+func NewSrvDescription(value string) (ElementString, error) {
+	return newCheckedElementString(AI4320, value)
+}
+
+// SrvDescription returns the data of AI (4320) SRV DESCRIPTION if present.
+func (d Message) SrvDescription() (string, bool) {
+	return d.lookupData(SrvDescriptionCode)
+}
+
+// NewDangerousGoods returns the element string of AI (4321) DANGEROUS GOODS. The value is checked against the
+// specification N1,yesno including its linters.
+func NewDangerousGoods(value string) (ElementString, error) {
+	return newCheckedElementString(AI4321, value)
+}
+
+// DangerousGoods returns the data of AI (4321) DANGEROUS GOODS if present.
+func (d Message) DangerousGoods() (string, bool) {
+	return d.lookupData(DangerousGoodsCode)
+}
+
+// NewAuthToLeave returns the element string of AI (4322) AUTH TO LEAVE. The value is checked against the
+// specification N1,yesno including its linters.
+func NewAuthToLeave(value string) (ElementString, error) {
+	return newCheckedElementString(AI4322, value)
+}
+
+// AuthToLeave returns the data of AI (4322) AUTH TO LEAVE if present.
+func (d Message) AuthToLeave() (string, bool) {
+	return d.lookupData(AuthToLeaveCode)
+}
+
+// NewSigRequired returns the element string of AI (4323) SIG REQUIRED. The value is checked against the
+// specification N1,yesno including its linters.
+func NewSigRequired(value string) (ElementString, error) {
+	return newCheckedElementString(AI4323, value)
+}
+
+// SigRequired returns the data of AI (4323) SIG REQUIRED if present.
+func (d Message) SigRequired() (string, bool) {
+	return d.lookupData(SigRequiredCode)
+}
+
+// NewNotBefDelDt returns the element string of AI (4324) NOT BEF DEL DT. The value is checked against the
+// specification N6,yymmd0 N4,hhmi including its linters.
+func NewNotBefDelDt(value string) (ElementString, error) {
+	return newCheckedElementString(AI4324, value)
+}
+
+// NotBefDelDt returns the data of AI (4324) NOT BEF DEL DT if present.
+func (d Message) NotBefDelDt() (string, bool) {
+	return d.lookupData(NotBefDelDtCode)
+}
+
+// NewNotAftDelDt returns the element string of AI (4325) NOT AFT DEL DT. The value is checked against the
+// specification N6,yymmd0 N4,hhmi including its linters.
+func NewNotAftDelDt(value string) (ElementString, error) {
+	return newCheckedElementString(AI4325, value)
+}
+
+// NotAftDelDt returns the data of AI (4325) NOT AFT DEL DT if present.
+func (d Message) NotAftDelDt() (string, bool) {
+	return d.lookupData(NotAftDelDtCode)
+}
+
+// NewRelDate returns the element string of AI (4326) REL DATE for the given date.
+func NewRelDate(date time.Time) ElementString {
+	return NewElementString(AI4326, formatGS1Date(date))
+}
+
+// RelDate returns the date of AI (4326) REL DATE. It returns false if the AI is missing or its data is not
+// a valid date.
+func (d Message) RelDate() (time.Time, bool) {
+	return d.dateData(RelDateCode)
+}
+
+// NewMaxTempF returns the element string of AI (4330) MAX TEMP F. The value is checked against the
+// specification N6 [X1],hyphen including its linters.
+func NewMaxTempF(value string) (ElementString, error) {
+	return newCheckedElementString(AI4330, value)
+}
+
+// MaxTempF returns the data of AI (4330) MAX TEMP F. if present.
+func (d Message) MaxTempF() (string, bool) {
+	return d.lookupData(MaxTempFCode)
+}
+
+// NewMaxTempC returns the element string of AI (4331) MAX TEMP C. The value is checked against the
+// specification N6 [X1],hyphen including its linters.
+func NewMaxTempC(value string) (ElementString, error) {
+	return newCheckedElementString(AI4331, value)
+}
+
+// MaxTempC returns the data of AI (4331) MAX TEMP C. if present.
+func (d Message) MaxTempC() (string, bool) {
+	return d.lookupData(MaxTempCCode)
+}
+
+// NewMinTempF returns the element string of AI (4332) MIN TEMP F. The value is checked against the
+// specification N6 [X1],hyphen including its linters.
+func NewMinTempF(value string) (ElementString, error) {
+	return newCheckedElementString(AI4332, value)
+}
+
+// MinTempF returns the data of AI (4332) MIN TEMP F. if present.
+func (d Message) MinTempF() (string, bool) {
+	return d.lookupData(MinTempFCode)
+}
+
+// NewMinTempC returns the element string of AI (4333) MIN TEMP C. The value is checked against the
+// specification N6 [X1],hyphen including its linters.
+func NewMinTempC(value string) (ElementString, error) {
+	return newCheckedElementString(AI4333, value)
+}
+
+// MinTempC returns the data of AI (4333) MIN TEMP C. if present.
+func (d Message) MinTempC() (string, bool) {
+	return d.lookupData(MinTempCCode)
+}
+
+// NewNSN returns the element string of AI (7001) NSN. The value is checked against the
+// specification N13.
+func NewNSN(value string) (ElementString, error) {
+	return newCheckedElementString(AI7001, value)
+}
+
+// NSN returns the data of AI (7001) NSN if present.
+func (d Message) NSN() (string, bool) {
+	return d.lookupData(NSNCode)
+}
+
+// NewMeatCut returns the element string of AI (7002) MEAT CUT. The value is checked against the
+// specification X..30.
+func NewMeatCut(value string) (ElementString, error) {
+	return newCheckedElementString(AI7002, value)
+}
+
+// MeatCut returns the data of AI (7002) MEAT CUT if present.
+func (d Message) MeatCut() (string, bool) {
+	return d.lookupData(MeatCutCode)
+}
+
+// NewExpiryTime returns the element string of AI (7003) EXPIRY TIME. The value is checked against the
+// specification N6,yymmdd N4,hhmi including its linters.
+func NewExpiryTime(value string) (ElementString, error) {
+	return newCheckedElementString(AI7003, value)
+}
+
+// ExpiryTime returns the data of AI (7003) EXPIRY TIME if present.
+func (d Message) ExpiryTime() (string, bool) {
+	return d.lookupData(ExpiryTimeCode)
+}
+
+// NewActivePotency returns the element string of AI (7004) ACTIVE POTENCY. The value is checked against the
+// specification N..4.
+func NewActivePotency(value string) (ElementString, error) {
+	return newCheckedElementString(AI7004, value)
+}
+
+// ActivePotency returns the data of AI (7004) ACTIVE POTENCY if present.
+func (d Message) ActivePotency() (string, bool) {
+	return d.lookupData(ActivePotencyCode)
+}
+
+// NewCatchArea returns the element string of AI (7005) CATCH AREA. The value is checked against the
+// specification X..12.
+func NewCatchArea(value string) (ElementString, error) {
+	return newCheckedElementString(AI7005, value)
+}
+
+// CatchArea returns the data of AI (7005) CATCH AREA if present.
+func (d Message) CatchArea() (string, bool) {
+	return d.lookupData(CatchAreaCode)
+}
+
+// NewFirstFreezeDate returns the element string of AI (7006) FIRST FREEZE DATE for the given date.
+func NewFirstFreezeDate(date time.Time) ElementString {
+	return NewElementString(AI7006, formatGS1Date(date))
+}
+
+// FirstFreezeDate returns the date of AI (7006) FIRST FREEZE DATE. It returns false if the AI is missing or its data is not
+// a valid date.
+func (d Message) FirstFreezeDate() (time.Time, bool) {
+	return d.dateData(FirstFreezeDateCode)
+}
+
+// NewHarvestDate returns the element string of AI (7007) HARVEST DATE. The value is checked against the
+// specification N6,yymmdd [N6],yymmdd including its linters.
+func NewHarvestDate(value string) (ElementString, error) {
+	return newCheckedElementString(AI7007, value)
+}
+
+// HarvestDate returns the data of AI (7007) HARVEST DATE if present.
+func (d Message) HarvestDate() (string, bool) {
+	return d.lookupData(HarvestDateCode)
+}
+
+// NewAquaticSpecies returns the element string of AI (7008) AQUATIC SPECIES. The value is checked against the
+// specification X..3.
+func NewAquaticSpecies(value string) (ElementString, error) {
+	return newCheckedElementString(AI7008, value)
+}
+
+// AquaticSpecies returns the data of AI (7008) AQUATIC SPECIES if present.
+func (d Message) AquaticSpecies() (string, bool) {
+	return d.lookupData(AquaticSpeciesCode)
+}
+
+// NewFishingGearType returns the element string of AI (7009) FISHING GEAR TYPE. The value is checked against the
+// specification X..10.
+func NewFishingGearType(value string) (ElementString, error) {
+	return newCheckedElementString(AI7009, value)
+}
+
+// FishingGearType returns the data of AI (7009) FISHING GEAR TYPE if present.
+func (d Message) FishingGearType() (string, bool) {
+	return d.lookupData(FishingGearTypeCode)
+}
+
+// NewProdMethod returns the element string of AI (7010) PROD METHOD. The value is checked against the
+// specification X..2.
+func NewProdMethod(value string) (ElementString, error) {
+	return newCheckedElementString(AI7010, value)
+}
+
+// ProdMethod returns the data of AI (7010) PROD METHOD if present.
+func (d Message) ProdMethod() (string, bool) {
+	return d.lookupData(ProdMethodCode)
+}
+
+// NewTestByDate returns the element string of AI (7011) TEST BY DATE. The value is checked against the
+// specification N6,yymmdd [N4],hhmi including its linters.
+func NewTestByDate(value string) (ElementString, error) {
+	return newCheckedElementString(AI7011, value)
+}
+
+// TestByDate returns the data of AI (7011) TEST BY DATE if present.
+func (d Message) TestByDate() (string, bool) {
+	return d.lookupData(TestByDateCode)
+}
+
+// NewRefurbLot returns the element string of AI (7020) REFURB LOT. The value is checked against the
+// specification X..20.
+func NewRefurbLot(value string) (ElementString, error) {
+	return newCheckedElementString(AI7020, value)
+}
+
+// RefurbLot returns the data of AI (7020) REFURB LOT if present.
+func (d Message) RefurbLot() (string, bool) {
+	return d.lookupData(RefurbLotCode)
+}
+
+// NewFuncStat returns the element string of AI (7021) FUNC STAT. The value is checked against the
+// specification X..20.
+func NewFuncStat(value string) (ElementString, error) {
+	return newCheckedElementString(AI7021, value)
+}
+
+// FuncStat returns the data of AI (7021) FUNC STAT if present.
+func (d Message) FuncStat() (string, bool) {
+	return d.lookupData(FuncStatCode)
+}
+
+// NewRevStat returns the element string of AI (7022) REV STAT. The value is checked against the
+// specification X..20.
+func NewRevStat(value string) (ElementString, error) {
+	return newCheckedElementString(AI7022, value)
+}
+
+// RevStat returns the data of AI (7022) REV STAT if present.
+func (d Message) RevStat() (string, bool) {
+	return d.lookupData(RevStatCode)
+}
+
+// NewGIAIAssembly returns the element string of AI (7023) GIAI - ASSEMBLY. The value is checked against the
+// specification X..30,key including its linters.
+func NewGIAIAssembly(value string) (ElementString, error) {
+	return newCheckedElementString(AI7023, value)
+}
+
+// GIAIAssembly returns the data of AI (7023) GIAI - ASSEMBLY if present.
+func (d Message) GIAIAssembly() (string, bool) {
+	return d.lookupData(GIAIAssemblyCode)
+}
+
+// NewProcessor0 returns the element string of AI (7030) PROCESSOR # 0. The value is checked against the
+// specification N3,iso3166999 X..27 including its linters.
+func NewProcessor0(value string) (ElementString, error) {
+	return newCheckedElementString(AI7030, value)
+}
+
+// Processor0 returns the data of AI (7030) PROCESSOR # 0 if present.
+func (d Message) Processor0() (string, bool) {
+	return d.lookupData(Processor0Code)
+}
+
+// NewProcessor1 returns the element string of AI (7031) PROCESSOR # 1. The value is checked against the
+// specification N3,iso3166999 X..27 including its linters.
+func NewProcessor1(value string) (ElementString, error) {
+	return newCheckedElementString(AI7031, value)
+}
+
+// Processor1 returns the data of AI (7031) PROCESSOR # 1 if present.
+func (d Message) Processor1() (string, bool) {
+	return d.lookupData(Processor1Code)
+}
+
+// NewProcessor2 returns the element string of AI (7032) PROCESSOR # 2. The value is checked against the
+// specification N3,iso3166999 X..27 including its linters.
+func NewProcessor2(value string) (ElementString, error) {
+	return newCheckedElementString(AI7032, value)
+}
+
+// Processor2 returns the data of AI (7032) PROCESSOR # 2 if present.
+func (d Message) Processor2() (string, bool) {
+	return d.lookupData(Processor2Code)
+}
+
+// NewProcessor3 returns the element string of AI (7033) PROCESSOR # 3. The value is checked against the
+// specification N3,iso3166999 X..27 including its linters.
+func NewProcessor3(value string) (ElementString, error) {
+	return newCheckedElementString(AI7033, value)
+}
+
+// Processor3 returns the data of AI (7033) PROCESSOR # 3 if present.
+func (d Message) Processor3() (string, bool) {
+	return d.lookupData(Processor3Code)
+}
+
+// NewProcessor4 returns the element string of AI (7034) PROCESSOR # 4. The value is checked against the
+// specification N3,iso3166999 X..27 including its linters.
+func NewProcessor4(value string) (ElementString, error) {
+	return newCheckedElementString(AI7034, value)
+}
+
+// Processor4 returns the data of AI (7034) PROCESSOR # 4 if present.
+func (d Message) Processor4() (string, bool) {
+	return d.lookupData(Processor4Code)
+}
+
+// NewProcessor5 returns the element string of AI (7035) PROCESSOR # 5. The value is checked against the
+// specification N3,iso3166999 X..27 including its linters.
+func NewProcessor5(value string) (ElementString, error) {
+	return newCheckedElementString(AI7035, value)
+}
+
+// Processor5 returns the data of AI (7035) PROCESSOR # 5 if present.
+func (d Message) Processor5() (string, bool) {
+	return d.lookupData(Processor5Code)
+}
+
+// NewProcessor6 returns the element string of AI (7036) PROCESSOR # 6. The value is checked against the
+// specification N3,iso3166999 X..27 including its linters.
+func NewProcessor6(value string) (ElementString, error) {
+	return newCheckedElementString(AI7036, value)
+}
+
+// Processor6 returns the data of AI (7036) PROCESSOR # 6 if present.
+func (d Message) Processor6() (string, bool) {
+	return d.lookupData(Processor6Code)
+}
+
+// NewProcessor7 returns the element string of AI (7037) PROCESSOR # 7. The value is checked against the
+// specification N3,iso3166999 X..27 including its linters.
+func NewProcessor7(value string) (ElementString, error) {
+	return newCheckedElementString(AI7037, value)
+}
+
+// Processor7 returns the data of AI (7037) PROCESSOR # 7 if present.
+func (d Message) Processor7() (string, bool) {
+	return d.lookupData(Processor7Code)
+}
+
+// NewProcessor8 returns the element string of AI (7038) PROCESSOR # 8. The value is checked against the
+// specification N3,iso3166999 X..27 including its linters.
+func NewProcessor8(value string) (ElementString, error) {
+	return newCheckedElementString(AI7038, value)
+}
+
+// Processor8 returns the data of AI (7038) PROCESSOR # 8 if present.
+func (d Message) Processor8() (string, bool) {
+	return d.lookupData(Processor8Code)
+}
+
+// NewProcessor9 returns the element string of AI (7039) PROCESSOR # 9. The value is checked against the
+// specification N3,iso3166999 X..27 including its linters.
+func NewProcessor9(value string) (ElementString, error) {
+	return newCheckedElementString(AI7039, value)
+}
+
+// Processor9 returns the data of AI (7039) PROCESSOR # 9 if present.
+func (d Message) Processor9() (string, bool) {
+	return d.lookupData(Processor9Code)
+}
+
+// NewUICEXT returns the element string of AI (7040) UIC+EXT. The value is checked against the
+// specification N1 X1 X1 X1,importeridx including its linters.
+func NewUICEXT(value string) (ElementString, error) {
+	return newCheckedElementString(AI7040, value)
+}
+
+// UICEXT returns the data of AI (7040) UIC+EXT if present.
+func (d Message) UICEXT() (string, bool) {
+	return d.lookupData(UICEXTCode)
+}
+
+// NewUFRGTUnitType returns the element string of AI (7041) UFRGT UNIT TYPE. The value is checked against the
+// specification X..4,packagetype including its linters.
+func NewUFRGTUnitType(value string) (ElementString, error) {
+	return newCheckedElementString(AI7041, value)
+}
+
+// UFRGTUnitType returns the data of AI (7041) UFRGT UNIT TYPE if present.
+func (d Message) UFRGTUnitType() (string, bool) {
+	return d.lookupData(UFRGTUnitTypeCode)
+}
+
+// NewNHRNPZN returns the element string of AI (710) NHRN PZN. The value is checked against the
+// specification X..20.
+func NewNHRNPZN(value string) (ElementString, error) {
+	return newCheckedElementString(AI710, value)
+}
+
+// NHRNPZN returns the data of AI (710) NHRN PZN if present.
+func (d Message) NHRNPZN() (string, bool) {
+	return d.lookupData(NHRNPZNCode)
+}
+
+// NewNHRNCIP returns the element string of AI (711) NHRN CIP. The value is checked against the
+// specification X..20.
+func NewNHRNCIP(value string) (ElementString, error) {
+	return newCheckedElementString(AI711, value)
+}
+
+// NHRNCIP returns the data of AI (711) NHRN CIP if present.
+func (d Message) NHRNCIP() (string, bool) {
+	return d.lookupData(NHRNCIPCode)
+}
+
+// NewNHRNCN returns the element string of AI (712) NHRN CN. The value is checked against the
+// specification X..20.
+func NewNHRNCN(value string) (ElementString, error) {
+	return newCheckedElementString(AI712, value)
+}
+
+// NHRNCN returns the data of AI (712) NHRN CN if present.
+func (d Message) NHRNCN() (string, bool) {
+	return d.lookupData(NHRNCNCode)
+}
+
+// NewNHRNDRN returns the element string of AI (713) NHRN DRN. The value is checked against the
+// specification X..20.
+func NewNHRNDRN(value string) (ElementString, error) {
+	return newCheckedElementString(AI713, value)
+}
+
+// NHRNDRN returns the data of AI (713) NHRN DRN if present.
+func (d Message) NHRNDRN() (string, bool) {
+	return d.lookupData(NHRNDRNCode)
+}
+
+// NewNHRNAim returns the element string of AI (714) NHRN AIM. The value is checked against the
+// specification X..20.
+func NewNHRNAim(value string) (ElementString, error) {
+	return newCheckedElementString(AI714, value)
+}
+
+// NHRNAim returns the data of AI (714) NHRN AIM if present.
+func (d Message) NHRNAim() (string, bool) {
+	return d.lookupData(NHRNAimCode)
+}
+
+// NewNHRNNDC returns the element string of AI (715) NHRN NDC. The value is checked against the
+// specification X..20.
+func NewNHRNNDC(value string) (ElementString, error) {
+	return newCheckedElementString(AI715, value)
+}
+
+// NHRNNDC returns the data of AI (715) NHRN NDC if present.
+func (d Message) NHRNNDC() (string, bool) {
+	return d.lookupData(NHRNNDCCode)
+}
+
+// NewNHRNAIC returns the element string of AI (716) NHRN AIC. The value is checked against the
+// specification X..20.
+func NewNHRNAIC(value string) (ElementString, error) {
+	return newCheckedElementString(AI716, value)
+}
+
+// NHRNAIC returns the data of AI (716) NHRN AIC if present.
+func (d Message) NHRNAIC() (string, bool) {
+	return d.lookupData(NHRNAICCode)
+}
+
+// NewCert1 returns the element string of AI (7230) CERT # 1. The value is checked against the
+// specification X2 X..28.
+func NewCert1(value string) (ElementString, error) {
+	return newCheckedElementString(AI7230, value)
+}
+
+// Cert1 returns the data of AI (7230) CERT # 1 if present.
+func (d Message) Cert1() (string, bool) {
+	return d.lookupData(Cert1Code)
+}
+
+// NewCert2 returns the element string of AI (7231) CERT # 2. The value is checked against the
+// specification X2 X..28.
+func NewCert2(value string) (ElementString, error) {
+	return newCheckedElementString(AI7231, value)
+}
+
+// Cert2 returns the data of AI (7231) CERT # 2 if present.
+func (d Message) Cert2() (string, bool) {
+	return d.lookupData(Cert2Code)
+}
+
+// NewCert3 returns the element string of AI (7232) CERT # 3. The value is checked against the
+// specification X2 X..28.
+func NewCert3(value string) (ElementString, error) {
+	return newCheckedElementString(AI7232, value)
+}
+
+// Cert3 returns the data of AI (7232) CERT # 3 if present.
+func (d Message) Cert3() (string, bool) {
+	return d.lookupData(Cert3Code)
+}
+
+// NewCert4 returns the element string of AI (7233) CERT # 4. The value is checked against the
+// specification X2 X..28.
+func NewCert4(value string) (ElementString, error) {
+	return newCheckedElementString(AI7233, value)
+}
+
+// Cert4 returns the data of AI (7233) CERT # 4 if present.
+func (d Message) Cert4() (string, bool) {
+	return d.lookupData(Cert4Code)
+}
+
+// NewCert5 returns the element string of AI (7234) CERT # 5. The value is checked against the
+// specification X2 X..28.
+func NewCert5(value string) (ElementString, error) {
+	return newCheckedElementString(AI7234, value)
+}
+
+// Cert5 returns the data of AI (7234) CERT # 5 if present.
+func (d Message) Cert5() (string, bool) {
+	return d.lookupData(Cert5Code)
+}
+
+// NewCert6 returns the element string of AI (7235) CERT # 6. The value is checked against the
+// specification X2 X..28.
+func NewCert6(value string) (ElementString, error) {
+	return newCheckedElementString(AI7235, value)
+}
+
+// Cert6 returns the data of AI (7235) CERT # 6 if present.
+func (d Message) Cert6() (string, bool) {
+	return d.lookupData(Cert6Code)
+}
+
+// NewCert7 returns the element string of AI (7236) CERT # 7. The value is checked against the
+// specification X2 X..28.
+func NewCert7(value string) (ElementString, error) {
+	return newCheckedElementString(AI7236, value)
+}
+
+// Cert7 returns the data of AI (7236) CERT # 7 if present.
+func (d Message) Cert7() (string, bool) {
+	return d.lookupData(Cert7Code)
+}
+
+// NewCert8 returns the element string of AI (7237) CERT # 8. The value is checked against the
+// specification X2 X..28.
+func NewCert8(value string) (ElementString, error) {
+	return newCheckedElementString(AI7237, value)
+}
+
+// Cert8 returns the data of AI (7237) CERT # 8 if present.
+func (d Message) Cert8() (string, bool) {
+	return d.lookupData(Cert8Code)
+}
+
+// NewCert9 returns the element string of AI (7238) CERT # 9. The value is checked against the
+// specification X2 X..28.
+func NewCert9(value string) (ElementString, error) {
+	return newCheckedElementString(AI7238, value)
+}
+
+// Cert9 returns the data of AI (7238) CERT # 9 if present.
+func (d Message) Cert9() (string, bool) {
+	return d.lookupData(Cert9Code)
+}
+
+// NewCert10 returns the element string of AI (7239) CERT # 10. The value is checked against the
+// specification X2 X..28.
+func NewCert10(value string) (ElementString, error) {
+	return newCheckedElementString(AI7239, value)
+}
+
+// Cert10 returns the data of AI (7239) CERT # 10 if present.
+func (d Message) Cert10() (string, bool) {
+	return d.lookupData(Cert10Code)
+}
+
+// NewProtocol returns the element string of AI (7240) PROTOCOL. The value is checked against the
+// specification X..20.
+func NewProtocol(value string) (ElementString, error) {
+	return newCheckedElementString(AI7240, value)
+}
+
+// Protocol returns the data of AI (7240) PROTOCOL if present.
+func (d Message) Protocol() (string, bool) {
+	return d.lookupData(ProtocolCode)
+}
+
+// NewAIDCMediaType returns the element string of AI (7241) AIDC MEDIA TYPE. The value is checked against the
+// specification N2,mediatype including its linters.
+func NewAIDCMediaType(value string) (ElementString, error) {
+	return newCheckedElementString(AI7241, value)
+}
+
+// AIDCMediaType returns the data of AI (7241) AIDC MEDIA TYPE if present.
+func (d Message) AIDCMediaType() (string, bool) {
+	return d.lookupData(AIDCMediaTypeCode)
+}
+
+// NewVCN returns the element string of AI (7242) VCN. The value is checked against the
+// specification X..25.
+func NewVCN(value string) (ElementString, error) {
+	return newCheckedElementString(AI7242, value)
+}
+
+// VCN returns the data of AI (7242) VCN if present.
+func (d Message) VCN() (string, bool) {
+	return d.lookupData(VCNCode)
+}
+
+// NewDOB returns the element string of AI (7250) DOB. The value is checked against the
+// specification N8,yyyymmdd including its linters.
+func NewDOB(value string) (ElementString, error) {
+	return newCheckedElementString(AI7250, value)
+}
+
+// DOB returns the data of AI (7250) DOB if present.
+func (d Message) DOB() (string, bool) {
+	return d.lookupData(DOBCode)
+}
+
+// NewDOBTime returns the element string of AI (7251) DOB TIME. The value is checked against the
+// specification N8,yyyymmdd N4,hhmi including its linters.
+func NewDOBTime(value string) (ElementString, error) {
+	return newCheckedElementString(AI7251, value)
+}
+
+// DOBTime returns the data of AI (7251) DOB TIME if present.
+func (d Message) DOBTime() (string, bool) {
+	return d.lookupData(DOBTimeCode)
+}
+
+// NewBioSex returns the element string of AI (7252) BIO SEX. The value is checked against the
+// specification N1,iso5218 including its linters.
+func NewBioSex(value string) (ElementString, error) {
+	return newCheckedElementString(AI7252, value)
+}
+
+// BioSex returns the data of AI (7252) BIO SEX if present.
+func (d Message) BioSex() (string, bool) {
+	return d.lookupData(BioSexCode)
+}
+
+// NewFamilyName returns the element string of AI (7253) FAMILY NAME. The value is checked against the
+// specification X..40,pcenc including its linters.
+func NewFamilyName(value string) (ElementString, error) {
+	return newCheckedElementString(AI7253, value)
+}
+
+// FamilyName returns the data of AI (7253) FAMILY NAME if present.
+func (d Message) FamilyName() (string, bool) {
+	return d.lookupData(FamilyNameCode)
+}
+
+// NewGivenName returns the element string of AI (7254) GIVEN NAME. The value is checked against the
+// specification X..40,pcenc including its linters.
+func NewGivenName(value string) (ElementString, error) {
+	return newCheckedElementString(AI7254, value)
+}
+
+// GivenName returns the data of AI (7254) GIVEN NAME if present.
+func (d Message) GivenName() (string, bool) {
+	return d.lookupData(GivenNameCode)
+}
+
+// NewSuffix returns the element string of AI (7255) SUFFIX. The value is checked against the
+// specification X..10.
+func NewSuffix(value string) (ElementString, error) {
+	return newCheckedElementString(AI7255, value)
+}
+
+// Suffix returns the data of AI (7255) SUFFIX if present.
+func (d Message) Suffix() (string, bool) {
+	return d.lookupData(SuffixCode)
+}
+
+// NewFullName returns the element string of AI (7256) FULL NAME. The value is checked against the
+// specification X..90,pcenc including its linters.
+func NewFullName(value string) (ElementString, error) {
+	return newCheckedElementString(AI7256, value)
+}
+
+// FullName returns the data of AI (7256) FULL NAME if present.
+func (d Message) FullName() (string, bool) {
+	return d.lookupData(FullNameCode)
+}
+
+// NewPersonAddr returns the element string of AI (7257) PERSON ADDR. The value is checked against the
+// specification X..70,pcenc including its linters.
+func NewPersonAddr(value string) (ElementString, error) {
+	return newCheckedElementString(AI7257, value)
+}
+
+// PersonAddr returns the data of AI (7257) PERSON ADDR if present.
+func (d Message) PersonAddr() (string, bool) {
+	return d.lookupData(PersonAddrCode)
+}
+
+// NewBirthSequence returns the element string of AI (7258) BIRTH SEQUENCE. The value is checked against the
+// specification X3,posinseqslash including its linters.
+func NewBirthSequence(value string) (ElementString, error) {
+	return newCheckedElementString(AI7258, value)
+}
+
+// BirthSequence returns the data of AI (7258) BIRTH SEQUENCE if present.
+func (d Message) BirthSequence() (string, bool) {
+	return d.lookupData(BirthSequenceCode)
+}
+
+// NewBaby returns the element string of AI (7259) BABY. The value is checked against the
+// specification X..40,pcenc including its linters.
+func NewBaby(value string) (ElementString, error) {
+	return newCheckedElementString(AI7259, value)
+}
+
+// Baby returns the data of AI (7259) BABY if present.
+func (d Message) Baby() (string, bool) {
+	return d.lookupData(BabyCode)
+}
+
+// NewDimensions returns the element string of AI (8001) DIMENSIONS. The value is checked against the
+// specification N4,nonzero N5,nonzero N3,nonzero N1,winding N1 including its linters.
+func NewDimensions(value string) (ElementString, error) {
+	return newCheckedElementString(AI8001, value)
+}
+
+// Dimensions returns the data of AI (8001) DIMENSIONS if present.
+func (d Message) Dimensions() (string, bool) {
+	return d.lookupData(DimensionsCode)
+}
+
+// NewCmtNo returns the element string of AI (8002) CMT No. The value is checked against the
+// specification X..20.
+func NewCmtNo(value string) (ElementString, error) {
+	return newCheckedElementString(AI8002, value)
+}
+
+// CmtNo returns the data of AI (8002) CMT No. if present.
+func (d Message) CmtNo() (string, bool) {
+	return d.lookupData(CmtNoCode)
+}
+
+// NewGRAI returns the element string of AI (8003) GRAI. The value is checked against the
+// specification N1,zero N13,csum,key [X..16] including its linters.
+func NewGRAI(value string) (ElementString, error) {
+	return newCheckedElementString(AI8003, value)
+}
+
+// GRAI returns the data of AI (8003) GRAI if present.
+func (d Message) GRAI() (string, bool) {
+	return d.lookupData(GRAICode)
+}
+
+// NewGIAI returns the element string of AI (8004) GIAI. The value is checked against the
+// specification X..30,key including its linters.
+func NewGIAI(value string) (ElementString, error) {
+	return newCheckedElementString(AI8004, value)
+}
+
+// GIAI returns the data of AI (8004) GIAI if present.
+func (d Message) GIAI() (string, bool) {
+	return d.lookupData(GIAICode)
+}
+
+// NewPricePerUnit returns the element string of AI (8005) PRICE PER UNIT. The value is checked against the
+// specification N6.
+func NewPricePerUnit(value string) (ElementString, error) {
+	return newCheckedElementString(AI8005, value)
+}
+
+// PricePerUnit returns the data of AI (8005) PRICE PER UNIT if present.
+func (d Message) PricePerUnit() (string, bool) {
+	return d.lookupData(PricePerUnitCode)
+}
+
+// NewITIP returns the element string of AI (8006) ITIP. The value is checked against the
+// specification N14,csum N4,pieceoftotal including its linters.
+func NewITIP(value string) (ElementString, error) {
+	return newCheckedElementString(AI8006, value)
+}
+
+// ITIP returns the data of AI (8006) ITIP if present.
+func (d Message) ITIP() (string, bool) {
+	return d.lookupData(ITIPCode)
+}
+
+// NewIBAN returns the element string of AI (8007) IBAN. The value is checked against the
+// specification X..34,iban including its linters.
+func NewIBAN(value string) (ElementString, error) {
+	return newCheckedElementString(AI8007, value)
+}
+
+// IBAN returns the data of AI (8007) IBAN if present.
+func (d Message) IBAN() (string, bool) {
+	return d.lookupData(IBANCode)
+}
+
+// NewProdTime returns the element string of AI (8008) PROD TIME. The value is checked against the
+// specification N6,yymmdd N2,hh [N2],mi [N2],ss including its linters.
+func NewProdTime(value string) (ElementString, error) {
+	return newCheckedElementString(AI8008, value)
+}
+
+// ProdTime returns the data of AI (8008) PROD TIME if present.
+func (d Message) ProdTime() (string, bool) {
+	return d.lookupData(ProdTimeCode)
+}
+
+// NewOptsen returns the element string of AI (8009) OPTSEN. The value is checked against the
+// specification X..50.
+func NewOptsen(value string) (ElementString, error) {
+	return newCheckedElementString(AI8009, value)
+}
+
+// Optsen returns the data of AI (8009) OPTSEN if present.
+func (d Message) Optsen() (string, bool) {
+	return d.lookupData(OptsenCode)
+}
+
+// NewCPID returns the element string of AI (8010) CPID. The value is checked against the
+// specification Y..30,key including its linters.
+func NewCPID(value string) (ElementString, error) {
+	return newCheckedElementString(AI8010, value)
+}
+
+// CPID returns the data of AI (8010) CPID if present.
+func (d Message) CPID() (string, bool) {
+	return d.lookupData(CPIDCode)
+}
+
+// NewCPIDSerial returns the element string of AI (8011) CPID SERIAL. The value is checked against the
+// specification N..12,nozeroprefix including its linters.
+func NewCPIDSerial(value string) (ElementString, error) {
+	return newCheckedElementString(AI8011, value)
+}
+
+// CPIDSerial returns the data of AI (8011) CPID SERIAL if present.
+func (d Message) CPIDSerial() (string, bool) {
+	return d.lookupData(CPIDSerialCode)
+}
+
+// NewVersion returns the element string of AI (8012) VERSION. The value is checked against the
+// specification X..20.
+func NewVersion(value string) (ElementString, error) {
+	return newCheckedElementString(AI8012, value)
+}
+
+// Version returns the data of AI (8012) VERSION if present.
+func (d Message) Version() (string, bool) {
+	return d.lookupData(VersionCode)
+}
+
+// NewGMN returns the element string of AI (8013) GMN. The value is checked against the
+// specification X..25,csumalpha,key including its linters.
+func NewGMN(value string) (ElementString, error) {
+	return newCheckedElementString(AI8013, value)
+}
+
+// GMN returns the data of AI (8013) GMN if present.
+func (d Message) GMN() (string, bool) {
+	return d.lookupData(GMNCode)
+}
+
+// NewMUDI returns the element string of AI (8014) MUDI. The value is checked against the
+// specification X..25,csumalpha,key,hasnondigit including its linters.
+func NewMUDI(value string) (ElementString, error) {
+	return newCheckedElementString(AI8014, value)
+}
+
+// MUDI returns the data of AI (8014) MUDI if present.
+func (d Message) MUDI() (string, bool) {
+	return d.lookupData(MUDICode)
+}
+
+// NewGSRNProvider returns the element string of AI (8017) GSRN - PROVIDER. The value is checked against the
+// specification N18,csum,key including its linters.
+func NewGSRNProvider(value string) (ElementString, error) {
+	return newCheckedElementString(AI8017, value)
+}
+
+// GSRNProvider returns the data of AI (8017) GSRN - PROVIDER if present.
+func (d Message) GSRNProvider() (string, bool) {
+	return d.lookupData(GSRNProviderCode)
+}
+
+// NewGSRNRecipient returns the element string of AI (8018) GSRN - RECIPIENT. The value is checked against the
+// specification N18,csum,key including its linters.
+func NewGSRNRecipient(value string) (ElementString, error) {
+	return newCheckedElementString(AI8018, value)
+}
+
+// GSRNRecipient returns the data of AI (8018) GSRN - RECIPIENT if present.
+func (d Message) GSRNRecipient() (string, bool) {
+	return d.lookupData(GSRNRecipientCode)
+}
+
+// NewSRIN returns the element string of AI (8019) SRIN. The value is checked against the
+// specification N..10.
+func NewSRIN(value string) (ElementString, error) {
+	return newCheckedElementString(AI8019, value)
+}
+
+// SRIN returns the data of AI (8019) SRIN if present.
+func (d Message) SRIN() (string, bool) {
+	return d.lookupData(SRINCode)
+}
+
+// NewRefNo returns the element string of AI (8020) REF No. The value is checked against the
+// specification X..25.
+func NewRefNo(value string) (ElementString, error) {
+	return newCheckedElementString(AI8020, value)
+}
+
+// RefNo returns the data of AI (8020) REF No. if present.
+func (d Message) RefNo() (string, bool) {
+	return d.lookupData(RefNoCode)
+}
+
+// NewITIPContent returns the element string of AI (8026) ITIP CONTENT. The value is checked against the
+// specification N14,csum N4,pieceoftotal including its linters.
+func NewITIPContent(value string) (ElementString, error) {
+	return newCheckedElementString(AI8026, value)
+}
+
+// ITIPContent returns the data of AI (8026) ITIP CONTENT if present.
+func (d Message) ITIPContent() (string, bool) {
+	return d.lookupData(ITIPContentCode)
+}
+
+// NewDigsig returns the element string of AI (8030) DIGSIG. The value is checked against the
+// specification Z..90.
+func NewDigsig(value string) (ElementString, error) {
+	return newCheckedElementString(AI8030, value)
+}
+
+// Digsig returns the data of AI (8030) DIGSIG if present.
+func (d Message) Digsig() (string, bool) {
+	return d.lookupData(DigsigCode)
+}
+
+// NewAI8110 returns the element string of AI (8110) . The value is checked against the
+// specification X..70,couponcode including its linters.
+func NewAI8110(value string) (ElementString, error) {
+	return newCheckedElementString(AI8110, value)
+}
+
+// AI8110 returns the data of AI (8110)  if present.
+func (d Message) AI8110() (string, bool) {
+	return d.lookupData(AI8110Code)
+}
+
+// NewPoints returns the element string of AI (8111) POINTS. The value is checked against the
+// specification N4.
+func NewPoints(value string) (ElementString, error) {
+	return newCheckedElementString(AI8111, value)
+}
+
+// Points returns the data of AI (8111) POINTS if present.
+func (d Message) Points() (string, bool) {
+	return d.lookupData(PointsCode)
+}
+
+// NewAI8112 returns the element string of AI (8112) . The value is checked against the
+// specification X..70,couponposoffer including its linters.
+func NewAI8112(value string) (ElementString, error) {
+	return newCheckedElementString(AI8112, value)
+}
+
+// AI8112 returns the data of AI (8112)  if present.
+func (d Message) AI8112() (string, bool) {
+	return d.lookupData(AI8112Code)
+}
+
+// NewProductURL returns the element string of AI (8200) PRODUCT URL. The value is checked against the
+// specification X..70.
+func NewProductURL(value string) (ElementString, error) {
+	return newCheckedElementString(AI8200, value)
+}
+
+// ProductURL returns the data of AI (8200) PRODUCT URL if present.
+func (d Message) ProductURL() (string, bool) {
+	return d.lookupData(ProductURLCode)
+}
+
+// NewInternal90 returns the element string of AI (90) INTERNAL. The value is checked against the
+// specification X..30.
+func NewInternal90(value string) (ElementString, error) {
+	return newCheckedElementString(AI90, value)
+}
+
+// Internal90 returns the data of AI (90) INTERNAL if present.
+func (d Message) Internal90() (string, bool) {
+	return d.lookupData(Internal90Code)
+}
+
+// NewInternal91 returns the element string of AI (91) INTERNAL. The value is checked against the
+// specification X..90.
+func NewInternal91(value string) (ElementString, error) {
+	return newCheckedElementString(AI91, value)
+}
+
+// Internal91 returns the data of AI (91) INTERNAL if present.
+func (d Message) Internal91() (string, bool) {
+	return d.lookupData(Internal91Code)
+}
+
+// NewInternal92 returns the element string of AI (92) INTERNAL. The value is checked against the
+// specification X..90.
+func NewInternal92(value string) (ElementString, error) {
+	return newCheckedElementString(AI92, value)
+}
+
+// Internal92 returns the data of AI (92) INTERNAL if present.
+func (d Message) Internal92() (string, bool) {
+	return d.lookupData(Internal92Code)
+}
+
+// NewInternal93 returns the element string of AI (93) INTERNAL. The value is checked against the
+// specification X..90.
+func NewInternal93(value string) (ElementString, error) {
+	return newCheckedElementString(AI93, value)
+}
+
+// Internal93 returns the data of AI (93) INTERNAL if present.
+func (d Message) Internal93() (string, bool) {
+	return d.lookupData(Internal93Code)
+}
+
+// NewInternal94 returns the element string of AI (94) INTERNAL. The value is checked against the
+// specification X..90.
+func NewInternal94(value string) (ElementString, error) {
+	return newCheckedElementString(AI94, value)
+}
+
+// Internal94 returns the data of AI (94) INTERNAL if present.
+func (d Message) Internal94() (string, bool) {
+	return d.lookupData(Internal94Code)
+}
+
+// NewInternal95 returns the element string of AI (95) INTERNAL. The value is checked against the
+// specification X..90.
+func NewInternal95(value string) (ElementString, error) {
+	return newCheckedElementString(AI95, value)
+}
+
+// Internal95 returns the data of AI (95) INTERNAL if present.
+func (d Message) Internal95() (string, bool) {
+	return d.lookupData(Internal95Code)
+}
+
+// NewInternal96 returns the element string of AI (96) INTERNAL. The value is checked against the
+// specification X..90.
+func NewInternal96(value string) (ElementString, error) {
+	return newCheckedElementString(AI96, value)
+}
+
+// Internal96 returns the data of AI (96) INTERNAL if present.
+func (d Message) Internal96() (string, bool) {
+	return d.lookupData(Internal96Code)
+}
+
+// NewInternal97 returns the element string of AI (97) INTERNAL. The value is checked against the
+// specification X..90.
+func NewInternal97(value string) (ElementString, error) {
+	return newCheckedElementString(AI97, value)
+}
+
+// Internal97 returns the data of AI (97) INTERNAL if present.
+func (d Message) Internal97() (string, bool) {
+	return d.lookupData(Internal97Code)
+}
+
+// NewInternal98 returns the element string of AI (98) INTERNAL. The value is checked against the
+// specification X..90.
+func NewInternal98(value string) (ElementString, error) {
+	return newCheckedElementString(AI98, value)
+}
+
+// Internal98 returns the data of AI (98) INTERNAL if present.
+func (d Message) Internal98() (string, bool) {
+	return d.lookupData(Internal98Code)
+}
+
+// NewInternal99 returns the element string of AI (99) INTERNAL. The value is checked against the
+// specification X..90.
+func NewInternal99(value string) (ElementString, error) {
+	return newCheckedElementString(AI99, value)
+}
+
+// Internal99 returns the data of AI (99) INTERNAL if present.
+func (d Message) Internal99() (string, bool) {
+	return d.lookupData(Internal99Code)
+}
@@ -0,0 +1,400 @@
+// Package barcode encodes GS1 messages into barcode symbols. Symbols are returned as modules, the smallest bars or
+// squares of a symbol, and can be rendered to images, PNG and SVG.
+//
+// Linear symbols, e.g. GS1-128, are represented by [Linear], two-dimensional symbols, e.g. GS1 DataMatrix, by
+// [Matrix]. Both include the quiet zones required by the symbology.
+package barcode
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// mmPerInch converts resolutions in dots per inch to dots per millimetre.
+const mmPerInch = 25.4
+
+// ErrDataTooLong is returned if a message exceeds the capacity of a symbol.
+var ErrDataTooLong = errors.New("data too long")
+
+var errNoElements = errors.New("message has no elements")
+
+// gs1Symbols returns the data of a barcode message with FNC1 in first position. FNC1 is represented as -1 to
+// distinguish it from the ASCII control character GS.
+func gs1Symbols(data string) []int {
+	symbols := make([]int, 0, len(data)+1)
+	symbols = append(symbols, -1)
+	for i := 0; i < len(data); i++ {
+		if data[i] == '\x1d' {
+			symbols = append(symbols, -1)
+		} else {
+			symbols = append(symbols, int(data[i]))
+		}
+	}
+	return symbols
+}
+
+// Linear is a linear symbol of a single row of modules.
+type Linear struct {
+	// Modules are the modules from left to right including the quiet zones. Dark modules are true.
+	Modules []bool
+	// QuietZone is the amount of light modules on each side of the symbol.
+	QuietZone int
+	// HRI is the human readable interpretation printed below the symbol, one entry per element. EAN/UPC symbols
+	// have the digits of the symbol and of the add-on instead.
+	HRI []string
+	// MaxWidthMM is the maximum width of the symbol including quiet zones in millimetres. It is zero if the
+	// symbology does not restrict the width.
+	MaxWidthMM float64
+}
+
+// Width returns the amount of modules including the quiet zones.
+func (l *Linear) Width() int {
+	return len(l.Modules)
+}
+
+// WidthMM returns the width of the symbol including the quiet zones at the given X-dimension (module width) in
+// millimetres.
+func (l *Linear) WidthMM(xdimMM float64) float64 {
+	return float64(len(l.Modules)) * xdimMM
+}
+
+// CheckWidth returns an error if the symbol exceeds its maximum width at the given X-dimension in millimetres.
+func (l *Linear) CheckWidth(xdimMM float64) error {
+	if xdimMM <= 0 {
+		return fmt.Errorf("invalid X-dimension %g mm: must be positive", xdimMM)
+	}
+	if l.MaxWidthMM > 0 && l.WidthMM(xdimMM) > l.MaxWidthMM {
+		return fmt.Errorf("symbol width of %.1f mm at X-dimension %.3g mm exceeds maximum of %g mm",
+			l.WidthMM(xdimMM), xdimMM, l.MaxWidthMM)
+	}
+	return nil
+}
+
+// Image renders the symbol at the given X-dimension and bar height in millimetres and resolution in dots per inch.
+// The X-dimension is rounded to whole pixels, but at least one. It returns an error if the symbol exceeds its maximum
+// width at the rounded X-dimension.
+func (l *Linear) Image(xdimMM, heightMM float64, dpi int) (image.Image, error) {
+	if dpi <= 0 {
+		return nil, fmt.Errorf("invalid resolution %d dpi: must be positive", dpi)
+	}
+	if xdimMM <= 0 {
+		return nil, fmt.Errorf("invalid X-dimension %g mm: must be positive", xdimMM)
+	}
+	if heightMM <= 0 {
+		return nil, fmt.Errorf("invalid height %g mm: must be positive", heightMM)
+	}
+	xdim := max(1, int(math.Round(xdimMM*float64(dpi)/mmPerInch)))
+	if err := l.CheckWidth(float64(xdim) * mmPerInch / float64(dpi)); err != nil {
+		return nil, err
+	}
+	height := max(1, int(math.Round(heightMM*float64(dpi)/mmPerInch)))
+	img := newImage(len(l.Modules)*xdim, height)
+	for x, dark := range l.Modules {
+		if dark {
+			fill(img, x*xdim, 0, xdim, height)
+		}
+	}
+	return img, nil
+}
+
+// PNG writes the symbol as PNG image at the given X-dimension and bar height in millimetres and resolution in dots
+// per inch. It returns an error if the symbol exceeds its maximum width, see [Linear.Image].
+func (l *Linear) PNG(w io.Writer, xdimMM, heightMM float64, dpi int) error {
+	img, err := l.Image(xdimMM, heightMM, dpi)
+	if err != nil {
+		return err
+	}
+	return png.Encode(w, img)
+}
+
+// SVG writes the symbol as SVG image at the given X-dimension and bar height in millimetres. It returns an error if
+// the symbol exceeds its maximum width.
+func (l *Linear) SVG(w io.Writer, xdimMM, heightMM float64) error {
+	if err := l.CheckWidth(xdimMM); err != nil {
+		return err
+	}
+	if heightMM <= 0 {
+		return fmt.Errorf("invalid height %g mm: must be positive", heightMM)
+	}
+	svg := newSVGWriter(w, float64(len(l.Modules)), 1, l.WidthMM(xdimMM), heightMM)
+	for _, bar := range runs(l.Modules) {
+		svg.rect(bar[0], 0, bar[1], 1)
+	}
+	return svg.close()
+}
+
+// runs returns the start and length of the runs of dark modules.
+func runs(modules []bool) [][2]int {
+	var bars [][2]int
+	for x := 0; x < len(modules); x++ {
+		if !modules[x] {
+			continue
+		}
+		start := x
+		for x < len(modules) && modules[x] {
+			x++
+		}
+		bars = append(bars, [2]int{start, x - start})
+	}
+	return bars
+}
+
+// appendWidths appends alternating dark and light modules of the given widths starting with a dark module, e.g.
+// 2, 1, 2 appends ■■□■■.
+func appendWidths(modules []bool, widths ...int) []bool {
+	for i, width := range widths {
+		for range width {
+			modules = append(modules, i%2 == 0)
+		}
+	}
+	return modules
+}
+
+// appendElements appends the elements of the given widths, alternating between dark and light modules and starting
+// with a dark element if dark is set.
+func appendElements(modules []bool, dark bool, widths ...int) []bool {
+	for _, width := range widths {
+		for range width {
+			modules = append(modules, dark)
+		}
+		dark = !dark
+	}
+	return modules
+}
+
+// Matrix is a two-dimensional symbol of square modules.
+type Matrix struct {
+	width, height int
+	modules       []bool
+	// QuietZone is the amount of light modules around the symbol. The quiet zone is not part of the modules
+	// returned by [Matrix.At] but is added when rendering.
+	QuietZone int
+}
+
+// NewMatrix creates a light matrix of the given size.
+func NewMatrix(width, height, quietZone int) *Matrix {
+	return &Matrix{width: width, height: height, modules: make([]bool, width*height), QuietZone: quietZone}
+}
+
+// Width returns the amount of modules per row excluding the quiet zone.
+func (m *Matrix) Width() int {
+	return m.width
+}
+
+// Height returns the amount of rows excluding the quiet zone.
+func (m *Matrix) Height() int {
+	return m.height
+}
+
+// At reports whether the module in column x and row y is dark. Modules outside the symbol are light.
+func (m *Matrix) At(x, y int) bool {
+	if x < 0 || y < 0 || x >= m.width || y >= m.height {
+		return false
+	}
+	return m.modules[y*m.width+x]
+}
+
+// Set sets the module in column x and row y.
+func (m *Matrix) Set(x, y int, dark bool) {
+	m.modules[y*m.width+x] = dark
+}
+
+// String returns the modules as text, one line per row, using # for dark and . for light modules.
+func (m *Matrix) String() string {
+	buf := make([]byte, 0, (m.width+1)*m.height)
+	for y := range m.height {
+		for x := range m.width {
+			if m.At(x, y) {
+				buf = append(buf, '#')
+			} else {
+				buf = append(buf, '.')
+			}
+		}
+		buf = append(buf, '\n')
+	}
+	return string(buf)
+}
+
+// Image renders the symbol including the quiet zone with moduleSize pixels per module.
+func (m *Matrix) Image(moduleSize int) image.Image {
+	q := m.QuietZone
+	img := newImage((m.width+2*q)*moduleSize, (m.height+2*q)*moduleSize)
+	for y := range m.height {
+		for x := range m.width {
+			if m.At(x, y) {
+				fill(img, (x+q)*moduleSize, (y+q)*moduleSize, moduleSize, moduleSize)
+			}
+		}
+	}
+	return img
+}
+
+// PNG writes the symbol as PNG image with moduleSize pixels per module.
+func (m *Matrix) PNG(w io.Writer, moduleSize int) error {
+	return png.Encode(w, m.Image(moduleSize))
+}
+
+// SVG writes the symbol including the quiet zone as SVG image at the given module size in millimetres.
+func (m *Matrix) SVG(w io.Writer, moduleSizeMM float64) error {
+	if moduleSizeMM <= 0 {
+		return fmt.Errorf("invalid module size %g mm: must be positive", moduleSizeMM)
+	}
+	q := m.QuietZone
+	width, height := m.width+2*q, m.height+2*q
+	svg := newSVGWriter(w, float64(width), float64(height), float64(width)*moduleSizeMM,
+		float64(height)*moduleSizeMM)
+	row := make([]bool, m.width)
+	for y := range m.height {
+		for x := range m.width {
+			row[x] = m.At(x, y)
+		}
+		for _, run := range runs(row) {
+			svg.rect(run[0]+q, y+q, run[1], 1)
+		}
+	}
+	return svg.close()
+}
+
+func newImage(width, height int) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+	return img
+}
+
+// fill paints the rectangle black.
+func fill(img *image.Gray, x, y, width, height int) {
+	for dy := range height {
+		for dx := range width {
+			img.SetGray(x+dx, y+dy, color.Gray{})
+		}
+	}
+}
+
+// svgWriter writes an SVG document of dark rectangles using modules as user units.
+type svgWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func newSVGWriter(w io.Writer, viewWidth, viewHeight, widthMM, heightMM float64) *svgWriter {
+	svg := &svgWriter{w: bufio.NewWriter(w)}
+	svg.printf(`<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+		`<svg xmlns="http://www.w3.org/2000/svg" version="1.1" width="%smm" height="%smm" viewBox="0 0 %s %s" `+
+		`preserveAspectRatio="none" shape-rendering="crispEdges">`+"\n",
+		formatFloat(widthMM), formatFloat(heightMM), formatFloat(viewWidth), formatFloat(viewHeight))
+	svg.printf(`<rect width="100%%" height="100%%" fill="#fff"/>` + "\n")
+	svg.printf(`<path fill="#000" d="`)
+	return svg
+}
+
+func (s *svgWriter) printf(format string, args ...any) {
+	if s.err == nil {
+		_, s.err = fmt.Fprintf(s.w, format, args...)
+	}
+}
+
+// rect adds a dark rectangle in module units.
+func (s *svgWriter) rect(x, y, width, height int) {
+	s.printf("M%d %dh%dv%dh-%dz", x, y, width, height, width)
+}
+
+func (s *svgWriter) close() error {
+	s.printf("\"/>\n</svg>\n")
+	if s.err != nil {
+		return s.err
+	}
+	return s.w.Flush()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// bitWriter appends values most significant bit first.
+type bitWriter struct {
+	data []byte
+	len  int
+}
+
+func (w *bitWriter) write(v, n int) {
+	for i := n - 1; i >= 0; i-- {
+		if w.len%8 == 0 {
+			w.data = append(w.data, 0)
+		}
+		if v>>i&1 == 1 {
+			w.data[w.len/8] |= 0x80 >> (w.len % 8)
+		}
+		w.len++
+	}
+}
+
+// set sets the bit at position i.
+func (w *bitWriter) set(i int) {
+	w.data[i/8] |= 0x80 >> (i % 8)
+}
+
+// truncate discards the bits from position n.
+func (w *bitWriter) truncate(n int) {
+	w.data = w.data[:(n+7)/8]
+	if n%8 != 0 {
+		w.data[n/8] &^= 0xff >> (n % 8)
+	}
+	w.len = n
+}
+
+// String returns the bits as string of 0 and 1.
+func (w *bitWriter) String() string {
+	var sb strings.Builder
+	for r := (&bitReader{data: w.data, size: w.len}); r.remaining() > 0; {
+		sb.WriteByte(byte('0' + r.read(1)))
+	}
+	return sb.String()
+}
+
+// parseBits returns a reader of the bits of a string of 0 and 1.
+func parseBits(bits string) (*bitReader, error) {
+	w := &bitWriter{}
+	for i, c := range bits {
+		if c != '0' && c != '1' {
+			return nil, fmt.Errorf("invalid character %q at position %d: binary data must consist of 0 and 1", c, i)
+		}
+		w.write(int(c-'0'), 1)
+	}
+	return &bitReader{data: w.data, size: w.len}, nil
+}
+
+// bitReader reads values most significant bit first from the first size bits of data.
+type bitReader struct {
+	data []byte
+	pos  int
+	size int
+}
+
+func (r *bitReader) remaining() int {
+	return r.size - r.pos
+}
+
+// peek returns the next n bits without consuming them.
+func (r *bitReader) peek(n int) int {
+	v := 0
+	for i := r.pos; i < r.pos+n; i++ {
+		v = v<<1 | int(r.data[i/8]>>(7-i%8)&1)
+	}
+	return v
+}
+
+func (r *bitReader) read(n int) int {
+	v := r.peek(n)
+	r.pos += n
+	return v
+}
@@ -0,0 +1,125 @@
+package barcode
+
+import (
+	"bytes"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestLinear_Image(t *testing.T) {
+	sym := &Linear{Modules: appendWidths(make([]bool, 2), 1, 2, 3)}
+	img, err := sym.Image(0.2, 0.5, 254) // 2 pixels per module, 5 pixels high
+	if err != nil {
+		t.Fatalf("Image() error = %v", err)
+	}
+	if got := img.Bounds().Dx(); got != 16 {
+		t.Errorf("Image() width = %d, want 16", got)
+	}
+	if got := img.Bounds().Dy(); got != 5 {
+		t.Errorf("Image() height = %d, want 5", got)
+	}
+	for x, want := range []bool{false, false, false, false, true, true, false, false, false, false, true, true} {
+		r, _, _, _ := img.At(x, 4).RGBA()
+		if got := r == 0; got != want {
+			t.Errorf("Image() pixel %d dark = %v, want %v", x, got, want)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := sym.PNG(&buf, 0.1, 0.1, 254); err != nil {
+		t.Fatalf("PNG() error = %v", err)
+	}
+	if _, err := png.Decode(&buf); err != nil {
+		t.Errorf("PNG() SHOULD write a valid PNG: %v", err)
+	}
+}
+
+func TestLinear_Image_Width(t *testing.T) {
+	sym := &Linear{Modules: make([]bool, 100), MaxWidthMM: 10}
+	tests := []struct {
+		name     string
+		xdimMM   float64
+		heightMM float64
+		dpi      int
+		wantErr  string
+	}{
+		{name: "Symbol within the maximum width SHOULD render", xdimMM: 0.1, heightMM: 1, dpi: 254},
+		{
+			name: "X-dimension rounded down to whole pixels SHOULD be checked", xdimMM: 0.12, heightMM: 1, dpi: 254,
+		},
+		{
+			name: "Symbol exceeding the maximum width SHOULD return an error", xdimMM: 0.2, heightMM: 1, dpi: 254,
+			wantErr: "symbol width of 20.0 mm at X-dimension 0.2 mm exceeds maximum of 10 mm",
+		},
+		{
+			name: "X-dimension rounded up to whole pixels SHOULD be checked", xdimMM: 0.09, heightMM: 1, dpi: 200,
+			wantErr: "exceeds maximum of 10 mm",
+		},
+		{
+			name: "Invalid resolution SHOULD return an error", xdimMM: 0.1, heightMM: 1, dpi: 0,
+			wantErr: "invalid resolution 0 dpi",
+		},
+		{
+			name: "Invalid height SHOULD return an error", xdimMM: 0.1, heightMM: 0, dpi: 254,
+			wantErr: "invalid height 0 mm",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sym.Image(tt.xdimMM, tt.heightMM, tt.dpi)
+			if (err != nil) != (tt.wantErr != "") || err != nil && !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Image() error = %v, want %q", err, tt.wantErr)
+			}
+			if err := sym.PNG(io.Discard, tt.xdimMM, tt.heightMM, tt.dpi); (err != nil) != (tt.wantErr != "") {
+				t.Errorf("PNG() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLinear_SVG(t *testing.T) {
+	sym := &Linear{Modules: appendWidths([]bool{false}, 1, 2, 3)}
+	var buf bytes.Buffer
+	if err := sym.SVG(&buf, 0.5, 10); err != nil {
+		t.Fatalf("SVG() error = %v", err)
+	}
+	got := buf.String()
+	for _, want := range []string{`width="3.5mm" height="10mm" viewBox="0 0 7 1"`, `d="M1 0h1v1h-1zM4 0h3v1h-3z"`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("SVG() = %s, want it to contain %s", got, want)
+		}
+	}
+	if err := sym.SVG(&buf, 0, 10); err == nil {
+		t.Error("SVG() SHOULD reject a zero X-dimension")
+	}
+}
+
+func TestMatrix(t *testing.T) {
+	m := NewMatrix(3, 2, 1)
+	m.Set(0, 0, true)
+	m.Set(2, 1, true)
+	if got, want := m.String(), "#..\n..#\n"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if m.At(-1, 0) || m.At(3, 1) {
+		t.Error("At() SHOULD report modules outside the symbol as light")
+	}
+
+	img := m.Image(2)
+	if got := img.Bounds(); got.Dx() != 10 || got.Dy() != 8 {
+		t.Errorf("Image() bounds = %v, want 10x8 including quiet zone", got)
+	}
+	if r, _, _, _ := img.At(2, 2).RGBA(); r != 0 {
+		t.Error("Image() SHOULD render the first module after the quiet zone dark")
+	}
+
+	var buf bytes.Buffer
+	if err := m.SVG(&buf, 1); err != nil {
+		t.Fatalf("SVG() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), `d="M1 1h1v1h-1zM3 2h1v1h-1z"`) {
+		t.Errorf("SVG() = %s", buf.String())
+	}
+}
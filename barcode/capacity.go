@@ -0,0 +1,341 @@
+package barcode
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+// Symbology is a symbology carrying GS1 AI data or GS1 Digital Link URIs, see [FitsIn]. It also selects the symbol
+// printed by package printer.
+type Symbology int
+
+const (
+	GS1128 Symbology = iota + 1
+	DataBarOmni
+	DataBarStacked
+	DataBarStackedOmni
+	DataBarLimited
+	DataBarExpanded
+	DataBarExpandedStacked
+	DataMatrix
+	GS1QR
+	DotCode
+	// DigitalLinkDataMatrix is Data Matrix carrying the message as GS1 Digital Link URI.
+	DigitalLinkDataMatrix
+	// DigitalLinkQR is QR Code carrying the message as GS1 Digital Link URI.
+	DigitalLinkQR
+)
+
+var symbologyIdentifiers = [...]gs1.SymbologyIdentifier{
+	GS1128:                 {Type: gs1.GS1128, Mode: 1},
+	DataBarOmni:            {Type: gs1.GS1DataBar},
+	DataBarStacked:         {Type: gs1.GS1DataBar},
+	DataBarStackedOmni:     {Type: gs1.GS1DataBar},
+	DataBarLimited:         {Type: gs1.GS1DataBar},
+	DataBarExpanded:        {Type: gs1.GS1DataBar},
+	DataBarExpandedStacked: {Type: gs1.GS1DataBar},
+	DataMatrix:             {Type: gs1.GS1DataMatrix, Mode: 2},
+	GS1QR:                  {Type: gs1.GS1QRCode, Mode: 3},
+	DotCode:                {Type: gs1.GS1DotCode, Mode: 1},
+	DigitalLinkDataMatrix:  {Type: gs1.GS1DataMatrix, Mode: 1},
+	DigitalLinkQR:          {Type: gs1.GS1QRCode, Mode: 1},
+}
+
+var symbologyNames = [...]string{
+	GS1128:                 "GS1-128",
+	DataBarOmni:            "GS1 DataBar Omnidirectional",
+	DataBarStacked:         "GS1 DataBar Stacked",
+	DataBarStackedOmni:     "GS1 DataBar Stacked Omnidirectional",
+	DataBarLimited:         "GS1 DataBar Limited",
+	DataBarExpanded:        "GS1 DataBar Expanded",
+	DataBarExpandedStacked: "GS1 DataBar Expanded Stacked",
+	DataMatrix:             "GS1 DataMatrix",
+	GS1QR:                  "GS1 QR Code",
+	DotCode:                "GS1 DotCode",
+	DigitalLinkDataMatrix:  "Data Matrix with GS1 Digital Link URI",
+	DigitalLinkQR:          "QR Code with GS1 Digital Link URI",
+}
+
+func (s Symbology) String() string {
+	if s < GS1128 || s > DigitalLinkQR {
+		return fmt.Sprintf("Symbology(%d)", int(s))
+	}
+	return symbologyNames[s]
+}
+
+// Identifier returns the symbology identifier transmitted by scanners reading the symbology, e.g. ]d2.
+func (s Symbology) Identifier() gs1.SymbologyIdentifier {
+	if s < GS1128 || s > DigitalLinkQR {
+		return gs1.SymbologyIdentifier{}
+	}
+	return symbologyIdentifiers[s]
+}
+
+// Fit is the encoded size of a message in a symbology as reported by [FitsIn].
+type Fit struct {
+	Symbology Symbology
+	// Characters is the amount of data characters or codewords of the message: data characters including AIs and
+	// FNC1 separators for GS1-128, data characters for GS1 DataBar Expanded and data codewords for Data Matrix, QR
+	// Code and DotCode.
+	Characters int
+	// Capacity is the maximum amount of Characters of the symbol. It is zero for GS1 DataBar Omnidirectional,
+	// Stacked and Limited, which carry a GTIN only.
+	Capacity int
+	// Segments is the amount of segments of GS1 DataBar Expanded symbols, the data characters and the check
+	// character.
+	Segments int
+	// Rows and Columns are the size of Data Matrix, QR Code and DotCode symbols in modules or dots without quiet
+	// zones. Rows is also the amount of rows of stacked GS1 DataBar symbols.
+	Rows, Columns int
+	// Version is the version of QR Code symbols.
+	Version int
+}
+
+// dotCodeMaxSize is the maximum amount of rows and columns of a DotCode symbol considered by [FitsIn].
+const dotCodeMaxSize = 200
+
+// FitsIn reports whether and how the message fits into a symbol of the symbology. The message must satisfy the GS1
+// application standards of the symbology, see [gs1.Message.CheckSymbology]. Messages exceeding the capacity return
+// an error wrapping [ErrDataTooLong] together with the size of the encoded data. The optional size restricts the
+// symbol:
+//
+//   - GS1 DataBar Expanded Stacked: the amount of segments per row, an even number from 2 to 22, is required
+//   - Data Matrix: rows and columns, e.g. 16, 48; by default the smallest square symbol is chosen
+//   - QR Code: the version from 1 to 40 at error correction level M; by default the smallest version is chosen
+//   - DotCode: rows and columns of dots, whose sum must be odd; by default the smallest symbol of an aspect ratio
+//     of 3:2 is chosen
+//
+// Digital Link URIs are measured for the domain [gs1.CanonicalPrefix]. DotCode is not encoded by this package, its
+// codewords are estimated from Code Set C encoding digit pairs and Code Set B encoding all other characters.
+//
+// FitsIn is a function rather than a method of [gs1.Message], as package barcode imports package gs1 and a method
+// would create an import cycle.
+func FitsIn(msg gs1.Message, symbology Symbology, size ...int) (Fit, error) {
+	fit := Fit{Symbology: symbology}
+	sizes := 0
+	switch symbology {
+	case DataBarExpandedStacked, GS1QR, DigitalLinkQR:
+		sizes = 1
+	case DataMatrix, DigitalLinkDataMatrix, DotCode:
+		sizes = 2
+	}
+	switch {
+	case symbology < GS1128 || symbology > DigitalLinkQR:
+		return fit, fmt.Errorf("invalid symbology %d", symbology)
+	case len(size) != 0 && len(size) != sizes || symbology == DataBarExpandedStacked && len(size) == 0:
+		return fit, fmt.Errorf("invalid size %v of %s: must consist of %d values", size, symbology, sizes)
+	}
+	if err := msg.CheckSymbology(symbology.Identifier()); err != nil {
+		return fit, err
+	}
+
+	switch symbology {
+	case GS1128:
+		return fitGS1128(msg, fit)
+	case DataBarOmni:
+		fit.Rows = 1
+		_, err := EncodeDataBarOmni(msg)
+		return fit, err
+	case DataBarStacked:
+		fit.Rows = 2
+		_, err := EncodeDataBarStacked(msg)
+		return fit, err
+	case DataBarStackedOmni:
+		fit.Rows = 2
+		_, err := EncodeDataBarStackedOmni(msg)
+		return fit, err
+	case DataBarLimited:
+		fit.Rows = 1
+		_, err := dataBarLimitedValue(msg)
+		return fit, err
+	case DataBarExpanded:
+		return fitDataBarExpanded(msg, fit, 0)
+	case DataBarExpandedStacked:
+		if segments := size[0]; segments < 2 || segments > 22 || segments%2 != 0 {
+			return fit, fmt.Errorf("invalid amount of segments per row %d: must be even and within 2 and 22",
+				segments)
+		}
+		return fitDataBarExpanded(msg, fit, size[0])
+	case DotCode:
+		return fitDotCode(gs1Symbols(msg.AsBarcodeMessage()), fit, size)
+	}
+
+	symbols := gs1Symbols(msg.AsBarcodeMessage())
+	if symbology == DigitalLinkDataMatrix || symbology == DigitalLinkQR {
+		uri, err := msg.AsDigitalLink(gs1.CanonicalPrefix)
+		if err != nil {
+			return fit, err
+		}
+		symbols = make([]int, len(uri))
+		for i := range len(uri) {
+			symbols[i] = int(uri[i])
+		}
+	}
+	if symbology == DataMatrix || symbology == DigitalLinkDataMatrix {
+		return fitDataMatrix(symbols, fit, size)
+	}
+	return fitQR(symbols, fit, size)
+}
+
+// fitGS1128 measures the data characters of a GS1-128 symbol.
+func fitGS1128(msg gs1.Message, fit Fit) (Fit, error) {
+	fit.Characters, fit.Capacity, fit.Rows = len(msg.AsBarcodeMessage()), GS1128MaxDataLength, 1
+	_, err := GS1128Codewords(msg)
+	return fit, err
+}
+
+// fitDataBarExpanded measures the data characters of a GS1 DataBar Expanded symbol, stacked if segments is set.
+func fitDataBarExpanded(msg gs1.Message, fit Fit, segments int) (Fit, error) {
+	fit.Capacity, fit.Rows = dataBarExpandedMaxChars, 1
+	w, err := dataBarExpandedData(msg, false, segments)
+	if err != nil {
+		return fit, err
+	}
+	fit.Characters = w.len / 12
+	fit.Segments = fit.Characters + 1
+	if segments > 0 {
+		fit.Rows = (fit.Segments + segments - 1) / segments
+	}
+	return fit, nil
+}
+
+// fitDataMatrix measures the data codewords of the symbols, starting with -1 for FNC1 in first position, and
+// selects the symbol size.
+func fitDataMatrix(symbols []int, fit Fit, size []int) (Fit, error) {
+	o := &dataMatrixOptions{}
+	if len(size) == 2 {
+		o.rows, o.columns = size[0], size[1]
+	}
+	if err := o.validateSize(); err != nil {
+		return fit, err
+	}
+	codewords := encodeDataMatrixData(symbols)
+	fit.Characters = len(codewords.data)
+	selected, ok := selectDataMatrixSize(len(codewords.data), codewords.optionalUnlatch, o)
+	if !ok {
+		fit.Capacity = dataMatrixSizes[len(dataMatrixSizes)-1].dataCodewords
+		if o.rows != 0 {
+			required, _ := lookupDataMatrixSize(o.rows, o.columns)
+			fit.Capacity = required.dataCodewords
+			return fit, fmt.Errorf("%w: %d codewords do not fit into a %dx%d DataMatrix symbol", ErrDataTooLong,
+				fit.Characters, o.rows, o.columns)
+		}
+		return fit, fmt.Errorf("%w: %d codewords exceed the capacity of DataMatrix symbols", ErrDataTooLong,
+			fit.Characters)
+	}
+	fit.Capacity, fit.Rows, fit.Columns = selected.dataCodewords, selected.rows, selected.columns
+	return fit, nil
+}
+
+// fitQR measures the data codewords of the symbols, starting with -1 for FNC1 in first position mode, and selects
+// the version at error correction level M.
+func fitQR(symbols []int, fit Fit, size []int) (Fit, error) {
+	o := &qrOptions{level: QRLevelM}
+	if len(size) == 1 {
+		if o.version = size[0]; o.version < 1 || o.version > 40 {
+			return fit, fmt.Errorf("invalid QR Code version %d: must be within 1 and 40", o.version)
+		}
+	}
+	fnc1 := len(symbols) > 0 && symbols[0] == -1
+	if fnc1 {
+		symbols = symbols[1:]
+	}
+	version, _, ok := selectQRVersion(symbols, fnc1, o)
+	if !ok {
+		version = o.version
+		if version == 0 {
+			version = 40
+		}
+	}
+	_, bits := segmentQR(symbols, fnc1, version)
+	if fnc1 {
+		bits += 4
+	}
+	fit.Characters, fit.Capacity = (bits+7)/8, qrDataCodewords(version, o.level)
+	if !ok {
+		return fit, fmt.Errorf("%w: %d codewords exceed the %d data codewords of a QR Code symbol of version %d-%s",
+			ErrDataTooLong, fit.Characters, fit.Capacity, version, o.level)
+	}
+	fit.Rows, fit.Columns, fit.Version = qrSize(version), qrSize(version), version
+	return fit, nil
+}
+
+// fitDotCode estimates the data codewords of the symbols, starting with -1 for FNC1, and selects the symbol size.
+func fitDotCode(symbols []int, fit Fit, size []int) (Fit, error) {
+	fit.Characters = dotCodeCodewords(symbols)
+	if len(size) == 2 {
+		rows, columns := size[0], size[1]
+		if rows < 5 || columns < 5 || (rows+columns)%2 == 0 {
+			return fit, fmt.Errorf("invalid DotCode size %dx%d: must be at least 5x5 with an odd sum", rows, columns)
+		}
+		fit.Capacity = dotCodeCapacity(rows, columns)
+		if fit.Characters > fit.Capacity {
+			return fit, fmt.Errorf("%w: %d codewords do not fit into a %dx%d DotCode symbol", ErrDataTooLong,
+				fit.Characters, rows, columns)
+		}
+		fit.Rows, fit.Columns = rows, columns
+		return fit, nil
+	}
+	for rows := 5; rows <= dotCodeMaxSize; rows++ {
+		columns := int(math.Ceil(float64(rows) * 3 / 2))
+		if (rows+columns)%2 == 0 {
+			columns++
+		}
+		if capacity := dotCodeCapacity(rows, columns); capacity >= fit.Characters {
+			fit.Capacity, fit.Rows, fit.Columns = capacity, rows, columns
+			return fit, nil
+		}
+	}
+	return fit, fmt.Errorf("%w: %d codewords exceed the capacity of DotCode symbols of %d rows", ErrDataTooLong,
+		fit.Characters, dotCodeMaxSize)
+}
+
+// dotCodeCapacity returns the maximum amount of data codewords of a DotCode symbol. Half of the positions hold a dot,
+// two of them the mask, and every codeword takes 9 dots. n data codewords require 3 + n/2 error correction codewords.
+func dotCodeCapacity(rows, columns int) int {
+	codewords := (rows*columns/2 - 2) / 9
+	n := 0
+	for m := n + 1; m+3+m/2 <= codewords; m++ {
+		n = m
+	}
+	return n
+}
+
+// dotCodeCodewords returns the data codewords of the symbols in DotCode. The symbol starts in Code Set C encoding
+// digit pairs. Other characters are encoded in Code Set B, entered by a shift for a single character or by a latch,
+// which is left for runs of at least four digits. FNC1 is available in both code sets.
+func dotCodeCodewords(symbols []int) int {
+	pairAt := func(i int) bool {
+		return i+1 < len(symbols) && isDigit(symbols[i]) && isDigit(symbols[i+1])
+	}
+	n := 0
+	codeC := true
+	for i := 0; i < len(symbols); {
+		switch {
+		case symbols[i] == -1:
+			n++
+			i++
+		case codeC && pairAt(i):
+			n++
+			i += 2
+		case codeC:
+			if i+1 == len(symbols) || symbols[i+1] == -1 || pairAt(i+1) {
+				n += 2 // Shift B
+			} else {
+				n++ // Latch B
+				codeC = false
+				continue
+			}
+			i++
+		case pairAt(i) && pairAt(i+2):
+			n++ // Latch C
+			codeC = true
+		default:
+			n++
+			i++
+		}
+	}
+	return n
+}
@@ -0,0 +1,151 @@
+package barcode
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFitsIn(t *testing.T) {
+	const data = "(01)09526064055028(17)250521(10)ABC123(21)456DEF"
+	tests := []struct {
+		name      string
+		data      string
+		symbology Symbology
+		size      []int
+		want      Fit
+	}{
+		{
+			name: "GS1-128 SHOULD report data characters including FNC1 separators", data: data, symbology: GS1128,
+			want: Fit{Characters: 41, Capacity: 48, Rows: 1},
+		},
+		{
+			name: "GS1 DataBar Omnidirectional SHOULD carry a GTIN", data: "(01)09526064055028",
+			symbology: DataBarOmni, want: Fit{Rows: 1},
+		},
+		{
+			name: "GS1 DataBar Limited SHOULD carry a GTIN with indicator digit 0 or 1", data: "(01)15012345678907",
+			symbology: DataBarLimited, want: Fit{Rows: 1},
+		},
+		{
+			name: "GS1 DataBar Expanded SHOULD report data characters and segments", data: data,
+			symbology: DataBarExpanded, want: Fit{Characters: 14, Capacity: 21, Segments: 15, Rows: 1},
+		},
+		{
+			name: "GS1 DataBar Expanded Stacked SHOULD report the rows of the segments", data: data,
+			symbology: DataBarExpandedStacked, size: []int{4},
+			want: Fit{Characters: 14, Capacity: 21, Segments: 15, Rows: 4},
+		},
+		{
+			name: "GS1 DataMatrix SHOULD report the smallest square symbol", data: data, symbology: DataMatrix,
+			want: Fit{Characters: 26, Capacity: 30, Rows: 22, Columns: 22},
+		},
+		{
+			name: "GS1 DataMatrix SHOULD fit into the given size", data: data, symbology: DataMatrix,
+			size: []int{16, 48}, want: Fit{Characters: 26, Capacity: 49, Rows: 16, Columns: 48},
+		},
+		{
+			name: "GS1 QR Code SHOULD report the smallest version", data: data, symbology: GS1QR,
+			want: Fit{Characters: 26, Capacity: 28, Rows: 25, Columns: 25, Version: 2},
+		},
+		{
+			name: "GS1 DotCode SHOULD report the smallest symbol of aspect ratio 3:2", data: data, symbology: DotCode,
+			want: Fit{Characters: 30, Capacity: 31, Rows: 24, Columns: 37},
+		},
+		{
+			name: "Digital Link SHOULD be measured as URI in QR Code", data: data, symbology: DigitalLinkQR,
+			want: Fit{Characters: 57, Capacity: 64, Rows: 33, Columns: 33, Version: 4},
+		},
+		{
+			name: "Digital Link SHOULD be measured as URI in Data Matrix", data: data, symbology: DigitalLinkDataMatrix,
+			want: Fit{Characters: 50, Capacity: 62, Rows: 32, Columns: 32},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FitsIn(mustParse(t, tt.data), tt.symbology, tt.size...)
+			if err != nil {
+				t.Fatalf("FitsIn() error = %v", err)
+			}
+			tt.want.Symbology = tt.symbology
+			if got != tt.want {
+				t.Errorf("FitsIn() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFitsIn_Errors(t *testing.T) {
+	const data = "(01)09526064055028(17)250521(10)ABC123(21)456DEF"
+	tests := []struct {
+		name      string
+		data      string
+		symbology Symbology
+		size      []int
+		wantErr   string
+		tooLong   bool
+	}{
+		{
+			name: "GS1-128 exceeding 48 data characters SHOULD not fit", symbology: GS1128,
+			data: "(01)09526064055028(10)ABC123456789012345(21)456DEF12345678901234", tooLong: true,
+		},
+		{
+			name: "GS1 DataBar Expanded exceeding 21 data characters SHOULD not fit", symbology: DataBarExpanded,
+			data: "(01)09526064055028(17)250521(10)ABC123456789012345(21)456DEF12345678901234", tooLong: true,
+		},
+		{name: "DataMatrix of a small size SHOULD not fit", data: data, symbology: DataMatrix, size: []int{10, 10},
+			tooLong: true},
+		{name: "QR Code of a small version SHOULD not fit", data: data, symbology: GS1QR, size: []int{1},
+			tooLong: true},
+		{name: "DotCode of a small size SHOULD not fit", data: data, symbology: DotCode, size: []int{10, 13},
+			tooLong: true},
+		{name: "DotCode of an even sum of rows and columns SHOULD be rejected", data: data, symbology: DotCode,
+			size: []int{10, 14}, wantErr: "odd sum"},
+		{name: "unknown DataMatrix size SHOULD be rejected", data: data, symbology: DataMatrix, size: []int{11, 10},
+			wantErr: "invalid DataMatrix size"},
+		{name: "GS1 DataBar Expanded Stacked SHOULD require segments", data: data,
+			symbology: DataBarExpandedStacked, wantErr: "must consist of 1 values"},
+		{name: "data without identification key SHOULD be rejected", data: "(10)ABC123", symbology: GS1128,
+			wantErr: "requires a GS1 identification key"},
+		{name: "GS1 DataBar Omnidirectional SHOULD reject elements other than the GTIN", data: data,
+			symbology: DataBarOmni, wantErr: "AI (01) only"},
+		{name: "GS1 DataBar Limited SHOULD reject indicator digits above 1", data: "(01)24012345678905",
+			symbology: DataBarLimited, wantErr: "indicator digit 0 or 1"},
+		{name: "Digital Link SHOULD require a primary key", data: "(10)ABC123", symbology: DigitalLinkQR,
+			wantErr: "Digital Link primary key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FitsIn(mustParse(t, tt.data), tt.symbology, tt.size...)
+			switch {
+			case tt.tooLong && !errors.Is(err, ErrDataTooLong):
+				t.Errorf("FitsIn() error = %v, want %v", err, ErrDataTooLong)
+			case tt.tooLong && got.Characters > 0 && got.Characters <= got.Capacity:
+				t.Errorf("FitsIn() = %+v SHOULD exceed the capacity", got)
+			case !tt.tooLong && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("FitsIn() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDotCodeCodewords(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want int
+	}{
+		{name: "digit pairs SHOULD be encoded in code set C", data: "(01)09526064055028", want: 9},
+		{name: "single letter SHOULD be shifted to code set B", data: "(01)09526064055028(10)12A34", want: 14},
+		{name: "letters SHOULD latch to code set B", data: "(01)09526064055028(10)ABC", want: 14},
+		{name: "digit runs SHOULD latch back to code set C", data: "(01)09526064055028(10)ABC1234", want: 17},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := mustParse(t, tt.data)
+			if got := dotCodeCodewords(gs1Symbols(msg.AsBarcodeMessage())); got != tt.want {
+				t.Errorf("dotCodeCodewords() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
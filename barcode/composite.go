@@ -0,0 +1,327 @@
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+// CompositeComponent is the type of the two-dimensional component of a GS1 Composite symbol, see ISO/IEC 24723.
+type CompositeComponent int
+
+const (
+	// CCA is a variant of MicroPDF417 holding up to 56 digits.
+	CCA CompositeComponent = iota + 1
+	// CCB is a MicroPDF417 symbol holding up to 338 digits.
+	CCB
+	// CCC is a PDF417 symbol holding up to 2361 digits. It is available above GS1-128 only.
+	CCC
+)
+
+func (c CompositeComponent) String() string {
+	if c < CCA || c > CCC {
+		return fmt.Sprintf("CompositeComponent(%d)", int(c))
+	}
+	return "CC-" + string("ABC"[c-CCA])
+}
+
+// LinearComponent is the symbology of the linear component of a GS1 Composite symbol.
+type LinearComponent int
+
+const (
+	LinearEAN13 LinearComponent = iota + 1
+	LinearUPCA
+	LinearEAN8
+	LinearUPCE
+	LinearGS1128
+	LinearDataBarOmni
+	LinearDataBarStacked
+	LinearDataBarExpanded
+	LinearDataBarExpandedStacked
+)
+
+// compositeColumns are the amount of data columns of CC-A and CC-B above each linear component.
+var compositeColumns = [...]int{
+	LinearEAN13:                  4,
+	LinearUPCA:                   4,
+	LinearEAN8:                   3,
+	LinearUPCE:                   2,
+	LinearGS1128:                 4,
+	LinearDataBarOmni:            4,
+	LinearDataBarStacked:         2,
+	LinearDataBarExpanded:        4,
+	LinearDataBarExpandedStacked: 4,
+}
+
+// Capacities in bits of the sizes of CC-A and CC-B by the amount of data columns, see ISO/IEC 24723, section 5.
+var (
+	ccaCapacities = map[int][]int{
+		2: {59, 78, 88, 108, 118, 138, 167},
+		3: {78, 98, 118, 138, 167},
+		4: {78, 108, 138, 167, 197},
+	}
+	ccbCapacities = map[int][]int{
+		2: {56, 104, 160, 208, 256, 296, 336},
+		3: {32, 72, 112, 152, 208, 304, 416, 536, 648, 768},
+		4: {56, 96, 152, 208, 264, 352, 496, 672, 840, 1016, 1184},
+	}
+)
+
+// Composite is the data of a GS1 Composite symbol.
+type Composite struct {
+	// Linear is the message of the linear component, Component the message of the composite component.
+	Linear, Component gs1.Message
+	// Type is the smallest type of composite component holding the data.
+	Type CompositeComponent
+	// Columns is the amount of data columns of CC-A and CC-B, determined by the linear component. It is zero for
+	// CC-C, whose columns depend on the width of the GS1-128 symbol.
+	Columns int
+	// Bits is the binary data of the composite component padded to the capacity of the smallest size, a string of 0
+	// and 1.
+	Bits string
+	// LinearBits is the binary data of a GS1 DataBar Expanded or Expanded Stacked linear component with the linkage
+	// flag set, see [DataBarExpandedBits]. It is empty for other linear components.
+	LinearBits string
+}
+
+// SplitComposite splits the message into the element carried by the linear component and the elements carried by
+// the composite component. EAN/UPC and GS1 DataBar linear components other than Expanded carry AI (01), GS1-128 and
+// GS1 DataBar Expanded carry the first element of the message.
+func SplitComposite(msg gs1.Message, linear LinearComponent) (linearMsg, component gs1.Message, _ error) {
+	if linear < LinearEAN13 || linear > LinearDataBarExpandedStacked {
+		return linearMsg, component, fmt.Errorf("invalid linear component %d", linear)
+	}
+	if len(msg.Elements) == 0 {
+		return linearMsg, component, errNoElements
+	}
+	i := 0
+	if linear != LinearGS1128 && linear != LinearDataBarExpanded && linear != LinearDataBarExpandedStacked {
+		i = -1
+		for j, el := range msg.Elements {
+			if el.AI == "01" {
+				i = j
+				break
+			}
+		}
+		if i < 0 {
+			return linearMsg, component, errors.New("message has no AI (01) for the linear component")
+		}
+	}
+
+	linearMsg.Elements = msg.Elements[i : i+1]
+	component.Elements = append(append(component.Elements, msg.Elements[:i]...), msg.Elements[i+1:]...)
+	if len(component.Elements) == 0 {
+		return linearMsg, component, errors.New("message has no elements for the composite component")
+	}
+	return linearMsg, component, nil
+}
+
+// EncodeComposite splits the message using [SplitComposite] and encodes the data of the composite component in the
+// smallest of CC-A, CC-B and, above GS1-128, CC-C. The composite component is encoded with the compaction method for
+// a leading date (11) or (17) and lot (10) where possible. The method for AI (90) is not applied.
+func EncodeComposite(msg gs1.Message, linear LinearComponent) (*Composite, error) {
+	linearMsg, component, err := SplitComposite(msg, linear)
+	if err != nil {
+		return nil, err
+	}
+	c := &Composite{Linear: linearMsg, Component: component}
+	switch linear {
+	case LinearEAN13:
+		_, err = eanUPCGTIN(linearMsg, "EAN-13", 13)
+	case LinearUPCA:
+		_, err = eanUPCGTIN(linearMsg, "UPC-A", 12)
+	case LinearEAN8:
+		_, err = eanUPCGTIN(linearMsg, "EAN-8", 8)
+	case LinearUPCE:
+		var gtin string
+		if gtin, err = eanUPCGTIN(linearMsg, "UPC-E", 12); err == nil {
+			_, err = gs1.UPCAToUPCE(gtin)
+		}
+	case LinearDataBarOmni, LinearDataBarStacked:
+		_, err = dataBarOmniValue(linearMsg)
+	case LinearDataBarExpanded, LinearDataBarExpandedStacked:
+		var w *bitWriter
+		if w, err = dataBarExpandedData(linearMsg, true, 0); err == nil {
+			c.LinearBits = w.String()
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	type candidate struct {
+		typ     CompositeComponent
+		columns int
+		size    func(n int) int
+	}
+	columns := compositeColumns[linear]
+	candidates := []candidate{
+		{CCA, columns, compositeSize(ccaCapacities[columns])},
+		{CCB, columns, compositeSize(ccbCapacities[columns])},
+	}
+	if linear == LinearGS1128 {
+		candidates = append(candidates, candidate{CCC, 0, cccSize})
+	}
+	var bits int
+	for _, s := range candidates {
+		w, end, err := compositeData(component)
+		if err != nil {
+			return nil, err
+		}
+		bits = w.len
+		if size := gpFit(w, end, s.size); size >= 0 {
+			gpPad(w, end.mode, size)
+			c.Type, c.Columns, c.Bits = s.typ, s.columns, w.String()
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %d bits exceed the capacity of the composite component", ErrDataTooLong, bits)
+}
+
+// compositeSize returns a function returning the smallest of the capacities holding n bits or -1.
+func compositeSize(capacities []int) func(n int) int {
+	return func(n int) int {
+		for _, capacity := range capacities {
+			if n <= capacity {
+				return capacity
+			}
+		}
+		return -1
+	}
+}
+
+// cccSize returns the size in bits of a CC-C holding n bits or -1. CC-C encodes whole bytes, six bytes in five
+// codewords, and at most 928 codewords including three codewords of overhead and the error correction codewords of
+// the recommended level.
+func cccSize(n int) int {
+	bytes := (n + 7) / 8
+	codewords := bytes/6*5 + bytes%6 + 3
+	ecc := 64
+	switch {
+	case codewords <= 40:
+		ecc = 8
+	case codewords <= 160:
+		ecc = 16
+	case codewords <= 320:
+		ecc = 32
+	}
+	if codewords+ecc > 928 {
+		return -1
+	}
+	return bytes * 8
+}
+
+// compositeData returns the binary data of a composite component before padding, see ISO/IEC 24723, section 5.3.
+func compositeData(msg gs1.Message) (*bitWriter, gpEnd, error) {
+	w := &bitWriter{}
+	elements := msg.Elements
+	var prefix string
+	switch first := elements[0]; {
+	case first.AI == "10":
+		// Method 10 without date, the lot follows the encodation method.
+		w.write(0b1011, 4)
+	case (first.AI == "11" || first.AI == "17") && dataBarDate(first.DataField) != dataBarNoDate:
+		w.write(0b10, 2)
+		w.write(dataBarDate(first.DataField), 16)
+		if first.AI == "17" {
+			w.write(1, 1)
+		} else {
+			w.write(0, 1)
+		}
+		elements = elements[1:]
+		if len(elements) > 0 && elements[0].AI != "10" {
+			// FNC1 indicates that no lot follows the date.
+			prefix = "\x1d"
+		}
+	default:
+		w.write(0, 1)
+	}
+	if len(elements) > 0 && elements[0].AI == "10" && w.len > 1 {
+		prefix = elements[0].DataField
+		if len(elements) > 1 {
+			prefix += "\x1d"
+		}
+		elements = elements[1:]
+	}
+
+	data := prefix + gs1.Message{Elements: elements}.AsBarcodeMessage()
+	end, err := encodeGeneralPurpose(w, gs1Symbols(data)[1:])
+	return w, end, err
+}
+
+// DecodeCompositeBits decodes the binary data of a composite component, a string of 0 and 1 as in [Composite.Bits],
+// into a message with symbology identifier ]e0. The encodation method for AI (90) is not supported.
+func DecodeCompositeBits(bits string) (gs1.Message, error) {
+	r, err := parseBits(bits)
+	if err != nil {
+		return gs1.Message{}, err
+	}
+	data, err := decodeComposite(r)
+	if err != nil {
+		return gs1.Message{}, err
+	}
+	return gs1.ParseBarcodeMessage("]e0" + strings.TrimSuffix(data, "\x1d"))
+}
+
+// DecodeDataBarExpandedComposite decodes the binary data of a GS1 DataBar Expanded linear component and of its
+// composite component into one message with symbology identifier ]e0. The linkage flag of the linear component
+// must be set if and only if the composite component is present, i.e. compositeBits is not empty.
+func DecodeDataBarExpandedComposite(linearBits, compositeBits string) (gs1.Message, error) {
+	linked := strings.HasPrefix(linearBits, "1")
+	switch {
+	case linked && compositeBits == "":
+		return gs1.Message{}, errors.New("linkage flag of the linear component is set but the composite component is missing")
+	case !linked && compositeBits != "":
+		return gs1.Message{}, errors.New("linkage flag of the linear component is not set for the composite component")
+	}
+	msg, err := DecodeDataBarExpandedBits(linearBits)
+	if err != nil || !linked {
+		return msg, err
+	}
+	component, err := DecodeCompositeBits(compositeBits)
+	if err != nil {
+		return gs1.Message{}, err
+	}
+	msg.Elements = append(msg.Elements, component.Elements...)
+	return msg, nil
+}
+
+// decodeComposite returns the barcode message of the binary data of a composite component.
+func decodeComposite(r *bitReader) (string, error) {
+	errShort := errors.New("binary data too short for its encodation method")
+	switch {
+	case r.remaining() < 1:
+		return "", errShort
+	case r.peek(1) == 0:
+		r.read(1)
+		return decodeGeneralPurpose(r), nil
+	case r.remaining() < 4:
+		return "", errShort
+	case r.peek(2) == 0b11:
+		return "", errors.New("encodation method 11 for AI (90) is not supported")
+	}
+
+	r.read(2)
+	var sb strings.Builder
+	dated := r.peek(2) != 0b11
+	if dated {
+		if r.remaining() < 17 {
+			return "", errShort
+		}
+		date := r.read(16)
+		ai := [2]string{"11", "17"}[r.read(1)]
+		fmt.Fprintf(&sb, "%s%02d%02d%02d", ai, date/384, date%384/32+1, date%32)
+	} else {
+		r.read(2)
+	}
+	data := decodeGeneralPurpose(r)
+	switch {
+	case dated && strings.HasPrefix(data, "\x1d"):
+		sb.WriteString(data[1:])
+	case !dated || data != "":
+		sb.WriteString("10" + data)
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,231 @@
+package barcode
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEncodeComposite(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		linear      LinearComponent
+		wantType    CompositeComponent
+		wantColumns int
+		wantLinear  string
+		wantBits    string
+	}{
+		{
+			name:        "lot SHOULD be encoded with method 10 without date",
+			data:        "(01)04006381333931(10)ABC123",
+			linear:      LinearEAN13,
+			wantType:    CCA,
+			wantColumns: 4,
+			wantLinear:  "(01)04006381333931",
+			wantBits:    "1011 0000 100000 100001 100010 00110 000 0100001",
+		},
+		{
+			name:        "date SHOULD be encoded with method 10",
+			data:        "(01)00012345000065(17)261231(10)LOT",
+			linear:      LinearUPCE,
+			wantType:    CCA,
+			wantColumns: 2,
+			wantLinear:  "(01)00012345000065",
+			wantBits:    "10 0010100001111111 1 0000 101011 101110 110011",
+		},
+		{
+			name:        "other AIs after the date SHOULD follow FNC1",
+			data:        "(01)00000050123452(11)260101(21)123",
+			linear:      LinearEAN8,
+			wantType:    CCA,
+			wantColumns: 3,
+			wantLinear:  "(01)00000050123452",
+			wantBits:    "10 0010011100000001 0 1111000 0010100 0100001",
+		},
+		{
+			name:        "other AIs SHOULD be encoded with method 0",
+			data:        "(01)04412345678909(21)12",
+			linear:      LinearDataBarOmni,
+			wantType:    CCA,
+			wantColumns: 4,
+			wantLinear:  "(01)04412345678909",
+			wantBits:    "0 0011111 0010101",
+		},
+		{
+			name:        "AI (01) SHOULD be taken from any position",
+			data:        "(21)12(01)04412345678909",
+			linear:      LinearDataBarStacked,
+			wantType:    CCA,
+			wantColumns: 2,
+			wantLinear:  "(01)04412345678909",
+			wantBits:    "0 0011111 0010101",
+		},
+		{
+			name:        "first element SHOULD be carried by GS1-128",
+			data:        "(00)106141411234567897(21)12",
+			linear:      LinearGS1128,
+			wantType:    CCA,
+			wantColumns: 4,
+			wantLinear:  "(00)106141411234567897",
+			wantBits:    "0 0011111 0010101",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeComposite(mustParse(t, tt.data), tt.linear)
+			if err != nil {
+				t.Fatalf("EncodeComposite() error = %v", err)
+			}
+			if got.Type != tt.wantType || got.Columns != tt.wantColumns {
+				t.Errorf("EncodeComposite() = %s with %d columns, want %s with %d columns", got.Type, got.Columns,
+					tt.wantType, tt.wantColumns)
+			}
+			if got.Linear.AsElementString() != tt.wantLinear {
+				t.Errorf("EncodeComposite() linear = %s, want %s", got.Linear.AsElementString(), tt.wantLinear)
+			}
+			if want := strings.ReplaceAll(tt.wantBits, " ", ""); !strings.HasPrefix(got.Bits, want) {
+				t.Errorf("EncodeComposite() bits = %s, want prefix %s", got.Bits, want)
+			}
+			if size := compositeSize(ccaCapacities[tt.wantColumns])(len(got.Bits)); size != len(got.Bits) {
+				t.Errorf("EncodeComposite() has %d bits, want padding to %d", len(got.Bits), size)
+			}
+
+			component, err := DecodeCompositeBits(got.Bits)
+			if err != nil {
+				t.Fatalf("DecodeCompositeBits() error = %v", err)
+			}
+			if component.AsElementString() != got.Component.AsElementString() {
+				t.Errorf("DecodeCompositeBits() = %s, want %s", component.AsElementString(),
+					got.Component.AsElementString())
+			}
+			if component.Symbology.String() != "]e0" {
+				t.Errorf("DecodeCompositeBits() symbology = %s, want ]e0", component.Symbology)
+			}
+		})
+	}
+}
+
+func TestEncodeComposite_Sizes(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		linear   LinearComponent
+		wantType CompositeComponent
+		wantBits int
+	}{
+		{name: "short data SHOULD fit in the smallest CC-A", data: "(01)04006381333931(10)1", linear: LinearEAN13,
+			wantType: CCA, wantBits: 78},
+		{name: "data exceeding CC-A SHOULD use CC-B", data: "(01)04006381333931(10)" + strings.Repeat("a", 30),
+			linear: LinearEAN13, wantType: CCB, wantBits: 264},
+		{name: "data exceeding CC-B SHOULD use CC-C above GS1-128",
+			data: "(01)04006381333931(10)" + strings.Repeat("a", 20) + "(240)" + strings.Repeat("a", 30) +
+				"(241)" + strings.Repeat("a", 30) + "(250)" + strings.Repeat("a", 30) + "(251)" + strings.Repeat("a", 30) +
+				"(90)" + strings.Repeat("a", 30),
+			linear: LinearGS1128, wantType: CCC, wantBits: 1328},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeComposite(mustParse(t, tt.data), tt.linear)
+			if err != nil {
+				t.Fatalf("EncodeComposite() error = %v", err)
+			}
+			if got.Type != tt.wantType || len(got.Bits) != tt.wantBits {
+				t.Errorf("EncodeComposite() = %s of %d bits, want %s of %d bits", got.Type, len(got.Bits), tt.wantType,
+					tt.wantBits)
+			}
+			component, err := DecodeCompositeBits(got.Bits)
+			if err != nil || component.AsElementString() != got.Component.AsElementString() {
+				t.Errorf("DecodeCompositeBits() = %s, %v, want %s", component.AsElementString(), err,
+					got.Component.AsElementString())
+			}
+		})
+	}
+}
+
+func TestEncodeComposite_Errors(t *testing.T) {
+	long := "(10)" + strings.Repeat("a", 20) + "(240)" + strings.Repeat("a", 30) + "(241)" + strings.Repeat("a", 30) +
+		"(250)" + strings.Repeat("a", 30) + "(251)" + strings.Repeat("a", 30) + "(90)" + strings.Repeat("a", 30)
+	tests := []struct {
+		name    string
+		data    string
+		linear  LinearComponent
+		wantErr string
+	}{
+		{name: "missing AI (01) SHOULD be rejected", data: "(00)106141411234567897(10)A", linear: LinearEAN13,
+			wantErr: "no AI (01)"},
+		{name: "missing composite data SHOULD be rejected", data: "(01)04006381333931", linear: LinearEAN13,
+			wantErr: "no elements for the composite component"},
+		{name: "GTIN not fitting the linear component SHOULD be rejected", data: "(01)04006381333931(10)A",
+			linear: LinearUPCA, wantErr: "UPC-A encodes GTIN-12 only"},
+		{name: "invalid linear component SHOULD be rejected", data: "(01)04006381333931(10)A", linear: 0,
+			wantErr: "invalid linear component"},
+		{name: "data exceeding CC-B SHOULD be rejected above DataBar", data: "(01)04412345678909" + long,
+			linear: LinearDataBarOmni, wantErr: ErrDataTooLong.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := EncodeComposite(mustParse(t, tt.data), tt.linear)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("EncodeComposite() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecodeCompositeBits_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		bits    string
+		wantErr string
+	}{
+		{name: "empty data SHOULD be rejected", bits: "", wantErr: "too short"},
+		{name: "truncated date SHOULD be rejected", bits: "10 00101", wantErr: "too short"},
+		{name: "method 11 SHOULD be rejected", bits: "11 0 00000", wantErr: "not supported"},
+		{name: "other characters SHOULD be rejected", bits: "0x", wantErr: "invalid character"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeCompositeBits(strings.ReplaceAll(tt.bits, " ", ""))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("DecodeCompositeBits() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecodeDataBarExpandedComposite(t *testing.T) {
+	for name, linear := range map[string]LinearComponent{
+		"GS1 DataBar Expanded":         LinearDataBarExpanded,
+		"GS1 DataBar Expanded Stacked": LinearDataBarExpandedStacked,
+	} {
+		t.Run(name, func(t *testing.T) {
+			c, err := EncodeComposite(mustParse(t, "(01)90012345678908(3103)001750(10)ABC(11)260101"), linear)
+			if err != nil {
+				t.Fatalf("EncodeComposite() error = %v", err)
+			}
+			if c.LinearBits == "" || c.LinearBits[0] != '1' {
+				t.Fatalf("EncodeComposite() linear bits = %q, want linkage flag set", c.LinearBits)
+			}
+			got, err := DecodeDataBarExpandedComposite(c.LinearBits, c.Bits)
+			if err != nil {
+				t.Fatalf("DecodeDataBarExpandedComposite() error = %v", err)
+			}
+			if want := "(01)90012345678908(3103)001750(10)ABC(11)260101"; got.AsElementString() != want {
+				t.Errorf("DecodeDataBarExpandedComposite() = %s, want %s", got.AsElementString(), want)
+			}
+
+			unlinked, _ := DataBarExpandedBits(c.Linear)
+			if _, err := DecodeDataBarExpandedComposite(unlinked, c.Bits); err == nil {
+				t.Errorf("DecodeDataBarExpandedComposite() SHOULD reject a composite component without linkage flag")
+			}
+			if _, err := DecodeDataBarExpandedComposite(c.LinearBits, ""); err == nil {
+				t.Errorf("DecodeDataBarExpandedComposite() SHOULD reject a missing composite component")
+			}
+			got, err = DecodeDataBarExpandedComposite(unlinked, "")
+			if err != nil || got.AsElementString() != "(01)90012345678908" {
+				t.Errorf("DecodeDataBarExpandedComposite() = %s, %v, want the linear component only",
+					got.AsElementString(), err)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package barcode
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+// GS1 DataBar symbols are made of data characters of four bars and four spaces, which carry their value in the
+// combination of element widths, and finder patterns between them. Rows start with a light guard element and
+// require no quiet zone, see ISO/IEC 24724.
+const (
+	// DataBarOmniHeight is the minimum height of a GS1 DataBar Omnidirectional symbol in modules.
+	DataBarOmniHeight = 33
+	// DataBarExpandedHeight is the minimum height of a GS1 DataBar Expanded symbol and of each row of a GS1 DataBar
+	// Expanded Stacked symbol in modules.
+	DataBarExpandedHeight = 34
+)
+
+// Row heights of the stacked symbols in modules.
+const (
+	dataBarStackedTopHeight    = 5
+	dataBarStackedBottomHeight = 7
+	dataBarSeparatorHeight     = 3
+)
+
+// dataBarSet describes the odd or the even elements of a data character: the sum of their widths, the widest
+// element and whether at least one element must be narrow.
+type dataBarSet struct {
+	modules, widest int
+	narrow          bool
+}
+
+// dataBarGroup describes a range of data character values starting at start. The value within the group is split
+// into the values of the odd and the even elements. If evenValues is set, the odd value is the quotient and the even
+// value the remainder of a division by evenValues, otherwise it is the other way round using oddValues.
+type dataBarGroup struct {
+	start                 int
+	odd, even             dataBarSet
+	evenValues, oddValues int
+}
+
+// dataBarOutsideGroups and dataBarInsideGroups are the groups of the outer and inner data characters of GS1 DataBar
+// Omnidirectional.
+var (
+	dataBarOutsideGroups = []dataBarGroup{
+		{start: 0, odd: dataBarSet{12, 8, false}, even: dataBarSet{4, 1, true}, evenValues: 1},
+		{start: 161, odd: dataBarSet{10, 6, false}, even: dataBarSet{6, 3, true}, evenValues: 10},
+		{start: 961, odd: dataBarSet{8, 4, false}, even: dataBarSet{8, 5, true}, evenValues: 34},
+		{start: 2015, odd: dataBarSet{6, 3, false}, even: dataBarSet{10, 6, true}, evenValues: 70},
+		{start: 2715, odd: dataBarSet{4, 1, false}, even: dataBarSet{12, 8, true}, evenValues: 126},
+	}
+	dataBarInsideGroups = []dataBarGroup{
+		{start: 0, odd: dataBarSet{5, 2, true}, even: dataBarSet{10, 7, false}, oddValues: 4},
+		{start: 336, odd: dataBarSet{7, 4, true}, even: dataBarSet{8, 5, false}, oddValues: 20},
+		{start: 1036, odd: dataBarSet{9, 6, true}, even: dataBarSet{6, 3, false}, oddValues: 48},
+		{start: 1516, odd: dataBarSet{11, 8, true}, even: dataBarSet{4, 1, false}, oddValues: 81},
+	}
+)
+
+// dataBarOmniFinders are the element widths of the finder patterns of GS1 DataBar Omnidirectional by value.
+var dataBarOmniFinders = [9][5]int{
+	{3, 8, 2, 1, 1}, {3, 5, 5, 1, 1}, {3, 3, 7, 1, 1}, {3, 1, 9, 1, 1}, {2, 7, 4, 1, 1},
+	{2, 5, 6, 1, 1}, {2, 3, 8, 1, 1}, {1, 5, 7, 1, 1}, {1, 3, 9, 1, 1},
+}
+
+// dataBarOmniWeights are the checksum weights of the elements of the four data characters of GS1 DataBar
+// Omnidirectional, the powers of 3 modulo 79.
+var dataBarOmniWeights = [4][8]int{
+	{1, 3, 9, 27, 2, 6, 18, 54},
+	{4, 12, 36, 29, 8, 24, 72, 58},
+	{16, 48, 65, 37, 32, 17, 51, 74},
+	{64, 34, 23, 69, 49, 68, 46, 59},
+}
+
+// EncodeDataBarOmni encodes the message as GS1 DataBar Omnidirectional symbol of 96 modules. The message must consist
+// of AI (01) only. The symbol should be rendered at least DataBarOmniHeight modules high, lower heights result in
+// GS1 DataBar Truncated.
+func EncodeDataBarOmni(msg gs1.Message) (*Linear, error) {
+	value, err := dataBarOmniValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &Linear{
+		Modules: appendElements(nil, false, dataBarOmniElements(value)...),
+		HRI:     msg.HRI(),
+	}, nil
+}
+
+// EncodeDataBarStacked encodes the message as GS1 DataBar Stacked symbol of two rows for small items that are not
+// scanned omnidirectionally. The message must consist of AI (01) only.
+func EncodeDataBarStacked(msg gs1.Message) (*Matrix, error) {
+	value, err := dataBarOmniValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	top, bottom := dataBarStackedRows(dataBarOmniElements(value))
+	m := NewMatrix(len(top), dataBarStackedTopHeight+1+dataBarStackedBottomHeight, 0)
+	setRows(m, 0, dataBarStackedTopHeight, top)
+	setRows(m, dataBarStackedTopHeight+1, dataBarStackedBottomHeight, bottom)
+
+	// The separator is light where the rows are dark. Where they differ, it alternates starting with a dark module.
+	y := dataBarStackedTopHeight
+	for x := 4; x < len(top)-4; x++ {
+		if top[x] == bottom[x] {
+			m.Set(x, y, !top[x])
+		} else {
+			m.Set(x, y, !m.At(x-1, y))
+		}
+	}
+	return m, nil
+}
+
+// EncodeDataBarStackedOmni encodes the message as GS1 DataBar Stacked Omnidirectional symbol of two rows of full
+// height. The message must consist of AI (01) only.
+func EncodeDataBarStackedOmni(msg gs1.Message) (*Matrix, error) {
+	value, err := dataBarOmniValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	top, bottom := dataBarStackedRows(dataBarOmniElements(value))
+	m := NewMatrix(len(top), 2*DataBarOmniHeight+dataBarSeparatorHeight, 0)
+	setRows(m, 0, DataBarOmniHeight, top)
+	setRows(m, DataBarOmniHeight+dataBarSeparatorHeight, DataBarOmniHeight, bottom)
+
+	y := DataBarOmniHeight
+	setRow(m, y, dataBarSeparator(top, 4, len(top)-4, [][2]int{{17, 33}}, false))
+	for x := 5; x < len(top)-4; x += 2 {
+		m.Set(x, y+1, true)
+	}
+	setRow(m, y+2, dataBarSeparator(bottom, 4, len(bottom)-4, [][2]int{{16, 32}}, false))
+	return m, nil
+}
+
+// dataBarOmniValue returns the value of the GTIN without check digit.
+func dataBarOmniValue(msg gs1.Message) (int, error) {
+	if len(msg.Elements) == 0 {
+		return 0, errNoElements
+	}
+	if len(msg.Elements) > 1 || msg.Elements[0].AI != "01" {
+		return 0, fmt.Errorf("GS1 DataBar Omnidirectional encodes AI (01) only, got %s", msg.AsElementString())
+	}
+	gtin, err := dataBarGTIN(msg.Elements[0].DataField)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(gtin[:13])
+}
+
+// dataBarGTIN validates the GTIN-14 including its check digit, which is not encoded by GS1 DataBar.
+func dataBarGTIN(gtin string) (string, error) {
+	if len(gtin) != 14 {
+		return "", fmt.Errorf("invalid GTIN %q: must have 14 digits", gtin)
+	}
+	check, err := gs1.CheckDigit(gtin[:13])
+	if err != nil {
+		return "", fmt.Errorf("invalid GTIN %q: %w", gtin, err)
+	}
+	if check != gtin[13] {
+		return "", fmt.Errorf("invalid GTIN %q: check digit must be %c", gtin, check)
+	}
+	return gtin, nil
+}
+
+// dataBarOmniElements returns the 46 element widths of a GS1 DataBar Omnidirectional symbol starting with a light
+// element.
+func dataBarOmniElements(value int) []int {
+	left, right := value/4537077, value%4537077
+	chars := [4][8]int{
+		dataBarCharacter(left/1597, dataBarOutsideGroups),
+		dataBarCharacter(left%1597, dataBarInsideGroups),
+		dataBarCharacter(right/1597, dataBarOutsideGroups),
+		dataBarCharacter(right%1597, dataBarInsideGroups),
+	}
+
+	checksum := 0
+	for c, char := range chars {
+		for i, width := range char {
+			checksum += dataBarOmniWeights[c][i] * width
+		}
+	}
+	checksum %= 79
+	if checksum >= 8 {
+		checksum++
+	}
+	if checksum >= 72 {
+		checksum++
+	}
+	leftFinder, rightFinder := dataBarOmniFinders[checksum/9], dataBarOmniFinders[checksum%9]
+
+	// Elements of outer characters are numbered from the edge, those of inner characters from the centre of the symbol.
+	elements := make([]int, 46)
+	elements[0], elements[1], elements[44], elements[45] = 1, 1, 1, 1
+	for i := range 8 {
+		elements[2+i] = chars[0][i]
+		elements[15+i] = chars[1][7-i]
+		elements[23+i] = chars[3][i]
+		elements[36+i] = chars[2][7-i]
+	}
+	for i := range 5 {
+		elements[10+i] = leftFinder[i]
+		elements[31+i] = rightFinder[4-i]
+	}
+	return elements
+}
+
+// dataBarStackedRows splits GS1 DataBar Omnidirectional elements into two rows of 50 modules. The top row is closed
+// by a dark and a light module, the bottom row is opened by them.
+func dataBarStackedRows(elements []int) (top, bottom []bool) {
+	top = appendElements(nil, false, elements[:23]...)
+	top = append(top, true, false)
+	bottom = appendElements([]bool{true, false}, true, elements[23:]...)
+	return top, bottom
+}
+
+// dataBarSeparator returns the separator row adjacent to a row of a stacked symbol. Between from and to, it is the
+// complement of the row. Within the finder areas, light modules of the row are separated by alternating dark and
+// light modules, starting with a dark one in reading direction, which is from right to left if reverse is set.
+func dataBarSeparator(row []bool, from, to int, finders [][2]int, reverse bool) []bool {
+	sep := make([]bool, len(row))
+	for x := from; x < to; x++ {
+		sep[x] = !row[x]
+	}
+	for _, finder := range finders {
+		dark := true
+		for i := range finder[1] - finder[0] {
+			x := finder[0] + i
+			if reverse {
+				x = finder[1] - 1 - i
+			}
+			if row[x] {
+				sep[x], dark = false, true
+				continue
+			}
+			sep[x], dark = dark, !dark
+		}
+	}
+	return sep
+}
+
+// dataBarCharacter returns the widths of the four odd and the four even elements of a data character in the order
+// odd, even, odd, and so on.
+func dataBarCharacter(value int, groups []dataBarGroup) [8]int {
+	return [8]int(dataBarCharacterWidths(value, groups, 4))
+}
+
+// dataBarCharacterWidths returns the widths of the given amount of odd and even elements of a data character in the
+// order odd, even, odd, and so on.
+func dataBarCharacterWidths(value int, groups []dataBarGroup, elements int) []int {
+	g := len(groups) - 1
+	for value < groups[g].start {
+		g--
+	}
+	group := groups[g]
+	value -= group.start
+
+	var odd, even int
+	if group.evenValues > 0 {
+		odd, even = value/group.evenValues, value%group.evenValues
+	} else {
+		odd, even = value%group.oddValues, value/group.oddValues
+	}
+	oddWidths := dataBarWidths(odd, group.odd.modules, elements, group.odd.widest, group.odd.narrow)
+	evenWidths := dataBarWidths(even, group.even.modules, elements, group.even.widest, group.even.narrow)
+
+	widths := make([]int, 2*elements)
+	for i := range elements {
+		widths[2*i] = oddWidths[i]
+		widths[2*i+1] = evenWidths[i]
+	}
+	return widths
+}
+
+// dataBarWidths returns the widths of the given amount of elements which sum up to modules and whose combination
+// has the given value, see ISO/IEC 24724, annex B. No element is wider than widest and, if narrow is set, at least
+// one element is one module wide.
+func dataBarWidths(value, modules, elements, widest int, narrow bool) []int {
+	widths := make([]int, elements)
+	n := modules
+	narrowMask := 0
+	for bar := range elements - 1 {
+		width := 1
+		narrowMask |= 1 << bar
+		var sub int
+		for {
+			sub = dataBarCombinations(n, width, elements, bar, widest, narrow && narrowMask == 0)
+			value -= sub
+			if value < 0 {
+				break
+			}
+			width++
+			narrowMask &^= 1 << bar
+		}
+		value += sub
+		n -= width
+		widths[bar] = width
+	}
+	widths[elements-1] = n
+	return widths
+}
+
+// dataBarCombinations returns the amount of combinations of the remaining elements if the element at index bar of n
+// remaining modules is width modules wide. If noNarrow is set, the combinations without a narrow element are
+// excluded.
+func dataBarCombinations(n, width, elements, bar, widest int, noNarrow bool) int {
+	sub := combinations(n-width-1, elements-bar-2)
+	if noNarrow && n-width-(elements-bar-1) >= elements-bar-1 {
+		sub -= combinations(n-width-(elements-bar), elements-bar-2)
+	}
+	if elements-bar-1 > 1 {
+		less := 0
+		for w := n - width - (elements - bar - 2); w > widest; w-- {
+			less += combinations(n-width-w-1, elements-bar-3)
+		}
+		sub -= less * (elements - 1 - bar)
+	} else if n-width > widest {
+		sub--
+	}
+	return sub
+}
+
+// combinations returns the binomial coefficient n choose r.
+func combinations(n, r int) int {
+	if r < 0 || r > n {
+		return 0
+	}
+	c := 1
+	for i := 1; i <= r; i++ {
+		c = c * (n - r + i) / i
+	}
+	return c
+}
+
+// setRows sets height rows of the matrix starting at row y to the modules.
+func setRows(m *Matrix, y, height int, modules []bool) {
+	for i := range height {
+		setRow(m, y+i, modules)
+	}
+}
+
+func setRow(m *Matrix, y int, modules []bool) {
+	for x, dark := range modules {
+		m.Set(x, y, dark)
+	}
+}
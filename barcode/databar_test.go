@@ -0,0 +1,256 @@
+package barcode
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// dataBarValue is the inverse of dataBarWidths, see ISO/IEC 24724, annex B.
+func dataBarValue(widths []int, widest int, narrow bool) int {
+	n := 0
+	for _, width := range widths {
+		n += width
+	}
+	value, narrowMask, elements := 0, 0, len(widths)
+	for bar := range elements - 1 {
+		width := 1
+		narrowMask |= 1 << bar
+		for ; width < widths[bar]; width++ {
+			value += dataBarCombinations(n, width, elements, bar, widest, narrow && narrowMask == 0)
+			narrowMask &^= 1 << bar
+		}
+		n -= width
+	}
+	return value
+}
+
+// elementWidths returns the widths of the runs of equal modules.
+func elementWidths(modules []bool) []int {
+	var widths []int
+	for i, dark := range modules {
+		if i == 0 || dark != modules[i-1] {
+			widths = append(widths, 0)
+		}
+		widths[len(widths)-1]++
+	}
+	return widths
+}
+
+func TestDataBarWidths(t *testing.T) {
+	tests := []struct {
+		name   string
+		groups []dataBarGroup
+		total  int
+	}{
+		{name: "outside characters of DataBar Omnidirectional", groups: dataBarOutsideGroups, total: 2841},
+		{name: "inside characters of DataBar Omnidirectional", groups: dataBarInsideGroups, total: 1597},
+		{name: "characters of DataBar Expanded", groups: dataBarExpandedGroups, total: 4192},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" SHOULD have distinct widths of each value", func(t *testing.T) {
+			for g, group := range tt.groups {
+				end := tt.total
+				if g+1 < len(tt.groups) {
+					end = tt.groups[g+1].start
+				}
+				seen := map[[8]int]bool{}
+				for value := group.start; value < end; value++ {
+					widths := dataBarCharacter(value, tt.groups)
+					if seen[widths] {
+						t.Fatalf("value %d has the widths %v of another value", value, widths)
+					}
+					seen[widths] = true
+
+					var odd, even []int
+					for i, width := range widths {
+						if i%2 == 0 {
+							odd = append(odd, width)
+						} else {
+							even = append(even, width)
+						}
+					}
+					for _, set := range []struct {
+						widths []int
+						set    dataBarSet
+					}{{odd, group.odd}, {even, group.even}} {
+						sum, widest, narrow := 0, 0, false
+						for _, width := range set.widths {
+							sum += width
+							widest = max(widest, width)
+							narrow = narrow || width == 1
+						}
+						if sum != set.set.modules || widest > set.set.widest || set.set.narrow && !narrow {
+							t.Fatalf("value %d has widths %v violating %+v", value, set.widths, set.set)
+						}
+					}
+
+					got := dataBarValue(odd, group.odd.widest, group.odd.narrow)
+					gotEven := dataBarValue(even, group.even.widest, group.even.narrow)
+					if group.evenValues > 0 {
+						got = got*group.evenValues + gotEven
+					} else {
+						got = gotEven*group.oddValues + got
+					}
+					if got+group.start != value {
+						t.Fatalf("widths %v of value %d decode to %d", widths, value, got+group.start)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeDataBarOmni(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []int
+	}{
+		{
+			name: "GTIN SHOULD be encoded in four characters with finder patterns of the checksum",
+			data: "(01)04412345678909",
+			want: []int{
+				1, 1, 2, 1, 3, 1, 4, 1, 3, 1, 3, 3, 7, 1, 1, 1, 1, 1, 5, 2, 2, 2, 1,
+				1, 1, 2, 2, 1, 2, 1, 5, 1, 1, 6, 5, 2, 4, 1, 1, 1, 3, 3, 2, 1, 1, 1,
+			},
+		},
+		{
+			name: "zero GTIN SHOULD be encoded",
+			data: "(01)00000000000000",
+			want: []int{
+				1, 1, 1, 1, 1, 1, 2, 1, 8, 1, 3, 8, 2, 1, 1, 7, 2, 1, 1, 1, 1, 1, 1,
+				1, 1, 1, 1, 1, 1, 2, 7, 1, 1, 9, 1, 3, 1, 8, 1, 2, 1, 1, 1, 1, 1, 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := mustParse(t, tt.data)
+			got, err := EncodeDataBarOmni(msg)
+			if err != nil {
+				t.Fatalf("EncodeDataBarOmni() error = %v", err)
+			}
+			if len(got.Modules) != 96 || got.Modules[0] {
+				t.Fatalf("EncodeDataBarOmni() has %d modules starting with dark %t, want 96 starting light",
+					len(got.Modules), got.Modules[0])
+			}
+			if widths := elementWidths(got.Modules); !reflect.DeepEqual(widths, tt.want) {
+				t.Errorf("EncodeDataBarOmni() widths = %v, want %v", widths, tt.want)
+			}
+			if !reflect.DeepEqual(got.HRI, msg.HRI()) {
+				t.Errorf("EncodeDataBarOmni() HRI = %v, want %v", got.HRI, msg.HRI())
+			}
+		})
+	}
+}
+
+func TestEncodeDataBarOmni_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "other AIs SHOULD be rejected", data: "(01)04412345678909(10)ABC", wantErr: "AI (01) only"},
+		{name: "other AIs than (01) SHOULD be rejected", data: "(10)ABC", wantErr: "AI (01) only"},
+		{name: "wrong check digit SHOULD be rejected", data: "(01)04412345678908", wantErr: "check digit must be 9"},
+		{name: "non-digits SHOULD be rejected", data: "(01)0441234567890X", wantErr: "invalid GTIN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := EncodeDataBarOmni(mustParse(t, tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("EncodeDataBarOmni() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEncodeDataBarStacked(t *testing.T) {
+	msg := mustParse(t, "(01)04412345678909")
+	linear, _ := EncodeDataBarOmni(msg)
+	got, err := EncodeDataBarStacked(msg)
+	if err != nil {
+		t.Fatalf("EncodeDataBarStacked() error = %v", err)
+	}
+	if got.Width() != 50 || got.Height() != 13 {
+		t.Fatalf("EncodeDataBarStacked() size = %dx%d, want 50x13", got.Width(), got.Height())
+	}
+
+	top, bottom := make([]bool, 50), make([]bool, 50)
+	for x := range 50 {
+		top[x], bottom[x] = got.At(x, 0), got.At(x, 12)
+		for y := range 5 {
+			if got.At(x, y) != top[x] {
+				t.Fatalf("module %d of top row %d differs", x, y)
+			}
+		}
+		for y := 6; y < 13; y++ {
+			if got.At(x, y) != bottom[x] {
+				t.Fatalf("module %d of bottom row %d differs", x, y)
+			}
+		}
+	}
+	if !reflect.DeepEqual(top[:48], linear.Modules[:48]) || !top[48] || top[49] {
+		t.Errorf("top row = %v, want left half followed by a dark and a light module", top)
+	}
+	if !bottom[0] || bottom[1] || !reflect.DeepEqual(bottom[2:], linear.Modules[48:]) {
+		t.Errorf("bottom row = %v, want a dark and a light module followed by the right half", bottom)
+	}
+
+	for x := range 50 {
+		sep := got.At(x, 5)
+		switch {
+		case x < 4 || x >= 46:
+			if sep {
+				t.Errorf("separator module %d SHOULD be light at the ends", x)
+			}
+		case top[x] == bottom[x]:
+			if sep == top[x] {
+				t.Errorf("separator module %d SHOULD complement equal rows", x)
+			}
+		case sep == got.At(x-1, 5):
+			t.Errorf("separator module %d SHOULD alternate where the rows differ", x)
+		}
+	}
+}
+
+func TestEncodeDataBarStackedOmni(t *testing.T) {
+	msg := mustParse(t, "(01)04412345678909")
+	got, err := EncodeDataBarStackedOmni(msg)
+	if err != nil {
+		t.Fatalf("EncodeDataBarStackedOmni() error = %v", err)
+	}
+	if got.Width() != 50 || got.Height() != 69 {
+		t.Fatalf("EncodeDataBarStackedOmni() size = %dx%d, want 50x69", got.Width(), got.Height())
+	}
+	rowString := func(y int) string {
+		return strings.Split(got.String(), "\n")[y]
+	}
+	tests := []struct {
+		name string
+		y    int
+		want string
+	}{
+		{name: "top row SHOULD hold the left half", y: 0, want: ".#..#...#....#...#...###.......#.#.#.....##..##.#."},
+		{
+			name: "top separator SHOULD complement the top row and alternate below the finder pattern",
+			y:    33,
+			want: ".....###.####.###.#.#...#.#.#.#.#.#.#####..##.....",
+		},
+		{name: "middle separator SHOULD alternate", y: 34, want: ".....#.#.#.#.#.#.#.#.#.#.#.#.#.#.#.#.#.#.#.#.#...."},
+		{
+			name: "bottom separator SHOULD complement the bottom row and alternate above the finder pattern",
+			y:    35,
+			want: "......##.##.#####.#......#.#.#..####.#.###...#....",
+		},
+		{name: "bottom row SHOULD hold the right half", y: 68, want: "#.#.##..#..#.....#.######.....##....#.#...###..#.#"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if row := rowString(tt.y); row != tt.want {
+				t.Errorf("row %d = %s, want %s", tt.y, row, tt.want)
+			}
+		})
+	}
+}
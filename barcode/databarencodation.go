@@ -0,0 +1,305 @@
+package barcode
+
+import (
+	"fmt"
+	"strings"
+)
+
+// gpMode is an encodation mode of the general purpose data field of GS1 DataBar Expanded and GS1 Composite
+// components, see ISO/IEC 24724, section 7.2.5.5. The field starts in numeric mode and FNC1 returns to it.
+type gpMode int
+
+const (
+	gpNumeric gpMode = iota
+	gpAlphanumeric
+	gpISO646
+	gpModes
+)
+
+// gpLatches are the value and length of the bit patterns latching between modes. Numeric mode reaches ISO/IEC 646
+// mode via alphanumeric mode.
+var gpLatches = [gpModes][gpModes][2]int{
+	gpNumeric:      {gpAlphanumeric: {0b0000, 4}},
+	gpAlphanumeric: {gpNumeric: {0b000, 3}, gpISO646: {0b00100, 5}},
+	gpISO646:       {gpNumeric: {0b000, 3}, gpAlphanumeric: {0b00100, 5}},
+}
+
+// gpISO646Specials are the punctuation characters of ISO/IEC 646 mode, encoded as 232 and following in 8 bits.
+const gpISO646Specials = `!"%&'()*+,-./:;<=>?_ `
+
+// gpEdgeKind describes how a state of the shortest encodation has been reached.
+type gpEdgeKind int
+
+const (
+	gpEdgeLatch gpEdgeKind = iota + 1
+	gpEdgePair
+	gpEdgeLastDigit
+	gpEdgeChar
+)
+
+// gpState is the least amount of bits encoding the first symbols and ending in a mode.
+type gpState struct {
+	cost    int
+	reached bool
+	// from and fromMode identify the previous state, kind the edge between them.
+	from     int
+	fromMode gpMode
+	kind     gpEdgeKind
+}
+
+// gpEnd describes the end of an encoded general purpose data field.
+type gpEnd struct {
+	mode gpMode
+	// lastDigit is the bit position of a single last digit, encoded in numeric mode together with FNC1, or -1. The
+	// digit may be encoded in four bits instead if less than seven bits remain in the symbol after it.
+	lastDigit int
+}
+
+// encodeGeneralPurpose appends the general purpose data field of the symbols using the least amount of bits. Symbols
+// are byte values or -1 for FNC1.
+func encodeGeneralPurpose(w *bitWriter, symbols []int) (gpEnd, error) {
+	for i, c := range symbols {
+		if _, bits := gpCharValue(gpISO646, c); bits == 0 {
+			return gpEnd{}, fmt.Errorf("character %q at position %d cannot be encoded in a general purpose data field",
+				rune(c), i)
+		}
+	}
+
+	n := len(symbols)
+	states := make([][gpModes]gpState, n+1)
+	states[0][gpNumeric] = gpState{reached: true}
+	relax := func(to int, mode gpMode, cost int, from int, fromMode gpMode, kind gpEdgeKind) {
+		if s := &states[to][mode]; !s.reached || cost < s.cost {
+			*s = gpState{cost: cost, reached: true, from: from, fromMode: fromMode, kind: kind}
+		}
+	}
+
+	for i := 0; i <= n; i++ {
+		for from := range gpModes {
+			if s := states[i][from]; s.reached {
+				for to, latch := range gpLatches[from] {
+					if latch[1] > 0 {
+						relax(i, gpMode(to), s.cost+latch[1], i, from, gpEdgeLatch)
+					}
+				}
+			}
+		}
+		if i == n {
+			break
+		}
+
+		if s := states[i][gpNumeric]; s.reached {
+			if i+1 < n && gpIsNumericPair(symbols[i], symbols[i+1]) {
+				relax(i+2, gpNumeric, s.cost+7, i, gpNumeric, gpEdgePair)
+			}
+			if i+1 == n && isDigit(symbols[i]) {
+				relax(n, gpNumeric, s.cost+7, i, gpNumeric, gpEdgeLastDigit)
+			}
+		}
+		for _, mode := range []gpMode{gpAlphanumeric, gpISO646} {
+			if s := states[i][mode]; s.reached {
+				if _, bits := gpCharValue(mode, symbols[i]); bits > 0 {
+					next := mode
+					if symbols[i] == -1 {
+						next = gpNumeric
+					}
+					relax(i+1, next, s.cost+bits, i, mode, gpEdgeChar)
+				}
+			}
+		}
+	}
+
+	end := gpNumeric
+	for _, mode := range []gpMode{gpAlphanumeric, gpISO646} {
+		if s := states[n][mode]; s.reached && s.cost < states[n][end].cost {
+			end = mode
+		}
+	}
+
+	var path []int // pairs of position and mode from the end to the start
+	for i, mode := n, end; i > 0 || mode != gpNumeric; {
+		path = append(path, i, int(mode))
+		s := states[i][mode]
+		i, mode = s.from, s.fromMode
+	}
+	result := gpEnd{mode: end, lastDigit: -1}
+	for p := len(path) - 2; p >= 0; p -= 2 {
+		i, mode := path[p], gpMode(path[p+1])
+		s := states[i][mode]
+		switch s.kind {
+		case gpEdgeLatch:
+			latch := gpLatches[s.fromMode][mode]
+			w.write(latch[0], latch[1])
+		case gpEdgePair:
+			w.write(11*gpNumericValue(symbols[s.from])+gpNumericValue(symbols[s.from+1])+8, 7)
+		case gpEdgeLastDigit:
+			result.lastDigit = w.len
+			w.write(11*gpNumericValue(symbols[s.from])+10+8, 7)
+		case gpEdgeChar:
+			w.write(gpCharValue(s.fromMode, symbols[s.from]))
+		}
+	}
+	return result, nil
+}
+
+// gpFit returns the size in bits of the data field holding the encoded general purpose data field, as returned by
+// size for an amount of bits. A single last digit is shortened to four bits if less than seven bits remain after it.
+// size returns -1 if the bits exceed the capacity.
+func gpFit(w *bitWriter, end gpEnd, size func(n int) int) int {
+	if d := end.lastDigit; d >= 0 {
+		if short := size(d + 4); short >= 0 && short-d < 7 {
+			r := &bitReader{data: w.data, pos: d, size: w.len}
+			digit := (r.read(7) - 8) / 11
+			w.truncate(d)
+			w.write(digit+1, 4)
+			return short
+		}
+	}
+	return size(w.len)
+}
+
+// gpPad pads the general purpose data field ending in mode to size bits. Numeric mode latches to alphanumeric mode
+// first, followed by the repeated pad pattern 00100.
+func gpPad(w *bitWriter, mode gpMode, size int) {
+	if mode == gpNumeric && size-w.len >= 4 {
+		w.write(0, 4)
+	}
+	for i := 0; w.len < size; i++ {
+		w.write(0b00100>>(4-i%5)&1, 1)
+	}
+}
+
+// gpIsNumericPair reports whether two symbols can be encoded together in numeric mode. Either may be FNC1.
+func gpIsNumericPair(a, b int) bool {
+	return (isDigit(a) || a == -1) && (isDigit(b) || b == -1) && (a != -1 || b != -1)
+}
+
+// gpNumericValue returns the value of a digit or of FNC1 in numeric mode.
+func gpNumericValue(c int) int {
+	if c == -1 {
+		return 10
+	}
+	return c - '0'
+}
+
+// gpCharValue returns the value and the amount of bits of the symbol in alphanumeric or ISO/IEC 646 mode. The amount
+// is zero if the mode cannot encode the symbol.
+func gpCharValue(mode gpMode, c int) (int, int) {
+	switch {
+	case c == -1:
+		return 0b01111, 5
+	case isDigit(c):
+		return c - '0' + 5, 5
+	case c >= 'A' && c <= 'Z' && mode == gpAlphanumeric:
+		return c - 'A' + 32, 6
+	case c >= 'A' && c <= 'Z':
+		return c - 'A' + 64, 7
+	case c >= 'a' && c <= 'z' && mode == gpISO646:
+		return c - 'a' + 90, 7
+	case c > 0 && c < 128 && mode == gpAlphanumeric:
+		if i := strings.IndexByte("*,-./", byte(c)); i >= 0 {
+			return i + 58, 6
+		}
+	case c > 0 && c < 128:
+		if i := strings.IndexByte(gpISO646Specials, byte(c)); i >= 0 {
+			return i + 232, 8
+		}
+	}
+	return 0, 0
+}
+
+// decodeGeneralPurpose decodes a general purpose data field up to the padding at the end of the data. FNC1 is
+// returned as GS.
+func decodeGeneralPurpose(r *bitReader) string {
+	var sb strings.Builder
+	mode := gpNumeric
+	for {
+		switch mode {
+		case gpNumeric:
+			switch {
+			case r.remaining() < 4:
+				return sb.String()
+			case r.remaining() < 7:
+				// A single last digit is encoded in four bits, zero is padding.
+				if v := r.read(4); v > 0 {
+					sb.WriteByte(byte('0' + v - 1))
+				}
+				return sb.String()
+			case r.peek(4) == 0:
+				r.read(4)
+				mode = gpAlphanumeric
+			default:
+				v := r.read(7) - 8
+				for _, d := range [2]int{v / 11, v % 11} {
+					if d == 10 {
+						sb.WriteByte('\x1d')
+					} else {
+						sb.WriteByte(byte('0' + d))
+					}
+				}
+			}
+		case gpAlphanumeric, gpISO646:
+			c, next, ok := gpDecodeChar(r, mode)
+			if !ok {
+				return sb.String()
+			}
+			if c >= 0 {
+				sb.WriteByte(byte(c))
+			}
+			mode = next
+		}
+	}
+}
+
+// gpDecodeChar reads a character or a latch in alphanumeric or ISO/IEC 646 mode. It returns the character or -1 for
+// a latch and the following mode. ok is false at the end of the data.
+func gpDecodeChar(r *bitReader, mode gpMode) (c int, next gpMode, ok bool) {
+	if r.remaining() >= 5 {
+		switch v := r.peek(5); {
+		case v == 0b01111:
+			r.read(5)
+			return '\x1d', gpNumeric, true
+		case v >= 5 && v < 15:
+			r.read(5)
+			return '0' + v - 5, mode, true
+		}
+	}
+	if mode == gpAlphanumeric && r.remaining() >= 6 {
+		switch v := r.peek(6); {
+		case v >= 32 && v < 58:
+			r.read(6)
+			return 'A' + v - 32, mode, true
+		case v >= 58 && v < 63:
+			r.read(6)
+			return int("*,-./"[v-58]), mode, true
+		}
+	}
+	if mode == gpISO646 && r.remaining() >= 7 {
+		switch v := r.peek(7); {
+		case v >= 64 && v < 90:
+			r.read(7)
+			return 'A' + v - 64, mode, true
+		case v >= 90 && v < 116:
+			r.read(7)
+			return 'a' + v - 90, mode, true
+		}
+	}
+	if mode == gpISO646 && r.remaining() >= 8 {
+		if v := r.peek(8); v >= 232 && v < 232+len(gpISO646Specials) {
+			r.read(8)
+			return int(gpISO646Specials[v-232]), mode, true
+		}
+	}
+	if r.remaining() >= 3 && r.peek(3) == 0 {
+		r.read(3)
+		return -1, gpNumeric, true
+	}
+	if r.remaining() >= 5 && r.peek(5) == 0b00100 {
+		r.read(5)
+		if mode == gpISO646 {
+			return -1, gpAlphanumeric, true
+		}
+		return -1, gpISO646, true
+	}
+	return 0, mode, false
+}
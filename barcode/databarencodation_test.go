@@ -0,0 +1,133 @@
+package barcode
+
+import (
+	"strings"
+	"testing"
+)
+
+// bitsOf returns a reader of a string of 0 and 1, ignoring spaces.
+func bitsOf(s string) *bitReader {
+	w := &bitWriter{}
+	for _, c := range strings.ReplaceAll(s, " ", "") {
+		w.write(int(c-'0'), 1)
+	}
+	return &bitReader{data: w.data, size: w.len}
+}
+
+func TestEncodeGeneralPurpose(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          string
+		want          string
+		wantMode      gpMode
+		wantLastDigit int
+	}{
+		{
+			name:          "digits SHOULD be encoded in pairs of 7 bits",
+			data:          "1012",
+			want:          "0010011 0010101",
+			wantLastDigit: -1,
+		},
+		{
+			name:          "single last digit SHOULD be encoded together with FNC1",
+			data:          "101",
+			want:          "0010011 0011101",
+			wantLastDigit: 7,
+		},
+		{
+			name:          "FNC1 SHOULD be encoded in a numeric pair",
+			data:          "12\x1d3",
+			want:          "0010101 1111001",
+			wantLastDigit: -1,
+		},
+		{
+			name:          "upper case letters SHOULD latch to alphanumeric",
+			data:          "AB",
+			want:          "0000 100000 100001",
+			wantMode:      gpAlphanumeric,
+			wantLastDigit: -1,
+		},
+		{
+			name:          "FNC1 in alphanumeric SHOULD return to numeric",
+			data:          "A\x1d12",
+			want:          "0000 100000 01111 0010101",
+			wantLastDigit: -1,
+		},
+		{
+			name:          "digit runs SHOULD latch back to numeric",
+			data:          "AB1234",
+			want:          "0000 100000 100001 000 0010101 0101101",
+			wantLastDigit: -1,
+		},
+		{
+			name:          "short digit runs SHOULD stay in alphanumeric",
+			data:          "A12B",
+			want:          "0000 100000 00110 00111 100001",
+			wantMode:      gpAlphanumeric,
+			wantLastDigit: -1,
+		},
+		{
+			name:          "lower case letters and punctuation SHOULD latch to ISO/IEC 646",
+			data:          "a b!",
+			want:          "0000 00100 1011010 11111100 1011011 11101000",
+			wantMode:      gpISO646,
+			wantLastDigit: -1,
+		},
+		{
+			name:          "upper case letters after ISO/IEC 646 SHOULD latch back to alphanumeric",
+			data:          "a%ABCDEF",
+			want:          "0000 00100 1011010 11101010 00100 100000 100001 100010 100011 100100 100101",
+			wantMode:      gpAlphanumeric,
+			wantLastDigit: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bitWriter{}
+			end, err := encodeGeneralPurpose(w, gs1Symbols(tt.data)[1:])
+			if err != nil {
+				t.Fatalf("encodeGeneralPurpose() error = %v", err)
+			}
+			if got, want := w.String(), strings.ReplaceAll(tt.want, " ", ""); got != want {
+				t.Errorf("encodeGeneralPurpose() = %s, want %s", got, want)
+			}
+			if end.mode != tt.wantMode || end.lastDigit != tt.wantLastDigit {
+				t.Errorf("encodeGeneralPurpose() end = %+v, want mode %d and last digit %d", end, tt.wantMode,
+					tt.wantLastDigit)
+			}
+			if got := decodeGeneralPurpose(&bitReader{data: w.data, size: w.len}); got != tt.data &&
+				got != tt.data+"\x1d" {
+				t.Errorf("decodeGeneralPurpose() = %q, want %q", got, tt.data)
+			}
+		})
+	}
+}
+
+func TestEncodeGeneralPurpose_Errors(t *testing.T) {
+	_, err := encodeGeneralPurpose(&bitWriter{}, gs1Symbols("AB#1")[1:])
+	if err == nil || !strings.Contains(err.Error(), `'#' at position 2`) {
+		t.Errorf("encodeGeneralPurpose() error = %v, want error for '#'", err)
+	}
+}
+
+func TestDecodeGeneralPurpose(t *testing.T) {
+	tests := []struct {
+		name string
+		bits string
+		want string
+	}{
+		{name: "numeric padding SHOULD be ignored", bits: "0010011 0000 00100 0", want: "10"},
+		{name: "short numeric padding SHOULD be ignored", bits: "0010011 0000 0", want: "10"},
+		{name: "single last digit SHOULD be decoded from 4 bits", bits: "0010011 0010 00", want: "101"},
+		{name: "alphanumeric padding SHOULD be ignored", bits: "0000 100000 00100 00100 00", want: "A"},
+		{name: "ISO/IEC 646 padding SHOULD be ignored", bits: "0000 00100 1011010 00100 001", want: "a"},
+		{name: "FNC1 in numeric pairs SHOULD be decoded as GS", bits: "1111001 0011101", want: "\x1d31\x1d"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := decodeGeneralPurpose(bitsOf(tt.bits)); got != tt.want {
+				t.Errorf("decodeGeneralPurpose() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
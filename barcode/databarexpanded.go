@@ -0,0 +1,590 @@
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+const (
+	// dataBarExpandedMaxChars is the maximum amount of data characters of a GS1 DataBar Expanded symbol, not
+	// counting the check character.
+	dataBarExpandedMaxChars = 21
+	// dataBarNoDate is the value of the date field of compaction methods 7 to 14 if the message has no date.
+	dataBarNoDate = 38400
+)
+
+// dataBarExpandedGroups are the groups of the data characters of GS1 DataBar Expanded.
+var dataBarExpandedGroups = []dataBarGroup{
+	{start: 0, odd: dataBarSet{12, 7, true}, even: dataBarSet{5, 2, false}, evenValues: 4},
+	{start: 348, odd: dataBarSet{10, 5, true}, even: dataBarSet{7, 4, false}, evenValues: 20},
+	{start: 1388, odd: dataBarSet{8, 4, true}, even: dataBarSet{9, 5, false}, evenValues: 52},
+	{start: 2948, odd: dataBarSet{6, 3, true}, even: dataBarSet{11, 6, false}, evenValues: 104},
+	{start: 3988, odd: dataBarSet{4, 1, true}, even: dataBarSet{13, 8, false}, evenValues: 204},
+}
+
+// dataBarExpandedFinders are the element widths of the finder patterns A to F of GS1 DataBar Expanded.
+var dataBarExpandedFinders = [6][5]int{
+	{1, 8, 4, 1, 1}, {3, 6, 4, 1, 1}, {3, 4, 6, 1, 1}, {3, 2, 8, 1, 1}, {2, 6, 5, 1, 1}, {2, 2, 9, 1, 1},
+}
+
+// dataBarExpandedSequences are the finder patterns of the symbol character pairs by the amount of pairs minus two.
+// The finder patterns of every second pair are reversed.
+var dataBarExpandedSequences = [...]string{
+	"AA", "ABB", "ACBD", "AEBDC", "AEBDDF", "AEBDEFF", "AABBCCDD", "AABBCCDEE", "AABBCCDEFF", "AABBCDDEEFF",
+}
+
+// dataBarDateAIs are the date AIs of compaction methods 7 to 14 in the order of their method bits.
+var dataBarDateAIs = [...]string{"11", "13", "15", "17"}
+
+// EncodeDataBarExpanded encodes the message as GS1 DataBar Expanded symbol. The compaction methods for AI (01) with
+// a GTIN of indicator digit 9 followed by a weight, an optional date, or a price are applied where possible. The
+// symbol should be rendered at least DataBarExpandedHeight modules high.
+func EncodeDataBarExpanded(msg gs1.Message) (*Linear, error) {
+	values, err := dataBarExpandedValues(msg, false, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &Linear{
+		Modules: appendElements(nil, false, dataBarExpandedElements(values)...),
+		HRI:     msg.HRI(),
+	}, nil
+}
+
+// EncodeDataBarExpandedStacked encodes the message as GS1 DataBar Expanded Stacked symbol of rows with the given
+// amount of segments, an even number from 2 to 22. Rows are DataBarExpandedHeight modules high and separated by
+// three rows of separator patterns.
+func EncodeDataBarExpandedStacked(msg gs1.Message, segments int) (*Matrix, error) {
+	if segments < 2 || segments > 22 || segments%2 != 0 {
+		return nil, fmt.Errorf("invalid amount of segments per row %d: must be even and within 2 and 22", segments)
+	}
+	values, err := dataBarExpandedValues(msg, false, segments)
+	if err != nil {
+		return nil, err
+	}
+	return dataBarExpandedStacked(dataBarExpandedElements(values), segments/2), nil
+}
+
+// DataBarExpandedBits returns the binary data of the message as carried by a GS1 DataBar Expanded symbol, a string of
+// 0 and 1 consisting of 12 bits per data character. It includes the linkage flag, the encodation method and the
+// padding.
+func DataBarExpandedBits(msg gs1.Message) (string, error) {
+	w, err := dataBarExpandedData(msg, false, 0)
+	if err != nil {
+		return "", err
+	}
+	return w.String(), nil
+}
+
+// DecodeDataBarExpandedBits decodes the binary data of a GS1 DataBar Expanded symbol, a string of 0 and 1 as returned
+// by [DataBarExpandedBits], into a message with symbology identifier ]e0. Elements compacted by an encodation method
+// are returned in the order of the method, e.g. the weight before the date.
+func DecodeDataBarExpandedBits(bits string) (gs1.Message, error) {
+	r, err := parseBits(bits)
+	if err != nil {
+		return gs1.Message{}, err
+	}
+	if r.remaining() < 1 {
+		return gs1.Message{}, errors.New("binary data is empty")
+	}
+	r.read(1) // linkage flag
+	data, err := decodeDataBarExpanded(r)
+	if err != nil {
+		return gs1.Message{}, err
+	}
+	return gs1.ParseBarcodeMessage("]e0" + strings.TrimSuffix(data, "\x1d"))
+}
+
+// dataBarExpandedValues returns the values of the data characters of the message.
+func dataBarExpandedValues(msg gs1.Message, linkage bool, segments int) ([]int, error) {
+	w, err := dataBarExpandedData(msg, linkage, segments)
+	if err != nil {
+		return nil, err
+	}
+	r := &bitReader{data: w.data, size: w.len}
+	values := make([]int, 0, w.len/12)
+	for r.remaining() > 0 {
+		values = append(values, r.read(12))
+	}
+	return values, nil
+}
+
+// dataBarExpandedData returns the padded binary data of the message. If segments is set, the data is extended so that
+// the last row of a stacked symbol with that amount of segments per row holds more than one symbol character.
+func dataBarExpandedData(msg gs1.Message, linkage bool, segments int) (*bitWriter, error) {
+	e, err := dataBarExpandedEncode(msg, linkage)
+	if err != nil {
+		return nil, err
+	}
+	w := e.w
+	size := gpFit(w, e.end, func(n int) int { return dataBarExpandedSize(n, segments) })
+	if size > dataBarExpandedMaxChars*12 {
+		return nil, fmt.Errorf("%w: %d bits exceed the GS1 DataBar Expanded maximum of %d data characters",
+			ErrDataTooLong, w.len, dataBarExpandedMaxChars)
+	}
+	gpPad(w, e.end.mode, size)
+	if e.vls >= 0 {
+		chars := size/12 + 1 // including the check character
+		if chars%2 == 1 {
+			w.set(e.vls)
+		}
+		if chars > 14 {
+			w.set(e.vls + 1)
+		}
+	}
+	return w, nil
+}
+
+// dataBarExpandedSize returns the size in bits of the data characters carrying n bits. The symbol has at least four
+// symbol characters including the check character. If segments is set, the last row of a stacked symbol must not
+// hold a single symbol character.
+func dataBarExpandedSize(n, segments int) int {
+	chars := max((n+11)/12, 3)
+	if segments > 0 && (chars+1)%segments == 1 {
+		chars++
+	}
+	return chars * 12
+}
+
+// dataBarExpandedEncodation is the binary data of a message before padding.
+type dataBarExpandedEncodation struct {
+	w *bitWriter
+	// vls is the position of the variable length symbol field or -1 if the encodation method has none.
+	vls int
+	end gpEnd
+}
+
+// dataBarExpandedEncode encodes the linkage flag and the message using the shortest applicable encodation method,
+// see ISO/IEC 24724, section 7.2.5.
+func dataBarExpandedEncode(msg gs1.Message, linkage bool) (*dataBarExpandedEncodation, error) {
+	if len(msg.Elements) == 0 {
+		return nil, errNoElements
+	}
+	e := &dataBarExpandedEncodation{w: &bitWriter{}, vls: -1, end: gpEnd{lastDigit: -1}}
+	w := e.w
+	if linkage {
+		w.write(1, 1)
+	} else {
+		w.write(0, 1)
+	}
+
+	// Method 2 encodes any message in the general purpose data field.
+	if msg.Elements[0].AI != "01" {
+		w.write(0b00, 2)
+		return e, e.general("", msg.Elements)
+	}
+	gtin, err := dataBarGTIN(msg.Elements[0].DataField)
+	if err != nil {
+		return nil, err
+	}
+	rest := msg.Elements[1:]
+
+	if gtin[0] == '9' && len(rest) == 1 {
+		// Methods 3 and 4 encode a weight in 15 bits.
+		v, ok := dataBarNumber(rest[0].DataField)
+		method := 0
+		switch {
+		case rest[0].AI == "3103" && ok && v <= 32767:
+			method = 0b0100
+		case rest[0].AI == "3202" && ok && v <= 9999:
+			method = 0b0101
+		case rest[0].AI == "3203" && ok && v <= 22767:
+			method, v = 0b0101, v+10000
+		}
+		if method > 0 {
+			w.write(method, 4)
+			dataBarWriteGTIN(w, gtin[1:13])
+			w.write(v, 15)
+			return e, nil
+		}
+	}
+
+	if gtin[0] == '9' {
+		// Methods 7 to 14 encode a weight of up to 99999 and an optional date.
+		if weight, date, ok := dataBarWeightAndDate(rest); ok {
+			dateIndex := 0
+			for i, ai := range dataBarDateAIs {
+				if date.AI == ai {
+					dateIndex = i
+				}
+			}
+			unit := 0
+			if weight.AI[:3] == "320" {
+				unit = 1
+			}
+			w.write(0b0111, 4)
+			w.write(dateIndex, 2)
+			w.write(unit, 1)
+			dataBarWriteGTIN(w, gtin[1:13])
+			v, _ := dataBarNumber(weight.DataField)
+			w.write(int(weight.AI[3]-'0')*100000+v, 20)
+			w.write(dataBarDate(date.DataField), 16)
+			return e, nil
+		}
+
+		// Methods 5 and 6 encode a price with its decimal point and an optional currency.
+		for i, el := range rest {
+			x := 0
+			if len(el.AI) == 4 {
+				x = int(el.AI[3] - '0')
+			}
+			var prefix string
+			switch {
+			case el.AI[:min(3, len(el.AI))] == "392" && x <= 3:
+				w.write(0b01100, 5)
+				prefix = el.DataField
+			case el.AI[:min(3, len(el.AI))] == "393" && x <= 3 && len(el.DataField) > 3:
+				w.write(0b01101, 5)
+				prefix = el.DataField[3:]
+			default:
+				continue
+			}
+			e.vls = w.len
+			w.write(0, 2)
+			dataBarWriteGTIN(w, gtin[1:13])
+			w.write(x, 2)
+			if prefix != el.DataField {
+				currency, _ := strconv.Atoi(el.DataField[:3])
+				w.write(currency, 10)
+			}
+			others := append(append([]gs1.ElementString{}, rest[:i]...), rest[i+1:]...)
+			if len(others) > 0 {
+				prefix += "\x1d"
+			}
+			return e, e.general(prefix, others)
+		}
+	}
+
+	// Method 1 encodes the GTIN followed by the general purpose data field.
+	w.write(1, 1)
+	e.vls = w.len
+	w.write(0, 2)
+	w.write(int(gtin[0]-'0'), 4)
+	dataBarWriteGTIN(w, gtin[1:13])
+	return e, e.general("", rest)
+}
+
+// general appends the variable length symbol field unless it has been written and the general purpose data field of
+// the prefix followed by the elements.
+func (e *dataBarExpandedEncodation) general(prefix string, elements []gs1.ElementString) error {
+	if e.vls < 0 {
+		e.vls = e.w.len
+		e.w.write(0, 2)
+	}
+	data := prefix + gs1.Message{Elements: elements}.AsBarcodeMessage()
+	end, err := encodeGeneralPurpose(e.w, gs1Symbols(data)[1:])
+	e.end = end
+	return err
+}
+
+// dataBarWeightAndDate returns the elements of a net weight in kilograms or pounds and of an optional date if the
+// elements consist of exactly these and they can be compacted.
+func dataBarWeightAndDate(elements []gs1.ElementString) (weight, date gs1.ElementString, ok bool) {
+	if len(elements) == 0 || len(elements) > 2 {
+		return weight, date, false
+	}
+	for _, el := range elements {
+		switch {
+		case len(el.AI) == 4 && (el.AI[:3] == "310" || el.AI[:3] == "320") && isDigit(int(el.AI[3])) &&
+			weight.AI == "":
+			v, ok := dataBarNumber(el.DataField)
+			if !ok || v > 99999 {
+				return weight, date, false
+			}
+			weight = el
+		case (el.AI == "11" || el.AI == "13" || el.AI == "15" || el.AI == "17") && date.AI == "":
+			if dataBarDate(el.DataField) == dataBarNoDate {
+				return weight, date, false
+			}
+			date = el
+		default:
+			return weight, date, false
+		}
+	}
+	return weight, date, weight.AI != ""
+}
+
+// dataBarDate returns the value of a date YYMMDD for compaction methods 7 to 14 or dataBarNoDate if the date is
+// missing or cannot be compacted.
+func dataBarDate(date string) int {
+	v, ok := dataBarNumber(date)
+	if !ok || len(date) != 6 {
+		return dataBarNoDate
+	}
+	yy, mm, dd := v/10000, v/100%100, v%100
+	if mm < 1 || mm > 12 || dd > 31 {
+		return dataBarNoDate
+	}
+	return yy*384 + (mm-1)*32 + dd
+}
+
+// dataBarNumber returns the value of a string of digits.
+func dataBarNumber(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	v := 0
+	for i := range len(s) {
+		if !isDigit(int(s[i])) {
+			return 0, false
+		}
+		v = v*10 + int(s[i]-'0')
+	}
+	return v, true
+}
+
+// dataBarWriteGTIN appends the 12 digits of a GTIN following its first digit in groups of three digits of 10 bits.
+func dataBarWriteGTIN(w *bitWriter, digits string) {
+	for i := 0; i < 12; i += 3 {
+		v, _ := dataBarNumber(digits[i : i+3])
+		w.write(v, 10)
+	}
+}
+
+// dataBarReadGTIN reads 12 digits of a GTIN following its first digit and returns the GTIN including its check
+// digit.
+func dataBarReadGTIN(r *bitReader, first int) (string, error) {
+	var sb strings.Builder
+	sb.WriteByte(byte('0' + first))
+	for range 4 {
+		v := r.read(10)
+		if v > 999 {
+			return "", fmt.Errorf("invalid GTIN digits %d", v)
+		}
+		fmt.Fprintf(&sb, "%03d", v)
+	}
+	check, err := gs1.CheckDigit(sb.String())
+	if err != nil {
+		return "", err
+	}
+	sb.WriteByte(check)
+	return sb.String(), nil
+}
+
+// decodeDataBarExpanded returns the barcode message of the binary data following the linkage flag.
+func decodeDataBarExpanded(r *bitReader) (string, error) {
+	errShort := errors.New("binary data too short for its encodation method")
+	var sb strings.Builder
+	readGTIN := func(first int) error {
+		if r.remaining() < 40 {
+			return errShort
+		}
+		gtin, err := dataBarReadGTIN(r, first)
+		sb.WriteString("01" + gtin)
+		return err
+	}
+
+	switch {
+	case r.remaining() < 2:
+		return "", errShort
+	case r.peek(1) == 1:
+		if r.remaining() < 7 {
+			return "", errShort
+		}
+		r.read(3)
+		if err := readGTIN(r.read(4)); err != nil {
+			return "", err
+		}
+	case r.peek(2) == 0b00:
+		if r.remaining() < 4 {
+			return "", errShort
+		}
+		r.read(4)
+	case r.remaining() < 4:
+		return "", errShort
+	case r.peek(4) == 0b0100, r.peek(4) == 0b0101:
+		method := r.read(4)
+		if err := readGTIN(9); err != nil {
+			return "", err
+		}
+		if r.remaining() < 15 {
+			return "", errShort
+		}
+		switch v := r.read(15); {
+		case method == 0b0100:
+			fmt.Fprintf(&sb, "3103%06d", v)
+		case v < 10000:
+			fmt.Fprintf(&sb, "3202%06d", v)
+		default:
+			fmt.Fprintf(&sb, "3203%06d", v-10000)
+		}
+		return sb.String(), nil
+	case r.remaining() < 7:
+		return "", errShort
+	case r.peek(4) == 0b0111:
+		r.read(4)
+		dateIndex, unit := r.read(2), r.read(1)
+		if err := readGTIN(9); err != nil {
+			return "", err
+		}
+		if r.remaining() < 36 {
+			return "", errShort
+		}
+		weight, date := r.read(20), r.read(16)
+		fmt.Fprintf(&sb, "%s%d%06d", [2]string{"310", "320"}[unit], weight/100000, weight%100000)
+		if date != dataBarNoDate {
+			fmt.Fprintf(&sb, "%s%02d%02d%02d", dataBarDateAIs[dateIndex], date/384, date%384/32+1, date%32)
+		}
+		return sb.String(), nil
+	case r.peek(5) == 0b01100, r.peek(5) == 0b01101:
+		method := r.read(5)
+		r.read(2)
+		if err := readGTIN(9); err != nil {
+			return "", err
+		}
+		if r.remaining() < 12 {
+			return "", errShort
+		}
+		if method == 0b01100 {
+			fmt.Fprintf(&sb, "392%d", r.read(2))
+		} else {
+			fmt.Fprintf(&sb, "393%d%03d", r.read(2), r.read(10))
+		}
+	default:
+		return "", fmt.Errorf("unknown encodation method %07b", r.peek(7))
+	}
+	sb.WriteString(decodeGeneralPurpose(r))
+	return sb.String(), nil
+}
+
+// dataBarExpandedElements returns the element widths of a GS1 DataBar Expanded symbol of the data characters,
+// starting with a light element. The check character is prepended to the data characters.
+func dataBarExpandedElements(values []int) []int {
+	chars := make([][8]int, len(values)+1)
+	checksum := 0
+	for i, v := range values {
+		chars[i+1] = dataBarCharacter(v, dataBarExpandedGroups)
+		for j, width := range chars[i+1] {
+			checksum += dataBarExpandedWeight(dataBarExpandedWeightRow(i+1, len(chars)), j) * width
+		}
+	}
+	chars[0] = dataBarCharacter(211*(len(chars)-4)+checksum%211, dataBarExpandedGroups)
+
+	sequence := dataBarExpandedSequences[(len(chars)+1)/2-2]
+	elements := []int{1, 1}
+	for p := range len(sequence) {
+		elements = append(elements, chars[2*p][:]...)
+		finder := dataBarExpandedFinders[sequence[p]-'A']
+		for i := range 5 {
+			if p%2 == 1 {
+				elements = append(elements, finder[4-i])
+			} else {
+				elements = append(elements, finder[i])
+			}
+		}
+		if 2*p+1 < len(chars) {
+			for i := range 8 {
+				elements = append(elements, chars[2*p+1][7-i])
+			}
+		}
+	}
+	return append(elements, 1, 1)
+}
+
+// dataBarExpandedWeightRow returns the row of checksum weights of the symbol character at index i of n symbol
+// characters. It depends on the finder pattern, its direction and the side of the character.
+func dataBarExpandedWeightRow(i, n int) int {
+	p := i / 2
+	row := 4*int(dataBarExpandedSequences[(n+1)/2-2][p]-'A') + i%2 - 1
+	if p%2 == 1 {
+		row += 2
+	}
+	return row
+}
+
+// dataBarExpandedWeight returns the checksum weight of element j in the given row, 3 to the power of 8*row+j modulo
+// 211.
+func dataBarExpandedWeight(row, j int) int {
+	w := 1
+	for range 8*row + j {
+		w = w * 3 % 211
+	}
+	return w
+}
+
+// dataBarExpandedStacked arranges the elements of a GS1 DataBar Expanded symbol in rows of the given amount of
+// symbol character pairs, see ISO/IEC 24724, section 7.2.8. Rows read in alternating directions if the amount of
+// pairs per row is even, so that each row starts with the finder pattern of the right orientation.
+func dataBarExpandedStacked(elements []int, pairs int) *Matrix {
+	chars := (len(elements) - 4) / 21 * 2
+	if (len(elements)-4)%21 != 0 {
+		chars++
+	}
+	blocks := (chars + 1) / 2
+	rowCount := (blocks + pairs - 1) / pairs
+
+	type row struct {
+		modules []bool
+		from    int // first module of the complement in separators
+		finders [][2]int
+		reverse bool
+	}
+	rows := make([]row, rowCount)
+	for r := range rows {
+		first, last := r*pairs, min((r+1)*pairs, blocks)
+		content := elements[2+21*first : min(2+21*last, len(elements)-2)]
+		odd := r%2 == 0 // rows are numbered from one
+		leftToRight := pairs%2 == 1 || odd || (last-first)%2 == 1
+		// An even row reading from left to right is shifted by one module.
+		shift := 0
+		if !odd && pairs%2 == 0 && leftToRight {
+			shift = 1
+		}
+
+		widths := []int{1 + shift, 1}
+		if leftToRight {
+			widths = append(widths, content...)
+		} else {
+			for i := len(content) - 1; i >= 0; i-- {
+				widths = append(widths, content[i])
+			}
+		}
+		widths = append(widths, 1, 1)
+		dark := !odd && shift == 0
+		rows[r] = row{modules: appendElements(nil, dark, widths...), from: 4 + shift, reverse: !leftToRight}
+
+		// The separators alternate within the 13 modules of the finder patterns without the two narrow elements.
+		x := 2 + shift
+		for i := range last - first {
+			b := first + i
+			if !leftToRight {
+				b = last - 1 - i
+			}
+			right := 17
+			if 2*b+1 >= chars {
+				right = 0
+			}
+			finder := x + 17
+			if !leftToRight {
+				finder = x + right
+			}
+			// The wide elements of the finder pattern come first if it is read in its original direction.
+			if wideFirst := b%2 == 0 == leftToRight; wideFirst {
+				rows[r].finders = append(rows[r].finders, [2]int{finder, finder + 13})
+			} else {
+				rows[r].finders = append(rows[r].finders, [2]int{finder + 2, finder + 15})
+			}
+			x += 17 + 15 + right
+		}
+	}
+
+	width := len(rows[0].modules)
+	m := NewMatrix(width, rowCount*DataBarExpandedHeight+(rowCount-1)*dataBarSeparatorHeight, 0)
+	for r, row := range rows {
+		y := r * (DataBarExpandedHeight + dataBarSeparatorHeight)
+		setRows(m, y, DataBarExpandedHeight, row.modules)
+		if r > 0 {
+			above := rows[r-1]
+			setRow(m, y-3, dataBarSeparator(above.modules, above.from, len(above.modules)-4, above.finders,
+				above.reverse))
+			for x := 5; x < width-4; x += 2 {
+				m.Set(x, y-2, true)
+			}
+			setRow(m, y-1, dataBarSeparator(row.modules, row.from, len(row.modules)-4, row.finders, row.reverse))
+		}
+	}
+	return m
+}
@@ -0,0 +1,258 @@
+package barcode
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDataBarExpandedBits(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "GTIN SHOULD be encoded with method 1",
+			data: "(01)00012345678905",
+			want: "0 1 10 0000 0000000001 0011101010 1000110111 1101111010",
+		},
+		{
+			name: "weight in kilograms SHOULD be encoded with method 3",
+			data: "(01)90012345678908(3103)001750",
+			want: "0 0100 0000000001 0011101010 1000110111 1101111010 000011011010110",
+		},
+		{
+			name: "price SHOULD be encoded with method 5",
+			data: "(01)90012345678908(3922)795",
+			want: "0 01100 10 0000000001 0011101010 1000110111 1101111010 10 1011110 1001001 0000 0010",
+		},
+		{
+			name: "elements without GTIN SHOULD be encoded with method 2",
+			data: "(10)12345(21)A",
+			want: "0 00 00 0010011 0010101 0101101 1001001 0011111 0000 100000 00100 00100",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DataBarExpandedBits(mustParse(t, tt.data))
+			if err != nil {
+				t.Fatalf("DataBarExpandedBits() error = %v", err)
+			}
+			if want := strings.ReplaceAll(tt.want, " ", ""); got != want {
+				t.Errorf("DataBarExpandedBits() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestDecodeDataBarExpandedBits(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{name: "method 1 SHOULD round trip", data: "(01)00012345678905(10)ABC123"},
+		{name: "method 2 SHOULD round trip", data: "(10)abc-1!(21)A1B2"},
+		{name: "method 3 SHOULD round trip", data: "(01)90012345678908(3103)032767"},
+		{name: "method 4 SHOULD round trip weights in pounds", data: "(01)90012345678908(3202)009999"},
+		{name: "method 4 SHOULD round trip weights with three decimals", data: "(01)90012345678908(3203)022767"},
+		{name: "method 5 SHOULD round trip", data: "(01)90012345678908(3922)795(10)LOT"},
+		{name: "method 6 SHOULD round trip", data: "(01)90012345678908(3933)0971234"},
+		{name: "method 7 SHOULD round trip weights without date", data: "(01)90012345678908(3105)012345"},
+		{name: "methods 7 to 14 SHOULD round trip dates", data: "(01)90012345678908(3202)001750(11)100312"},
+		{
+			name: "methods 7 to 14 SHOULD return the weight before the date",
+			data: "(01)90012345678908(17)261231(3103)099999",
+			want: "(01)90012345678908(3103)099999(17)261231",
+		},
+		{name: "short last digit SHOULD round trip", data: "(01)00012345678905(10)1"},
+		{name: "large weights SHOULD fall back to method 1", data: "(01)90012345678908(3103)032768"},
+		{name: "GTIN without indicator 9 SHOULD use method 1", data: "(01)00012345678905(3103)001750"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bits, err := DataBarExpandedBits(mustParse(t, tt.data))
+			if err != nil {
+				t.Fatalf("DataBarExpandedBits() error = %v", err)
+			}
+			if len(bits)%12 != 0 || len(bits) < 36 {
+				t.Errorf("DataBarExpandedBits() has %d bits, want a multiple of 12 of at least 36", len(bits))
+			}
+			got, err := DecodeDataBarExpandedBits(bits)
+			if err != nil {
+				t.Fatalf("DecodeDataBarExpandedBits() error = %v", err)
+			}
+			want := tt.want
+			if want == "" {
+				want = tt.data
+			}
+			if got.AsElementString() != want {
+				t.Errorf("DecodeDataBarExpandedBits() = %s, want %s", got.AsElementString(), want)
+			}
+		})
+	}
+}
+
+func TestDecodeDataBarExpandedBits_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		bits    string
+		wantErr string
+	}{
+		{name: "empty data SHOULD be rejected", bits: "", wantErr: "empty"},
+		{name: "other characters SHOULD be rejected", bits: "0102", wantErr: "invalid character '2' at position 3"},
+		{name: "truncated GTIN SHOULD be rejected", bits: "0 1 00 0000 0000000001", wantErr: "too short"},
+		{name: "truncated weight SHOULD be rejected", bits: "0 0100" + strings.Repeat("0", 45), wantErr: "too short"},
+		{name: "invalid GTIN digits SHOULD be rejected", bits: "0 1 00 0000" + strings.Repeat("1", 40), wantErr: "GTIN"},
+		{name: "truncated date SHOULD be rejected", bits: "0 0111 000" + strings.Repeat("0", 50), wantErr: "too short"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeDataBarExpandedBits(strings.ReplaceAll(tt.bits, " ", ""))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("DecodeDataBarExpandedBits() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDataBarExpandedBits_Errors(t *testing.T) {
+	_, err := DataBarExpandedBits(mustParse(t, "(10)"+strings.Repeat("a", 20)+"(21)"+strings.Repeat("b", 20)))
+	if !errors.Is(err, ErrDataTooLong) {
+		t.Errorf("DataBarExpandedBits() error = %v, want %v", err, ErrDataTooLong)
+	}
+	_, err = DataBarExpandedBits(mustParse(t, "(01)00012345678906"))
+	if err == nil || !strings.Contains(err.Error(), "check digit must be 5") {
+		t.Errorf("DataBarExpandedBits() error = %v, want check digit error", err)
+	}
+}
+
+func TestEncodeDataBarExpanded(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		chars int
+	}{
+		{name: "GTIN SHOULD be encoded in five symbol characters ending with a finder pattern", data: "(01)00012345678905", chars: 5},
+		{name: "even amount of symbol characters SHOULD end with a symbol character", data: "(10)12345(21)A", chars: 6},
+		{name: "long messages SHOULD be encoded", data: "(01)00012345678905(10)ABCDE(21)12345678", chars: 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := mustParse(t, tt.data)
+			bits, _ := DataBarExpandedBits(msg)
+			got, err := EncodeDataBarExpanded(msg)
+			if err != nil {
+				t.Fatalf("EncodeDataBarExpanded() error = %v", err)
+			}
+			if chars := len(bits)/12 + 1; chars != tt.chars {
+				t.Fatalf("symbol has %d characters, want %d", chars, tt.chars)
+			}
+			if want := 4 + 17*tt.chars + 15*((tt.chars+1)/2); len(got.Modules) != want {
+				t.Errorf("EncodeDataBarExpanded() has %d modules, want %d", len(got.Modules), want)
+			}
+			widths := elementWidths(got.Modules)
+			if got.Modules[0] || widths[0] != 1 || widths[1] != 1 || widths[len(widths)-1] != 1 ||
+				widths[len(widths)-2] != 1 {
+				t.Errorf("EncodeDataBarExpanded() SHOULD start light and have guards of narrow elements")
+			}
+			if !reflect.DeepEqual(got.HRI, msg.HRI()) {
+				t.Errorf("EncodeDataBarExpanded() HRI = %v, want %v", got.HRI, msg.HRI())
+			}
+
+			// The check character encodes the amount of symbol characters and the weighted sum of the others.
+			elements := dataBarExpandedElements(mustValues(t, bits))
+			if check := dataBarExpandedValue(t, elements[2:10]); check/211 != tt.chars-4 {
+				t.Errorf("check character %d SHOULD encode %d symbol characters", check, tt.chars)
+			}
+		})
+	}
+}
+
+// mustValues returns the data character values of a string of 0 and 1.
+func mustValues(t *testing.T, bits string) []int {
+	t.Helper()
+	r := bitsOf(bits)
+	var values []int
+	for r.remaining() > 0 {
+		values = append(values, r.read(12))
+	}
+	return values
+}
+
+// dataBarExpandedValue returns the value of the widths of a GS1 DataBar Expanded symbol character.
+func dataBarExpandedValue(t *testing.T, widths []int) int {
+	t.Helper()
+	var odd, even []int
+	sum := 0
+	for i, width := range widths {
+		if i%2 == 0 {
+			odd = append(odd, width)
+			sum += width
+		} else {
+			even = append(even, width)
+		}
+	}
+	for _, group := range dataBarExpandedGroups {
+		if group.odd.modules == sum {
+			return group.start + dataBarValue(odd, group.odd.widest, group.odd.narrow)*group.evenValues +
+				dataBarValue(even, group.even.widest, group.even.narrow)
+		}
+	}
+	t.Fatalf("widths %v are no symbol character", widths)
+	return 0
+}
+
+func TestEncodeDataBarExpandedStacked(t *testing.T) {
+	msg := mustParse(t, "(01)00012345678905(10)ABCDE(21)12345678")
+	linear, _ := EncodeDataBarExpanded(msg)
+	got, err := EncodeDataBarExpandedStacked(msg, 4)
+	if err != nil {
+		t.Fatalf("EncodeDataBarExpandedStacked() error = %v", err)
+	}
+	// 12 symbol characters in 3 rows of 4 segments
+	if got.Width() != 102 || got.Height() != 3*34+2*3 {
+		t.Fatalf("EncodeDataBarExpandedStacked() size = %dx%d, want 102x108", got.Width(), got.Height())
+	}
+
+	rows := make([][]bool, 3)
+	for r := range rows {
+		rows[r] = make([]bool, got.Width())
+		for x := range rows[r] {
+			rows[r][x] = got.At(x, r*37)
+			for y := r * 37; y < r*37+34; y++ {
+				if got.At(x, y) != rows[r][x] {
+					t.Fatalf("module %d of row %d differs in line %d", x, r, y)
+				}
+			}
+		}
+	}
+	// The first row reads from left to right, the second from right to left.
+	if !reflect.DeepEqual(rows[0][2:100], linear.Modules[2:100]) {
+		t.Errorf("first row SHOULD hold the first two pairs")
+	}
+	second := elementWidths(rows[1])
+	for i, j := 2, len(second)-3; i < j; i, j = i+1, j-1 {
+		second[i], second[j] = second[j], second[i]
+	}
+	if !reflect.DeepEqual(second[2:len(second)-2], elementWidths(linear.Modules)[2+42:2+84]) {
+		t.Errorf("second row SHOULD hold the next two pairs in reverse")
+	}
+	if !rows[1][0] || rows[0][0] || rows[2][0] {
+		t.Errorf("only the reversed row SHOULD start dark")
+	}
+
+	for x := range got.Width() {
+		if want := x >= 5 && x < 98 && x%2 == 1; got.At(x, 35) != want {
+			t.Errorf("middle separator module %d = %t, want %t", x, got.At(x, 35), want)
+		}
+	}
+
+	for _, segments := range []int{0, 3, 24} {
+		if _, err := EncodeDataBarExpandedStacked(msg, segments); err == nil {
+			t.Errorf("EncodeDataBarExpandedStacked() with %d segments SHOULD fail", segments)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+// GS1 DataBar Limited encodes a GTIN with indicator digit 0 or 1 in a single row of 74 modules: a left and a right
+// data character of seven bars and seven spaces each with a check character between them, enclosed by guard
+// elements. It has no finder patterns, the check character takes their role. See ISO/IEC 24724.
+const (
+	// DataBarLimitedHeight is the minimum height of a GS1 DataBar Limited symbol in modules.
+	DataBarLimitedHeight = 10
+	// dataBarLimitedValues is the amount of values of a data character of GS1 DataBar Limited.
+	dataBarLimitedValues = 2013571
+	// dataBarLimitedChecks is the amount of check character values of GS1 DataBar Limited.
+	dataBarLimitedChecks = 89
+)
+
+// errDataBarLimitedCheck is returned by [EncodeDataBarLimited] as long as the element widths of the 89 check
+// characters, ISO/IEC 24724 table 7, are not part of this package.
+var errDataBarLimitedCheck = errors.New("GS1 DataBar Limited check character patterns of ISO/IEC 24724 are not " +
+	"available")
+
+// dataBarLimitedGroups are the groups of the data characters of GS1 DataBar Limited.
+var dataBarLimitedGroups = []dataBarGroup{
+	{start: 0, odd: dataBarSet{17, 6, false}, even: dataBarSet{9, 3, true}, evenValues: 28},
+	{start: 183064, odd: dataBarSet{13, 5, false}, even: dataBarSet{13, 4, true}, evenValues: 728},
+	{start: 820064, odd: dataBarSet{9, 3, false}, even: dataBarSet{17, 6, true}, evenValues: 6454},
+	{start: 1000776, odd: dataBarSet{15, 5, false}, even: dataBarSet{11, 4, true}, evenValues: 203},
+	{start: 1491021, odd: dataBarSet{11, 4, false}, even: dataBarSet{15, 5, true}, evenValues: 2408},
+	{start: 1979845, odd: dataBarSet{19, 8, false}, even: dataBarSet{7, 1, true}, evenValues: 1},
+	{start: 1996939, odd: dataBarSet{7, 1, false}, even: dataBarSet{19, 8, true}, evenValues: 16632},
+}
+
+// dataBarLimitedWeights are the checksum weights of the elements of the left and the right data character of GS1
+// DataBar Limited, the powers of 3 modulo 89.
+var dataBarLimitedWeights = [2][14]int{
+	{1, 3, 9, 27, 81, 65, 17, 51, 64, 14, 42, 37, 22, 66},
+	{20, 60, 2, 6, 18, 54, 73, 41, 34, 13, 39, 28, 84, 74},
+}
+
+// dataBarLimitedCheckElements are the element widths of the check characters of GS1 DataBar Limited by value,
+// ISO/IEC 24724 table 7. Each has seven bars and seven spaces of 18 modules in total.
+var dataBarLimitedCheckElements [][14]int
+
+// EncodeDataBarLimited encodes the message as GS1 DataBar Limited symbol of 74 modules. The message must consist of
+// AI (01) only and the indicator digit of the GTIN must be 0 or 1. The symbol should be rendered at least
+// DataBarLimitedHeight modules high.
+func EncodeDataBarLimited(msg gs1.Message) (*Linear, error) {
+	value, err := dataBarLimitedValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	elements, err := dataBarLimitedElements(value)
+	if err != nil {
+		return nil, err
+	}
+	return &Linear{
+		Modules: appendElements(nil, false, elements...),
+		HRI:     msg.HRI(),
+	}, nil
+}
+
+// dataBarLimitedValue returns the value of the GTIN without check digit.
+func dataBarLimitedValue(msg gs1.Message) (int, error) {
+	if len(msg.Elements) == 0 {
+		return 0, errNoElements
+	}
+	if len(msg.Elements) > 1 || msg.Elements[0].AI != "01" {
+		return 0, fmt.Errorf("GS1 DataBar Limited encodes AI (01) only, got %s", msg.AsElementString())
+	}
+	gtin, err := dataBarGTIN(msg.Elements[0].DataField)
+	if err != nil {
+		return 0, err
+	}
+	if gtin[0] != '0' && gtin[0] != '1' {
+		return 0, fmt.Errorf("invalid GTIN %q: GS1 DataBar Limited requires indicator digit 0 or 1", gtin)
+	}
+	return strconv.Atoi(gtin[:13])
+}
+
+// dataBarLimitedCharacters returns the widths of the left and the right data character and the check character
+// value of a GS1 DataBar Limited symbol.
+func dataBarLimitedCharacters(value int) (left, right []int, check int) {
+	left = dataBarCharacterWidths(value/dataBarLimitedValues, dataBarLimitedGroups, 7)
+	right = dataBarCharacterWidths(value%dataBarLimitedValues, dataBarLimitedGroups, 7)
+	for i := range 14 {
+		check += dataBarLimitedWeights[0][i]*left[i] + dataBarLimitedWeights[1][i]*right[i]
+	}
+	return left, right, check % dataBarLimitedChecks
+}
+
+// dataBarLimitedElements returns the 46 element widths of a GS1 DataBar Limited symbol starting with a light
+// element.
+func dataBarLimitedElements(value int) ([]int, error) {
+	left, right, check := dataBarLimitedCharacters(value)
+	if check >= len(dataBarLimitedCheckElements) {
+		return nil, errDataBarLimitedCheck
+	}
+	elements := make([]int, 0, 46)
+	elements = append(elements, 1, 1)
+	elements = append(elements, left...)
+	elements = append(elements, dataBarLimitedCheckElements[check][:]...)
+	elements = append(elements, right...)
+	return append(elements, 1, 1), nil
+}
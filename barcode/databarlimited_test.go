@@ -0,0 +1,97 @@
+package barcode
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+func TestDataBarLimitedGroups(t *testing.T) {
+	for g, group := range dataBarLimitedGroups {
+		end := dataBarLimitedValues
+		if g+1 < len(dataBarLimitedGroups) {
+			end = dataBarLimitedGroups[g+1].start
+		}
+		for _, value := range []int{group.start, group.start + 1, (group.start + end) / 2, end - 1} {
+			widths := dataBarCharacterWidths(value, dataBarLimitedGroups, 7)
+			var odd, even []int
+			for i := 0; i < len(widths); i += 2 {
+				odd, even = append(odd, widths[i]), append(even, widths[i+1])
+			}
+			if sum(odd) != group.odd.modules || sum(even) != group.even.modules {
+				t.Errorf("value %d SHOULD have %d odd and %d even modules, got %v", value, group.odd.modules,
+					group.even.modules, widths)
+			}
+			got := group.start + dataBarValue(odd, group.odd.widest, group.odd.narrow)*group.evenValues +
+				dataBarValue(even, group.even.widest, group.even.narrow)
+			if got != value {
+				t.Errorf("widths %v of value %d SHOULD decode to the value, got %d", widths, value, got)
+			}
+		}
+	}
+}
+
+func TestDataBarLimitedWeights(t *testing.T) {
+	weight := 1
+	for i := range 28 {
+		if got := dataBarLimitedWeights[i/14][i%14]; got != weight {
+			t.Errorf("weight %d = %d, SHOULD be 3^%d mod 89 = %d", i, got, i, weight)
+		}
+		weight = weight * 3 % dataBarLimitedChecks
+	}
+}
+
+func TestDataBarLimitedCharacters(t *testing.T) {
+	value, err := dataBarLimitedValue(mustParse(t, "(01)15012345678907"))
+	if err != nil {
+		t.Fatalf("dataBarLimitedValue() error = %v", err)
+	}
+	if value != 1501234567890 {
+		t.Errorf("dataBarLimitedValue() = %d, want 1501234567890", value)
+	}
+	left, right, check := dataBarLimitedCharacters(value)
+	if sum(left) != 26 || sum(right) != 26 {
+		t.Errorf("data characters SHOULD be 26 modules wide, got %v and %v", left, right)
+	}
+	if check < 0 || check >= dataBarLimitedChecks {
+		t.Errorf("check character value %d SHOULD be less than %d", check, dataBarLimitedChecks)
+	}
+}
+
+func TestEncodeDataBarLimited(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     gs1.Message
+		wantErr string
+	}{
+		{name: "indicator digit above 1 SHOULD be rejected", msg: mustParse(t, "(01)24012345678905"),
+			wantErr: "indicator digit 0 or 1"},
+		{name: "wrong check digit SHOULD be rejected", msg: mustParse(t, "(01)15012345678908"),
+			wantErr: "check digit must be 7"},
+		{name: "elements other than the GTIN SHOULD be rejected", msg: mustParse(t, "(01)15012345678907(10)A"),
+			wantErr: "AI (01) only"},
+		{name: "empty message SHOULD be rejected", wantErr: errNoElements.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := EncodeDataBarLimited(tt.msg)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("EncodeDataBarLimited() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := EncodeDataBarLimited(mustParse(t, "(01)15012345678907")); !errors.Is(err, errDataBarLimitedCheck) {
+		t.Errorf("EncodeDataBarLimited() error = %v, want %v", err, errDataBarLimitedCheck)
+	}
+}
+
+func sum(widths []int) int {
+	n := 0
+	for _, width := range widths {
+		n += width
+	}
+	return n
+}
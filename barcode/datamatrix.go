@@ -0,0 +1,370 @@
+package barcode
+
+import (
+	"fmt"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+// dataMatrixQuietZone is the minimum quiet zone around a DataMatrix symbol in modules.
+const dataMatrixQuietZone = 1
+
+// DataMatrixShape restricts the sizes a DataMatrix symbol is chosen from.
+type DataMatrixShape int
+
+const (
+	// DataMatrixSquare chooses square symbols from 10x10 to 144x144 modules.
+	DataMatrixSquare DataMatrixShape = iota
+	// DataMatrixRectangular chooses rectangular symbols from 8x18 to 16x48 modules.
+	DataMatrixRectangular
+	// DataMatrixAny chooses the symbol of the least area among square and rectangular symbols.
+	DataMatrixAny
+)
+
+// dataMatrixSize describes a DataMatrix ECC 200 symbol size as defined in ISO/IEC 16022, table 7, and for the
+// rectangular extensions (DMRE) in ISO/IEC 21471.
+type dataMatrixSize struct {
+	rows, columns             int // rows and columns of modules including finder patterns
+	regionRows, regionColumns int // rows and columns of modules of a single data region
+	dataCodewords             int
+	eccCodewords              int // error correction codewords of all blocks
+	blocks                    int // interleaved Reed-Solomon blocks
+	dmre                      bool
+}
+
+func (s dataMatrixSize) square() bool {
+	return s.rows == s.columns
+}
+
+// mappingRows returns the amount of rows of the mapping matrix holding the data regions without finder patterns.
+func (s dataMatrixSize) mappingRows() int {
+	return s.rows / (s.regionRows + 2) * s.regionRows
+}
+
+// mappingColumns returns the amount of columns of the mapping matrix.
+func (s dataMatrixSize) mappingColumns() int {
+	return s.columns / (s.regionColumns + 2) * s.regionColumns
+}
+
+var dataMatrixSizes = []dataMatrixSize{
+	{10, 10, 8, 8, 3, 5, 1, false},
+	{12, 12, 10, 10, 5, 7, 1, false},
+	{14, 14, 12, 12, 8, 10, 1, false},
+	{16, 16, 14, 14, 12, 12, 1, false},
+	{18, 18, 16, 16, 18, 14, 1, false},
+	{20, 20, 18, 18, 22, 18, 1, false},
+	{22, 22, 20, 20, 30, 20, 1, false},
+	{24, 24, 22, 22, 36, 24, 1, false},
+	{26, 26, 24, 24, 44, 28, 1, false},
+	{32, 32, 14, 14, 62, 36, 1, false},
+	{36, 36, 16, 16, 86, 42, 1, false},
+	{40, 40, 18, 18, 114, 48, 1, false},
+	{44, 44, 20, 20, 144, 56, 1, false},
+	{48, 48, 22, 22, 174, 68, 1, false},
+	{52, 52, 24, 24, 204, 84, 2, false},
+	{64, 64, 14, 14, 280, 112, 2, false},
+	{72, 72, 16, 16, 368, 144, 4, false},
+	{80, 80, 18, 18, 456, 192, 4, false},
+	{88, 88, 20, 20, 576, 224, 4, false},
+	{96, 96, 22, 22, 696, 272, 4, false},
+	{104, 104, 24, 24, 816, 336, 6, false},
+	{120, 120, 18, 18, 1050, 408, 6, false},
+	{132, 132, 20, 20, 1304, 496, 8, false},
+	{144, 144, 22, 22, 1558, 620, 10, false},
+	{8, 18, 6, 16, 5, 7, 1, false},
+	{8, 32, 6, 14, 10, 11, 1, false},
+	{12, 26, 10, 24, 16, 14, 1, false},
+	{12, 36, 10, 16, 22, 18, 1, false},
+	{16, 36, 14, 16, 32, 24, 1, false},
+	{16, 48, 14, 22, 49, 28, 1, false},
+	{8, 48, 6, 22, 18, 15, 1, true},
+	{8, 64, 6, 14, 24, 18, 1, true},
+	{8, 80, 6, 18, 32, 22, 1, true},
+	{8, 96, 6, 22, 38, 28, 1, true},
+	{8, 120, 6, 18, 49, 32, 1, true},
+	{8, 144, 6, 22, 63, 36, 1, true},
+	{12, 64, 10, 14, 43, 27, 1, true},
+	{12, 88, 10, 20, 64, 36, 1, true},
+	{16, 64, 14, 14, 62, 36, 1, true},
+	{20, 36, 18, 16, 44, 28, 1, true},
+	{20, 44, 18, 20, 56, 34, 1, true},
+	{20, 64, 18, 14, 84, 42, 1, true},
+	{22, 48, 20, 22, 72, 38, 1, true},
+	{24, 48, 22, 22, 80, 41, 1, true},
+	{24, 64, 22, 14, 108, 46, 1, true},
+	{26, 40, 24, 18, 70, 38, 1, true},
+	{26, 48, 24, 22, 90, 42, 1, true},
+	{26, 64, 24, 14, 118, 50, 1, true},
+}
+
+// dataMatrixOptions are the options of [EncodeDataMatrix].
+type dataMatrixOptions struct {
+	shape         DataMatrixShape
+	dmre          bool
+	rows, columns int
+}
+
+// DataMatrixOption configures [EncodeDataMatrix].
+type DataMatrixOption func(*dataMatrixOptions)
+
+// WithDataMatrixShape restricts the symbol to the given shape. Symbols are square by default.
+func WithDataMatrixShape(shape DataMatrixShape) DataMatrixOption {
+	return func(o *dataMatrixOptions) {
+		o.shape = shape
+	}
+}
+
+// WithDMRE additionally allows the rectangular extension sizes of ISO/IEC 21471, e.g. 8x48 or 26x64, for
+// rectangular symbols. Not all scanners support these sizes.
+func WithDMRE() DataMatrixOption {
+	return func(o *dataMatrixOptions) {
+		o.dmre = true
+	}
+}
+
+// WithDataMatrixSize requires a symbol of the given amount of rows and columns, e.g. 16x48. Encoding fails if the
+// size does not exist or the message does not fit.
+func WithDataMatrixSize(rows, columns int) DataMatrixOption {
+	return func(o *dataMatrixOptions) {
+		o.rows, o.columns = rows, columns
+	}
+}
+
+// validateSize checks that the size required by [WithDataMatrixSize] exists.
+func (o *dataMatrixOptions) validateSize() error {
+	if o.rows == 0 && o.columns == 0 {
+		return nil
+	}
+	if _, ok := lookupDataMatrixSize(o.rows, o.columns); !ok {
+		return fmt.Errorf("invalid DataMatrix size %dx%d", o.rows, o.columns)
+	}
+	return nil
+}
+
+// allows reports whether the symbol size may be chosen.
+func (o *dataMatrixOptions) allows(size dataMatrixSize) bool {
+	if o.rows != 0 || o.columns != 0 {
+		return size.rows == o.rows && size.columns == o.columns
+	}
+	switch {
+	case size.dmre && !o.dmre:
+		return false
+	case o.shape == DataMatrixSquare:
+		return size.square()
+	case o.shape == DataMatrixRectangular:
+		return !size.square()
+	default:
+		return true
+	}
+}
+
+// EncodeDataMatrix encodes the message as GS1 DataMatrix (ECC 200) symbol. The symbol starts with FNC1 in first
+// position and separates AIs without predefined length by FNC1. ASCII, C40, Text, X12, EDIFACT and Base256
+// encodation are combined to produce the least amount of codewords, which are placed in the smallest symbol allowed
+// by the options.
+func EncodeDataMatrix(msg gs1.Message, opts ...DataMatrixOption) (*Matrix, error) {
+	if len(msg.Elements) == 0 {
+		return nil, errNoElements
+	}
+	o := &dataMatrixOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if err := o.validateSize(); err != nil {
+		return nil, err
+	}
+
+	codewords := encodeDataMatrixData(gs1Symbols(msg.AsBarcodeMessage()))
+	size, ok := selectDataMatrixSize(len(codewords.data), codewords.optionalUnlatch, o)
+	if !ok {
+		if o.rows != 0 || o.columns != 0 {
+			return nil, fmt.Errorf("%w: %d codewords do not fit into a %dx%d DataMatrix symbol", ErrDataTooLong,
+				len(codewords.data), o.rows, o.columns)
+		}
+		return nil, fmt.Errorf("%w: %d codewords exceed the capacity of DataMatrix symbols", ErrDataTooLong,
+			len(codewords.data))
+	}
+	data := codewords.data
+	if len(data) > size.dataCodewords {
+		// The trailing unlatch is omitted if the data ends at the end of the symbol.
+		data = data[:size.dataCodewords]
+	}
+	return dataMatrixSymbol(size, padDataMatrix(data, size.dataCodewords)), nil
+}
+
+// lookupDataMatrixSize returns the symbol size of the given amount of rows and columns.
+func lookupDataMatrixSize(rows, columns int) (dataMatrixSize, bool) {
+	for _, size := range dataMatrixSizes {
+		if size.rows == rows && size.columns == columns {
+			return size, true
+		}
+	}
+	return dataMatrixSize{}, false
+}
+
+// selectDataMatrixSize returns the allowed size of the least area holding the amount of data codewords. If the
+// encodation ends with an optional unlatch, a size holding all but the unlatch is sufficient.
+func selectDataMatrixSize(n int, optionalUnlatch bool, o *dataMatrixOptions) (dataMatrixSize, bool) {
+	var best dataMatrixSize
+	found := false
+	for _, size := range dataMatrixSizes {
+		fits := size.dataCodewords >= n || optionalUnlatch && size.dataCodewords == n-1
+		if !fits || !o.allows(size) {
+			continue
+		}
+		if !found || size.rows*size.columns < best.rows*best.columns {
+			best, found = size, true
+		}
+	}
+	return best, found
+}
+
+// padDataMatrix fills the remaining data codewords of the symbol with pad codewords randomised by the 253-state
+// algorithm.
+func padDataMatrix(data []byte, n int) []byte {
+	padded := make([]byte, len(data), n)
+	copy(padded, data)
+	if len(padded) < n {
+		padded = append(padded, dmPad)
+	}
+	for len(padded) < n {
+		pos := len(padded) + 1
+		pad := dmPad + (149*pos)%253 + 1
+		if pad > 254 {
+			pad -= 254
+		}
+		padded = append(padded, byte(pad))
+	}
+	return padded
+}
+
+// dataMatrixSymbol adds the error correction codewords to the data codewords and places them in a symbol of the
+// given size.
+func dataMatrixSymbol(size dataMatrixSize, data []byte) *Matrix {
+	codewords := make([]byte, size.dataCodewords+size.eccCodewords)
+	copy(codewords, data)
+	eccPerBlock := size.eccCodewords / size.blocks
+	for block := range size.blocks {
+		var blockData []byte
+		for i := block; i < len(data); i += size.blocks {
+			blockData = append(blockData, data[i])
+		}
+		for i, cw := range dataMatrixField.ecc(blockData, eccPerBlock, 1) {
+			codewords[size.dataCodewords+i*size.blocks+block] = cw
+		}
+	}
+
+	mapping := placeDataMatrix(size.mappingRows(), size.mappingColumns(), codewords)
+	m := NewMatrix(size.columns, size.rows, dataMatrixQuietZone)
+	blockRows, blockColumns := size.regionRows+2, size.regionColumns+2
+	for y := range size.rows {
+		for x := range size.columns {
+			bx, by := x%blockColumns, y%blockRows
+			var dark bool
+			switch {
+			case bx == 0 || by == blockRows-1:
+				dark = true
+			case by == 0:
+				dark = bx%2 == 0
+			case bx == blockColumns-1:
+				dark = by%2 == 1
+			default:
+				row := y/blockRows*size.regionRows + by - 1
+				column := x/blockColumns*size.regionColumns + bx - 1
+				dark = mapping[row*size.mappingColumns()+column]
+			}
+			m.Set(x, y, dark)
+		}
+	}
+	return m
+}
+
+// placeDataMatrix places the bits of the codewords in a mapping matrix as defined in ISO/IEC 16022, annex F.
+func placeDataMatrix(rows, columns int, codewords []byte) []bool {
+	placed := make([]bool, rows*columns)
+	dark := make([]bool, rows*columns)
+
+	// module places bit (1 is the most significant bit) of the codeword, wrapping around the matrix borders.
+	module := func(row, column int, cw byte, bit int) {
+		if row < 0 {
+			row += rows
+			column += 4 - (rows+4)%8
+		}
+		if column < 0 {
+			column += columns
+			row += 4 - (columns+4)%8
+		}
+		// Some rectangular extension sizes wrap below the last row, see ISO/IEC 21471, annex E.
+		if row >= rows {
+			row -= rows
+		}
+		placed[row*columns+column] = true
+		dark[row*columns+column] = cw>>(8-bit)&1 == 1
+	}
+	// shape places a codeword at the positions of its eight bits.
+	shape := func(cw byte, positions [8][2]int) {
+		for i, p := range positions {
+			module(p[0], p[1], cw, i+1)
+		}
+	}
+	utah := func(row, column int, cw byte) {
+		shape(cw, [8][2]int{
+			{row - 2, column - 2}, {row - 2, column - 1}, {row - 1, column - 2}, {row - 1, column - 1},
+			{row - 1, column}, {row, column - 2}, {row, column - 1}, {row, column},
+		})
+	}
+	r, c := rows, columns
+	corners := [4][8][2]int{
+		{{r - 1, 0}, {r - 1, 1}, {r - 1, 2}, {0, c - 2}, {0, c - 1}, {1, c - 1}, {2, c - 1}, {3, c - 1}},
+		{{r - 3, 0}, {r - 2, 0}, {r - 1, 0}, {0, c - 4}, {0, c - 3}, {0, c - 2}, {0, c - 1}, {1, c - 1}},
+		{{r - 3, 0}, {r - 2, 0}, {r - 1, 0}, {0, c - 2}, {0, c - 1}, {1, c - 1}, {2, c - 1}, {3, c - 1}},
+		{{r - 1, 0}, {r - 1, c - 1}, {0, c - 3}, {0, c - 2}, {0, c - 1}, {1, c - 3}, {1, c - 2}, {1, c - 1}},
+	}
+
+	next := 0
+	nextCodeword := func() byte {
+		next++
+		return codewords[next-1]
+	}
+	row, column := 4, 0
+	for row < rows || column < columns {
+		switch {
+		case row == rows && column == 0:
+			shape(nextCodeword(), corners[0])
+		case row == rows-2 && column == 0 && columns%4 != 0:
+			shape(nextCodeword(), corners[1])
+		case row == rows-2 && column == 0 && columns%8 == 4:
+			shape(nextCodeword(), corners[2])
+		case row == rows+4 && column == 2 && columns%8 == 0:
+			shape(nextCodeword(), corners[3])
+		}
+		// Sweep upwards to the right.
+		for {
+			if row < rows && column >= 0 && !placed[row*columns+column] {
+				utah(row, column, nextCodeword())
+			}
+			row, column = row-2, column+2
+			if row < 0 || column >= columns {
+				break
+			}
+		}
+		row, column = row+1, column+3
+		// Sweep downwards to the left.
+		for {
+			if row >= 0 && column < columns && !placed[row*columns+column] {
+				utah(row, column, nextCodeword())
+			}
+			row, column = row+2, column-2
+			if row >= rows || column < 0 {
+				break
+			}
+		}
+		row, column = row+3, column+1
+	}
+	// Unused modules in the lower right corner are filled with a fixed pattern.
+	if !placed[rows*columns-1] {
+		dark[rows*columns-1] = true
+		dark[rows*columns-columns-2] = true
+	}
+	return dark
+}
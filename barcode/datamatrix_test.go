@@ -0,0 +1,167 @@
+package barcode
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEncodeDataMatrix(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		opts       []DataMatrixOption
+		wantWidth  int
+		wantHeight int
+	}{
+		{
+			name:       "GTIN SHOULD fit into a 16x16 symbol",
+			data:       "(01)09501101530003",
+			wantWidth:  16,
+			wantHeight: 16,
+		},
+		{
+			name:       "pharma serialisation SHOULD fit into a 22x22 symbol",
+			data:       "(01)09501101530003(17)251231(10)ABC123(21)1234567890AB",
+			wantWidth:  22,
+			wantHeight: 22,
+		},
+		{
+			name:       "rectangular shape SHOULD choose a rectangular symbol",
+			data:       "(01)09501101530003",
+			opts:       []DataMatrixOption{WithDataMatrixShape(DataMatrixRectangular)},
+			wantWidth:  32,
+			wantHeight: 8,
+		},
+		{
+			name:       "rectangular shape SHOULD choose 12x36 for 18 codewords",
+			data:       "(01)09501101530003(10)ABCDE12345",
+			opts:       []DataMatrixOption{WithDataMatrixShape(DataMatrixRectangular)},
+			wantWidth:  36,
+			wantHeight: 12,
+		},
+		{
+			name:       "DMRE SHOULD choose the smaller 8x48 for 18 codewords",
+			data:       "(01)09501101530003(10)ABCDE12345",
+			opts:       []DataMatrixOption{WithDataMatrixShape(DataMatrixRectangular), WithDMRE()},
+			wantWidth:  48,
+			wantHeight: 8,
+		},
+		{
+			name:       "DMRE SHOULD choose 24x64 instead of a square symbol",
+			data:       "(01)09501101530003(91)" + strings.Repeat("a.", 45),
+			opts:       []DataMatrixOption{WithDataMatrixShape(DataMatrixRectangular), WithDMRE()},
+			wantWidth:  64,
+			wantHeight: 24,
+		},
+		{
+			name:       "fixed size SHOULD be used even if larger than required",
+			data:       "(01)09501101530003",
+			opts:       []DataMatrixOption{WithDataMatrixSize(26, 26)},
+			wantWidth:  26,
+			wantHeight: 26,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := EncodeDataMatrix(mustParse(t, tt.data), tt.opts...)
+			if err != nil {
+				t.Fatalf("EncodeDataMatrix() error = %v", err)
+			}
+			if m.Width() != tt.wantWidth || m.Height() != tt.wantHeight {
+				t.Errorf("EncodeDataMatrix() size = %dx%d, want %dx%d", m.Width(), m.Height(), tt.wantWidth,
+					tt.wantHeight)
+			}
+			// The finder pattern is solid on the left and bottom and alternating on the top and right.
+			for y := range m.Height() {
+				if !m.At(0, y) || m.At(m.Width()-1, y) != (y%2 == 1 || y == m.Height()-1) {
+					t.Fatalf("EncodeDataMatrix() SHOULD have finder patterns on row %d", y)
+				}
+			}
+			for x := range m.Width() {
+				if !m.At(x, m.Height()-1) || m.At(x, 0) != (x%2 == 0) {
+					t.Fatalf("EncodeDataMatrix() SHOULD have finder patterns on column %d", x)
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeDataMatrix_Errors(t *testing.T) {
+	msg := mustParse(t, "(01)09501101530003(17)251231(10)ABC123(21)1234567890AB")
+	if _, err := EncodeDataMatrix(msg, WithDataMatrixSize(10, 10)); !errors.Is(err, ErrDataTooLong) {
+		t.Errorf("EncodeDataMatrix() error = %v, want ErrDataTooLong", err)
+	}
+	if _, err := EncodeDataMatrix(msg, WithDataMatrixSize(11, 11)); err == nil ||
+		!strings.Contains(err.Error(), "invalid DataMatrix size 11x11") {
+		t.Errorf("EncodeDataMatrix() error = %v, want invalid DataMatrix size", err)
+	}
+	long := mustParse(t, "(01)09501101530003"+strings.Repeat("(91)"+strings.Repeat("a.", 45), 30))
+	if _, err := EncodeDataMatrix(long); !errors.Is(err, ErrDataTooLong) {
+		t.Errorf("EncodeDataMatrix() error = %v, want ErrDataTooLong", err)
+	}
+}
+
+func TestDataMatrixSizes(t *testing.T) {
+	for _, size := range dataMatrixSizes {
+		rows, columns := size.mappingRows(), size.mappingColumns()
+		total := size.dataCodewords + size.eccCodewords
+		if rows*columns/8 != total {
+			t.Errorf("%dx%d: mapping matrix holds %d codewords, want %d", size.rows, size.columns, rows*columns/8,
+				total)
+		}
+
+		// All codewords are placed exactly once, unused modules hold the fixed pattern.
+		codewords := make([]byte, total)
+		for i := range codewords {
+			codewords[i] = 0xff
+		}
+		dark := 0
+		for _, d := range placeDataMatrix(rows, columns, codewords) {
+			if d {
+				dark++
+			}
+		}
+		if want := total * 8; dark != want && dark != want+2 {
+			t.Errorf("%dx%d: %d dark modules, want %d", size.rows, size.columns, dark, want)
+		}
+	}
+}
+
+func TestDataMatrixSymbol_ErrorCorrection(t *testing.T) {
+	// Every block of data and error correction codewords is a multiple of the generator polynomial, i.e. it is zero
+	// at the roots a^1 to a^n.
+	for _, size := range dataMatrixSizes {
+		data := make([]byte, size.dataCodewords)
+		for i := range data {
+			data[i] = byte(i*7 + 1)
+		}
+		codewords := make([]byte, size.dataCodewords+size.eccCodewords)
+		copy(codewords, data)
+		eccPerBlock := size.eccCodewords / size.blocks
+		for block := range size.blocks {
+			var blockData []byte
+			for i := block; i < len(data); i += size.blocks {
+				blockData = append(blockData, data[i])
+			}
+			poly := append(blockData, dataMatrixField.ecc(blockData, eccPerBlock, 1)...)
+			for root := 1; root <= eccPerBlock; root++ {
+				var v byte
+				for _, c := range poly {
+					v = dataMatrixField.mul(v, dataMatrixField.exp[root]) ^ c
+				}
+				if v != 0 {
+					t.Fatalf("%dx%d: block %d is not zero at a^%d", size.rows, size.columns, block, root)
+				}
+			}
+		}
+	}
+}
+
+func TestPadDataMatrix(t *testing.T) {
+	got := padDataMatrix([]byte{142, 164, 186}, 8)
+	want := []byte{142, 164, 186, 129, 115, 11, 161, 56}
+	if string(got) != string(want) {
+		t.Errorf("padDataMatrix() = %v, want %v", got, want)
+	}
+}
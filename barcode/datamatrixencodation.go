@@ -0,0 +1,344 @@
+package barcode
+
+// DataMatrix codewords with special meaning in ASCII encodation.
+const (
+	dmPad            = 129
+	dmLatchC40       = 230
+	dmLatchBase256   = 231
+	dmFNC1           = 232
+	dmUpperShift     = 235
+	dmLatchX12       = 238
+	dmLatchText      = 239
+	dmLatchEDIFACT   = 240
+	dmUnlatch        = 254
+	dmEDIFACTUnlatch = 31
+)
+
+// dmMode is a DataMatrix encodation mode. Base256 is not a mode of the shortest path search since it returns to ASCII
+// after a field of known length.
+type dmMode int
+
+const (
+	dmASCII dmMode = iota
+	dmC40
+	dmText
+	dmX12
+	dmEDIFACT
+	dmModes
+)
+
+// dmLatches are the ASCII codewords latching to the modes.
+var dmLatches = [dmModes]byte{dmC40: dmLatchC40, dmText: dmLatchText, dmX12: dmLatchX12, dmEDIFACT: dmLatchEDIFACT}
+
+// dmEdgeKind describes how a state of the shortest encodation has been reached.
+type dmEdgeKind int
+
+const (
+	dmEdgeLatch dmEdgeKind = iota + 1
+	dmEdgeUnlatch
+	dmEdgeASCII
+	dmEdgeTriple
+	dmEdgeEDIFACT
+	dmEdgeEDIFACTEnd
+	dmEdgeBase256
+)
+
+// dmState is the least amount of codewords encoding the first symbols and ending in a mode.
+type dmState struct {
+	cost    int
+	reached bool
+	// from and fromMode identify the previous state, kind the edge between them.
+	from     int
+	fromMode dmMode
+	kind     dmEdgeKind
+}
+
+// dataMatrixCodewords are the data codewords of a message before padding.
+type dataMatrixCodewords struct {
+	data []byte
+	// optionalUnlatch reports whether the last codeword unlatches from C40, Text or X12 and may be omitted if the data
+	// ends at the end of the symbol.
+	optionalUnlatch bool
+}
+
+// encodeDataMatrixData returns the data codewords of the symbols using the least amount of codewords. Symbols are
+// byte values or -1 for FNC1. FNC1 in first position is always encoded in ASCII.
+func encodeDataMatrixData(symbols []int) dataMatrixCodewords {
+	n := len(symbols)
+	states := make([][dmModes]dmState, n+1)
+	states[0][dmASCII] = dmState{reached: true}
+	relax := func(to int, mode dmMode, cost int, from int, fromMode dmMode, kind dmEdgeKind) {
+		if s := &states[to][mode]; !s.reached || cost < s.cost {
+			*s = dmState{cost: cost, reached: true, from: from, fromMode: fromMode, kind: kind}
+		}
+	}
+
+	for i := 0; i <= n; i++ {
+		for _, mode := range []dmMode{dmC40, dmText, dmX12} {
+			if s := states[i][mode]; s.reached {
+				relax(i, dmASCII, s.cost+1, i, mode, dmEdgeUnlatch)
+			}
+		}
+		if s := states[i][dmEDIFACT]; s.reached {
+			relax(i, dmASCII, s.cost+1, i, dmEDIFACT, dmEdgeEDIFACTEnd)
+		}
+		fnc1First := i == 0 && n > 0 && symbols[0] == -1
+		if s := states[i][dmASCII]; s.reached && !fnc1First && i < n {
+			for _, mode := range []dmMode{dmC40, dmText, dmX12, dmEDIFACT} {
+				relax(i, mode, s.cost+1, i, dmASCII, dmEdgeLatch)
+			}
+		}
+		if i == n {
+			break
+		}
+
+		if s := states[i][dmASCII]; s.reached {
+			k, cost := dmASCIIStep(symbols[i:])
+			relax(i+k, dmASCII, s.cost+cost, i, dmASCII, dmEdgeASCII)
+			if !fnc1First {
+				for j := i + 1; j <= n && symbols[j-1] != -1; j++ {
+					relax(j, dmASCII, s.cost+1+dmBase256Header(j-i)+j-i, i, dmASCII, dmEdgeBase256)
+				}
+			}
+		}
+		for _, mode := range []dmMode{dmC40, dmText} {
+			if s := states[i][mode]; s.reached {
+				if k, values := dmTripleStep(mode, symbols[i:]); k > 0 {
+					relax(i+k, mode, s.cost+(values+2)/3*2, i, mode, dmEdgeTriple)
+				}
+			}
+		}
+		if s := states[i][dmX12]; s.reached && i+3 <= n && dmIsX12(symbols[i:i+3]...) {
+			relax(i+3, dmX12, s.cost+2, i, dmX12, dmEdgeTriple)
+		}
+		if s := states[i][dmEDIFACT]; s.reached {
+			if i+4 <= n && dmIsEDIFACT(symbols[i:i+4]...) {
+				relax(i+4, dmEDIFACT, s.cost+3, i, dmEDIFACT, dmEdgeEDIFACT)
+			}
+			for k := 1; k <= 3 && i+k <= n && dmIsEDIFACT(symbols[i:i+k]...); k++ {
+				relax(i+k, dmASCII, s.cost+min(k+1, 3), i, dmEDIFACT, dmEdgeEDIFACTEnd)
+			}
+		}
+	}
+
+	// Data may end in C40, Text or X12 followed by an unlatch, which is omitted if the symbol is full.
+	end := dmASCII
+	for _, mode := range []dmMode{dmC40, dmText, dmX12} {
+		if s := states[n][mode]; s.reached && s.cost+1 <= states[n][end].cost {
+			end = mode
+		}
+	}
+
+	var path []int // pairs of position and mode from the end to the start
+	for i, mode := n, end; i > 0 || mode != dmASCII; {
+		path = append(path, i, int(mode))
+		s := states[i][mode]
+		i, mode = s.from, s.fromMode
+	}
+	w := &dmWriter{}
+	for p := len(path) - 2; p >= 0; p -= 2 {
+		i, mode := path[p], dmMode(path[p+1])
+		s := states[i][mode]
+		w.write(s.kind, mode, symbols[s.from:i])
+	}
+	if end != dmASCII {
+		w.data = append(w.data, dmUnlatch)
+	}
+	return dataMatrixCodewords{data: w.data, optionalUnlatch: end != dmASCII}
+}
+
+// dmASCIIStep returns the amount of symbols encoded by the next ASCII codeword and the amount of codewords.
+func dmASCIIStep(symbols []int) (int, int) {
+	switch c := symbols[0]; {
+	case len(symbols) >= 2 && isDigit(c) && isDigit(symbols[1]):
+		return 2, 1
+	case c >= 128:
+		return 1, 2
+	default:
+		return 1, 1
+	}
+}
+
+// dmBase256Header returns the amount of codewords of the length field of a Base256 field of n bytes.
+func dmBase256Header(n int) int {
+	if n < 250 {
+		return 1
+	}
+	return 2
+}
+
+// dmTripleStep returns the amount of symbols whose C40 or Text values fill whole triples and the amount of values.
+// At the end of data, two remaining values are padded to a triple. It returns zero if the symbols cannot end on a
+// triple.
+func dmTripleStep(mode dmMode, symbols []int) (int, int) {
+	values := 0
+	for k, c := range symbols {
+		values += len(dmTripleValues(mode, c))
+		if values%3 == 0 {
+			return k + 1, values
+		}
+		if k == len(symbols)-1 && values%3 == 2 {
+			return k + 1, values
+		}
+	}
+	return 0, 0
+}
+
+// dmTripleValues returns the C40 or Text values of the symbol including shift values.
+func dmTripleValues(mode dmMode, c int) []int {
+	const shift1, shift2, shift3 = 0, 1, 2
+	switch {
+	case c == -1:
+		return []int{shift2, 27}
+	case c >= 128:
+		return append([]int{shift2, 30}, dmTripleValues(mode, c-128)...)
+	case c == ' ':
+		return []int{3}
+	case isDigit(c):
+		return []int{c - '0' + 4}
+	case mode == dmC40 && c >= 'A' && c <= 'Z', mode == dmText && c >= 'a' && c <= 'z':
+		return []int{c&0x1f + 13}
+	case c < 32:
+		return []int{shift1, c}
+	case c >= 33 && c <= 47:
+		return []int{shift2, c - 33}
+	case c >= 58 && c <= 64:
+		return []int{shift2, c - 58 + 15}
+	case c >= 91 && c <= 95:
+		return []int{shift2, c - 91 + 22}
+	case mode == dmText && c >= 'A' && c <= 'Z':
+		return []int{shift3, c - 64}
+	case mode == dmText:
+		// ` and { | } ~ DEL
+		return []int{shift3, c & 0x1f}
+	default:
+		// ` a-z { | } ~ DEL
+		return []int{shift3, c - 96}
+	}
+}
+
+// dmX12Value returns the X12 value of the symbol or -1 if the symbol is not in the X12 character set.
+func dmX12Value(c int) int {
+	switch {
+	case c == '\r':
+		return 0
+	case c == '*':
+		return 1
+	case c == '>':
+		return 2
+	case c == ' ':
+		return 3
+	case isDigit(c):
+		return c - '0' + 4
+	case c >= 'A' && c <= 'Z':
+		return c - 'A' + 14
+	default:
+		return -1
+	}
+}
+
+func dmIsX12(symbols ...int) bool {
+	for _, c := range symbols {
+		if dmX12Value(c) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// dmIsEDIFACT reports whether the symbols are in the EDIFACT character set of ASCII 32 to 94.
+func dmIsEDIFACT(symbols ...int) bool {
+	for _, c := range symbols {
+		if c < 32 || c > 94 {
+			return false
+		}
+	}
+	return true
+}
+
+// dmWriter appends the codewords of the edges of the shortest encodation.
+type dmWriter struct {
+	data []byte
+}
+
+func (w *dmWriter) write(kind dmEdgeKind, to dmMode, symbols []int) {
+	switch kind {
+	case dmEdgeLatch:
+		w.data = append(w.data, dmLatches[to])
+	case dmEdgeUnlatch:
+		w.data = append(w.data, dmUnlatch)
+	case dmEdgeASCII:
+		w.writeASCII(symbols)
+	case dmEdgeTriple:
+		var values []int
+		for _, c := range symbols {
+			if to == dmX12 {
+				values = append(values, dmX12Value(c))
+			} else {
+				values = append(values, dmTripleValues(to, c)...)
+			}
+		}
+		if len(values)%3 == 2 {
+			values = append(values, 0)
+		}
+		for i := 0; i < len(values); i += 3 {
+			v := 1600*values[i] + 40*values[i+1] + values[i+2] + 1
+			w.data = append(w.data, byte(v>>8), byte(v))
+		}
+	case dmEdgeEDIFACT:
+		w.writeEDIFACT(symbols, false)
+	case dmEdgeEDIFACTEnd:
+		w.writeEDIFACT(symbols, true)
+	case dmEdgeBase256:
+		w.data = append(w.data, dmLatchBase256)
+		if n := len(symbols); n < 250 {
+			w.writeBase256(n)
+		} else {
+			w.writeBase256(n/250 + 249)
+			w.writeBase256(n % 250)
+		}
+		for _, c := range symbols {
+			w.writeBase256(c)
+		}
+	}
+}
+
+func (w *dmWriter) writeASCII(symbols []int) {
+	switch c := symbols[0]; {
+	case len(symbols) == 2:
+		w.data = append(w.data, byte(130+(c-'0')*10+symbols[1]-'0'))
+	case c == -1:
+		w.data = append(w.data, dmFNC1)
+	case c >= 128:
+		w.data = append(w.data, dmUpperShift, byte(c-128+1))
+	default:
+		w.data = append(w.data, byte(c+1))
+	}
+}
+
+// writeEDIFACT packs the 6 bit values of the symbols, optionally followed by the unlatch, into codewords. Bits of the
+// last codeword not used by the values are zero.
+func (w *dmWriter) writeEDIFACT(symbols []int, unlatch bool) {
+	values := make([]int, 0, 4)
+	for _, c := range symbols {
+		values = append(values, c&0x3f)
+	}
+	if unlatch {
+		values = append(values, dmEDIFACTUnlatch)
+	}
+	v := 0
+	for i := range 4 {
+		v <<= 6
+		if i < len(values) {
+			v |= values[i]
+		}
+	}
+	packed := []byte{byte(v >> 16), byte(v >> 8), byte(v)}
+	w.data = append(w.data, packed[:min(len(values), 3)]...)
+}
+
+// writeBase256 appends the value randomised by the 255-state algorithm.
+func (w *dmWriter) writeBase256(v int) {
+	pos := len(w.data) + 1
+	w.data = append(w.data, byte((v+(149*pos)%255+1)%256))
+}
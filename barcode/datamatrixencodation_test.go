@@ -0,0 +1,258 @@
+package barcode
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// decodeDataMatrixData decodes the data codewords to symbols, representing FNC1 as -1.
+func decodeDataMatrixData(codewords []byte) ([]int, error) {
+	var symbols []int
+	upper := 0
+	emit := func(c int) {
+		if c != -1 {
+			c += upper
+		}
+		symbols = append(symbols, c)
+		upper = 0
+	}
+	for pos := 0; pos < len(codewords); {
+		cw := int(codewords[pos])
+		pos++
+		switch {
+		case cw >= 1 && cw <= 128:
+			emit(cw - 1)
+		case cw == dmPad:
+			return symbols, nil
+		case cw >= 130 && cw <= 229:
+			emit('0' + (cw-130)/10)
+			emit('0' + (cw-130)%10)
+		case cw == dmFNC1:
+			emit(-1)
+		case cw == dmUpperShift:
+			upper = 128
+		case cw == dmLatchC40, cw == dmLatchText, cw == dmLatchX12:
+			for pos < len(codewords) && codewords[pos] != dmUnlatch {
+				if pos+1 >= len(codewords) {
+					return nil, fmt.Errorf("incomplete triple at %d", pos)
+				}
+				v := int(codewords[pos])<<8 | int(codewords[pos+1]) - 1
+				pos += 2
+				for _, value := range []int{v / 1600, v / 40 % 40, v % 40} {
+					symbols = append(symbols, value+1000*cw)
+				}
+			}
+			pos++
+			var err error
+			if symbols, err = decodeTriples(symbols); err != nil {
+				return nil, err
+			}
+		case cw == dmLatchEDIFACT:
+			var bits, n int
+			for unlatched := false; !unlatched; {
+				for n < 6 {
+					bits, n = bits<<8|int(codewords[pos]), n+8
+					pos++
+				}
+				v := bits >> (n - 6) & 0x3f
+				n -= 6
+				if v == dmEDIFACTUnlatch {
+					unlatched = true
+					continue
+				}
+				if v < 32 {
+					v += 64
+				}
+				emit(v)
+				if n == 0 {
+					bits = 0
+				}
+			}
+		case cw == dmLatchBase256:
+			derandomise := func() int {
+				pos++
+				return (int(codewords[pos-1]) - (149*pos)%255 - 1 + 256) % 256
+			}
+			length := derandomise()
+			if length >= 250 {
+				length = (length-249)*250 + derandomise()
+			}
+			for range length {
+				emit(derandomise())
+			}
+		default:
+			return nil, fmt.Errorf("unexpected codeword %d at %d", cw, pos-1)
+		}
+	}
+	return symbols, nil
+}
+
+// decodeTriples replaces the C40, Text or X12 values at the end of symbols, marked by adding 1000 times the latch
+// codeword, by the symbols they encode.
+func decodeTriples(symbols []int) ([]int, error) {
+	start := len(symbols)
+	for start > 0 && symbols[start-1] >= 1000*dmLatchC40 {
+		start--
+	}
+	values := symbols[start:]
+	symbols = symbols[:start]
+	shift, upper := -1, 0
+	for _, v := range values {
+		latch, value := v/1000, v%1000
+		c := -2
+		switch {
+		case latch == dmLatchX12:
+			c = []int{'\r', '*', '>', ' '}[min(value, 3)]
+			if value >= 4 && value <= 13 {
+				c = value - 4 + '0'
+			} else if value >= 14 {
+				c = value - 14 + 'A'
+			}
+		case shift == 0:
+			c = value
+		case shift == 1 && value == 27:
+			c = -1
+		case shift == 1 && value == 30:
+			upper, shift = 128, -1
+			continue
+		case shift == 1:
+			c = int("!\"#$%&'()*+,-./:;<=>?@[\\]^_"[value])
+		case shift == 2 && latch == dmLatchC40:
+			c = value + 96
+		case shift == 2:
+			c = int("`ABCDEFGHIJKLMNOPQRSTUVWXYZ{|}~\x7f"[value])
+		case value <= 2:
+			shift = value
+			continue
+		case value == 3:
+			c = ' '
+		case value <= 13:
+			c = value - 4 + '0'
+		case latch == dmLatchC40:
+			c = value - 14 + 'A'
+		default:
+			c = value - 14 + 'a'
+		}
+		shift = -1
+		if c != -1 {
+			c += upper
+		}
+		upper = 0
+		symbols = append(symbols, c)
+	}
+	if shift == 0 {
+		// The pad value of a triple ending the data
+		shift = -1
+	}
+	if shift != -1 {
+		return nil, fmt.Errorf("incomplete shift")
+	}
+	return symbols, nil
+}
+
+func toSymbols(s string) []int {
+	symbols := make([]int, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] == '^' {
+			symbols = append(symbols, -1)
+		} else {
+			symbols = append(symbols, int(s[i]))
+		}
+	}
+	return symbols
+}
+
+func TestEncodeDataMatrixData(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		// latch is the expected latch codeword, or 0 if the data is expected to be encoded in ASCII only.
+		latch byte
+		want  []byte
+	}{
+		{
+			name: "GS1 digits SHOULD be encoded as ASCII digit pairs after FNC1 in first position",
+			data: "^0109501101530003",
+			want: []byte{232, 131, 139, 180, 141, 131, 183, 130, 133},
+		},
+		{
+			name:  "upper case text SHOULD be encoded in C40",
+			data:  "^10ABCDEFGHIJKLMNOP",
+			latch: dmLatchC40,
+		},
+		{
+			name:  "lower case text SHOULD be encoded in Text",
+			data:  "^91abcdefghijklmnopqrstuvwxyz",
+			latch: dmLatchText,
+		},
+		{
+			name:  "X12 data SHOULD be encoded in X12",
+			data:  "A*B>C*D>E*F>G*H>I*J>K*L>M*N>O*P>Q*R>",
+			latch: dmLatchX12,
+		},
+		{
+			name:  "punctuation SHOULD be encoded in EDIFACT",
+			data:  "A.B;C.D;E.F;G.H;I.J;K.L;M.N;O.P;Q.R;S.T;",
+			latch: dmLatchEDIFACT,
+		},
+		{
+			name:  "binary data SHOULD be encoded in Base256",
+			data:  "\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b",
+			latch: dmLatchBase256,
+		},
+		{
+			name:  "FNC1 separators SHOULD be encoded within C40",
+			data:  "^10ABCDEFGHIJKLMN^21ABCDEFGHIJKLMN",
+			latch: dmLatchC40,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			symbols := toSymbols(tt.data)
+			got := encodeDataMatrixData(symbols)
+			if tt.want != nil && !reflect.DeepEqual(got.data, tt.want) {
+				t.Errorf("encodeDataMatrixData() = %v, want %v", got.data, tt.want)
+			}
+			if tt.latch != 0 && bytes.IndexByte(got.data, tt.latch) < 0 {
+				t.Errorf("encodeDataMatrixData() = %v, want latch %d", got.data, tt.latch)
+			}
+			if tt.latch == 0 && tt.want == nil {
+				t.Fatal("invalid test")
+			}
+			decoded, err := decodeDataMatrixData(got.data)
+			if err != nil {
+				t.Fatalf("decode error = %v", err)
+			}
+			if !reflect.DeepEqual(decoded, symbols) {
+				t.Errorf("decoded = %v, want %v", decoded, symbols)
+			}
+		})
+	}
+}
+
+func TestEncodeDataMatrixData_RoundTrip(t *testing.T) {
+	inputs := []string{
+		"^01095011015300031725123110ABC123^21XYZ",
+		"^8200https://example.com/product?id=1234",
+		"^91Mixed CASE data with 1234567890 digits and symbols !\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~",
+		"^99" + strings.Repeat("ABC", 40) + strings.Repeat("abc", 40) + strings.Repeat("12", 30),
+		"\r*> 0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+		"\x00\x01\x1f\x7f\x80\xff\xfe" + strings.Repeat("\xa0", 300),
+		"AB", "ABC", "ABCD", "A.B", "AB.", "abc.", "a", "1", "12", "123",
+	}
+	for _, input := range inputs {
+		symbols := toSymbols(input)
+		got := encodeDataMatrixData(symbols)
+		decoded, err := decodeDataMatrixData(got.data)
+		if err != nil {
+			t.Errorf("%q: decode error = %v", input, err)
+			continue
+		}
+		if !reflect.DeepEqual(decoded, symbols) {
+			t.Errorf("%q: decoded = %v, want %v", input, decoded, symbols)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package barcode
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+const (
+	// eanUPCAddOnGap is the space between an EAN/UPC symbol and its add-on in modules, within the allowed 7 to 12.
+	eanUPCAddOnGap = 9
+	// Minimum quiet zones on each side of EAN/UPC symbols in modules, see GS1 General Specifications, section
+	// 5.2.3.4.
+	ean13QuietZone = 11
+	ean8QuietZone  = 7
+	upcQuietZone   = 9
+)
+
+// eanPatterns are the element widths of the digits of number set A, starting with a light element. Number set B uses
+// the reversed widths, number set C the widths of number set A starting with a dark element.
+var eanPatterns = [10][4]int{
+	{3, 2, 1, 1}, {2, 2, 2, 1}, {2, 1, 2, 2}, {1, 4, 1, 1}, {1, 1, 3, 2},
+	{1, 2, 3, 1}, {1, 1, 1, 4}, {1, 3, 1, 2}, {1, 2, 1, 3}, {3, 1, 1, 2},
+}
+
+// ean13NumberSets are the number sets of the left half of an EAN-13 symbol by its first digit.
+var ean13NumberSets = [10]string{
+	"AAAAAA", "AABABB", "AABBAB", "AABBBA", "ABAABB", "ABBAAB", "ABBBAA", "ABABAB", "ABABBA", "ABBABA",
+}
+
+// upcENumberSets are the number sets of a UPC-E symbol of number system 0 by its check digit. Number system 1 swaps
+// number sets A and B.
+var upcENumberSets = [10]string{
+	"BBBAAA", "BBABAA", "BBAABA", "BBAAAB", "BABBAA", "BAABBA", "BAAABB", "BABABA", "BABAAB", "BAABAB",
+}
+
+// addOn5NumberSets are the number sets of a 5-digit add-on by its check value.
+var addOn5NumberSets = [10]string{
+	"BBAAA", "BABAA", "BAABA", "BAAAB", "ABBAA", "AABBA", "AAABB", "ABABA", "ABAAB", "AABAB",
+}
+
+// EncodeEAN13 encodes the message as EAN-13 symbol. The message must consist of AI (01) with a GTIN-13, i.e. a
+// GTIN-14 starting with 0. The add-on of the message is appended as 2- or 5-digit add-on symbol.
+func EncodeEAN13(msg gs1.Message) (*Linear, error) {
+	gtin, err := eanUPCGTIN(msg, "EAN-13", 13)
+	if err != nil {
+		return nil, err
+	}
+	return eanUPCSymbol(msg, gtin, ean13QuietZone, ean13Modules(gtin))
+}
+
+// EncodeUPCA encodes the message as UPC-A symbol. The message must consist of AI (01) with a GTIN-12, i.e. a GTIN-14
+// starting with 00. The add-on of the message is appended as 2- or 5-digit add-on symbol.
+func EncodeUPCA(msg gs1.Message) (*Linear, error) {
+	gtin, err := eanUPCGTIN(msg, "UPC-A", 12)
+	if err != nil {
+		return nil, err
+	}
+	return eanUPCSymbol(msg, gtin, upcQuietZone, ean13Modules("0"+gtin))
+}
+
+// EncodeEAN8 encodes the message as EAN-8 symbol. The message must consist of AI (01) with a GTIN-8, i.e. a GTIN-14
+// starting with 000000. The add-on of the message is appended as 2- or 5-digit add-on symbol.
+func EncodeEAN8(msg gs1.Message) (*Linear, error) {
+	gtin, err := eanUPCGTIN(msg, "EAN-8", 8)
+	if err != nil {
+		return nil, err
+	}
+	modules := appendElements(nil, true, 1, 1, 1)
+	modules = eanDigits(modules, gtin[:4], "AAAA")
+	modules = appendElements(modules, false, 1, 1, 1, 1, 1)
+	modules = eanDigits(modules, gtin[4:], "CCCC")
+	return eanUPCSymbol(msg, gtin, ean8QuietZone, appendElements(modules, true, 1, 1, 1))
+}
+
+// EncodeUPCE encodes the message as UPC-E symbol. The message must consist of AI (01) with a GTIN-12 of number system
+// 0 or 1 that can be zero-suppressed, see [gs1.UPCAToUPCE]. The add-on of the message is appended as 2- or 5-digit
+// add-on symbol.
+func EncodeUPCE(msg gs1.Message) (*Linear, error) {
+	gtin, err := eanUPCGTIN(msg, "UPC-E", 12)
+	if err != nil {
+		return nil, err
+	}
+	upce, err := gs1.UPCAToUPCE(gtin)
+	if err != nil {
+		return nil, err
+	}
+	sets := upcENumberSets[upce[7]-'0']
+	if upce[0] == '1' {
+		sets = strings.NewReplacer("A", "B", "B", "A").Replace(sets)
+	}
+	modules := appendElements(nil, true, 1, 1, 1)
+	modules = eanDigits(modules, upce[1:7], sets)
+	return eanUPCSymbol(msg, upce, upcQuietZone, appendElements(modules, false, 1, 1, 1, 1, 1, 1))
+}
+
+// eanUPCGTIN returns the last digits of the GTIN of a message consisting of AI (01) only. The GTIN-14 must start with
+// zeros in place of the other digits.
+func eanUPCGTIN(msg gs1.Message, symbology string, digits int) (string, error) {
+	if len(msg.Elements) == 0 {
+		return "", errNoElements
+	}
+	if len(msg.Elements) > 1 || msg.Elements[0].AI != "01" {
+		return "", fmt.Errorf("%s encodes AI (01) only, got %s", symbology, msg.AsElementString())
+	}
+	gtin, err := gs1.ParseGTIN(msg.Elements[0].DataField)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimLeft(string(gtin[:14-digits]), "0") != "" {
+		return "", fmt.Errorf("%s encodes GTIN-%d only, got %s", symbology, digits, gtin)
+	}
+	return string(gtin[14-digits:]), nil
+}
+
+// ean13Modules returns the modules of an EAN-13 symbol without quiet zones. The first digit selects the number sets
+// of the left half.
+func ean13Modules(gtin string) []bool {
+	modules := appendElements(nil, true, 1, 1, 1)
+	modules = eanDigits(modules, gtin[1:7], ean13NumberSets[gtin[0]-'0'])
+	modules = appendElements(modules, false, 1, 1, 1, 1, 1)
+	modules = eanDigits(modules, gtin[7:], "CCCCCC")
+	return appendElements(modules, true, 1, 1, 1)
+}
+
+// eanDigits appends the symbol characters of the digits using number sets A, B or C.
+func eanDigits(modules []bool, digits, sets string) []bool {
+	for i := range len(digits) {
+		widths := eanPatterns[digits[i]-'0']
+		switch sets[i] {
+		case 'A':
+			modules = appendElements(modules, false, widths[:]...)
+		case 'B':
+			modules = appendElements(modules, false, widths[3], widths[2], widths[1], widths[0])
+		case 'C':
+			modules = appendElements(modules, true, widths[:]...)
+		}
+	}
+	return modules
+}
+
+// eanUPCSymbol returns the symbol of the modules and the add-on of the message surrounded by quiet zones. The HRI
+// consists of the digits of the symbol followed by the add-on.
+func eanUPCSymbol(msg gs1.Message, digits string, quietZone int, modules []bool) (*Linear, error) {
+	symbol := make([]bool, quietZone, quietZone+len(modules)+eanUPCAddOnGap+47+quietZone)
+	symbol = append(symbol, modules...)
+	hri := []string{digits}
+	if msg.AddOn != "" {
+		addOn, err := addOnModules(msg.AddOn)
+		if err != nil {
+			return nil, err
+		}
+		symbol = append(append(symbol, make([]bool, eanUPCAddOnGap)...), addOn...)
+		hri = append(hri, msg.AddOn)
+	}
+	return &Linear{
+		Modules:   append(symbol, make([]bool, quietZone)...),
+		QuietZone: quietZone,
+		HRI:       hri,
+	}, nil
+}
+
+// addOnModules returns the modules of a 2- or 5-digit add-on symbol, see GS1 General Specifications, section
+// 5.2.2.6.
+func addOnModules(addOn string) ([]bool, error) {
+	var sets string
+	switch _, err := gs1.CheckDigit(addOn); {
+	case err != nil || len(addOn) != 2 && len(addOn) != 5:
+		return nil, fmt.Errorf("invalid add-on %q: must consist of 2 or 5 digits", addOn)
+	case len(addOn) == 2:
+		sets = [4]string{"AA", "AB", "BA", "BB"}[(int(addOn[0]-'0')*10+int(addOn[1]-'0'))%4]
+	default:
+		sum := 0
+		for i := range 5 {
+			weight := 3
+			if i%2 == 1 {
+				weight = 9
+			}
+			sum += weight * int(addOn[i]-'0')
+		}
+		sets = addOn5NumberSets[sum%10]
+	}
+
+	modules := appendElements(nil, true, 1, 1, 2)
+	for i := range len(addOn) {
+		if i > 0 {
+			modules = appendElements(modules, false, 1, 1)
+		}
+		modules = eanDigits(modules, addOn[i:i+1], sets[i:i+1])
+	}
+	return modules, nil
+}
@@ -0,0 +1,153 @@
+package barcode
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+func TestEncodeEANUPC(t *testing.T) {
+	tests := []struct {
+		name          string
+		encode        func(gs1.Message) (*Linear, error)
+		data          string
+		addOn         string
+		wantQuietZone int
+		want          string
+		wantHRI       []string
+	}{
+		{
+			name:          "EAN-8 SHOULD encode four digits on each side of the centre guard",
+			encode:        EncodeEAN8,
+			data:          "(01)00000050123452",
+			wantQuietZone: 7,
+			want:          "#.#.##...#...##.#..##..#..#..##.#.#.#....#.#.###..#..###.##.##..#.#",
+			wantHRI:       []string{"50123452"},
+		},
+		{
+			name:          "UPC-E SHOULD encode the zero-suppressed digits",
+			encode:        EncodeUPCE,
+			data:          "(01)00012345000065",
+			wantQuietZone: 9,
+			want:          "#.#.##..##..#..##.####.#..###.#.###..#.#.####.#.#.#",
+			wantHRI:       []string{"01234565"},
+		},
+		{
+			name:          "EAN-13 SHOULD be followed by a 2-digit add-on",
+			encode:        EncodeEAN13,
+			data:          "(01)05901234123457",
+			addOn:         "12",
+			wantQuietZone: 11,
+			want: "#.#...#.##.#..###.##..##..#..##.####.#..###.#.#.#.##..##.##.##..#....#.#.###..#..###.#...#..#.#" +
+				".........#.##..##..#.#..#..##",
+			wantHRI: []string{"5901234123457", "12"},
+		},
+		{
+			name:          "EAN-13 SHOULD be followed by a 5-digit add-on",
+			encode:        EncodeEAN13,
+			data:          "(01)05901234123457",
+			addOn:         "51234",
+			wantQuietZone: 11,
+			want: "#.#...#.##.#..###.##..##..#..##.####.#..###.#.#.#.##..##.##.##..#....#.#.###..#..###.#...#..#.#" +
+				".........#.##.##...#.#..##..#.#..##.##.#.####.#.#..###.#",
+			wantHRI: []string{"5901234123457", "51234"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := mustParse(t, tt.data)
+			msg.AddOn = tt.addOn
+			got, err := tt.encode(msg)
+			if err != nil {
+				t.Fatalf("encode() error = %v", err)
+			}
+			if got.QuietZone != tt.wantQuietZone {
+				t.Errorf("encode() quiet zone = %d, want %d", got.QuietZone, tt.wantQuietZone)
+			}
+			var sb strings.Builder
+			for i, dark := range got.Modules {
+				if i < got.QuietZone || i >= len(got.Modules)-got.QuietZone {
+					if dark {
+						t.Fatalf("quiet zone module %d SHOULD be light", i)
+					}
+					continue
+				}
+				if dark {
+					sb.WriteByte('#')
+				} else {
+					sb.WriteByte('.')
+				}
+			}
+			if sb.String() != tt.want {
+				t.Errorf("encode() = %s, want %s", sb.String(), tt.want)
+			}
+			if !reflect.DeepEqual(got.HRI, tt.wantHRI) {
+				t.Errorf("encode() HRI = %v, want %v", got.HRI, tt.wantHRI)
+			}
+		})
+	}
+}
+
+func TestEncodeEANUPC_Sizes(t *testing.T) {
+	tests := []struct {
+		name   string
+		encode func(gs1.Message) (*Linear, error)
+		data   string
+		want   int
+	}{
+		{name: "EAN-13 SHOULD have 95 modules", encode: EncodeEAN13, data: "(01)04006381333931", want: 95 + 2*11},
+		{name: "UPC-A SHOULD have 95 modules", encode: EncodeUPCA, data: "(01)00036000291452", want: 95 + 2*9},
+		{name: "UPC-E of number system 1 SHOULD have 51 modules", encode: EncodeUPCE, data: "(01)00112345000062",
+			want: 51 + 2*9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.encode(mustParse(t, tt.data))
+			if err != nil {
+				t.Fatalf("encode() error = %v", err)
+			}
+			if got.Width() != tt.want {
+				t.Errorf("encode() width = %d, want %d", got.Width(), tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeEANUPC_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		encode  func(gs1.Message) (*Linear, error)
+		data    string
+		addOn   string
+		wantErr string
+	}{
+		{name: "other AIs SHOULD be rejected", encode: EncodeEAN13, data: "(01)04006381333931(10)A",
+			wantErr: "EAN-13 encodes AI (01) only"},
+		{name: "GTIN-14 SHOULD be rejected by EAN-13", encode: EncodeEAN13, data: "(01)14006381333938",
+			wantErr: "EAN-13 encodes GTIN-13 only"},
+		{name: "GTIN-13 SHOULD be rejected by UPC-A", encode: EncodeUPCA, data: "(01)04006381333931",
+			wantErr: "UPC-A encodes GTIN-12 only"},
+		{name: "GTIN-12 SHOULD be rejected by EAN-8", encode: EncodeEAN8, data: "(01)00036000291452",
+			wantErr: "EAN-8 encodes GTIN-8 only"},
+		{name: "wrong check digit SHOULD be rejected", encode: EncodeEAN13, data: "(01)04006381333932",
+			wantErr: "wrong check digit"},
+		{name: "UPC-A without zeros to suppress SHOULD be rejected by UPC-E", encode: EncodeUPCE,
+			data: "(01)00036000291452", wantErr: "cannot be zero-suppressed"},
+		{name: "add-on of 3 digits SHOULD be rejected", encode: EncodeEAN13, data: "(01)04006381333931",
+			addOn: "123", wantErr: "invalid add-on"},
+		{name: "add-on of letters SHOULD be rejected", encode: EncodeEAN13, data: "(01)04006381333931",
+			addOn: "AB", wantErr: "invalid add-on"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := mustParse(t, tt.data)
+			msg.AddOn = tt.addOn
+			_, err := tt.encode(msg)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("encode() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
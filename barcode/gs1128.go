@@ -0,0 +1,244 @@
+package barcode
+
+import (
+	"fmt"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+const (
+	// GS1128MaxDataLength is the maximum amount of data characters of a GS1-128 symbol including AIs and FNC1
+	// separators but excluding the leading FNC1, see GS1 General Specifications, section 5.4.1.
+	GS1128MaxDataLength = 48
+	// GS1128MaxWidthMM is the maximum width of a GS1-128 symbol including quiet zones in millimetres.
+	GS1128MaxWidthMM = 165
+	// gs1128QuietZone is the minimum quiet zone on each side of a GS1-128 symbol in modules.
+	gs1128QuietZone = 10
+)
+
+// Code 128 symbol character values with special meaning.
+const (
+	code128Shift  = 98
+	code128CodeC  = 99
+	code128CodeB  = 100
+	code128CodeA  = 101
+	code128FNC1   = 102
+	code128StartA = 103
+	code128StartB = 104
+	code128StartC = 105
+	code128Stop   = 106
+)
+
+// code128Patterns are the bar and space widths of the Code 128 symbol characters by value, starting with a bar.
+var code128Patterns = [...]string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232", "2331112",
+}
+
+// EncodeGS1128 encodes the message as GS1-128 symbol. The symbol starts with FNC1 in first position and separates AIs
+// without predefined length by FNC1. Code sets A, B and C are switched to produce the least amount of symbol
+// characters.
+func EncodeGS1128(msg gs1.Message) (*Linear, error) {
+	codewords, err := GS1128Codewords(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Linear{
+		Modules:    code128Modules(codewords),
+		QuietZone:  gs1128QuietZone,
+		HRI:        msg.HRI(),
+		MaxWidthMM: GS1128MaxWidthMM,
+	}, nil
+}
+
+// code128Modules returns the modules of the symbol characters surrounded by the quiet zones.
+func code128Modules(codewords []int) []bool {
+	modules := make([]bool, gs1128QuietZone, gs1128QuietZone+11*len(codewords)+2+gs1128QuietZone)
+	for _, cw := range codewords {
+		widths := make([]int, 0, 7)
+		for _, width := range code128Patterns[cw] {
+			widths = append(widths, int(width-'0'))
+		}
+		modules = appendWidths(modules, widths...)
+	}
+	return append(modules, make([]bool, gs1128QuietZone)...)
+}
+
+// GS1128Codewords returns the Code 128 symbol character values of the message including start character, FNC1 in
+// first position, check character and stop character.
+func GS1128Codewords(msg gs1.Message) ([]int, error) {
+	if len(msg.Elements) == 0 {
+		return nil, errNoElements
+	}
+	data := msg.AsBarcodeMessage()
+	if len(data) > GS1128MaxDataLength {
+		return nil, fmt.Errorf("%w: %d data characters exceed the GS1-128 maximum of %d", ErrDataTooLong, len(data),
+			GS1128MaxDataLength)
+	}
+
+	symbols := gs1Symbols(data)
+	for i, c := range symbols {
+		if c > 127 {
+			return nil, fmt.Errorf("character %q at position %d cannot be encoded in Code 128", c, i-1)
+		}
+	}
+
+	codewords := code128Encode(symbols)
+	sum := codewords[0]
+	for i, cw := range codewords[1:] {
+		sum += (i + 1) * cw
+	}
+	return append(codewords, sum%103, code128Stop), nil
+}
+
+// code128Set is a Code 128 code set.
+type code128Set int
+
+const (
+	code128SetA code128Set = iota
+	code128SetB
+	code128SetC
+)
+
+// code128Step describes how a state of the shortest encodation has been reached.
+type code128Step struct {
+	// from is the code set of the previous state. It differs from the code set of the state on a code set switch.
+	from code128Set
+	// n is the amount of symbols encoded by the step. It is zero for code set switches and the start character.
+	n     int
+	shift bool
+}
+
+// code128Encode returns the start character and the data symbol characters of the symbols using the least amount of
+// symbol characters. Symbols are ASCII values or -1 for FNC1.
+func code128Encode(symbols []int) []int {
+	const infinity = 1 << 30
+	// Every symbol character costs 100, symbol characters in code set A cost one more so code set B is chosen if both
+	// result in the same amount of symbol characters.
+	weight := [3]int{101, 100, 100}
+	n := len(symbols)
+	// cost[i][set] is the least weighted amount of symbol characters encoding the first i symbols and ending in set.
+	cost := make([][3]int, n+1)
+	steps := make([][3]code128Step, n+1)
+	for i := range cost {
+		cost[i] = [3]int{infinity, infinity, infinity}
+	}
+	cost[0] = weight
+
+	relax := func(i int, set code128Set, c int, step code128Step) {
+		if c < cost[i][set] {
+			cost[i][set] = c
+			steps[i][set] = step
+		}
+	}
+	for i := 0; i <= n; i++ {
+		if i > 0 {
+			// Switching the code set costs one symbol character. Switches start from a state not reached by a switch.
+			current := cost[i]
+			for to := code128SetA; to <= code128SetC; to++ {
+				for from := code128SetA; from <= code128SetC; from++ {
+					if from != to {
+						relax(i, to, current[from]+weight[to], code128Step{from: from})
+					}
+				}
+			}
+		}
+		if i == n {
+			break
+		}
+		for set := code128SetA; set <= code128SetC; set++ {
+			c := cost[i][set]
+			if c == infinity {
+				continue
+			}
+			if k := code128Consumes(set, symbols[i:]); k > 0 {
+				relax(i+k, set, c+weight[set], code128Step{from: set, n: k})
+			}
+			if set != code128SetC {
+				other := code128SetA + code128SetB - set
+				if code128Consumes(other, symbols[i:]) == 1 {
+					relax(i+1, set, c+2*weight[set], code128Step{from: set, n: 1, shift: true})
+				}
+			}
+		}
+	}
+
+	end := code128SetA
+	for set := code128SetB; set <= code128SetC; set++ {
+		if cost[n][set] < cost[n][end] {
+			end = set
+		}
+	}
+
+	// Walk the steps backwards and emit the symbol characters in reverse.
+	var reversed []int
+	set := end
+	for i := n; i > 0; {
+		step := steps[i][set]
+		switch {
+		case step.n == 0:
+			reversed = append(reversed, [...]int{code128CodeA, code128CodeB, code128CodeC}[set])
+			set = step.from
+		case step.shift:
+			reversed = append(reversed, code128Value(code128SetA+code128SetB-set, symbols[i-1:i]), code128Shift)
+			i--
+		default:
+			reversed = append(reversed, code128Value(set, symbols[i-step.n:i]))
+			i -= step.n
+		}
+	}
+	codewords := make([]int, 0, len(reversed)+1)
+	codewords = append(codewords, [...]int{code128StartA, code128StartB, code128StartC}[set])
+	for i := len(reversed) - 1; i >= 0; i-- {
+		codewords = append(codewords, reversed[i])
+	}
+	return codewords
+}
+
+// code128Consumes returns the amount of symbols encoded by the next symbol character in the code set or zero if the
+// next symbol cannot be encoded in the code set.
+func code128Consumes(set code128Set, symbols []int) int {
+	c := symbols[0]
+	switch {
+	case c == -1:
+		return 1
+	case set == code128SetC:
+		if len(symbols) >= 2 && isDigit(c) && isDigit(symbols[1]) {
+			return 2
+		}
+		return 0
+	case set == code128SetA && c < 96, set == code128SetB && c >= 32:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// code128Value returns the symbol character value of the symbols in the code set.
+func code128Value(set code128Set, symbols []int) int {
+	c := symbols[0]
+	switch {
+	case c == -1:
+		return code128FNC1
+	case set == code128SetC:
+		return (c-'0')*10 + symbols[1] - '0'
+	case c < 32:
+		return c + 64
+	default:
+		return c - 32
+	}
+}
+
+func isDigit(c int) bool {
+	return c >= '0' && c <= '9'
+}
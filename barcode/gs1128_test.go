@@ -0,0 +1,136 @@
+package barcode
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+func mustParse(t *testing.T, data string) gs1.Message {
+	t.Helper()
+	msg, err := gs1.ParseMessage(data)
+	if err != nil {
+		t.Fatalf("ParseMessage(%q) error = %v", data, err)
+	}
+	return msg
+}
+
+func TestCode128Patterns(t *testing.T) {
+	for value, pattern := range code128Patterns {
+		want := 11
+		if value == code128Stop {
+			want = 13
+		}
+		sum := 0
+		for _, width := range pattern {
+			sum += int(width - '0')
+		}
+		if sum != want {
+			t.Errorf("pattern of value %d has %d modules, want %d", value, sum, want)
+		}
+	}
+}
+
+func TestGS1128Codewords(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []int
+	}{
+		{
+			name: "GTIN SHOULD be encoded in code set C",
+			data: "(01)09501101530003",
+			want: []int{105, 102, 1, 9, 50, 11, 1, 53, 0, 3, 71, 106},
+		},
+		{
+			name: "alphanumeric data SHOULD switch to code set B and back to C for digit runs",
+			data: "(01)09501101530003(17)251231(10)ABC123(21)12345",
+			want: []int{105, 102, 1, 9, 50, 11, 1, 53, 0, 3, 17, 25, 12, 31, 10, 100, 33, 34, 35, 17, 99, 23, 102,
+				21, 12, 34, 100, 21, 43, 106},
+		},
+		{
+			name: "leading odd digit SHOULD be encoded in code set B before switching to C",
+			data: "(91)abcdefgh123456789",
+			want: []int{104, 102, 25, 17, 65, 66, 67, 68, 69, 70, 71, 72, 17, 99, 23, 45, 67, 89, 56, 106},
+		},
+		{
+			name: "short digit runs SHOULD stay in code set B",
+			data: "(10)A1B2C3D4(21)1",
+			want: []int{104, 102, 17, 16, 33, 17, 34, 18, 35, 19, 36, 20, 102, 18, 17, 17, 6, 106},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GS1128Codewords(mustParse(t, tt.data))
+			if err != nil {
+				t.Fatalf("GS1128Codewords() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GS1128Codewords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCode128Encode(t *testing.T) {
+	// Control characters are only available in code set A, lower case letters only in code set B.
+	got := code128Encode([]int{'a', '\t', 'b'})
+	want := []int{code128StartB, 65, code128Shift, 73, 66}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("code128Encode() = %v, want %v", got, want)
+	}
+	got = code128Encode([]int{'\t', '\n', 'a', '\r'})
+	want = []int{code128StartA, 73, 74, code128Shift, 65, 77}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("code128Encode() = %v, want %v", got, want)
+	}
+}
+
+func TestEncodeGS1128(t *testing.T) {
+	msg := mustParse(t, "(01)09501101530003")
+	sym, err := EncodeGS1128(msg)
+	if err != nil {
+		t.Fatalf("EncodeGS1128() error = %v", err)
+	}
+	// 10 quiet zone + 11 per symbol character + 13 stop + 10 quiet zone
+	if got, want := sym.Width(), 10+11*11+13+10; got != want {
+		t.Errorf("Width() = %d, want %d", got, want)
+	}
+	if sym.Modules[9] || !sym.Modules[10] || !sym.Modules[len(sym.Modules)-11] || sym.Modules[len(sym.Modules)-10] {
+		t.Error("EncodeGS1128() SHOULD surround the bars by quiet zones")
+	}
+	if !reflect.DeepEqual(sym.HRI, []string{"(01) 09501101530003"}) {
+		t.Errorf("HRI = %v", sym.HRI)
+	}
+	if err := sym.CheckWidth(0.495); err != nil {
+		t.Errorf("CheckWidth() error = %v", err)
+	}
+}
+
+func TestEncodeGS1128_Limits(t *testing.T) {
+	_, err := EncodeGS1128(mustParse(t, "(01)09501101530003(10)ABCDEFGHIJKLMNOPQRST(21)123456789012"))
+	if !errors.Is(err, ErrDataTooLong) {
+		t.Errorf("EncodeGS1128() error = %v, want ErrDataTooLong", err)
+	}
+
+	sym, err := EncodeGS1128(mustParse(t, "(01)09501101530003(10)ABCDEFGHIJKLMNOPQRST(21)ABCD"))
+	if err != nil {
+		t.Fatalf("EncodeGS1128() error = %v", err)
+	}
+	if err := sym.CheckWidth(1.016); err == nil {
+		t.Error("CheckWidth() SHOULD reject symbols wider than 165 mm")
+	}
+	if err := sym.SVG(&bytes.Buffer{}, 1.016, 32); err == nil || !strings.Contains(err.Error(), "165") {
+		t.Errorf("SVG() error = %v, want width error", err)
+	}
+	if _, err := sym.Image(1.016, 32, 300); err == nil || !strings.Contains(err.Error(), "165") {
+		t.Errorf("Image() error = %v, want width error", err)
+	}
+	if err := sym.PNG(&bytes.Buffer{}, 1.016, 32, 300); err == nil || !strings.Contains(err.Error(), "165") {
+		t.Errorf("PNG() error = %v, want width error", err)
+	}
+}
@@ -0,0 +1,499 @@
+package barcode
+
+import (
+	"fmt"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+// qrQuietZone is the minimum quiet zone around a QR Code symbol in modules.
+const qrQuietZone = 4
+
+// QRLevel is the error correction level of a QR Code symbol.
+type QRLevel int
+
+const (
+	// QRLevelL recovers approximately 7% of the codewords.
+	QRLevelL QRLevel = iota
+	// QRLevelM recovers approximately 15% of the codewords.
+	QRLevelM
+	// QRLevelQ recovers approximately 25% of the codewords.
+	QRLevelQ
+	// QRLevelH recovers approximately 30% of the codewords.
+	QRLevelH
+)
+
+func (l QRLevel) String() string {
+	if l < QRLevelL || l > QRLevelH {
+		return fmt.Sprintf("QRLevel(%d)", int(l))
+	}
+	return string("LMQH"[l])
+}
+
+// formatBits returns the error correction level indicator of the format information.
+func (l QRLevel) formatBits() int {
+	return [...]int{1, 0, 3, 2}[l]
+}
+
+// qrECCPerBlock are the error correction codewords per block by level and version.
+var qrECCPerBlock = [4][41]int{
+	{0, 7, 10, 15, 20, 26, 18, 20, 24, 30, 18, 20, 24, 26, 30, 22, 24, 28, 30, 28, 28, 28, 28, 30, 30, 26, 28, 30, 30,
+		30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{0, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26, 26, 28, 28, 28, 28, 28, 28, 28,
+		28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+	{0, 13, 22, 18, 26, 18, 24, 18, 22, 20, 24, 28, 26, 24, 20, 30, 24, 28, 28, 26, 30, 28, 30, 30, 30, 30, 28, 30, 30,
+		30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{0, 17, 28, 22, 16, 22, 28, 26, 26, 24, 28, 24, 28, 22, 24, 24, 30, 28, 28, 26, 28, 30, 24, 30, 30, 30, 30, 30, 30,
+		30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+}
+
+// qrBlocks are the amount of error correction blocks by level and version.
+var qrBlocks = [4][41]int{
+	{0, 1, 1, 1, 1, 1, 2, 2, 2, 2, 4, 4, 4, 4, 4, 6, 6, 6, 6, 7, 8, 8, 9, 9, 10, 12, 12, 12, 13, 14, 15, 16, 17, 18, 19,
+		19, 20, 21, 22, 24, 25},
+	{0, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16, 17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31,
+		33, 35, 37, 38, 40, 43, 45, 47, 49},
+	{0, 1, 1, 2, 2, 4, 4, 6, 6, 8, 8, 8, 10, 12, 16, 12, 17, 16, 18, 21, 20, 23, 23, 25, 27, 29, 34, 34, 35, 38, 40, 43,
+		45, 48, 51, 53, 56, 59, 62, 65, 68},
+	{0, 1, 1, 2, 4, 4, 4, 5, 6, 8, 8, 11, 11, 16, 16, 18, 16, 19, 21, 25, 25, 25, 34, 30, 32, 35, 37, 40, 42, 45, 48, 51,
+		54, 57, 60, 63, 66, 70, 74, 77, 81},
+}
+
+// qrSize returns the amount of modules per side of the version.
+func qrSize(version int) int {
+	return 4*version + 17
+}
+
+// qrCodewords returns the amount of data and error correction codewords of the version.
+func qrCodewords(version int) int {
+	modules := (16*version+128)*version + 64
+	if version >= 2 {
+		alignments := version/7 + 2
+		modules -= (25*alignments-10)*alignments - 55
+		if version >= 7 {
+			modules -= 36
+		}
+	}
+	return modules / 8
+}
+
+// qrDataCodewords returns the amount of data codewords of the version at the level.
+func qrDataCodewords(version int, level QRLevel) int {
+	return qrCodewords(version) - qrECCPerBlock[level][version]*qrBlocks[level][version]
+}
+
+// qrAlignmentPositions returns the row and column coordinates of the alignment pattern centres of the version.
+func qrAlignmentPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+	n := version/7 + 2
+	step := (version*8 + n*3 + 5) / (n*4 - 4) * 2
+	positions := make([]int, n)
+	positions[0] = 6
+	for i, pos := n-1, qrSize(version)-7; i >= 1; i, pos = i-1, pos-step {
+		positions[i] = pos
+	}
+	return positions
+}
+
+// qrOptions are the options of the QR Code encoders.
+type qrOptions struct {
+	level   QRLevel
+	version int
+	mask    int
+}
+
+// QROption configures [EncodeGS1QR] and [EncodeDigitalLinkQR].
+type QROption func(*qrOptions)
+
+// WithQRLevel sets the error correction level. The default is [QRLevelM].
+func WithQRLevel(level QRLevel) QROption {
+	return func(o *qrOptions) {
+		o.level = level
+	}
+}
+
+// WithQRVersion requires a symbol of the given version from 1 to 40. Encoding fails if the data does not fit. By
+// default, the smallest version holding the data is chosen.
+func WithQRVersion(version int) QROption {
+	return func(o *qrOptions) {
+		o.version = version
+	}
+}
+
+// EncodeGS1QR encodes the message as GS1 QR Code symbol. The symbol starts with the FNC1 in first position mode
+// indicator and separates AIs without predefined length by FNC1. Numeric, alphanumeric and byte mode segments are
+// combined to produce the least amount of bits.
+func EncodeGS1QR(msg gs1.Message, opts ...QROption) (*Matrix, error) {
+	if len(msg.Elements) == 0 {
+		return nil, errNoElements
+	}
+	return encodeQR(gs1Symbols(msg.AsBarcodeMessage()), opts)
+}
+
+// EncodeDigitalLinkQR encodes the message as GS1 Digital Link URI with the given domain, e.g. [gs1.CanonicalPrefix],
+// in a QR Code symbol. Numeric, alphanumeric and byte mode segments are combined to produce the least amount of bits.
+func EncodeDigitalLinkQR(msg gs1.Message, domain string, opts ...QROption) (*Matrix, error) {
+	uri, err := msg.AsDigitalLink(domain)
+	if err != nil {
+		return nil, err
+	}
+	symbols := make([]int, len(uri))
+	for i := range len(uri) {
+		symbols[i] = int(uri[i])
+	}
+	return encodeQR(symbols, opts)
+}
+
+// encodeQR encodes the symbols, starting with -1 for FNC1 in first position mode.
+func encodeQR(symbols []int, opts []QROption) (*Matrix, error) {
+	o := &qrOptions{level: QRLevelM, mask: -1}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.level < QRLevelL || o.level > QRLevelH {
+		return nil, fmt.Errorf("invalid QR Code error correction level %d", o.level)
+	}
+	if o.version < 0 || o.version > 40 {
+		return nil, fmt.Errorf("invalid QR Code version %d: must be within 1 and 40", o.version)
+	}
+
+	fnc1 := len(symbols) > 0 && symbols[0] == -1
+	if fnc1 {
+		symbols = symbols[1:]
+	}
+	version, segments, ok := selectQRVersion(symbols, fnc1, o)
+	if !ok {
+		if o.version != 0 {
+			return nil, fmt.Errorf("%w: data does not fit into a QR Code symbol of version %d-%s", ErrDataTooLong,
+				o.version, o.level)
+		}
+		return nil, fmt.Errorf("%w: data exceeds the capacity of QR Code symbols at level %s", ErrDataTooLong,
+			o.level)
+	}
+	data := qrDataBits(segments, fnc1, version, qrDataCodewords(version, o.level))
+	return qrSymbol(version, o.level, qrInterleave(version, o.level, data), o.mask), nil
+}
+
+// selectQRVersion returns the smallest allowed version holding the symbols and their segmentation.
+func selectQRVersion(symbols []int, fnc1 bool, o *qrOptions) (int, []qrSegment, bool) {
+	for version := 1; version <= 40; version++ {
+		if o.version != 0 && version != o.version {
+			continue
+		}
+		segments, bits := segmentQR(symbols, fnc1, version)
+		if fnc1 {
+			bits += 4
+		}
+		if bits <= qrDataCodewords(version, o.level)*8 {
+			return version, segments, true
+		}
+	}
+	return 0, nil, false
+}
+
+// qrDataBits returns the data codewords of the segments including terminator and padding.
+func qrDataBits(segments []qrSegment, fnc1 bool, version, n int) []byte {
+	w := &bitWriter{}
+	if fnc1 {
+		w.write(qrModeFNC1First, 4)
+	}
+	for _, seg := range segments {
+		seg.write(w, version)
+	}
+	w.write(0, min(4, n*8-w.len))
+	for w.len%8 != 0 {
+		w.write(0, 1)
+	}
+	for i := 0; len(w.data) < n; i++ {
+		w.write([...]int{0xec, 0x11}[i%2], 8)
+	}
+	return w.data
+}
+
+// qrInterleave splits the data codewords into blocks, adds their error correction codewords and interleaves them.
+func qrInterleave(version int, level QRLevel, data []byte) []byte {
+	blocks := qrBlocks[level][version]
+	ecc := qrECCPerBlock[level][version]
+	total := qrCodewords(version)
+	shortBlocks := blocks - total%blocks
+	shortData := total/blocks - ecc
+
+	dataBlocks := make([][]byte, blocks)
+	eccBlocks := make([][]byte, blocks)
+	for b, pos := 0, 0; b < blocks; b++ {
+		n := shortData
+		if b >= shortBlocks {
+			n++
+		}
+		dataBlocks[b] = data[pos : pos+n]
+		eccBlocks[b] = qrField.ecc(dataBlocks[b], ecc, 0)
+		pos += n
+	}
+
+	result := make([]byte, 0, total)
+	for i := 0; i <= shortData; i++ {
+		for _, block := range dataBlocks {
+			if i < len(block) {
+				result = append(result, block[i])
+			}
+		}
+	}
+	for i := range ecc {
+		for _, block := range eccBlocks {
+			result = append(result, block[i])
+		}
+	}
+	return result
+}
+
+// qrSymbol draws the function patterns and the codewords with the given mask, or the mask of the least penalty if
+// mask is negative.
+func qrSymbol(version int, level QRLevel, codewords []byte, mask int) *Matrix {
+	m, function := qrFunctionPatterns(version)
+	for i, pos := range qrPlacement(function) {
+		if i < len(codewords)*8 {
+			m.Set(pos[0], pos[1], codewords[i/8]>>(7-i%8)&1 == 1)
+		}
+	}
+
+	if mask < 0 {
+		best := 0
+		for candidate := range 8 {
+			applyQRMask(m, function, candidate)
+			drawQRFormat(m, level, candidate)
+			if p := qrPenalty(m); candidate == 0 || p < best {
+				best, mask = p, candidate
+			}
+			applyQRMask(m, function, candidate)
+		}
+	}
+	applyQRMask(m, function, mask)
+	drawQRFormat(m, level, mask)
+	return m
+}
+
+// qrFunctionPatterns returns a symbol of the version with finder, timing and alignment patterns and version
+// information drawn, and the matrix of modules not available for data, which includes the format information.
+func qrFunctionPatterns(version int) (*Matrix, *Matrix) {
+	size := qrSize(version)
+	m := NewMatrix(size, size, qrQuietZone)
+	function := NewMatrix(size, size, 0)
+	set := func(x, y int, dark bool) {
+		m.Set(x, y, dark)
+		function.Set(x, y, true)
+	}
+
+	for i := range size {
+		set(6, i, i%2 == 0)
+		set(i, 6, i%2 == 0)
+	}
+	for _, c := range [][2]int{{3, 3}, {size - 4, 3}, {3, size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x, y := c[0]+dx, c[1]+dy
+				if x >= 0 && x < size && y >= 0 && y < size {
+					d := max(abs(dx), abs(dy))
+					set(x, y, d != 2 && d != 4)
+				}
+			}
+		}
+	}
+	positions := qrAlignmentPositions(version)
+	last := len(positions) - 1
+	for i, y := range positions {
+		for j, x := range positions {
+			if i == 0 && j == 0 || i == 0 && j == last || i == last && j == 0 {
+				continue
+			}
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					set(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+				}
+			}
+		}
+	}
+	// Reserve the format information, which is drawn after choosing the mask.
+	for _, pos := range qrFormatPositions(size) {
+		function.Set(pos[0], pos[1], true)
+	}
+	set(8, size-8, true)
+	if version >= 7 {
+		rem := version
+		for range 12 {
+			rem = rem<<1 ^ rem>>11*0x1f25
+		}
+		bits := version<<12 | rem
+		for i := range 18 {
+			a, b := size-11+i%3, i/3
+			set(a, b, bits>>i&1 == 1)
+			set(b, a, bits>>i&1 == 1)
+		}
+	}
+	return m, function
+}
+
+// qrPlacement returns the data modules in the order of codeword bits: two module wide columns from right to left,
+// alternating upwards and downwards, skipping function patterns.
+func qrPlacement(function *Matrix) [][2]int {
+	size := function.Width()
+	var positions [][2]int
+	for right := size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := range size {
+			for j := range 2 {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = size - 1 - vert
+				}
+				if !function.At(x, y) {
+					positions = append(positions, [2]int{x, y})
+				}
+			}
+		}
+	}
+	return positions
+}
+
+// drawQRFormat draws both copies of the format information.
+func drawQRFormat(m *Matrix, level QRLevel, mask int) {
+	data := level.formatBits()<<3 | mask
+	rem := data
+	for range 10 {
+		rem = rem<<1 ^ rem>>9*0x537
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+	for i, pos := range qrFormatPositions(m.Width()) {
+		m.Set(pos[0], pos[1], bits>>(i%15)&1 == 1)
+	}
+}
+
+// qrFormatPositions returns the modules of the two copies of the format information, least significant bit first.
+func qrFormatPositions(size int) [30][2]int {
+	var positions [30][2]int
+	for i := range 15 {
+		switch {
+		case i < 6:
+			positions[i] = [2]int{8, i}
+		case i < 8:
+			positions[i] = [2]int{8, i + 1}
+		case i == 8:
+			positions[i] = [2]int{7, 8}
+		default:
+			positions[i] = [2]int{14 - i, 8}
+		}
+		if i < 8 {
+			positions[15+i] = [2]int{size - 1 - i, 8}
+		} else {
+			positions[15+i] = [2]int{8, size - 15 + i}
+		}
+	}
+	return positions
+}
+
+// applyQRMask inverts the modules of the mask pattern that are not part of function patterns. Applying a mask twice
+// restores the modules.
+func applyQRMask(m, function *Matrix, mask int) {
+	for y := range m.Height() {
+		for x := range m.Width() {
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			if invert && !function.At(x, y) {
+				m.Set(x, y, !m.At(x, y))
+			}
+		}
+	}
+}
+
+// qrPenalty evaluates the symbol by the penalty rules of ISO/IEC 18004, section 7.8.3.
+func qrPenalty(m *Matrix) int {
+	size := m.Width()
+	penalty := 0
+	dark := 0
+	for y := range size {
+		for x := range size {
+			if m.At(x, y) {
+				dark++
+			}
+			// Rule 2: blocks of 2x2 modules of the same colour
+			if x < size-1 && y < size-1 {
+				c := m.At(x, y)
+				if m.At(x+1, y) == c && m.At(x, y+1) == c && m.At(x+1, y+1) == c {
+					penalty += 3
+				}
+			}
+		}
+	}
+	for _, horizontal := range []bool{true, false} {
+		at := func(line, i int) bool {
+			if i < 0 || i >= size {
+				return false
+			}
+			if horizontal {
+				return m.At(i, line)
+			}
+			return m.At(line, i)
+		}
+		for line := range size {
+			// Rule 1: runs of five or more modules of the same colour
+			run := 1
+			for i := 1; i <= size; i++ {
+				if i < size && at(line, i) == at(line, i-1) {
+					run++
+					continue
+				}
+				if run >= 5 {
+					penalty += 3 + run - 5
+				}
+				run = 1
+			}
+			// Rule 3: finder like patterns 1:1:3:1:1 preceded or followed by four light modules
+			for i := range size - 6 {
+				if !at(line, i) || at(line, i+1) || !at(line, i+2) || !at(line, i+3) || !at(line, i+4) ||
+					at(line, i+5) || !at(line, i+6) {
+					continue
+				}
+				before, after := true, true
+				for k := 1; k <= 4; k++ {
+					before = before && !at(line, i-k)
+					after = after && !at(line, i+6+k)
+				}
+				if before || after {
+					penalty += 40
+				}
+			}
+		}
+	}
+	// Rule 4: deviation of the proportion of dark modules from 50% in steps of 5%
+	total := size * size
+	penalty += abs(dark*2-total) * 10 / total * 10
+	return penalty
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,384 @@
+package barcode
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+// decodedQR is the content of a QR Code symbol read back by decodeQR.
+type decodedQR struct {
+	version int
+	level   QRLevel
+	mask    int
+	fnc1    bool
+	data    string
+}
+
+// decodeQR reads the format information, codewords and segments of the symbol. Error correction codewords are
+// verified rather than used for correction. FNC1 is returned as GS.
+func decodeQR(m *Matrix) (decodedQR, error) {
+	var d decodedQR
+	d.version = (m.Width() - 17) / 4
+	format := 0
+	positions := qrFormatPositions(m.Width())
+	for i, pos := range positions[:15] {
+		if m.At(pos[0], pos[1]) {
+			format |= 1 << i
+		}
+	}
+	format ^= 0x5412
+	d.level = QRLevel(slices.Index([]int{1, 0, 3, 2}, format>>13))
+	d.mask = format >> 10 & 7
+
+	unmasked := *m
+	unmasked.modules = slices.Clone(m.modules)
+	_, function := qrFunctionPatterns(d.version)
+	applyQRMask(&unmasked, function, d.mask)
+	codewords := make([]byte, qrCodewords(d.version))
+	for i, pos := range qrPlacement(function)[:len(codewords)*8] {
+		if unmasked.At(pos[0], pos[1]) {
+			codewords[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+
+	// Undo the interleaving of the data codewords and recompute the error correction codewords.
+	blocks := qrBlocks[d.level][d.version]
+	shortBlocks := blocks - len(codewords)%blocks
+	shortData := len(codewords)/blocks - qrECCPerBlock[d.level][d.version]
+	dataBlocks := make([][]byte, blocks)
+	for i, pos := 0, 0; i <= shortData; i++ {
+		for b := range blocks {
+			if i < shortData || b >= shortBlocks {
+				dataBlocks[b] = append(dataBlocks[b], codewords[pos])
+				pos++
+			}
+		}
+	}
+	data := bytes.Join(dataBlocks, nil)
+	if !bytes.Equal(qrInterleave(d.version, d.level, data), codewords) {
+		return d, errors.New("error correction codewords do not match")
+	}
+
+	r := &bitReader{data: data, size: len(data) * 8}
+	var sb strings.Builder
+	for r.remaining() >= 4 {
+		mode := r.read(4)
+		switch mode {
+		case 0:
+			d.data = sb.String()
+			return d, nil
+		case qrModeFNC1First:
+			d.fnc1 = true
+			continue
+		}
+		var m qrMode
+		switch mode {
+		case qrModeNumeric:
+			m = qrNumeric
+		case qrModeAlphanumeric:
+			m = qrAlphanumericMode
+		case qrModeByte:
+			m = qrByte
+		default:
+			return d, fmt.Errorf("unexpected mode indicator %04b", mode)
+		}
+		n := r.read(m.countBits(d.version))
+		var chars []byte
+		switch m {
+		case qrNumeric:
+			for ; n > 0; n -= 3 {
+				k := min(3, n)
+				chars = fmt.Appendf(chars, "%0*d", k, r.read(k*3+1))
+			}
+		case qrAlphanumericMode:
+			for ; n > 0; n -= 2 {
+				if n == 1 {
+					chars = append(chars, qrAlphanumeric[r.read(6)])
+				} else {
+					v := r.read(11)
+					chars = append(chars, qrAlphanumeric[v/45], qrAlphanumeric[v%45])
+				}
+			}
+			if d.fnc1 {
+				// %% is a literal %, a single % is FNC1.
+				chars = []byte(strings.NewReplacer("%%", "%", "%", "\x1d").Replace(string(chars)))
+			}
+		default:
+			for range n {
+				chars = append(chars, byte(r.read(8)))
+			}
+		}
+		sb.Write(chars)
+	}
+	d.data = sb.String()
+	return d, nil
+}
+
+func TestEncodeGS1QR(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		opts        []QROption
+		wantVersion int
+		wantLevel   QRLevel
+	}{
+		{
+			name:        "GTIN SHOULD fit into version 1 at level M",
+			data:        "(01)09501101530003",
+			wantVersion: 1,
+			wantLevel:   QRLevelM,
+		},
+		{
+			name:        "level H SHOULD require a larger version",
+			data:        "(01)09501101530003(17)251231",
+			opts:        []QROption{WithQRLevel(QRLevelH)},
+			wantVersion: 2,
+			wantLevel:   QRLevelH,
+		},
+		{
+			name:        "AIs without predefined length SHOULD be separated by FNC1",
+			data:        "(01)09501101530003(10)ABC123(21)XYZ(17)251231",
+			wantVersion: 2,
+			wantLevel:   QRLevelM,
+		},
+		{
+			name:        "percent sign SHOULD be escaped in alphanumeric mode",
+			data:        "(01)09501101530003(10)AB%12(21)%%X",
+			wantVersion: 2,
+			wantLevel:   QRLevelM,
+		},
+		{
+			name:        "lower case letters SHOULD use byte mode",
+			data:        "(01)09501101530003(21)abcdefghij(10)xyz",
+			wantVersion: 2,
+			wantLevel:   QRLevelM,
+		},
+		{
+			name:        "fixed version SHOULD be used even if larger than required",
+			data:        "(01)09501101530003",
+			opts:        []QROption{WithQRVersion(10), WithQRLevel(QRLevelQ)},
+			wantVersion: 10,
+			wantLevel:   QRLevelQ,
+		},
+		{
+			name:        "long data SHOULD use versions with version information and multiple blocks",
+			data:        "(01)09501101530003(91)" + strings.Repeat("a0123456789B", 7),
+			opts:        []QROption{WithQRLevel(QRLevelH)},
+			wantVersion: 8,
+			wantLevel:   QRLevelH,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := mustParse(t, tt.data)
+			m, err := EncodeGS1QR(msg, tt.opts...)
+			if err != nil {
+				t.Fatalf("EncodeGS1QR() error = %v", err)
+			}
+			if m.Width() != qrSize(tt.wantVersion) || m.Height() != qrSize(tt.wantVersion) {
+				t.Fatalf("EncodeGS1QR() size = %dx%d, want version %d", m.Width(), m.Height(), tt.wantVersion)
+			}
+			got, err := decodeQR(m)
+			if err != nil {
+				t.Fatalf("decodeQR() error = %v", err)
+			}
+			if got.version != tt.wantVersion || got.level != tt.wantLevel {
+				t.Errorf("decodeQR() = %d-%s, want %d-%s", got.version, got.level, tt.wantVersion, tt.wantLevel)
+			}
+			if !got.fnc1 {
+				t.Errorf("decodeQR() SHOULD find the FNC1 in first position mode indicator")
+			}
+			if want := msg.AsBarcodeMessage(); got.data != want {
+				t.Errorf("decodeQR() data = %q, want %q", got.data, want)
+			}
+		})
+	}
+}
+
+func TestEncodeDigitalLinkQR(t *testing.T) {
+	msg := mustParse(t, "(01)09501101530003(10)ABC%1(21)abc")
+	m, err := EncodeDigitalLinkQR(msg, gs1.CanonicalPrefix)
+	if err != nil {
+		t.Fatalf("EncodeDigitalLinkQR() error = %v", err)
+	}
+	got, err := decodeQR(m)
+	if err != nil {
+		t.Fatalf("decodeQR() error = %v", err)
+	}
+	want, err := msg.AsDigitalLink(gs1.CanonicalPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.fnc1 {
+		t.Errorf("decodeQR() SHOULD NOT find the FNC1 in first position mode indicator")
+	}
+	if got.data != want {
+		t.Errorf("decodeQR() data = %q, want %q", got.data, want)
+	}
+}
+
+func TestEncodeGS1QR_Errors(t *testing.T) {
+	msg := mustParse(t, "(01)09501101530003(21)abcdefghij")
+	tests := []struct {
+		name    string
+		msg     gs1.Message
+		opts    []QROption
+		wantErr error
+	}{
+		{name: "data exceeding the fixed version SHOULD fail", msg: msg, opts: []QROption{WithQRVersion(1)},
+			wantErr: ErrDataTooLong},
+		{name: "data exceeding version 40 SHOULD fail", msg: gs1.Message{Elements: slices.Repeat(msg.Elements, 150)},
+			wantErr: ErrDataTooLong},
+		{name: "empty message SHOULD fail", msg: gs1.Message{}, wantErr: errNoElements},
+		{name: "invalid version SHOULD fail", msg: msg, opts: []QROption{WithQRVersion(41)}},
+		{name: "invalid level SHOULD fail", msg: msg, opts: []QROption{WithQRLevel(QRLevelH + 1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := EncodeGS1QR(tt.msg, tt.opts...)
+			if err == nil {
+				t.Fatal("EncodeGS1QR() SHOULD fail")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("EncodeGS1QR() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQRInterleave(t *testing.T) {
+	// ISO/IEC 18004, Annex I: 01234567 as version 1-M
+	data := qrDataBits([]qrSegment{{mode: qrNumeric, symbols: []int{'0', '1', '2', '3', '4', '5', '6', '7'}}}, false,
+		1, qrDataCodewords(1, QRLevelM))
+	want := []byte{0x10, 0x20, 0x0c, 0x56, 0x61, 0x80, 0xec, 0x11, 0xec, 0x11, 0xec, 0x11, 0xec, 0x11, 0xec, 0x11,
+		0xa5, 0x24, 0xd4, 0xc1, 0xed, 0x36, 0xc7, 0x87, 0x2c, 0x55}
+	if got := qrInterleave(1, QRLevelM, data); !bytes.Equal(got, want) {
+		t.Errorf("qrInterleave() = % x, want % x", got, want)
+	}
+}
+
+func TestQRDataCodewords(t *testing.T) {
+	tests := []struct {
+		version int
+		level   QRLevel
+		want    int
+	}{
+		{1, QRLevelL, 19},
+		{1, QRLevelM, 16},
+		{1, QRLevelQ, 13},
+		{1, QRLevelH, 9},
+		{7, QRLevelM, 124},
+		{40, QRLevelL, 2956},
+		{40, QRLevelH, 1276},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d-%s SHOULD have %d data codewords", tt.version, tt.level, tt.want), func(t *testing.T) {
+			if got := qrDataCodewords(tt.version, tt.level); got != tt.want {
+				t.Errorf("qrDataCodewords() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+	for version, want := range map[int]int{1: 26, 2: 44, 7: 196, 14: 581, 40: 3706} {
+		if got := qrCodewords(version); got != want {
+			t.Errorf("qrCodewords(%d) = %d, want %d", version, got, want)
+		}
+	}
+}
+
+func TestQRAlignmentPositions(t *testing.T) {
+	tests := []struct {
+		version int
+		want    []int
+	}{
+		{1, nil},
+		{2, []int{6, 18}},
+		{7, []int{6, 22, 38}},
+		{32, []int{6, 34, 60, 86, 112, 138}},
+		{36, []int{6, 24, 50, 76, 102, 128, 154}},
+		{40, []int{6, 30, 58, 86, 114, 142, 170}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("version %d SHOULD have alignment patterns at %v", tt.version, tt.want), func(t *testing.T) {
+			if got := qrAlignmentPositions(tt.version); !slices.Equal(got, tt.want) {
+				t.Errorf("qrAlignmentPositions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQRSymbol_FormatAndVersion(t *testing.T) {
+	formats := []struct {
+		level QRLevel
+		mask  int
+		want  int
+	}{
+		{QRLevelL, 0, 0b111011111000100},
+		{QRLevelM, 0, 0b101010000010010},
+		{QRLevelQ, 0, 0b011010101011111},
+		{QRLevelH, 0, 0b001011010001001},
+		{QRLevelL, 4, 0b110011000101111},
+		{QRLevelH, 7, 0b000100000111011},
+	}
+	for _, tt := range formats {
+		t.Run(fmt.Sprintf("format of %s with mask %d SHOULD be %015b", tt.level, tt.mask, tt.want), func(t *testing.T) {
+			m := qrSymbol(1, tt.level, nil, tt.mask)
+			positions := qrFormatPositions(m.Width())
+			for i, pos := range positions {
+				if want := tt.want>>(i%15)&1 == 1; m.At(pos[0], pos[1]) != want {
+					t.Fatalf("format bit %d of copy %d = %t, want %t", i%15, i/15+1, !want, want)
+				}
+			}
+			if !m.At(8, m.Height()-8) {
+				t.Error("dark module SHOULD be set")
+			}
+		})
+	}
+
+	versions := []struct {
+		version int
+		want    int
+	}{
+		{7, 0x07c94},
+		{8, 0x085bc},
+		{21, 0x15683},
+		{40, 0x28c69},
+	}
+	for _, tt := range versions {
+		t.Run(fmt.Sprintf("version information of %d SHOULD be %05x", tt.version, tt.want), func(t *testing.T) {
+			m := qrSymbol(tt.version, QRLevelM, nil, 0)
+			size := m.Width()
+			for i := range 18 {
+				want := tt.want>>i&1 == 1
+				if m.At(size-11+i%3, i/3) != want || m.At(i/3, size-11+i%3) != want {
+					t.Fatalf("version bit %d SHOULD be %t in both copies", i, want)
+				}
+			}
+		})
+	}
+}
+
+func TestQRSymbol_Mask(t *testing.T) {
+	data := qrDataBits([]qrSegment{{mode: qrByte, symbols: []int{'g', 's', '1'}}}, false, 1,
+		qrDataCodewords(1, QRLevelL))
+	codewords := qrInterleave(1, QRLevelL, data)
+	auto := qrSymbol(1, QRLevelL, codewords, -1)
+	d, err := decodeQR(auto)
+	if err != nil {
+		t.Fatalf("decodeQR() error = %v", err)
+	}
+	for mask := range 8 {
+		m := qrSymbol(1, QRLevelL, codewords, mask)
+		if mask == d.mask && m.String() != auto.String() {
+			t.Errorf("automatic mask SHOULD equal mask %d", mask)
+		}
+		if p, best := qrPenalty(m), qrPenalty(auto); p < best {
+			t.Errorf("mask %d SHOULD NOT have less penalty %d than the chosen mask %d with %d", mask, p, d.mask, best)
+		}
+	}
+}
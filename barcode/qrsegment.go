@@ -0,0 +1,221 @@
+package barcode
+
+import "strings"
+
+// QR Code mode indicators.
+const (
+	qrModeNumeric      = 0b0001
+	qrModeAlphanumeric = 0b0010
+	qrModeByte         = 0b0100
+	qrModeFNC1First    = 0b0101
+)
+
+// qrAlphanumeric is the character set of the alphanumeric mode ordered by value.
+const qrAlphanumeric = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+// qrMode is a QR Code segment mode.
+type qrMode int
+
+const (
+	qrNumeric qrMode = iota
+	qrAlphanumericMode
+	qrByte
+)
+
+// indicator returns the mode indicator.
+func (m qrMode) indicator() int {
+	return [...]int{qrModeNumeric, qrModeAlphanumeric, qrModeByte}[m]
+}
+
+// countBits returns the length of the character count indicator of the mode in the version.
+func (m qrMode) countBits(version int) int {
+	bits := [...][3]int{{10, 12, 14}, {9, 11, 13}, {8, 16, 16}}[m]
+	switch {
+	case version <= 9:
+		return bits[0]
+	case version <= 26:
+		return bits[1]
+	default:
+		return bits[2]
+	}
+}
+
+// qrSegment is a run of symbols encoded in the same mode.
+type qrSegment struct {
+	mode    qrMode
+	symbols []int
+	// fnc1 reports whether the symbol is in FNC1 mode, in which % has a special meaning in alphanumeric mode.
+	fnc1 bool
+}
+
+// chars returns the characters of the segment. In FNC1 mode, FNC1 is represented as % in alphanumeric mode, which
+// requires % to be doubled, and as GS in byte mode.
+func (s qrSegment) chars() []int {
+	chars := make([]int, 0, len(s.symbols))
+	for _, c := range s.symbols {
+		switch {
+		case c == -1 && s.mode == qrAlphanumericMode:
+			chars = append(chars, '%')
+		case c == -1:
+			chars = append(chars, '\x1d')
+		case c == '%' && s.mode == qrAlphanumericMode && s.fnc1:
+			chars = append(chars, '%', '%')
+		default:
+			chars = append(chars, c)
+		}
+	}
+	return chars
+}
+
+// write appends the mode indicator, character count and data of the segment.
+func (s qrSegment) write(w *bitWriter, version int) {
+	chars := s.chars()
+	w.write(s.mode.indicator(), 4)
+	w.write(len(chars), s.mode.countBits(version))
+	switch s.mode {
+	case qrNumeric:
+		for i := 0; i < len(chars); i += 3 {
+			n := min(3, len(chars)-i)
+			v := 0
+			for _, c := range chars[i : i+n] {
+				v = v*10 + c - '0'
+			}
+			w.write(v, n*3+1)
+		}
+	case qrAlphanumericMode:
+		for i := 0; i < len(chars); i += 2 {
+			if i+1 < len(chars) {
+				w.write(qrAlphanumericValue(chars[i])*45+qrAlphanumericValue(chars[i+1]), 11)
+			} else {
+				w.write(qrAlphanumericValue(chars[i]), 6)
+			}
+		}
+	default:
+		for _, c := range chars {
+			w.write(c, 8)
+		}
+	}
+}
+
+func qrAlphanumericValue(c int) int {
+	return strings.IndexByte(qrAlphanumeric, byte(c))
+}
+
+// qrState is a state of the shortest segmentation. Numeric states track the amount of digits modulo 3 and
+// alphanumeric states the amount of characters modulo 2 since the bits per character depend on the grouping.
+type qrState int
+
+const (
+	qrNumeric0 qrState = iota
+	qrNumeric1
+	qrNumeric2
+	qrAlphanumeric0
+	qrAlphanumeric1
+	qrByteState
+	qrClosed // between segments
+	qrStates
+)
+
+func (s qrState) mode() qrMode {
+	switch {
+	case s <= qrNumeric2:
+		return qrNumeric
+	case s <= qrAlphanumeric1:
+		return qrAlphanumericMode
+	default:
+		return qrByte
+	}
+}
+
+// qrStep is the transition of a state when encoding a symbol, reporting the bits used and the next state.
+func qrStep(s qrState, c int, fnc1 bool) (int, qrState, bool) {
+	switch s {
+	case qrNumeric0, qrNumeric1, qrNumeric2:
+		if c < '0' || c > '9' {
+			return 0, 0, false
+		}
+		return [...]int{4, 3, 3}[s], [...]qrState{qrNumeric1, qrNumeric2, qrNumeric0}[s], true
+	case qrAlphanumeric0, qrAlphanumeric1:
+		chars := 1
+		switch {
+		case c == -1 && fnc1:
+		case c == '%' && fnc1:
+			chars = 2
+		case c < 0 || qrAlphanumericValue(c) < 0:
+			return 0, 0, false
+		}
+		// The first character of a pair uses 6 bits, completing the pair 5 more.
+		bits, next := 0, s
+		for range chars {
+			if next == qrAlphanumeric0 {
+				bits, next = bits+6, qrAlphanumeric1
+			} else {
+				bits, next = bits+5, qrAlphanumeric0
+			}
+		}
+		return bits, next, true
+	default:
+		return 8, qrByteState, true
+	}
+}
+
+// segmentQR returns the segmentation of the symbols using the least amount of bits in the version and the amount of
+// bits excluding the FNC1 mode indicator.
+func segmentQR(symbols []int, fnc1 bool, version int) ([]qrSegment, int) {
+	type state struct {
+		bits    int
+		reached bool
+		prev    qrState
+	}
+	n := len(symbols)
+	states := make([][qrStates]state, n+1)
+	states[0][qrClosed] = state{reached: true}
+	relax := func(i int, to qrState, bits int, prev qrState) {
+		if s := &states[i][to]; !s.reached || bits < s.bits {
+			*s = state{bits: bits, reached: true, prev: prev}
+		}
+	}
+	for i := 0; i <= n; i++ {
+		for s := qrNumeric0; s < qrClosed; s++ {
+			if states[i][s].reached {
+				relax(i, qrClosed, states[i][s].bits, s)
+			}
+		}
+		if i == n {
+			break
+		}
+		if closed := states[i][qrClosed]; closed.reached {
+			for _, s := range []qrState{qrNumeric0, qrAlphanumeric0, qrByteState} {
+				relax(i, s, closed.bits+4+s.mode().countBits(version), qrClosed)
+			}
+		}
+		for s := qrNumeric0; s < qrClosed; s++ {
+			if !states[i][s].reached {
+				continue
+			}
+			if bits, next, ok := qrStep(s, symbols[i], fnc1); ok {
+				relax(i+1, next, states[i][s].bits+bits, s)
+			}
+		}
+	}
+
+	// Walk back collecting the symbols of each segment. Closing and opening a segment keeps the position.
+	var segments []qrSegment
+	end := n
+	for i, s := n, qrClosed; i > 0 || s != qrClosed; {
+		prev := states[i][s].prev
+		switch {
+		case s == qrClosed:
+			end = i
+		case prev == qrClosed:
+			segments = append(segments, qrSegment{mode: s.mode(), symbols: symbols[i:end], fnc1: fnc1})
+		default:
+			i--
+		}
+		s = prev
+	}
+	for l, r := 0, len(segments)-1; l < r; l, r = l+1, r-1 {
+		segments[l], segments[r] = segments[r], segments[l]
+	}
+	return segments, states[n][qrClosed].bits
+}
@@ -0,0 +1,165 @@
+package barcode
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestSegmentQR(t *testing.T) {
+	type segment struct {
+		mode qrMode
+		data string
+	}
+	tests := []struct {
+		name     string
+		data     string
+		fnc1     bool
+		version  int
+		want     []segment
+		wantBits int
+	}{
+		{
+			name:     "digits SHOULD be encoded in a single numeric segment",
+			data:     "0109501101530003",
+			fnc1:     true,
+			version:  1,
+			want:     []segment{{qrNumeric, "0109501101530003"}},
+			wantBits: 4 + 10 + 54,
+		},
+		{
+			name:     "digits followed by upper case letters SHOULD switch to alphanumeric",
+			data:     "010950110153000310ABC123",
+			fnc1:     true,
+			version:  1,
+			want:     []segment{{qrNumeric, "010950110153000310"}, {qrAlphanumericMode, "ABC123"}},
+			wantBits: 4 + 10 + 60 + 4 + 9 + 33,
+		},
+		{
+			name:     "short digit runs SHOULD NOT switch to numeric",
+			data:     "10ABC\x1d21XYZ",
+			fnc1:     true,
+			version:  1,
+			want:     []segment{{qrAlphanumericMode, "10ABC\x1d21XYZ"}},
+			wantBits: 4 + 9 + 5*11 + 6,
+		},
+		{
+			name:     "percent sign SHOULD count twice in FNC1 mode",
+			data:     "AB%",
+			fnc1:     true,
+			version:  1,
+			want:     []segment{{qrAlphanumericMode, "AB%"}},
+			wantBits: 4 + 9 + 2*11,
+		},
+		{
+			name:     "percent sign SHOULD count once without FNC1 mode",
+			data:     "AB%",
+			version:  1,
+			want:     []segment{{qrAlphanumericMode, "AB%"}},
+			wantBits: 4 + 9 + 11 + 6,
+		},
+		{
+			name:     "lower case letters SHOULD use byte mode",
+			data:     "21abc",
+			fnc1:     true,
+			version:  1,
+			want:     []segment{{qrByte, "21abc"}},
+			wantBits: 4 + 8 + 5*8,
+		},
+		{
+			name:    "long digit run within bytes SHOULD use numeric",
+			data:    "abc0123456789012345def",
+			version: 1,
+			want: []segment{
+				{qrByte, "abc"},
+				{qrNumeric, "0123456789012345"},
+				{qrByte, "def"},
+			},
+			wantBits: 4 + 8 + 24 + 4 + 10 + 54 + 4 + 8 + 24,
+		},
+		{
+			name:     "count indicators SHOULD grow with the version",
+			data:     "abc",
+			version:  10,
+			want:     []segment{{qrByte, "abc"}},
+			wantBits: 4 + 16 + 24,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			symbols := make([]int, len(tt.data))
+			for i := range len(tt.data) {
+				symbols[i] = int(tt.data[i])
+				if tt.data[i] == '\x1d' {
+					symbols[i] = -1
+				}
+			}
+			segments, bits := segmentQR(symbols, tt.fnc1, tt.version)
+			var got []segment
+			for _, seg := range segments {
+				var data []byte
+				for _, c := range seg.symbols {
+					if c == -1 {
+						c = '\x1d'
+					}
+					data = append(data, byte(c))
+				}
+				got = append(got, segment{seg.mode, string(data)})
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("segmentQR() = %q, want %q", got, tt.want)
+			}
+			if bits != tt.wantBits {
+				t.Errorf("segmentQR() bits = %d, want %d", bits, tt.wantBits)
+			}
+			w := &bitWriter{}
+			for _, seg := range segments {
+				seg.write(w, tt.version)
+			}
+			if w.len != bits {
+				t.Errorf("written bits = %d, want %d", w.len, bits)
+			}
+		})
+	}
+}
+
+func TestQRSegment_Write(t *testing.T) {
+	tests := []struct {
+		name    string
+		segment qrSegment
+		want    []byte
+	}{
+		{
+			name:    "numeric SHOULD pack three digits into 10 bits",
+			segment: qrSegment{mode: qrNumeric, symbols: []int{'0', '1', '2', '3', '4', '5', '6', '7'}},
+			// 0001 0000001000 0000001100 0101011001 1000011
+			want: []byte{0x10, 0x20, 0x0c, 0x56, 0x61, 0x80},
+		},
+		{
+			name:    "alphanumeric SHOULD pack two characters into 11 bits",
+			segment: qrSegment{mode: qrAlphanumericMode, symbols: []int{'A', 'C', '-', '4', '2'}},
+			// 0010 000000101 00111001110 11100111001 000010
+			want: []byte{0x20, 0x29, 0xce, 0xe7, 0x21, 0x00},
+		},
+		{
+			name:    "FNC1 SHOULD be written as % in alphanumeric mode",
+			segment: qrSegment{mode: qrAlphanumericMode, symbols: []int{'A', -1, '%'}, fnc1: true},
+			// 0010 000000100 00111101000 11011010100
+			want: []byte{0x20, 0x21, 0xe8, 0xda, 0x80},
+		},
+		{
+			name:    "FNC1 SHOULD be written as GS in byte mode",
+			segment: qrSegment{mode: qrByte, symbols: []int{'a', -1}},
+			want:    []byte{0x40, 0x26, 0x11, 0xd0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bitWriter{}
+			tt.segment.write(w, 1)
+			if !bytes.Equal(w.data, tt.want) {
+				t.Errorf("write() = % x, want % x", w.data, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package barcode
+
+// galoisField is the field GF(256) generated by a primitive polynomial.
+type galoisField struct {
+	exp [512]byte
+	log [256]int
+}
+
+var (
+	// dataMatrixField is GF(256) with the polynomial x^8 + x^5 + x^3 + x^2 + 1 used by DataMatrix.
+	dataMatrixField = newGaloisField(0x12d)
+	// qrField is GF(256) with the polynomial x^8 + x^4 + x^3 + x^2 + 1 used by QR Code.
+	qrField = newGaloisField(0x11d)
+)
+
+func newGaloisField(poly int) *galoisField {
+	gf := &galoisField{}
+	x := 1
+	for i := range 255 {
+		gf.exp[i] = byte(x)
+		gf.log[x] = i
+		x <<= 1
+		if x >= 256 {
+			x ^= poly
+		}
+	}
+	// The doubled table saves the reduction of exponents modulo 255 on multiplication.
+	for i := 255; i < len(gf.exp); i++ {
+		gf.exp[i] = gf.exp[i-255]
+	}
+	return gf
+}
+
+func (gf *galoisField) mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gf.exp[gf.log[a]+gf.log[b]]
+}
+
+// generator returns the coefficients of the generator polynomial (x - a^base)(x - a^(base+1))...(x - a^(base+n-1))
+// without the leading coefficient, highest degree first.
+func (gf *galoisField) generator(n, base int) []byte {
+	poly := []byte{1}
+	for i := range n {
+		root := gf.exp[(base+i)%255]
+		next := make([]byte, len(poly)+1)
+		for j, c := range poly {
+			next[j] ^= c
+			next[j+1] ^= gf.mul(c, root)
+		}
+		poly = next
+	}
+	return poly[1:]
+}
+
+// ecc returns the n Reed-Solomon error correction codewords of the data using the generator polynomial with the
+// given first root exponent.
+func (gf *galoisField) ecc(data []byte, n, base int) []byte {
+	gen := gf.generator(n, base)
+	rem := make([]byte, n)
+	for _, d := range data {
+		factor := d ^ rem[0]
+		copy(rem, rem[1:])
+		rem[n-1] = 0
+		for i, g := range gen {
+			rem[i] ^= gf.mul(g, factor)
+		}
+	}
+	return rem
+}
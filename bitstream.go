@@ -0,0 +1,70 @@
+package gs1
+
+import "errors"
+
+var errBitstreamExhausted = errors.New("bit stream exhausted")
+
+// bitWriter appends values most significant bit first to a growing bit stream.
+type bitWriter struct {
+	data []byte
+	len  int // len is the amount of bits written
+}
+
+// writeUint writes the n least significant bits of v.
+func (w *bitWriter) writeUint(v uint64, n int) {
+	for i := n - 1; i >= 0; i-- {
+		w.writeBit(v>>uint(i)&1 == 1)
+	}
+}
+
+func (w *bitWriter) writeBit(bit bool) {
+	if w.len%8 == 0 {
+		w.data = append(w.data, 0)
+	}
+	if bit {
+		w.data[w.len/8] |= 0x80 >> uint(w.len%8)
+	}
+	w.len++
+}
+
+// padTo appends zero bits until the stream is a multiple of n bits long.
+func (w *bitWriter) padTo(n int) {
+	for w.len%n != 0 {
+		w.writeBit(false)
+	}
+}
+
+// bitReader reads values most significant bit first from a bit stream.
+type bitReader struct {
+	data []byte
+	pos  int // pos is the index of the next bit to read
+}
+
+// remaining returns the amount of unread bits.
+func (r *bitReader) remaining() int {
+	return len(r.data)*8 - r.pos
+}
+
+// readUint reads n bits as an unsigned integer. n must not exceed 64.
+func (r *bitReader) readUint(n int) (uint64, error) {
+	if n > r.remaining() {
+		return 0, errBitstreamExhausted
+	}
+	var v uint64
+	for i := 0; i < n; i++ {
+		bit := r.data[r.pos/8] >> uint(7-r.pos%8) & 1
+		v = v<<1 | uint64(bit)
+		r.pos++
+	}
+	return v, nil
+}
+
+// onlyZerosRemaining reports whether all unread bits are zero, e.g. because they pad the last memory word.
+func (r *bitReader) onlyZerosRemaining() bool {
+	for pos := r.pos; pos < len(r.data)*8; pos++ {
+		if r.data[pos/8]>>uint(7-pos%8)&1 == 1 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,57 @@
+package gs1
+
+import "strings"
+
+// cset82 is the GS1 AI encodable character set 82 as defined in GS1 General Specification v25.0, chapter 7.11.
+const cset82 = "!\"%&'()*+,-./0123456789:;<=>?ABCDEFGHIJKLMNOPQRSTUVWXYZ_abcdefghijklmnopqrstuvwxyz"
+
+// cset39 is the GS1 AI encodable character set 39 as defined in GS1 General Specification v25.0, chapter 7.11.
+const cset39 = "#-/0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// isNumeric reports whether s consists of digits only. An empty string is numeric.
+func isNumeric(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isAlphanumeric reports whether c is an ASCII digit or letter.
+func isAlphanumeric(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z')
+}
+
+// isCSET82 reports whether s consists of characters of the GS1 AI encodable character set 82 only.
+func isCSET82(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(cset82, s[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isInCharset reports whether s consists of characters of the Syntax Dictionary character set N, X, Y or Z only.
+func isInCharset(s string, charset byte) bool {
+	var alphabet string
+	switch charset {
+	case 'N':
+		return isNumeric(s)
+	case 'X':
+		alphabet = cset82
+	case 'Y':
+		alphabet = cset39
+	case 'Z':
+		alphabet = base64URLAlphabet
+	default:
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(alphabet, s[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,32 @@
+package gs1
+
+import "errors"
+
+// CheckDigit calculates the GS1 standard check digit for the numeric data without its check digit, e.g. the first 13
+// digits of a GTIN-14.
+func CheckDigit(data string) (byte, error) {
+	if data == "" || !isNumeric(data) {
+		return 0, errors.New("check digit requires numeric data")
+	}
+	return checkDigit(data), nil
+}
+
+// checkDigit calculates the GS1 standard check digit (modulo 10) for the numeric data without its check digit as
+// defined in GS1 General Specification v25.0, chapter 7.9.1.
+func checkDigit(data string) byte {
+	sum := 0
+	for i := len(data) - 1; i >= 0; i-- {
+		digit := int(data[i] - '0')
+		// Weights alternate between 3 and 1 starting with 3 at the right-most position.
+		if (len(data)-1-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// withCheckDigit appends the check digit to the numeric data.
+func withCheckDigit(data string) string {
+	return data + string(checkDigit(data))
+}
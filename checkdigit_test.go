@@ -0,0 +1,48 @@
+package gs1
+
+import "testing"
+
+func TestWithCheckDigit(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "GTIN-14 SHOULD get a valid check digit",
+			data: "0952606405502",
+			want: "09526064055028",
+		},
+		{
+			name: "GLN SHOULD get a valid check digit",
+			data: "061414112345",
+			want: "0614141123452",
+		},
+		{
+			name: "SSCC SHOULD get a valid check digit",
+			data: "10614141123456789",
+			want: "106141411234567897",
+		},
+		{
+			name: "EAN-13 padded to GTIN-14 SHOULD get a valid check digit",
+			data: "0123456789012",
+			want: "01234567890128",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := withCheckDigit(tt.data); got != tt.want {
+				t.Errorf("withCheckDigit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckDigit(t *testing.T) {
+	if got, err := CheckDigit("0952606405502"); err != nil || got != '8' {
+		t.Errorf("CheckDigit() = %c, %v, want 8", got, err)
+	}
+	if _, err := CheckDigit("09526A"); err == nil {
+		t.Error("CheckDigit() SHOULD reject non-numeric data")
+	}
+}
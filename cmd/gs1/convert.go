@@ -0,0 +1,99 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+// fnc1 is the FNC1 character separating variable length AIs within barcode messages.
+const fnc1 = "\x1d"
+
+// runConvert implements `gs1 convert`, which converts the data into element string, barcode message, scan data or
+// Digital Link syntax.
+func runConvert(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("convert", stderr)
+	to := fs.String("to", "element", "Target syntax: element, barcode, scan or dl")
+	symbology := fs.String("symbology", "]d2", "Symbology identifier of scan data")
+	domain := fs.String("domain", gs1.CanonicalPrefix, "Domain of Digital Link URIs")
+	fnc1Substitute := fs.String("fnc1", "^", "Substitute printed in place of FNC1, use GS for the FNC1 character")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	substitute := *fnc1Substitute
+	if substitute == "GS" {
+		substitute = fnc1
+	}
+	var identifier gs1.SymbologyIdentifier
+	if *to == "scan" {
+		var err error
+		if identifier, err = gs1.ParseSymbologyIdentifier(*symbology); err != nil {
+			fmt.Fprintf(stderr, "gs1 convert: %v\n", err)
+			return exitUsage
+		}
+	}
+	convert, ok := map[string]func(gs1.Message) (string, error){
+		"element": func(msg gs1.Message) (string, error) { return msg.AsElementString(), nil },
+		"barcode": func(msg gs1.Message) (string, error) {
+			// The barcode message starts with FNC1 in first position.
+			return substitute + strings.ReplaceAll(msg.AsBarcodeMessage(), fnc1, substitute), nil
+		},
+		"scan": func(msg gs1.Message) (string, error) {
+			return strings.ReplaceAll(msg.AsScanData(identifier), fnc1, substitute), nil
+		},
+		"dl": func(msg gs1.Message) (string, error) { return msg.AsDigitalLink(*domain) },
+	}[*to]
+	if !ok {
+		fmt.Fprintf(stderr, "gs1 convert: unsupported target syntax %q\n", *to)
+		return exitUsage
+	}
+
+	return forEachInput(fs.Args(), stdin, stderr, func(input string) error {
+		msg, err := gs1.ParseAny(input)
+		if err != nil {
+			return err
+		}
+		out, err := convert(msg)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(stdout, out)
+		return err
+	})
+}
+
+// runCheckDigit implements `gs1 check-digit`, which appends the check digit to numeric data or verifies it.
+func runCheckDigit(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("check-digit", stderr)
+	verify := fs.Bool("verify", false, "Verify the last digit instead of calculating it")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	return forEachInput(fs.Args(), stdin, stderr, func(input string) error {
+		data := input
+		if *verify {
+			if len(input) < 2 {
+				return errors.New("data requires at least 2 digits")
+			}
+			data = input[:len(input)-1]
+		}
+		digit, err := gs1.CheckDigit(data)
+		if err != nil {
+			return err
+		}
+		if *verify {
+			if input[len(input)-1] != digit {
+				return fmt.Errorf("wrong check digit %c, want %c", input[len(input)-1], digit)
+			}
+			_, err = fmt.Fprintf(stdout, "%s: OK\n", input)
+			return err
+		}
+		_, err = fmt.Fprintf(stdout, "%s%c\n", data, digit)
+		return err
+	})
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+// decimalAIPrefixes are the AI prefixes whose last digit denotes the implied decimal point of the final component,
+// e.g. AI (3103) NET WEIGHT (kg) with three decimal places.
+var decimalAIPrefixes = []string{"31", "32", "33", "34", "35", "36", "390", "391", "392", "393", "394", "395"}
+
+// runExplain implements `gs1 explain`, which prints every element with its title, components, decoded values and the
+// issues found by validation.
+func runExplain(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("explain", stderr)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	return forEachInput(fs.Args(), stdin, stderr, func(input string) error {
+		msg, err := gs1.ParseAny(input)
+		if err != nil {
+			return err
+		}
+		issues := map[string][]string{}
+		var validationErr *gs1.ValidationError
+		if err := msg.Validate(); errors.As(err, &validationErr) {
+			for _, issue := range validationErr.Issues {
+				issues[issue.AI] = append(issues[issue.AI], issue.Message)
+			}
+		}
+		_, err = io.WriteString(stdout, explain(input, msg, issues))
+		return err
+	})
+}
+
+// explain renders the message and the validation issues keyed by AI.
+func explain(input string, msg gs1.Message, issues map[string][]string) string {
+	builder := strings.Builder{}
+	fmt.Fprintf(&builder, "%s\n  syntax: %s\n", input, msg.SyntaxType)
+	if msg.SyntaxType == gs1.BarcodeMessageScanData {
+		fmt.Fprintf(&builder, "  symbology: %s\n", msg.Symbology)
+	}
+	for _, el := range msg.Elements {
+		fmt.Fprintf(&builder, "  (%s) %s: %s\n", el.AI, el.Title, el.DataField)
+		components, parts, err := el.ComponentData()
+		if err == nil && len(parts) > 1 {
+			for i, part := range parts {
+				decoded := decode(el.AI, components[i], part, i == len(parts)-1)
+				fmt.Fprintf(&builder, "      %-8s %s%s\n", components[i], part, decoded)
+			}
+		} else if err == nil {
+			if decoded := decode(el.AI, components[0], parts[0], true); decoded != "" {
+				fmt.Fprintf(&builder, "      value:%s\n", decoded)
+			}
+		}
+		for _, issue := range issues[el.AI] {
+			fmt.Fprintf(&builder, "      ! %s\n", issue)
+		}
+	}
+	return builder.String()
+}
+
+// decode returns a human-readable value of dates and decimal values preceded by a space, or an empty string.
+func decode(ai string, component gs1.SpecComponent, data string, final bool) string {
+	switch {
+	case slices.Contains(component.Linters, "yymmdd") || slices.Contains(component.Linters, "yymmd0"):
+		if date, err := gs1.ParseDate(data); err == nil {
+			return " " + date.Format(time.DateOnly)
+		}
+	case slices.Contains(component.Linters, "yyyymmdd"):
+		if date, err := time.Parse("20060102", data); err == nil {
+			return " " + date.Format(time.DateOnly)
+		}
+	case final && component.Charset == 'N' && len(ai) == 4 && slices.ContainsFunc(decimalAIPrefixes,
+		func(prefix string) bool { return strings.HasPrefix(ai, prefix) }):
+		places := int(ai[3] - '0')
+		if places > 0 && places < len(data) {
+			integer := strings.TrimLeft(data[:len(data)-places], "0")
+			if integer == "" {
+				integer = "0"
+			}
+			return " " + integer + "." + data[len(data)-places:]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+// Command gs1 parses, validates, converts and explains GS1 data on the command line.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Exit codes of the gs1 command.
+const (
+	exitOK      = 0 // all inputs have been processed successfully
+	exitInvalid = 1 // at least one input is invalid
+	exitUsage   = 2 // the command line is invalid
+)
+
+// command is a subcommand of gs1. It returns the exit code.
+type command struct {
+	Name        string
+	Description string
+	Run         func(args []string, stdin io.Reader, stdout, stderr io.Writer) int
+}
+
+var commands = []command{
+	{Name: "parse", Description: "Parse GS1 data of any supported syntax", Run: runParse},
+	{Name: "validate", Description: "Validate GS1 data against the Syntax Dictionary", Run: runValidate},
+	{Name: "convert", Description: "Convert GS1 data to another syntax", Run: runConvert},
+	{Name: "check-digit", Description: "Calculate or verify GS1 check digits", Run: runCheckDigit},
+	{Name: "explain", Description: "Explain GS1 data per AI", Run: runExplain},
+	{Name: "serve", Description: "Serve the commands as JSON HTTP endpoints", Run: runServe},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return exitUsage
+	}
+	for _, cmd := range commands {
+		if cmd.Name == args[0] {
+			return cmd.Run(args[1:], stdin, stdout, stderr)
+		}
+	}
+	fmt.Fprintf(stderr, "gs1: unknown command %q\n", args[0])
+	usage(stderr)
+	return exitUsage
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: gs1 <command> [flags] [data...]")
+	fmt.Fprintln(w, "\nData is read line by line from stdin if not passed as arguments.\n\nCommands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-12s %s\n", cmd.Name, cmd.Description)
+	}
+}
+
+// newFlagSet creates the flag set of a subcommand writing its errors and usage to stderr.
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("gs1 "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	return fs
+}
+
+// forEachInput calls fn for every argument or, without arguments, for every non-empty line of stdin. Failing inputs
+// are reported on stderr and processing continues with the next input.
+func forEachInput(args []string, stdin io.Reader, stderr io.Writer, fn func(input string) error) int {
+	code := exitOK
+	handle := func(label, input string) {
+		if err := fn(input); err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", label, err)
+			code = exitInvalid
+		}
+	}
+
+	if len(args) > 0 {
+		for _, arg := range args {
+			handle(arg, arg)
+		}
+		return code
+	}
+
+	scanner := bufio.NewScanner(stdin)
+	for line := 1; scanner.Scan(); line++ {
+		input := strings.TrimRight(scanner.Text(), "\r")
+		if input == "" {
+			continue
+		}
+		handle(fmt.Sprintf("line %d", line), input)
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(stderr, "gs1: %v\n", err)
+		return exitInvalid
+	}
+	return code
+}
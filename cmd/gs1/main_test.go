@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		stdin      string
+		wantCode   int
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name: "missing command SHOULD print the usage", wantCode: exitUsage, wantStderr: "Usage: gs1 <command>",
+		},
+		{
+			name: "unknown command SHOULD be rejected", args: []string{"print"}, wantCode: exitUsage,
+			wantStderr: `gs1: unknown command "print"`,
+		},
+		{
+			name: "unknown flag SHOULD be rejected", args: []string{"parse", "-json", "(01)09526064055028"},
+			wantCode: exitUsage, wantStderr: "flag provided but not defined: -json",
+		},
+		{
+			name: "parse SHOULD print JSON", args: []string{"parse", "(01)09526064055028(10)ABC"}, wantCode: exitOK,
+			wantStdout: `{"input":"(01)09526064055028(10)ABC","syntax":"ElementStringSyntax",` +
+				`"elements":{"01":"09526064055028","10":"ABC"}}` + "\n",
+		},
+		{
+			name: "parse SHOULD print the symbology of scan data", args: []string{"parse", "]d20109526064055028"},
+			wantCode: exitOK, wantStdout: `{"input":"]d20109526064055028","syntax":"BarcodeMessageScanData",` +
+				`"symbology":"]d2","elements":{"01":"09526064055028"}}` + "\n",
+		},
+		{
+			name: "parse SHOULD print the HRI", args: []string{"parse", "-format", "hri", "^010952606405502810ABC"},
+			wantCode: exitOK, wantStdout: "(01) 09526064055028 (10) ABC\n",
+		},
+		{
+			name: "parse of an unsupported format SHOULD be rejected", args: []string{"parse", "-format", "xml"},
+			wantCode: exitUsage, wantStderr: `unsupported format "xml"`,
+		},
+		{
+			name: "parse of invalid data SHOULD exit with 1", args: []string{"parse", "bad"}, wantCode: exitInvalid,
+			wantStderr: "bad: unsupported data syntax",
+		},
+		{
+			name: "parse SHOULD read stdin line by line", args: []string{"parse", "-format", "element"},
+			stdin: "^0109526064055028\r\n\nhttps://id.gs1.org/01/09526064055028/10/ABC\n", wantCode: exitOK,
+			wantStdout: "(01)09526064055028\n(01)09526064055028(10)ABC\n",
+		},
+		{
+			name: "failing lines of stdin SHOULD be reported and processing SHOULD continue",
+			args: []string{"parse", "-format", "element"}, stdin: "bad\n(01)09526064055028\n", wantCode: exitInvalid,
+			wantStdout: "(01)09526064055028\n", wantStderr: "line 1: unsupported data syntax",
+		},
+		{
+			name: "validate SHOULD report valid data", args: []string{"validate", "(01)09526064055028"},
+			wantCode: exitOK, wantStdout: "(01)09526064055028: OK\n",
+		},
+		{
+			name: "validate SHOULD report violations", args: []string{"validate", "-q", "(01)09526064055028",
+				"(01)09526064055029"}, wantCode: exitInvalid, wantStderr: "(01)09526064055029: ",
+		},
+		{
+			name: "convert SHOULD convert to element strings", args: []string{"convert", "]C10109526064055028"},
+			wantCode: exitOK, wantStdout: "(01)09526064055028\n",
+		},
+		{
+			name: "convert SHOULD convert to barcode messages",
+			args: []string{"convert", "-to", "barcode", "(01)09526064055028(10)ABC(21)X"}, wantCode: exitOK,
+			wantStdout: "^010952606405502810ABC^21X\n",
+		},
+		{
+			name: "convert SHOULD convert to scan data with FNC1",
+			args: []string{"convert", "-to", "scan", "-symbology", "]C1", "-fnc1", "GS",
+				"(01)09526064055028(10)ABC(21)X"},
+			wantCode: exitOK, wantStdout: "]C1010952606405502810ABC\x1d21X\n",
+		},
+		{
+			name:     "convert SHOULD convert to Digital Link URIs",
+			args:     []string{"convert", "-to", "dl", "-domain", "https://example.com", "(01)09526064055028(10)ABC"},
+			wantCode: exitOK, wantStdout: "https://example.com/01/09526064055028/10/ABC\n",
+		},
+		{
+			name: "convert to Digital Link without primary key SHOULD exit with 1",
+			args: []string{"convert", "-to", "dl", "(10)ABC"}, wantCode: exitInvalid, wantStderr: "(10)ABC: ",
+		},
+		{
+			name: "convert to an unsupported syntax SHOULD be rejected", args: []string{"convert", "-to", "epc"},
+			wantCode: exitUsage, wantStderr: `unsupported target syntax "epc"`,
+		},
+		{
+			name: "convert with an invalid symbology identifier SHOULD be rejected",
+			args: []string{"convert", "-to", "scan", "-symbology", "C1"}, wantCode: exitUsage,
+			wantStderr: "gs1 convert: ",
+		},
+		{
+			name: "check-digit SHOULD append the check digit", args: []string{"check-digit", "952606405502"},
+			wantCode: exitOK, wantStdout: "9526064055028\n",
+		},
+		{
+			name: "check-digit SHOULD report a wrong check digit", args: []string{"check-digit", "-verify"},
+			stdin: "9526064055028\n9526064055029\n", wantCode: exitInvalid, wantStdout: "9526064055028: OK\n",
+			wantStderr: "line 2: wrong check digit 9, want 8",
+		},
+		{
+			name: "explain SHOULD decode dates and decimal values",
+			args: []string{"explain", "(01)09526064055028(3103)001250(17)251231"}, wantCode: exitOK,
+			wantStdout: "(01)09526064055028(3103)001250(17)251231\n" +
+				"  syntax: ElementStringSyntax\n" +
+				"  (01) GTIN: 09526064055028\n" +
+				"  (3103) NET WEIGHT (kg): 001250\n" +
+				"      value: 1.250\n" +
+				"  (17) USE BY or EXPIRY: 251231\n" +
+				"      value: 2025-12-31\n",
+		},
+		{
+			name: "explain SHOULD list the issues per AI", args: []string{"explain", "]d20109526064055029"},
+			wantCode: exitOK,
+			wantStdout: "]d20109526064055029\n" +
+				"  syntax: BarcodeMessageScanData\n" +
+				"  symbology: ]d2\n" +
+				"  (01) GTIN: 09526064055029\n" +
+				"      ! csum: wrong check digit 9, want 8\n",
+		},
+		{
+			name: "explain of invalid data SHOULD exit with 1", args: []string{"explain"}, stdin: "(01\n",
+			wantCode: exitInvalid, wantStderr: "line 1: ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Errorf("run() = %d, want %d, stderr %q", code, tt.wantCode, stderr.String())
+			}
+			if stdout.String() != tt.wantStdout {
+				t.Errorf("run() stdout = %q, want %q", stdout.String(), tt.wantStdout)
+			}
+			if !strings.Contains(stderr.String(), tt.wantStderr) || tt.wantStderr == "" && stderr.Len() > 0 {
+				t.Errorf("run() stderr = %q, want %q", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
+
+func TestRun_ConvertRoundTrip(t *testing.T) {
+	const data = "(01)09526064055028(10)ABC(21)X"
+	for _, args := range [][]string{
+		{"-to", "barcode"},
+		{"-to", "barcode", "-fnc1", "GS"},
+		{"-to", "scan", "-symbology", "]C1"},
+		{"-to", "dl"},
+	} {
+		t.Run(strings.Join(args, " "), func(t *testing.T) {
+			var converted, parsed, stderr bytes.Buffer
+			if code := run(append(append([]string{"convert"}, args...), data), nil, &converted, &stderr); code != exitOK {
+				t.Fatalf("run(convert) = %d, stderr %q", code, stderr.String())
+			}
+			if code := run([]string{"parse", "-format", "element"}, &converted, &parsed, &stderr); code != exitOK {
+				t.Fatalf("run(parse) of %q = %d, stderr %q", converted.String(), code, stderr.String())
+			}
+			if got := strings.TrimSuffix(parsed.String(), "\n"); got != data {
+				t.Errorf("convert output %q SHOULD parse to %s, got %s", converted.String(), data, got)
+			}
+		})
+	}
+}
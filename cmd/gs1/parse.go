@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+// parseOutput is the JSON output of `gs1 parse`.
+type parseOutput struct {
+	Input     string                `json:"input"`
+	Syntax    gs1.MessageSyntaxType `json:"syntax"`
+	Symbology string                `json:"symbology,omitempty"`
+	Elements  gs1.Message           `json:"elements"`
+}
+
+// runParse implements `gs1 parse`, which detects the syntax of the data and prints the parsed message.
+func runParse(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("parse", stderr)
+	format := fs.String("format", "json", "Output format: json, element or hri")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if *format != "json" && *format != "element" && *format != "hri" {
+		fmt.Fprintf(stderr, "gs1 parse: unsupported format %q\n", *format)
+		return exitUsage
+	}
+
+	return forEachInput(fs.Args(), stdin, stderr, func(input string) error {
+		msg, err := gs1.ParseAny(input)
+		if err != nil {
+			return err
+		}
+		switch *format {
+		case "element":
+			_, err = fmt.Fprintln(stdout, msg.AsElementString())
+		case "hri":
+			_, err = fmt.Fprintln(stdout, strings.Join(msg.HRI(), " "))
+		default:
+			out := parseOutput{Input: input, Syntax: msg.SyntaxType, Elements: msg}
+			if msg.SyntaxType == gs1.BarcodeMessageScanData {
+				out.Symbology = msg.Symbology.String()
+			}
+			var data []byte
+			data, err = json.Marshal(out)
+			if err == nil {
+				_, err = fmt.Fprintln(stdout, string(data))
+			}
+		}
+		return err
+	})
+}
+
+// runValidate implements `gs1 validate`. It exits with [exitInvalid] if any input cannot be parsed or violates the
+// Syntax Dictionary.
+func runValidate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("validate", stderr)
+	quiet := fs.Bool("q", false, "Only report invalid inputs")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	return forEachInput(fs.Args(), stdin, stderr, func(input string) error {
+		msg, err := gs1.ParseAny(input)
+		if err != nil {
+			return err
+		}
+		if err := msg.Validate(); err != nil {
+			var validationErr *gs1.ValidationError
+			if errors.As(err, &validationErr) {
+				issues := make([]string, len(validationErr.Issues))
+				for i, issue := range validationErr.Issues {
+					issues[i] = issue.String()
+				}
+				return errors.New(strings.Join(issues, "; "))
+			}
+			return err
+		}
+		if !*quiet {
+			fmt.Fprintf(stdout, "%s: OK\n", input)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/adippel/gs1engine-go/gs1http"
+)
+
+// runServe implements `gs1 serve`, which serves the endpoints of package gs1http until interrupted.
+func runServe(args []string, _ io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("serve", stderr)
+	addr := fs.String("addr", ":8080", "Address to listen on")
+	maxBytes := fs.Int64("max-bytes", gs1http.DefaultMaxRequestBytes, "Maximum size of request bodies")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           gs1http.New(gs1http.WithMaxRequestBytes(*maxBytes)),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Fprintf(stdout, "Listening on %s\n", *addr)
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(stderr, "gs1 serve: %v\n", err)
+		return exitInvalid
+	}
+	return exitOK
+}
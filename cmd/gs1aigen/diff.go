@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+type diffOpts struct {
+	From   string
+	To     string
+	Format string
+}
+
+// runDiff implements `gs1aigen diff`, which reports the differences between two Syntax Dictionary files.
+func runDiff(args []string, out io.Writer) error {
+	var opts diffOpts
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	fs.StringVar(&opts.From, "from", "", "Path to the Syntax Dictionary of the previous release")
+	fs.StringVar(&opts.To, "to", "", "Path to the Syntax Dictionary of the new release")
+	fs.StringVar(&opts.Format, "format", "text", "Output format: text or json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if opts.From == "" || opts.To == "" {
+		return errors.New("both -from and -to are required")
+	}
+
+	from, err := readSyntaxDictionary(opts.From)
+	if err != nil {
+		return err
+	}
+	to, err := readSyntaxDictionary(opts.To)
+	if err != nil {
+		return err
+	}
+	diff := gs1.DiffSyntaxDictionaries(from, to)
+
+	switch opts.Format {
+	case "text":
+		return writeDiffText(out, diff)
+	case "json":
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(diff)
+	default:
+		return fmt.Errorf("unsupported format %q", opts.Format)
+	}
+}
+
+func readSyntaxDictionary(path string) ([]gs1.ApplicationIdentifierSpec, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	ais, err := gs1.ParseSyntaxDictionary(f)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	return ais, nil
+}
+
+// writeDiffText writes the diff in a human-readable form.
+func writeDiffText(out io.Writer, diff gs1.SyntaxDictionaryDiff) error {
+	builder := strings.Builder{}
+	fmt.Fprintf(&builder, "Added AIs: %d\n", len(diff.Added))
+	for _, spec := range diff.Added {
+		fmt.Fprintf(&builder, "  + %-4s  %s\n", spec.AI, spec.Title)
+	}
+	fmt.Fprintf(&builder, "Removed AIs: %d\n", len(diff.Removed))
+	for _, spec := range diff.Removed {
+		fmt.Fprintf(&builder, "  - %-4s  %s\n", spec.AI, spec.Title)
+	}
+	fmt.Fprintf(&builder, "Changed AIs: %d\n", len(diff.Changed))
+	for _, change := range diff.Changed {
+		fmt.Fprintf(&builder, "  ~ %-4s  %s\n", change.AI, change.Title)
+		for _, field := range change.Changes {
+			fmt.Fprintf(&builder, "      %s: %q -> %q\n", field.Field, field.From, field.To)
+		}
+	}
+	_, err := io.WriteString(out, builder.String())
+	return err
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+func writeDictionary(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "gs1-syntax-dictionary.txt")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRunDiff(t *testing.T) {
+	from := writeDictionary(t, `
+10          ?  X..20                            req=01,02,8006,8026                              # BATCH/LOT
+90          ?  X..30                                                                              # INTERNAL
+`)
+	to := writeDictionary(t, `
+10          ?  X..20,nozeroprefix               req=01,02,8006,8026                              # BATCH/LOT
+7241        ?  N2                               req=01                                           # AIDC MEDIA TYPE
+`)
+
+	t.Run("text output SHOULD list added, removed and changed AIs", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := runDiff([]string{"-from", from, "-to", to}, &out); err != nil {
+			t.Fatalf("runDiff() error = %v", err)
+		}
+		want := `Added AIs: 1
+  + 7241  AIDC MEDIA TYPE
+Removed AIs: 1
+  - 90    INTERNAL
+Changed AIs: 1
+  ~ 10    BATCH/LOT
+      linters: "" -> "nozeroprefix"
+`
+		if out.String() != want {
+			t.Errorf("runDiff() = %q, want %q", out.String(), want)
+		}
+	})
+
+	t.Run("JSON output SHOULD decode into the diff", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := runDiff([]string{"-from", from, "-to", to, "-format", "json"}, &out); err != nil {
+			t.Fatalf("runDiff() error = %v", err)
+		}
+		var diff gs1.SyntaxDictionaryDiff
+		if err := json.Unmarshal(out.Bytes(), &diff); err != nil {
+			t.Fatalf("json.Unmarshal() error = %v", err)
+		}
+		if len(diff.Added) != 1 || diff.Added[0].AI != "7241" || len(diff.Removed) != 1 ||
+			diff.Removed[0].AI != "90" || len(diff.Changed) != 1 || diff.Changed[0].Changes[0].To != "nozeroprefix" {
+			t.Errorf("runDiff() = %s", out.String())
+		}
+		if !strings.Contains(out.String(), `"field": "linters"`) {
+			t.Errorf("runDiff() = %s, want indented JSON", out.String())
+		}
+	})
+
+	t.Run("identical releases SHOULD report no changes", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := runDiff([]string{"-from", from, "-to", from}, &out); err != nil {
+			t.Fatalf("runDiff() error = %v", err)
+		}
+		if want := "Added AIs: 0\nRemoved AIs: 0\nChanged AIs: 0\n"; out.String() != want {
+			t.Errorf("runDiff() = %q, want %q", out.String(), want)
+		}
+	})
+
+	for _, tt := range []struct {
+		name string
+		args []string
+	}{
+		{name: "missing -to SHOULD fail", args: []string{"-from", from}},
+		{name: "unknown format SHOULD fail", args: []string{"-from", from, "-to", to, "-format", "xml"}},
+		{name: "missing file SHOULD fail", args: []string{"-from", from, "-to", filepath.Join(t.TempDir(), "none")}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := runDiff(tt.args, &bytes.Buffer{}); err == nil {
+				t.Errorf("runDiff() SHOULD return an error")
+			}
+		})
+	}
+}
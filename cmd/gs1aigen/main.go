@@ -5,9 +5,12 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"go/format"
 	"io"
 	"log"
 	"os"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/adippel/gs1engine-go"
@@ -37,10 +40,13 @@ type {{ $structName }} struct {
 }
 {{- end }}
 
+// SyntaxDictionaryRelease is the GS1 Syntax Dictionary release the AI descriptions are generated from.
+const SyntaxDictionaryRelease = "{{ .Release }}"
+
 // Go descriptions for the GS1 Application Identifier. Enable seamless parsing and validation of GS1 messages.
 var (
 {{- range .AIs }}
-	// AI{{ .AI }} describes a {{ .Title }}. See also https://ref.gs1.org/ai/{{ .AI }}.
+	// AI{{ .AI }} describes a {{ sentence .Title }} See also https://ref.gs1.org/ai/{{ .AI }}.
 	AI{{ .AI }} = {{ $structName }}{
 		AI:				"{{ .AI }}",
 		Flags:         	"{{ .Flags }}",
@@ -65,25 +71,57 @@ type cliOpts struct {
 	PackageName              string // the name to use when rendering the registry
 	AIStructName             string // the struct to use for every [gs1.ApplicationIdentifierSpec]
 	DisableStructDeclaration bool   // controls creation of the default struct declaration; uses AIStructName
+	TemplateFile             string // a user template replacing the built-in template
+	Typed                    bool   // selects the built-in template for typed constructors and accessors
 }
 
 const defaultSyntaxDictionaryRelease = "2025-01-30"
 
-// generateAIRegistry generates ApplicationIdentifier and a lookup table from the given ais.
-func generateAIRegistry(out io.Writer, ais []gs1.ApplicationIdentifierSpec, opts cliOpts) error {
+// unsetRelease is declared by Syntax Dictionary files not taken from a release.
+const unsetRelease = "UNSET"
+
+// generateAIRegistry generates ApplicationIdentifier and a lookup table from the given dictionary. If the dictionary
+// does not declare its release, the requested release is used. A user template or the typed template replace the
+// built-in registry template if selected. Go output is formatted.
+func generateAIRegistry(out io.Writer, dictionary gs1.SyntaxDictionary, opts cliOpts) error {
 	if opts.AIStructName == "" {
 		return errors.New("AI struct name is required")
 	}
+	release := dictionary.Release
+	if release == "" || release == unsetRelease {
+		release = opts.SyntaxDictionaryRelease
+	}
 
+	ais, err := newTemplateData(dictionary, release, opts.AIStructName)
+	if err != nil {
+		return err
+	}
 	tplOpts := struct {
 		cliOpts
-		AIs []gs1.ApplicationIdentifierSpec
+		Release string
+		AIs     []aiTemplateData
 	}{
 		cliOpts: opts,
+		Release: release,
 		AIs:     ais,
 	}
 
-	t, err := template.New("ai").Parse(tmpl)
+	text := tmpl
+	if opts.Typed {
+		text = typedTmpl
+	}
+	if opts.TemplateFile != "" {
+		data, err := os.ReadFile(opts.TemplateFile)
+		if err != nil {
+			return fmt.Errorf("error reading template: %w", err)
+		}
+		text = string(data)
+	}
+	t, err := template.New("ai").Funcs(template.FuncMap{
+		"join":     strings.Join,
+		"quote":    strconv.Quote,
+		"sentence": sentence,
+	}).Parse(text)
 	if err != nil {
 		return err
 	}
@@ -93,6 +131,13 @@ func generateAIRegistry(out io.Writer, ais []gs1.ApplicationIdentifierSpec, opts
 	if err != nil {
 		return fmt.Errorf("error executing template: %w", err)
 	}
+	if opts.TemplateFile == "" || strings.HasSuffix(opts.OutFile, ".go") {
+		formatted, err := format.Source(tempOut.Bytes())
+		if err != nil {
+			return fmt.Errorf("error formatting generated code: %w", err)
+		}
+		tempOut = *bytes.NewBuffer(formatted)
+	}
 	_, err = io.Copy(out, &tempOut)
 	if err != nil {
 		return fmt.Errorf("error copying template: %w", err)
@@ -102,6 +147,16 @@ func generateAIRegistry(out io.Writer, ais []gs1.ApplicationIdentifierSpec, opts
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		subcommands := map[string]func([]string, io.Writer) error{"diff": runDiff, "schema": runSchema}
+		if run, ok := subcommands[os.Args[1]]; ok {
+			if err := run(os.Args[2:], os.Stdout); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+	}
+
 	var cliOpts cliOpts
 
 	flag.StringVar(&cliOpts.PackageName, "package", "main", "Package name to use")
@@ -109,6 +164,8 @@ func main() {
 	flag.StringVar(&cliOpts.SyntaxDictionaryRelease, "release", defaultSyntaxDictionaryRelease, "Syntax Dictionary release to use")
 	flag.StringVar(&cliOpts.AIStructName, "struct-name", "ApplicationIdentifier", "Name of the struct to use for generating")
 	flag.BoolVar(&cliOpts.DisableStructDeclaration, "disable-struct-gen", false, "Disables default AI struct declaration generation")
+	flag.StringVar(&cliOpts.TemplateFile, "template", "", "Path to a text/template replacing the built-in template")
+	flag.BoolVar(&cliOpts.Typed, "typed", false, "Generates typed constructors and accessors per AI for package gs1")
 	flag.Parse()
 
 	data, err := gs1.DownloadSyntaxDictionary(cliOpts.SyntaxDictionaryRelease)
@@ -116,9 +173,9 @@ func main() {
 		panic(err)
 	}
 
-	ais, error := gs1.ParseSyntaxDictionary(&data)
-	if error != nil {
-		panic(error)
+	dictionary, err := gs1.ReadSyntaxDictionary(&data)
+	if err != nil {
+		log.Fatal(err)
 	}
 
 	f, err := os.Create(cliOpts.OutFile)
@@ -127,10 +184,18 @@ func main() {
 	}
 	defer f.Close()
 
-	err = generateAIRegistry(f, ais, cliOpts)
+	err = generateAIRegistry(f, dictionary, cliOpts)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	fmt.Printf("Wrote %s\n", cliOpts.OutFile)
 }
+
+// sentence terminates the text with a period unless it already ends with one, e.g. the title `CUST. PART No.`.
+func sentence(text string) string {
+	if strings.HasSuffix(text, ".") {
+		return text
+	}
+	return text + "."
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+type schemaOpts struct {
+	Dictionary string
+	Release    string
+	Format     string
+}
+
+// runSchema implements `gs1aigen schema`, which writes a JSON Schema or OpenAPI components for GS1 messages.
+func runSchema(args []string, out io.Writer) error {
+	var opts schemaOpts
+	fs := flag.NewFlagSet("schema", flag.ContinueOnError)
+	fs.StringVar(&opts.Dictionary, "dictionary", "", "Path to the Syntax Dictionary")
+	fs.StringVar(&opts.Release, "release", "", "Release to declare if the dictionary does not declare its release")
+	fs.StringVar(&opts.Format, "format", "jsonschema", "Output format: jsonschema or openapi")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if opts.Dictionary == "" {
+		return errors.New("-dictionary is required")
+	}
+
+	f, err := os.Open(opts.Dictionary)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	dictionary, err := gs1.ReadSyntaxDictionary(f)
+	if err != nil {
+		return fmt.Errorf("error parsing %s: %w", opts.Dictionary, err)
+	}
+	release := dictionary.Release
+	if release == "" || release == unsetRelease {
+		release = opts.Release
+	}
+
+	var doc any
+	switch opts.Format {
+	case "jsonschema":
+		doc, err = gs1.NewJSONSchema(release, dictionary.AIs)
+	case "openapi":
+		doc, err = gs1.NewOpenAPIComponents(release, dictionary.AIs)
+	default:
+		return fmt.Errorf("unsupported format %q", opts.Format)
+	}
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
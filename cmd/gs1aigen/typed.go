@@ -0,0 +1,231 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+const typedTmpl = `// DO NOT EDIT.
+// Code generated by github.com/adippel/go-gs1engine/gs1aigen.
+
+package {{ .PackageName }}
+
+import "time"
+
+// Codes of the GS1 Application Identifiers of Syntax Dictionary release {{ .Release }}.
+const (
+{{- range .AIs }}
+	// {{ .Name }}Code is the code of AI ({{ .AI }}) {{ sentence .Title }}
+	{{ .Name }}Code = "{{ .AI }}"
+{{- end }}
+)
+
+// aiComponents are the precomputed specification components of the AIs.
+var aiComponents = map[string][]SpecComponent{
+{{- range .AIs }}
+	"{{ .AI }}": {{ .ComponentsLiteral }},
+{{- end }}
+}
+
+// aiDigitalLinkSpecs are the precomputed Digital Link specs of the AIs.
+var aiDigitalLinkSpecs = map[string]DigitalLinkSpec{
+{{- range .AIs }}
+	"{{ .AI }}": {{ .DigitalLinkLiteral }},
+{{- end }}
+}
+{{ range .AIs }}
+{{- if .IsDate }}
+// New{{ .Name }} returns the element string of AI ({{ .AI }}) {{ .Title }} for the given date.
+func New{{ .Name }}(date time.Time) ElementString {
+	return NewElementString(AI{{ .AI }}, formatGS1Date(date))
+}
+
+// {{ .Name }} returns the date of AI ({{ .AI }}) {{ sentence .Title }} It returns false if the AI is missing or its data is not
+// a valid date.
+func (d Message) {{ .Name }}() (time.Time, bool) {
+	return d.dateData({{ .Name }}Code)
+}
+{{ else }}
+// New{{ .Name }} returns the element string of AI ({{ .AI }}) {{ sentence .Title }} The value is checked against the
+// specification {{ .SpecificationText }}{{ if gt (len .Specification) 1 }} including its linters{{ end }}.
+func New{{ .Name }}(value string) (ElementString, error) {
+	return newCheckedElementString(AI{{ .AI }}, value)
+}
+
+// {{ .Name }} returns the data of AI ({{ .AI }}) {{ .Title }} if present.
+func (d Message) {{ .Name }}() (string, bool) {
+	return d.lookupData({{ .Name }}Code)
+}
+{{ end }}
+{{- end }}`
+
+// aiTemplateData is passed to the templates for every AI.
+type aiTemplateData struct {
+	gs1.ApplicationIdentifierSpec
+	// Name is a Go identifier derived from the title, e.g. BatchLot for AI (10).
+	Name string
+	// IsDate is set for AIs consisting of a single date in the format YYMMDD.
+	IsDate bool
+	// SpecificationText is the specification as written in the Syntax Dictionary, e.g. `N13,csum,key [X..17]`.
+	SpecificationText string
+	// ComponentsLiteral and DigitalLinkLiteral are Go literals of the precomputed data.
+	ComponentsLiteral  string
+	DigitalLinkLiteral string
+}
+
+// dateLinters identify components carrying a date in the format YYMMDD. `yymmd0` allows day 00.
+var dateLinters = []string{"yymmdd", "yymmd0"}
+
+// nameOverrides replace names derived from titles that do not read well as Go identifier.
+var nameOverrides = map[string]string{
+	"15": "BestBeforeDate",
+	"17": "ExpiryDate",
+}
+
+// acronyms are kept upper case within names.
+var acronyms = []string{
+	"AIC", "AIDC", "CIP", "CN", "CPID", "CPV", "DOB", "DRN", "EXT", "GCN", "GDTI", "GIAI", "GINC", "GLN", "GMN", "GRAI",
+	"GSIN", "GSRN", "GTIN", "IBAN", "ID", "ITIP", "MTO", "MUDI", "NDC", "NHRN", "NSN", "PCN", "PZN", "RTN", "SRIN",
+	"SSCC", "TPX", "UFRGT", "UIC", "URL", "VCN",
+}
+
+// reservedNames are members of gs1.Message which cannot be used for accessors. TestReservedNames checks the list
+// against the fields and methods of gs1.Message.
+var reservedNames = []string{
+	"Symbology", "SyntaxType", "Elements", "AddOn", "AsElementString", "AsBarcodeMessage", "AsScanData",
+	"AsDigitalLink", "HRI", "Validate", "CheckSymbology", "ToEPCURI", "ToEPCBinary", "ToEPCHex", "MarshalText",
+	"UnmarshalText", "MarshalJSON", "UnmarshalJSON", "MarshalXML", "UnmarshalXML", "Value", "Scan",
+}
+
+// newTemplateData derives names and precomputed data for all AIs of the dictionary.
+func newTemplateData(dictionary gs1.SyntaxDictionary, release, structName string) ([]aiTemplateData, error) {
+	registry, err := gs1.NewRegistry(release, dictionary.AIs)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string][]int{}
+	data := make([]aiTemplateData, 0, len(dictionary.AIs))
+	for _, spec := range dictionary.AIs {
+		ai, _ := registry.Lookup(spec.AI)
+		components, _ := registry.Components(spec.AI)
+		dlSpec, err := registry.DigitalLinkSpec(ai)
+		if err != nil {
+			return nil, err
+		}
+
+		d := aiTemplateData{
+			ApplicationIdentifierSpec: spec,
+			Name:                      goName(spec),
+			IsDate:                    isDateComponent(components),
+			SpecificationText:         strings.Join(spec.Specification, ","),
+			ComponentsLiteral:         componentsLiteral(components),
+			DigitalLinkLiteral:        digitalLinkLiteral(dlSpec, structName),
+		}
+		names[d.Name] = append(names[d.Name], len(data))
+		data = append(data, d)
+	}
+
+	// Titles shared by several AIs, e.g. the decimal variants of measures, are distinguished by the AI.
+	for name, indexes := range names {
+		if len(indexes) > 1 || slices.Contains(reservedNames, name) {
+			for _, i := range indexes {
+				data[i].Name = name + data[i].AI
+			}
+		}
+	}
+	return data, nil
+}
+
+// goName derives an exported Go identifier from the title of the AI, e.g. `NetWeightKg` from `NET WEIGHT (kg)`.
+func goName(spec gs1.ApplicationIdentifierSpec) string {
+	if name, ok := nameOverrides[spec.AI]; ok {
+		return name
+	}
+	title := strings.NewReplacer("²", "2", "³", "3").Replace(spec.Title)
+	words := strings.FieldsFunc(title, func(r rune) bool {
+		return r > unicode.MaxASCII || (!unicode.IsLetter(r) && !unicode.IsDigit(r))
+	})
+
+	builder := strings.Builder{}
+	for _, word := range words {
+		switch {
+		case slices.Contains(acronyms, word):
+			builder.WriteString(word)
+		case strings.ToUpper(word) == word:
+			builder.WriteString(word[:1])
+			builder.WriteString(strings.ToLower(word[1:]))
+		default:
+			builder.WriteString(strings.ToUpper(word[:1]))
+			builder.WriteString(word[1:])
+		}
+	}
+	name := builder.String()
+	if name == "" || !unicode.IsLetter(rune(name[0])) {
+		return "AI" + spec.AI + name
+	}
+	return name
+}
+
+func isDateComponent(components []gs1.SpecComponent) bool {
+	if len(components) != 1 {
+		return false
+	}
+	c := components[0]
+	return c.Charset == 'N' && c.MinLength == 6 && c.MaxLength == 6 && !c.Optional &&
+		slices.ContainsFunc(c.Linters, func(linter string) bool { return slices.Contains(dateLinters, linter) })
+}
+
+func componentsLiteral(components []gs1.SpecComponent) string {
+	var literals []string
+	for _, c := range components {
+		fields := []string{
+			fmt.Sprintf("Charset: %q", rune(c.Charset)),
+			fmt.Sprintf("MinLength: %d", c.MinLength),
+			fmt.Sprintf("MaxLength: %d", c.MaxLength),
+		}
+		if c.Optional {
+			fields = append(fields, "Optional: true")
+		}
+		if len(c.Linters) > 0 {
+			fields = append(fields, "Linters: []string{"+quoteAll(c.Linters)+"}")
+		}
+		literals = append(literals, "{"+strings.Join(fields, ", ")+"}")
+	}
+	return "{" + strings.Join(literals, ", ") + "}"
+}
+
+func digitalLinkLiteral(spec gs1.DigitalLinkSpec, structName string) string {
+	var fields []string
+	if spec.IsValidDataAttribute {
+		fields = append(fields, "IsValidDataAttribute: true")
+	}
+	if spec.IsValidPrimaryKey {
+		fields = append(fields, "IsValidPrimaryKey: true")
+	}
+	if len(spec.AllowedQualifiers) > 0 {
+		var sequences []string
+		for _, sequence := range spec.AllowedQualifiers {
+			var vars []string
+			for _, ai := range sequence {
+				vars = append(vars, "AI"+ai.AI)
+			}
+			sequences = append(sequences, "{"+strings.Join(vars, ", ")+"}")
+		}
+		fields = append(fields, "AllowedQualifiers: [][]"+structName+"{"+strings.Join(sequences, ", ")+"}")
+	}
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+func readTestDictionary(t *testing.T) gs1.SyntaxDictionary {
+	t.Helper()
+	f, err := os.Open("../../testdata/gs1-syntax-dictionary-2025-01-30.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	dictionary, err := gs1.ReadSyntaxDictionary(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dictionary
+}
+
+func TestGenerateTyped(t *testing.T) {
+	var out bytes.Buffer
+	opts := cliOpts{PackageName: "gs1", AIStructName: "ApplicationIdentifier", Typed: true}
+	if err := generateAIRegistry(&out, readTestDictionary(t), opts); err != nil {
+		t.Fatalf("generateAIRegistry() error = %v", err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("// CustPartNoCode is the code of AI (241) CUST. PART No.\n")) {
+		t.Errorf("titles ending with a period SHOULD not get a second period")
+	}
+	if !bytes.Contains(out.Bytes(), []byte("// BatchLotCode is the code of AI (10) BATCH/LOT.\n")) {
+		t.Errorf("titles SHOULD be terminated with a period")
+	}
+}
+
+func TestReservedNames(t *testing.T) {
+	dictionary := readTestDictionary(t)
+	data, err := newTemplateData(dictionary, "2025-01-30", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated := map[string]bool{}
+	for _, d := range data {
+		generated[d.Name] = true
+	}
+
+	// Members of gs1.Message that are not generated accessors must be reserved, or a future accessor would clash.
+	var members []string
+	typ := reflect.TypeOf(gs1.Message{})
+	for i := range typ.NumField() {
+		members = append(members, typ.Field(i).Name)
+	}
+	ptr := reflect.PointerTo(typ)
+	for i := range ptr.NumMethod() {
+		members = append(members, ptr.Method(i).Name)
+	}
+	for _, name := range members {
+		if !generated[name] && !slices.Contains(reservedNames, name) {
+			t.Errorf("reservedNames SHOULD contain gs1.Message member %s", name)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package gs1
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// releaseHeader precedes the release within the comment header of a Syntax Dictionary file.
+	releaseHeader = "Release:"
+	// flagCharacters are the characters allocated to flags by the Syntax Dictionary.
+	flagCharacters = "*!?\"$%&'()+,-./:;<=>@[\\]^_`{|}~"
+	// definedFlags are the flags with a definition: pre-defined length, identification key and Digital Link attribute.
+	definedFlags = "*!?"
+	// aiPatternWildcard matches any digit within AI patterns of `req` and `ex` attributes, e.g. `35nn`.
+	aiPatternWildcard = 'n'
+)
+
+// SyntaxDictionaryIssue is a single inconsistency within a GS1 Syntax Dictionary.
+type SyntaxDictionaryIssue struct {
+	// Line is the line of the issue. It is 0 if the AI has not been parsed from a file.
+	Line int
+	// AI is the AI affected by the issue. It is empty if the AI itself cannot be parsed.
+	AI      string
+	Message string
+}
+
+func (i SyntaxDictionaryIssue) String() string {
+	if i.AI == "" {
+		return fmt.Sprintf("line %d: %s", i.Line, i.Message)
+	}
+	return fmt.Sprintf("line %d: AI (%s): %s", i.Line, i.AI, i.Message)
+}
+
+// SyntaxDictionaryError lists all issues found within a GS1 Syntax Dictionary.
+type SyntaxDictionaryError struct {
+	Issues []SyntaxDictionaryIssue
+}
+
+func (e *SyntaxDictionaryError) Error() string {
+	builder := strings.Builder{}
+	fmt.Fprintf(&builder, "invalid syntax dictionary: %d issue(s)", len(e.Issues))
+	for _, issue := range e.Issues {
+		builder.WriteString("\n\t")
+		builder.WriteString(issue.String())
+	}
+	return builder.String()
+}
+
+type syntaxDictionaryIssues []SyntaxDictionaryIssue
+
+func (issues *syntaxDictionaryIssues) add(line int, ai string, message string) {
+	*issues = append(*issues, SyntaxDictionaryIssue{Line: line, AI: ai, Message: message})
+}
+
+func (issues syntaxDictionaryIssues) err() error {
+	if len(issues) == 0 {
+		return nil
+	}
+	return &SyntaxDictionaryError{Issues: issues}
+}
+
+// isValidAICode reports whether code consists of 2 to 4 digits.
+func isValidAICode(code string) bool {
+	return len(code) >= minAILength && len(code) <= maxAILength && isNumeric(code)
+}
+
+// checkSpecification reports specification components that cannot be parsed, variable length components that are not
+// the final component and mandatory components following optional ones.
+func checkSpecification(entry ApplicationIdentifierSpec, issues *syntaxDictionaryIssues) {
+	if len(entry.Specification) == 0 {
+		return
+	}
+	components, err := ApplicationIdentifier{AI: entry.AI, Specification: entry.Specification}.Components()
+	if err != nil {
+		issues.add(entry.Line, entry.AI, err.Error())
+		return
+	}
+	optional := false
+	for i, c := range components {
+		if c.Optional {
+			optional = true
+		} else if optional {
+			issues.add(entry.Line, entry.AI, fmt.Sprintf("mandatory component %s follows optional component", c))
+		}
+		if c.MinLength != c.MaxLength && i != len(components)-1 {
+			issues.add(entry.Line, entry.AI, fmt.Sprintf("variable length component %s is not the final component", c))
+		}
+	}
+}
+
+// ValidateSyntaxDictionary ensures that the `req` and `ex` attributes of the AIs only reference AIs or patterns, e.g.
+// `35nn`, matching at least one of the given AIs, and that `dlpkey` qualifiers reference existing AIs. Duplicate AIs
+// are reported as well. All issues found are returned as [*SyntaxDictionaryError].
+func ValidateSyntaxDictionary(specs []ApplicationIdentifierSpec) error {
+	var issues syntaxDictionaryIssues
+	defined := make(map[string]int, len(specs))
+	for _, spec := range specs {
+		if first, exists := defined[spec.AI]; exists {
+			issues.add(spec.Line, spec.AI, fmt.Sprintf("duplicate AI, first defined in line %d", first))
+			continue
+		}
+		defined[spec.AI] = spec.Line
+	}
+
+	for _, spec := range specs {
+		for _, attr := range spec.Attributes {
+			key, value, _ := strings.Cut(attr, "=")
+			switch key {
+			case "req", "ex":
+				for _, group := range strings.Split(value, ",") {
+					for _, pattern := range strings.Split(group, "+") {
+						if !matchesAnyAI(pattern, defined) {
+							issues.add(spec.Line, spec.AI, fmt.Sprintf("%s references unknown AI or pattern %q", key, pattern))
+						}
+					}
+				}
+			case dlPrimaryKeyAttrName:
+				if value == "" {
+					continue
+				}
+				for _, sequence := range strings.Split(value, string(pairingORSeparator)) {
+					for _, qualifier := range strings.Split(sequence, ",") {
+						if _, ok := defined[qualifier]; !ok {
+							issues.add(spec.Line, spec.AI, fmt.Sprintf("dlpkey references unknown AI %q", qualifier))
+						}
+					}
+				}
+			}
+		}
+	}
+	return issues.err()
+}
+
+// matchesAnyAI reports whether the AI pattern, e.g. `01` or `35nn`, matches at least one of the defined AIs.
+func matchesAnyAI(pattern string, defined map[string]int) bool {
+	prefix := strings.TrimRight(pattern, string(aiPatternWildcard))
+	if len(pattern) < minAILength || len(pattern) > maxAILength || !isNumeric(prefix) {
+		return false
+	}
+	if prefix == pattern {
+		_, ok := defined[pattern]
+		return ok
+	}
+	for ai := range defined {
+		if len(ai) == len(pattern) && strings.HasPrefix(ai, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package gs1
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSyntaxDictionary_Issues(t *testing.T) {
+	tests := []struct {
+		name       string
+		dictionary string
+		want       []SyntaxDictionaryIssue
+	}{
+		{
+			name:       "Duplicate AIs SHOULD be reported",
+			dictionary: "10  ?  X..20  # BATCH/LOT\n\n10  ?  X..20  # BATCH/LOT\n",
+			want:       []SyntaxDictionaryIssue{{Line: 3, AI: "10", Message: "duplicate AI, first defined in line 1"}},
+		},
+		{
+			name:       "AIs duplicated by a range SHOULD be reported",
+			dictionary: "92  ?  X..90  # INTERNAL\n91-93  ?  X..90  # INTERNAL\n",
+			want:       []SyntaxDictionaryIssue{{Line: 2, AI: "92", Message: "duplicate AI, first defined in line 1"}},
+		},
+		{
+			name:       "Malformed ranges SHOULD be reported",
+			dictionary: "99-91  ?  X..90  # INTERNAL\n310-3105  *  N6  # NET WEIGHT\n",
+			want: []SyntaxDictionaryIssue{
+				{Line: 1, AI: "99-91", Message: `malformed AI range "99-91": start must be less than stop`},
+				{Line: 2, AI: "310-3105", Message: `malformed AI range "310-3105": bounds must be AIs of the same length`},
+			},
+		},
+		{
+			name:       "Unknown flags SHOULD be reported",
+			dictionary: "10  ?~  X..20  # BATCH/LOT\n",
+			want:       []SyntaxDictionaryIssue{{Line: 1, AI: "10", Message: `unknown flag '~'`}},
+		},
+		{
+			name:       "Invalid specification grammar SHOULD be reported",
+			dictionary: "10  ?  X..  # BATCH/LOT\n11  *?  N6,yymmd0 [X5  # PROD DATE\n",
+			want: []SyntaxDictionaryIssue{
+				{Line: 1, AI: "10", Message: `invalid specification of AI (10): invalid component length "X.."`},
+				{Line: 2, AI: "11", Message: `invalid specification of AI (11): unterminated optional component "[X5"`},
+			},
+		},
+		{
+			name:       "Optional components before mandatory ones SHOULD be reported",
+			dictionary: "253  !?  N13,csum [X..17] N2  dlpkey  # GDTI\n",
+			want: []SyntaxDictionaryIssue{
+				{Line: 1, AI: "253", Message: "variable length component [X..17] is not the final component"},
+				{Line: 1, AI: "253", Message: "mandatory component N2 follows optional component"},
+			},
+		},
+		{
+			name:       "Missing specifications SHOULD be reported",
+			dictionary: "10  ?  req=01  # BATCH/LOT\n",
+			want:       []SyntaxDictionaryIssue{{Line: 1, AI: "10", Message: "missing specification"}},
+		},
+		{
+			name:       "Lines not starting with an AI SHOULD be reported",
+			dictionary: "AI  ?  X..20  # BATCH/LOT\n",
+			want:       []SyntaxDictionaryIssue{{Line: 1, Message: `expected AI, got "AI"`}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSyntaxDictionary(strings.NewReader(tt.dictionary))
+			var dictErr *SyntaxDictionaryError
+			if !errors.As(err, &dictErr) {
+				t.Fatalf("ParseSyntaxDictionary() error = %v, want *SyntaxDictionaryError", err)
+			}
+			if !reflect.DeepEqual(dictErr.Issues, tt.want) {
+				t.Errorf("ParseSyntaxDictionary() issues = %v, want %v", dictErr.Issues, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSyntaxDictionary_RangeKeepsLeadingZeros(t *testing.T) {
+	ais, err := ParseSyntaxDictionary(strings.NewReader("3100-3102  *  N6  # NET WEIGHT (kg)\n08-09  ?  X..20  # TEST\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, ai := range ais {
+		got = append(got, ai.AI)
+	}
+	if want := []string{"3100", "3101", "3102", "08", "09"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseSyntaxDictionary() AIs = %v, want %v", got, want)
+	}
+}
+
+func TestReadSyntaxDictionary(t *testing.T) {
+	dictionary := `# GS1 Barcode Syntax Dictionary
+#
+# Release: 2025-07-01
+#
+01  *!?  N14,csum,gcppos2  ex=255,37 dlpkey=22,10|235  # GTIN
+10    ?  X..20  req=01,02,8006  # BATCH/LOT
+22    ?  X..20  req=01  # CPV
+3100-3105  *?  N6  req=01+21,02 ex=310n,395n  # NET WEIGHT (kg)
+`
+	_, err := ReadSyntaxDictionary(strings.NewReader(dictionary))
+	var dictErr *SyntaxDictionaryError
+	if !errors.As(err, &dictErr) {
+		t.Fatalf("ReadSyntaxDictionary() error = %v, want *SyntaxDictionaryError", err)
+	}
+	want := []SyntaxDictionaryIssue{
+		{Line: 5, AI: "01", Message: `ex references unknown AI or pattern "255"`},
+		{Line: 5, AI: "01", Message: `ex references unknown AI or pattern "37"`},
+		{Line: 5, AI: "01", Message: `dlpkey references unknown AI "235"`},
+		{Line: 6, AI: "10", Message: `req references unknown AI or pattern "02"`},
+		{Line: 6, AI: "10", Message: `req references unknown AI or pattern "8006"`},
+	}
+	for _, ai := range []string{"3100", "3101", "3102", "3103", "3104", "3105"} {
+		want = append(want,
+			SyntaxDictionaryIssue{Line: 8, AI: ai, Message: `req references unknown AI or pattern "21"`},
+			SyntaxDictionaryIssue{Line: 8, AI: ai, Message: `req references unknown AI or pattern "02"`},
+			SyntaxDictionaryIssue{Line: 8, AI: ai, Message: `ex references unknown AI or pattern "395n"`},
+		)
+	}
+	if !reflect.DeepEqual(dictErr.Issues, want) {
+		t.Errorf("ReadSyntaxDictionary() issues = %v, want %v", dictErr.Issues, want)
+	}
+}
+
+func TestReadSyntaxDictionary_Release(t *testing.T) {
+	dictionary := "# Release: 2025-07-01\n10  ?  X..20  # BATCH/LOT\n"
+	got, err := ReadSyntaxDictionary(strings.NewReader(dictionary))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Release != "2025-07-01" {
+		t.Errorf("Release = %q, want 2025-07-01", got.Release)
+	}
+	if len(got.AIs) != 1 || got.AIs[0].Line != 2 {
+		t.Errorf("AIs = %v, want AI (10) in line 2", got.AIs)
+	}
+}
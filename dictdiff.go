@@ -0,0 +1,159 @@
+package gs1
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Fields of an [ApplicationIdentifierSpec] compared by [DiffSyntaxDictionaries].
+const (
+	DiffFieldFlags      = "flags"
+	DiffFieldComponents = "components"
+	DiffFieldLinters    = "linters"
+	DiffFieldReq        = "req"
+	DiffFieldEx         = "ex"
+	DiffFieldDLPKey     = dlPrimaryKeyAttrName
+	DiffFieldAttributes = "attributes"
+	DiffFieldTitle      = "title"
+)
+
+// SyntaxDictionaryDiff lists the differences between two GS1 Syntax Dictionary releases.
+type SyntaxDictionaryDiff struct {
+	Added   []ApplicationIdentifierSpec `json:"added"`
+	Removed []ApplicationIdentifierSpec `json:"removed"`
+	Changed []AIChange                  `json:"changed"`
+}
+
+// IsEmpty reports whether both releases define the same AIs.
+func (d SyntaxDictionaryDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// AIChange lists the changes of an AI defined by both releases.
+type AIChange struct {
+	AI      string        `json:"ai"`
+	Title   string        `json:"title"`
+	Changes []FieldChange `json:"changes"`
+}
+
+// FieldChange describes the change of a single field of an AI, e.g. [DiffFieldLinters]. From and To are empty if the
+// field is not defined by the respective release.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// DiffSyntaxDictionaries compares two GS1 Syntax Dictionary releases as returned by [ParseSyntaxDictionary]. The
+// results are ordered by AI. Attributes are compared per kind, i.e. `req`, `ex` and `dlpkey`; all other attributes
+// are compared as a whole.
+func DiffSyntaxDictionaries(from, to []ApplicationIdentifierSpec) SyntaxDictionaryDiff {
+	fromAIs := indexSpecs(from)
+	toAIs := indexSpecs(to)
+
+	diff := SyntaxDictionaryDiff{
+		Added:   []ApplicationIdentifierSpec{},
+		Removed: []ApplicationIdentifierSpec{},
+		Changed: []AIChange{},
+	}
+	for _, spec := range to {
+		if _, ok := fromAIs[spec.AI]; !ok {
+			diff.Added = append(diff.Added, spec)
+		}
+	}
+	for _, spec := range from {
+		newSpec, ok := toAIs[spec.AI]
+		if !ok {
+			diff.Removed = append(diff.Removed, spec)
+			continue
+		}
+		if changes := diffSpec(spec, newSpec); len(changes) > 0 {
+			diff.Changed = append(diff.Changed, AIChange{AI: spec.AI, Title: newSpec.Title, Changes: changes})
+		}
+	}
+
+	bySpecAI := func(a, b ApplicationIdentifierSpec) int { return cmp.Compare(a.AI, b.AI) }
+	slices.SortFunc(diff.Added, bySpecAI)
+	slices.SortFunc(diff.Removed, bySpecAI)
+	slices.SortFunc(diff.Changed, func(a, b AIChange) int { return cmp.Compare(a.AI, b.AI) })
+	return diff
+}
+
+func indexSpecs(specs []ApplicationIdentifierSpec) map[string]ApplicationIdentifierSpec {
+	index := make(map[string]ApplicationIdentifierSpec, len(specs))
+	for _, spec := range specs {
+		index[spec.AI] = spec
+	}
+	return index
+}
+
+// diffSpec returns the changed fields of an AI.
+func diffSpec(from, to ApplicationIdentifierSpec) (changes []FieldChange) {
+	add := func(field, fromValue, toValue string) {
+		if fromValue != toValue {
+			changes = append(changes, FieldChange{Field: field, From: fromValue, To: toValue})
+		}
+	}
+
+	add(DiffFieldFlags, from.Flags, to.Flags)
+	fromTypes, fromLinters := specSummary(from)
+	toTypes, toLinters := specSummary(to)
+	add(DiffFieldComponents, fromTypes, toTypes)
+	add(DiffFieldLinters, fromLinters, toLinters)
+	for _, key := range []string{DiffFieldReq, DiffFieldEx, DiffFieldDLPKey} {
+		add(key, attributesOf(from, key), attributesOf(to, key))
+	}
+	add(DiffFieldAttributes, otherAttributes(from), otherAttributes(to))
+	add(DiffFieldTitle, from.Title, to.Title)
+	return changes
+}
+
+// specSummary returns the component types, e.g. `N13 [X..17]`, and the linters per component, e.g. `csum,key -`, of
+// the spec. Components without linters are denoted by `-`, so a linter moving to another component is reported. The
+// linters are empty if no component has any. A specification that cannot be parsed is returned as is.
+func specSummary(spec ApplicationIdentifierSpec) (types string, linters string) {
+	ai := ApplicationIdentifier{AI: spec.AI, Specification: spec.Specification}
+	components, err := ai.Components()
+	if err != nil {
+		return strings.Join(spec.Specification, ","), ""
+	}
+	var typeNames, linterNames []string
+	hasLinters := false
+	for _, c := range components {
+		typeNames = append(typeNames, c.String())
+		if len(c.Linters) == 0 {
+			linterNames = append(linterNames, "-")
+			continue
+		}
+		hasLinters = true
+		linterNames = append(linterNames, strings.Join(c.Linters, ","))
+	}
+	if !hasLinters {
+		return strings.Join(typeNames, " "), ""
+	}
+	return strings.Join(typeNames, " "), strings.Join(linterNames, " ")
+}
+
+// attributesOf returns all attributes of the given kind, e.g. `req=01,02 req=8006`.
+func attributesOf(spec ApplicationIdentifierSpec, key string) string {
+	var attributes []string
+	for _, attr := range spec.Attributes {
+		if name, _, _ := strings.Cut(attr, "="); name == key {
+			attributes = append(attributes, attr)
+		}
+	}
+	return strings.Join(attributes, " ")
+}
+
+// otherAttributes returns all attributes not being `req`, `ex` or `dlpkey`.
+func otherAttributes(spec ApplicationIdentifierSpec) string {
+	var attributes []string
+	for _, attr := range spec.Attributes {
+		name, _, _ := strings.Cut(attr, "=")
+		if name != DiffFieldReq && name != DiffFieldEx && name != DiffFieldDLPKey {
+			attributes = append(attributes, attr)
+		}
+	}
+	return strings.Join(attributes, " ")
+}
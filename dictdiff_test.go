@@ -0,0 +1,60 @@
+package gs1
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffSyntaxDictionaries(t *testing.T) {
+	from := `
+01         *?  N14,csum,keyoff1                 ex=255,37 dlpkey=22,10,21|235                    # GTIN
+10          ?  X..20                            req=01,02,8006,8026                              # BATCH/LOT
+253         ?  N13,csum,key [X..17]             dlpkey                                           # GDTI
+7003        ?  N6,yymmdd N4,hhmi                req=01,02                                        # EXPIRY TIME
+90          ?  X..30                                                                              # INTERNAL
+`
+	to := `
+01         *?  N14,csum,keyoff1                 ex=255,37 dlpkey=22,10,21|235|7040               # GTIN
+10          ?  X..20,nozeroprefix               req=01,02,8006,8026                              # BATCH/LOT
+253        *?  N13,csum,key [X..20]             dlpkey                                           # GDTI
+7241        ?  N2                               req=01                                           # AIDC MEDIA TYPE
+7003        ?  N6 N4,yymmdd,hhmi                req=01,02                                        # EXPIRY TIME
+`
+	fromSpecs, err := ParseSyntaxDictionary(strings.NewReader(from))
+	if err != nil {
+		t.Fatal(err)
+	}
+	toSpecs, err := ParseSyntaxDictionary(strings.NewReader(to))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := DiffSyntaxDictionaries(fromSpecs, toSpecs)
+	want := SyntaxDictionaryDiff{
+		Added:   []ApplicationIdentifierSpec{toSpecs[3]},
+		Removed: []ApplicationIdentifierSpec{fromSpecs[4]},
+		Changed: []AIChange{
+			{AI: "01", Title: "GTIN", Changes: []FieldChange{
+				{Field: DiffFieldDLPKey, From: "dlpkey=22,10,21|235", To: "dlpkey=22,10,21|235|7040"},
+			}},
+			{AI: "10", Title: "BATCH/LOT", Changes: []FieldChange{
+				{Field: DiffFieldLinters, From: "", To: "nozeroprefix"},
+			}},
+			{AI: "253", Title: "GDTI", Changes: []FieldChange{
+				{Field: DiffFieldFlags, From: "?", To: "*?"},
+				{Field: DiffFieldComponents, From: "N13 [X..17]", To: "N13 [X..20]"},
+			}},
+			{AI: "7003", Title: "EXPIRY TIME", Changes: []FieldChange{
+				{Field: DiffFieldLinters, From: "yymmdd hhmi", To: "- yymmdd,hhmi"},
+			}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffSyntaxDictionaries() = %+v, want %+v", got, want)
+	}
+
+	if diff := DiffSyntaxDictionaries(fromSpecs, fromSpecs); !diff.IsEmpty() {
+		t.Errorf("DiffSyntaxDictionaries() of the same release = %+v, want empty diff", diff)
+	}
+}
@@ -0,0 +1,195 @@
+package gs1
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// gtinAI is the AI of the GTIN, which is the only primary key that may be shortened within Digital Link URIs.
+const gtinAI = "01"
+
+// ParseDigitalLink parses a GS1 Digital Link URI as defined in https://ref.gs1.org/standards/digital-link/uri-syntax/,
+// e.g. `https://id.gs1.org/01/09526064055028/10/ABC123?17=250521`. The primary key and its key qualifiers are taken
+// from the path, data attributes from the query string. Query parameters that are not AIs, e.g. `linkType`, are
+// ignored. GTIN-8, GTIN-12 and GTIN-13 are normalised to GTIN-14.
+func ParseDigitalLink(uri string, opts ...Option) (d Message, _ error) {
+	registry := resolveRegistry(opts)
+	u, err := url.Parse(uri)
+	if err != nil {
+		return d, fmt.Errorf("invalid Digital Link URI: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return d, errors.New("invalid Digital Link URI: scheme must be http or https")
+	}
+
+	segments := strings.Split(strings.Trim(u.EscapedPath(), "/"), "/")
+	pkIndex := -1
+	// The path may start with arbitrary segments, so the primary key is the first AI from which on the remaining
+	// segments form AI value pairs.
+	for i := 0; i < len(segments); i++ {
+		if (len(segments)-i)%2 != 0 {
+			continue
+		}
+		if registry.digitalLinkSpecOf(segments[i]).IsValidPrimaryKey {
+			pkIndex = i
+			break
+		}
+	}
+	if pkIndex == -1 {
+		return d, errors.New("invalid Digital Link URI: no primary key found in path")
+	}
+
+	for i := pkIndex; i < len(segments); i += 2 {
+		ai, ok := registry.Lookup(segments[i])
+		if !ok {
+			return Message{}, fmt.Errorf("invalid Digital Link URI: unknown AI %s in path", segments[i])
+		}
+		value, err := url.PathUnescape(segments[i+1])
+		if err != nil {
+			return Message{}, fmt.Errorf("invalid Digital Link URI: %w", err)
+		}
+		if ai.AI == gtinAI {
+			value, err = normaliseGTIN(value)
+			if err != nil {
+				return Message{}, fmt.Errorf("invalid Digital Link URI: %w", err)
+			}
+		}
+		d.Elements = append(d.Elements, NewElementString(ai, value))
+	}
+	if err := checkDigitalLinkQualifiers(registry, d.Elements); err != nil {
+		return Message{}, fmt.Errorf("invalid Digital Link URI: %w", err)
+	}
+
+	// The order of the query string is kept, as url.ParseQuery would sort the parameters.
+	for _, param := range strings.Split(u.RawQuery, "&") {
+		if param == "" {
+			continue
+		}
+		rawKey, rawValue, _ := strings.Cut(param, "=")
+		ai, ok := registry.Lookup(rawKey)
+		if !ok {
+			continue // non-AI parameter, e.g. linkType
+		}
+		if !registry.digitalLinkSpecOf(ai.AI).IsValidDataAttribute {
+			return Message{}, fmt.Errorf("invalid Digital Link URI: AI (%s) is not a valid data attribute", ai.AI)
+		}
+		if _, exists := d.element(ai.AI); exists {
+			return Message{}, fmt.Errorf("invalid Digital Link URI: duplicate AI (%s)", ai.AI)
+		}
+		value, err := url.QueryUnescape(rawValue)
+		if err != nil {
+			return Message{}, fmt.Errorf("invalid Digital Link URI: %w", err)
+		}
+		d.Elements = append(d.Elements, NewElementString(ai, value))
+	}
+
+	d.SyntaxType = DigitalLinkURI
+	return d, nil
+}
+
+// checkDigitalLinkQualifiers ensures the key qualifiers following the primary key are allowed and in order.
+func checkDigitalLinkQualifiers(registry *Registry, path []ElementString) error {
+	spec := registry.digitalLinkSpecOf(path[0].AI)
+	qualifiers := path[1:]
+	if len(qualifiers) == 0 {
+		return nil
+	}
+	for _, sequence := range spec.AllowedQualifiers {
+		pos := 0
+		for _, q := range qualifiers {
+			idx := slices.IndexFunc(sequence[pos:], func(ai ApplicationIdentifier) bool { return ai.AI == q.AI })
+			if idx == -1 {
+				pos = -1
+				break
+			}
+			pos += idx + 1
+		}
+		if pos != -1 {
+			return nil
+		}
+	}
+	return fmt.Errorf("key qualifiers %v are not allowed for primary key (%s) or in wrong order", qualifiers, path[0].AI)
+}
+
+// normaliseGTIN pads GTIN-8, GTIN-12 and GTIN-13 with leading zeros to a GTIN-14.
+func normaliseGTIN(gtin string) (string, error) {
+	switch len(gtin) {
+	case 8, 12, 13, 14:
+	default:
+		return "", fmt.Errorf("invalid GTIN length %d", len(gtin))
+	}
+	if !isNumeric(gtin) {
+		return "", errors.New("GTIN must be numeric")
+	}
+	return strings.Repeat("0", 14-len(gtin)) + gtin, nil
+}
+
+// AsDigitalLink returns the Message as a GS1 Digital Link URI using the given domain, e.g. `https://example.com`. If
+// the domain is empty, [CanonicalPrefix] is used. The first primary key of the message is put into the path together
+// with its key qualifiers, all other elements are added as data attributes to the query string.
+func (d Message) AsDigitalLink(domain string, opts ...Option) (string, error) {
+	registry := resolveRegistry(opts)
+	if domain == "" {
+		domain = CanonicalPrefix
+	}
+
+	pkIndex := slices.IndexFunc(d.Elements, func(el ElementString) bool {
+		return registry.digitalLinkSpecOf(el.AI).IsValidPrimaryKey
+	})
+	if pkIndex == -1 {
+		return "", errors.New("message does not contain a Digital Link primary key")
+	}
+	pk := d.Elements[pkIndex]
+
+	qualifiers := digitalLinkQualifiers(registry, pk, d.Elements)
+
+	builder := strings.Builder{}
+	builder.WriteString(strings.TrimSuffix(domain, "/"))
+	for _, el := range append([]ElementString{pk}, qualifiers...) {
+		builder.WriteString("/")
+		builder.WriteString(el.AI)
+		builder.WriteString("/")
+		builder.WriteString(url.PathEscape(el.DataField))
+	}
+
+	separator := "?"
+	for i, el := range d.Elements {
+		if i == pkIndex || slices.ContainsFunc(qualifiers, func(q ElementString) bool { return q.AI == el.AI }) {
+			continue
+		}
+		if !registry.digitalLinkSpecOf(el.AI).IsValidDataAttribute {
+			return "", fmt.Errorf("AI (%s) is not a valid Digital Link data attribute", el.AI)
+		}
+		builder.WriteString(separator)
+		builder.WriteString(el.AI)
+		builder.WriteString("=")
+		// Spaces are percent-encoded rather than using '+' to be unambiguous within URI paths and queries alike.
+		builder.WriteString(strings.ReplaceAll(url.QueryEscape(el.DataField), "+", "%20"))
+		separator = "&"
+	}
+
+	return builder.String(), nil
+}
+
+// digitalLinkQualifiers selects the elements to be used as key qualifiers of the primary key. The qualifier sequence
+// matching most elements is used, its elements are returned in the order defined by the sequence.
+func digitalLinkQualifiers(registry *Registry, pk ElementString, elements []ElementString) (qualifiers []ElementString) {
+	for _, sequence := range registry.digitalLinkSpecOf(pk.AI).AllowedQualifiers {
+		var matched []ElementString
+		for _, ai := range sequence {
+			for _, el := range elements {
+				if el.AI == ai.AI {
+					matched = append(matched, el)
+					break
+				}
+			}
+		}
+		if len(matched) > len(qualifiers) {
+			qualifiers = matched
+		}
+	}
+	return qualifiers
+}
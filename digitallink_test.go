@@ -0,0 +1,138 @@
+package gs1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDigitalLink(t *testing.T) {
+	tests := []struct {
+		name    string
+		uri     string
+		want    []ElementString
+		wantErr bool
+	}{
+		{
+			name: "Canonical URI with qualifiers and attributes SHOULD parse",
+			uri:  "https://id.gs1.org/01/09526064055028/10/ABC123/21/456DEF?17=250521",
+			want: []ElementString{
+				NewElementString(AI01, "09526064055028"),
+				NewElementString(AI10, "ABC123"),
+				NewElementString(AI21, "456DEF"),
+				NewElementString(AI17, "250521"),
+			},
+		},
+		{
+			name: "URI with path prefix, escaped values and non-AI parameters SHOULD parse",
+			uri:  "https://example.com/products/01/09526064055028/21/AB%2F1?linkType=gs1:pip&3103=000195",
+			want: []ElementString{
+				NewElementString(AI01, "09526064055028"),
+				NewElementString(AI21, "AB/1"),
+				NewElementString(AI3103, "000195"),
+			},
+		},
+		{
+			name: "GTIN-13 SHOULD be normalised to GTIN-14",
+			uri:  "https://example.com/01/9526064055028",
+			want: []ElementString{NewElementString(AI01, "09526064055028")},
+		},
+		{
+			name: "SSCC without qualifiers SHOULD parse",
+			uri:  "https://example.com/00/106141411234567897",
+			want: []ElementString{NewElementString(AI00, "106141411234567897")},
+		},
+		{
+			name:    "Qualifiers in wrong order SHOULD return an error",
+			uri:     "https://example.com/01/09526064055028/21/456DEF/10/ABC123",
+			wantErr: true,
+		},
+		{
+			name:    "Qualifiers of different sequences SHOULD return an error",
+			uri:     "https://example.com/01/09526064055028/10/ABC123/235/XYZ",
+			wantErr: true,
+		},
+		{
+			name:    "URI without primary key SHOULD return an error",
+			uri:     "https://example.com/10/ABC123",
+			wantErr: true,
+		},
+		{
+			name:    "Attribute not permitted in Digital Link SHOULD return an error",
+			uri:     "https://example.com/01/09526064055028?03=09526064055028",
+			wantErr: true,
+		},
+		{
+			name:    "URN SHOULD return an error",
+			uri:     "urn:epc:id:sgtin:0614141.812345.6789",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDigitalLink(tt.uri)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDigitalLink() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			want := Message{SyntaxType: DigitalLinkURI, Elements: tt.want}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ParseDigitalLink() got = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestMessage_AsDigitalLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		elements []ElementString
+		domain   string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name: "Qualifiers SHOULD be put into the path in order of the qualifier sequence",
+			elements: []ElementString{
+				NewElementString(AI01, "09526064055028"),
+				NewElementString(AI17, "250521"),
+				NewElementString(AI21, "456/DEF"),
+				NewElementString(AI10, "ABC123"),
+			},
+			want: "https://id.gs1.org/01/09526064055028/10/ABC123/21/456%2FDEF?17=250521",
+		},
+		{
+			name:     "Custom domain SHOULD be used",
+			elements: []ElementString{NewElementString(AI00, "106141411234567897")},
+			domain:   "https://example.com/",
+			want:     "https://example.com/00/106141411234567897",
+		},
+		{
+			name:     "Message without primary key SHOULD return an error",
+			elements: []ElementString{NewElementString(AI10, "ABC123")},
+			wantErr:  true,
+		},
+		{
+			name: "Element not permitted as data attribute SHOULD return an error",
+			elements: []ElementString{
+				NewElementString(AI01, "09526064055028"),
+				NewElementString(AI03, "09526064055028"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Message{Elements: tt.elements}.AsDigitalLink(tt.domain)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AsDigitalLink() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("AsDigitalLink() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
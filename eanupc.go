@@ -0,0 +1,161 @@
+package gs1
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseEANUPC parses scan data of EAN/UPC symbols preceded by one of the symbology identifiers ]E0 to ]E4, e.g.
+// ]E04006381333931. EAN-13, UPC-A and EAN-8 data are returned as AI (01) element normalised to a GTIN-14. UPC-E
+// transmitted in its zero-suppressed form of 8 digits is expanded to the UPC-A. Add-ons are returned as
+// [Message.AddOn]:
+//
+//   - ]E0: EAN-13 of 13 digits, UPC-A of 12 digits or UPC-E of 8 digits
+//   - ]E1: 2-digit add-on only
+//   - ]E2: 5-digit add-on only
+//   - ]E3: EAN-13, UPC-A, UPC-E or EAN-8 followed by a 2- or 5-digit add-on, transmitted as 13 or 8 digits
+//   - ]E4: EAN-8 of 8 digits
+func ParseEANUPC(data string, opts ...Option) (Message, error) {
+	registry := resolveRegistry(opts)
+	symbology, err := ParseSymbologyIdentifier(data[:min(3, len(data))])
+	if err != nil {
+		return Message{}, err
+	}
+	if info, ok := symbology.Info(); !ok || info.Content != GTINData {
+		return Message{}, fmt.Errorf("invalid symbology identifier %s: must be ]E0 to ]E4", symbology)
+	}
+	data = data[3:]
+	if !isNumeric(data) {
+		return Message{}, fmt.Errorf("invalid EAN/UPC data %q: must be numeric", data)
+	}
+
+	var gtin string
+	d := Message{Symbology: symbology, SyntaxType: BarcodeMessageScanData}
+	switch n := len(data); {
+	case symbology.Mode == 0 && n == 8:
+		if gtin, err = UPCEToUPCA(data); err != nil {
+			return Message{}, err
+		}
+	case symbology.Mode == 0 && (n == 12 || n == 13), symbology.Mode == 4 && n == 8:
+		gtin = data
+	case symbology.Mode == 1 && n == 2, symbology.Mode == 2 && n == 5:
+		d.AddOn = data
+		return d, nil
+	case symbology.Mode == 3 && (n == 15 || n == 18):
+		gtin, d.AddOn = data[:13], data[13:]
+	case symbology.Mode == 3 && (n == 10 || n == 13):
+		gtin, d.AddOn = data[:8], data[8:]
+	default:
+		return Message{}, fmt.Errorf("invalid length %d of EAN/UPC data for symbology identifier %s", n, symbology)
+	}
+
+	normalised, err := ParseGTIN(gtin)
+	if err != nil {
+		return Message{}, err
+	}
+	ai, err := registry.lookup("01")
+	if err != nil {
+		return Message{}, err
+	}
+	d.Elements = []ElementString{{ApplicationIdentifier: ai, DataField: string(normalised)}}
+	return d, nil
+}
+
+// ParseITF14 parses scan data of ITF-14 symbols preceded by the symbology identifier ]I1, e.g. ]I114012345678908.
+// The GTIN-14 is returned as AI (01) element.
+func ParseITF14(data string, opts ...Option) (Message, error) {
+	registry := resolveRegistry(opts)
+	symbology, err := ParseSymbologyIdentifier(data[:min(3, len(data))])
+	if err != nil {
+		return Message{}, err
+	}
+	if symbology != (SymbologyIdentifier{ITF, 1}) {
+		return Message{}, fmt.Errorf("invalid symbology identifier %s: must be ]I1", symbology)
+	}
+	data = data[3:]
+	if len(data) != 14 || !isNumeric(data) {
+		return Message{}, fmt.Errorf("invalid ITF-14 data %q: must consist of 14 digits", data)
+	}
+	gtin, err := ParseGTIN(data)
+	if err != nil {
+		return Message{}, err
+	}
+	ai, err := registry.lookup("01")
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{
+		Symbology:  symbology,
+		SyntaxType: BarcodeMessageScanData,
+		Elements:   []ElementString{{ApplicationIdentifier: ai, DataField: string(gtin)}},
+	}, nil
+}
+
+// UPCEToUPCA expands a UPC-E of 8 digits including number system and check digit to the UPC-A it was zero-suppressed
+// from, see GS1 General Specifications, section 5.2.2.4.2.
+func UPCEToUPCA(upce string) (string, error) {
+	if len(upce) != 8 || !isNumeric(upce) {
+		return "", fmt.Errorf("invalid UPC-E %q: must consist of 8 digits", upce)
+	}
+	if upce[0] != '0' && upce[0] != '1' {
+		return "", fmt.Errorf("invalid UPC-E %s: number system must be 0 or 1", upce)
+	}
+	d := upce[1:7]
+	var digits string
+	switch d[5] {
+	case '0', '1', '2':
+		digits = d[:2] + d[5:] + "0000" + d[2:5]
+	case '3':
+		digits = d[:3] + "00000" + d[3:5]
+	case '4':
+		digits = d[:4] + "00000" + d[4:5]
+	default:
+		digits = d[:5] + "0000" + d[5:]
+	}
+	return upce[:1] + digits + upce[7:], nil
+}
+
+// UPCAToUPCE returns the UPC-E of 8 digits of a UPC-A of 12 digits including its check digit. It returns an error if
+// the UPC-A cannot be zero-suppressed.
+func UPCAToUPCE(upca string) (string, error) {
+	if len(upca) != 12 || !isNumeric(upca) {
+		return "", fmt.Errorf("invalid UPC-A %q: must consist of 12 digits", upca)
+	}
+	if upca[0] != '0' && upca[0] != '1' {
+		return "", fmt.Errorf("invalid UPC-A %s: number system must be 0 or 1 for UPC-E", upca)
+	}
+	manufacturer, item := upca[1:6], upca[6:11]
+	var d string
+	switch {
+	case manufacturer[2] <= '2' && strings.HasSuffix(manufacturer, "00") && item[:2] == "00":
+		d = manufacturer[:2] + item[2:] + manufacturer[2:3]
+	case strings.HasSuffix(manufacturer, "00") && item[:3] == "000":
+		d = manufacturer[:3] + item[3:] + "3"
+	case strings.HasSuffix(manufacturer, "0") && item[:4] == "0000":
+		d = manufacturer[:4] + item[4:] + "4"
+	case item[:4] == "0000" && item[4] >= '5':
+		d = manufacturer + item[4:]
+	default:
+		return "", fmt.Errorf("UPC-A %s cannot be zero-suppressed to UPC-E", upca)
+	}
+	return upca[:1] + d + upca[11:], nil
+}
+
+// eanUPCScanData returns the data of the message as transmitted by EAN/UPC symbols with the given symbology
+// identifier mode, see [ParseEANUPC]. GTINs with six leading zeros are transmitted as EAN-8 in modes 3 and 4.
+func (d Message) eanUPCScanData(mode int) string {
+	var gtin string
+	if el, ok := d.element("01"); ok && len(el.DataField) == 14 {
+		gtin = el.DataField[1:]
+		if (mode == 3 || mode == 4) && strings.HasPrefix(el.DataField, "000000") {
+			gtin = el.DataField[6:]
+		}
+	}
+	switch mode {
+	case 1, 2:
+		return d.AddOn
+	case 3:
+		return gtin + d.AddOn
+	}
+	return gtin
+}
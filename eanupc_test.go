@@ -0,0 +1,171 @@
+package gs1
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseEANUPC(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantGTIN  string
+		wantAddOn string
+	}{
+		{name: "EAN-13 SHOULD be parsed as GTIN", data: "]E04006381333931", wantGTIN: "04006381333931"},
+		{name: "UPC-A SHOULD be parsed as GTIN", data: "]E00012345000065", wantGTIN: "00012345000065"},
+		{name: "UPC-A of 12 digits SHOULD be parsed as GTIN", data: "]E0012345000065", wantGTIN: "00012345000065"},
+		{name: "UPC-E SHOULD be expanded", data: "]E001234565", wantGTIN: "00012345000065"},
+		{name: "EAN-8 SHOULD be parsed as GTIN", data: "]E450123452", wantGTIN: "00000050123452"},
+		{name: "2-digit add-on SHOULD be parsed without GTIN", data: "]E112", wantAddOn: "12"},
+		{name: "5-digit add-on SHOULD be parsed without GTIN", data: "]E251234", wantAddOn: "51234"},
+		{name: "EAN-13 with 2-digit add-on SHOULD be split", data: "]E3400638133393112", wantGTIN: "04006381333931",
+			wantAddOn: "12"},
+		{name: "EAN-13 with 5-digit add-on SHOULD be split", data: "]E3400638133393151234",
+			wantGTIN: "04006381333931", wantAddOn: "51234"},
+		{name: "EAN-8 with 5-digit add-on SHOULD be split", data: "]E35012345251234", wantGTIN: "00000050123452",
+			wantAddOn: "51234"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, parse := range []func(string, ...Option) (Message, error){ParseEANUPC, ParseMessage} {
+				got, err := parse(tt.data)
+				if err != nil {
+					t.Fatalf("ParseEANUPC() error = %v", err)
+				}
+				var want []ElementString
+				if tt.wantGTIN != "" {
+					want = []ElementString{NewElementString(AI01, tt.wantGTIN)}
+				}
+				if !reflect.DeepEqual(got.Elements, want) || got.AddOn != tt.wantAddOn {
+					t.Errorf("ParseEANUPC() = %v with add-on %q, want %v with add-on %q", got.Elements, got.AddOn,
+						want, tt.wantAddOn)
+				}
+				if got.SyntaxType != BarcodeMessageScanData || got.Symbology.String() != tt.data[:3] {
+					t.Errorf("ParseEANUPC() syntax = %s %s, want %s %s", got.SyntaxType, got.Symbology,
+						BarcodeMessageScanData, tt.data[:3])
+				}
+			}
+		})
+	}
+}
+
+func TestParseEANUPC_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "other symbologies SHOULD be rejected", data: "]C1010401234567890", wantErr: "must be ]E0 to ]E4"},
+		{name: "missing symbology identifier SHOULD be rejected", data: "]E", wantErr: "invalid symbology identifier"},
+		{name: "non-digits SHOULD be rejected", data: "]E0400638133393A", wantErr: "must be numeric"},
+		{name: "wrong check digit SHOULD be rejected", data: "]E04006381333932", wantErr: "wrong check digit"},
+		{name: "wrong length SHOULD be rejected", data: "]E4400638133393", wantErr: "invalid length 12"},
+		{name: "add-on without GTIN SHOULD be rejected in mode 3", data: "]E312", wantErr: "invalid length 2"},
+		{name: "UPC-E of number system 2 SHOULD be rejected", data: "]E021234565", wantErr: "number system"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseEANUPC(tt.data)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseEANUPC() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUPCE(t *testing.T) {
+	tests := []struct {
+		name string
+		upca string
+		upce string
+	}{
+		{name: "manufacturer ending in 000 to 200 SHOULD keep three item digits", upca: "012000003455", upce: "01234505"},
+		{name: "manufacturer ending in 300 to 900 SHOULD keep two item digits", upca: "045600000125",
+			upce: "04561235"},
+		{name: "manufacturer ending in 0 SHOULD keep one item digit", upca: "045670000085", upce: "04567845"},
+		{name: "item 5 to 9 SHOULD keep the manufacturer", upca: "012345000065", upce: "01234565"},
+		{name: "number system 1 SHOULD be kept", upca: "112345000065", upce: "11234565"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upce, err := UPCAToUPCE(tt.upca)
+			if err != nil || upce != tt.upce {
+				t.Errorf("UPCAToUPCE() = %s, %v, want %s", upce, err, tt.upce)
+			}
+			upca, err := UPCEToUPCA(tt.upce)
+			if err != nil || upca != tt.upca {
+				t.Errorf("UPCEToUPCA() = %s, %v, want %s", upca, err, tt.upca)
+			}
+		})
+	}
+
+	for _, upca := range []string{"012345600065", "012345000045", "212345000065", "01234500006"} {
+		if _, err := UPCAToUPCE(upca); err == nil {
+			t.Errorf("UPCAToUPCE(%s) SHOULD fail", upca)
+		}
+	}
+}
+
+func TestMessage_AsScanData_EANUPC(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		symbology SymbologyIdentifier
+		want      string
+	}{
+		{name: "EAN-13 SHOULD be transmitted as 13 digits", data: "]E04006381333931",
+			symbology: SymbologyIdentifier{EANUPC, 0}, want: "]E04006381333931"},
+		{name: "UPC-E SHOULD be transmitted expanded", data: "]E001234565",
+			symbology: SymbologyIdentifier{EANUPC, 0}, want: "]E00012345000065"},
+		{name: "EAN-8 SHOULD be transmitted as 8 digits", data: "]E450123452",
+			symbology: SymbologyIdentifier{EANUPC, 4}, want: "]E450123452"},
+		{name: "add-on SHOULD follow the GTIN", data: "]E35012345251234",
+			symbology: SymbologyIdentifier{EANUPC, 3}, want: "]E35012345251234"},
+		{name: "add-on SHOULD be transmitted alone", data: "]E3400638133393112",
+			symbology: SymbologyIdentifier{EANUPC, 1}, want: "]E112"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := ParseEANUPC(tt.data)
+			if err != nil {
+				t.Fatalf("ParseEANUPC() error = %v", err)
+			}
+			if got := msg.AsScanData(tt.symbology); got != tt.want {
+				t.Errorf("AsScanData() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseITF14(t *testing.T) {
+	msg, err := ParseITF14("]I114012345678908")
+	if err != nil {
+		t.Fatalf("ParseITF14() error = %v", err)
+	}
+	if got := msg.AsElementString(); got != "(01)14012345678908" {
+		t.Errorf("ParseITF14() = %s, want (01)14012345678908", got)
+	}
+	if got := msg.AsScanData(SymbologyIdentifier{ITF, 1}); got != "]I114012345678908" {
+		t.Errorf("AsScanData() = %s, want ]I114012345678908", got)
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "other modes SHOULD be rejected", data: "]I014012345678908", wantErr: "must be ]I1"},
+		{name: "GTIN-13 SHOULD be rejected", data: "]I14006381333931", wantErr: "must consist of 14 digits"},
+		{name: "wrong check digit SHOULD be rejected", data: "]I114012345678902", wantErr: "wrong check digit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseITF14(tt.data)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseITF14() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
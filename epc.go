@@ -0,0 +1,509 @@
+package gs1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// epcPureIdentityPrefix starts every EPC pure identity URI, see GS1 EPC Tag Data Standard (TDS) §6.3.
+const epcPureIdentityPrefix = "urn:epc:id:"
+
+const (
+	minGCPLength = 6  // minGCPLength is the shortest GS1 Company Prefix supported by the TDS
+	maxGCPLength = 12 // maxGCPLength is the longest GS1 Company Prefix supported by the TDS
+)
+
+// EPCScheme names an EPC scheme of the GS1 EPC Tag Data Standard as used in pure identity URIs, e.g. `sgtin`.
+type EPCScheme string
+
+const (
+	SGTIN EPCScheme = "sgtin" // SGTIN is the serialised GTIN, (01) + (21)
+	SSCC  EPCScheme = "sscc"  // SSCC is the serial shipping container code, (00)
+	SGLN  EPCScheme = "sgln"  // SGLN is the GLN with extension, (414) + (254)
+	GRAI  EPCScheme = "grai"  // GRAI is the global returnable asset identifier, (8003)
+	GIAI  EPCScheme = "giai"  // GIAI is the global individual asset identifier, (8004)
+	GSRN  EPCScheme = "gsrn"  // GSRN is the global service relation number of a recipient, (8018)
+	GSRNP EPCScheme = "gsrnp" // GSRNP is the global service relation number of a provider, (8017)
+	GDTI  EPCScheme = "gdti"  // GDTI is the global document type identifier, (253)
+	SGCN  EPCScheme = "sgcn"  // SGCN is the serialised global coupon number, (255)
+	CPI   EPCScheme = "cpi"   // CPI is the component / part identifier, (8010) + (8011)
+	ITIP  EPCScheme = "itip"  // ITIP is the individual trade item piece, (8006) + (21)
+	UPUI  EPCScheme = "upui"  // UPUI is the unit pack identifier, (01) + (235)
+	PGLN  EPCScheme = "pgln"  // PGLN is the GLN of a party, (417)
+)
+
+// epcSchemeCodec translates between the element strings of a [Message] and the dot separated fields of an EPC pure
+// identity URI. The fields passed to and returned by a codec are not escaped.
+type epcSchemeCodec struct {
+	// keyAI is the AI identifying the EPC scheme within a message.
+	keyAI string
+	// fields is the amount of dot separated fields following the scheme name.
+	fields int
+	// escaped marks the fields that need to be escaped according to TDS Table A-1.
+	escaped []int
+	encode  func(m Message, gcpLength int) ([]string, error)
+	decode  func(fields []string) ([]ElementString, error)
+}
+
+// epcSchemes is ordered by precedence: (01) with (21) yields an SGTIN before (01) with (235) yields an UPUI.
+var epcSchemes = []struct {
+	scheme EPCScheme
+	codec  epcSchemeCodec
+}{
+	{SSCC, epcSchemeCodec{keyAI: "00", fields: 2, encode: encodeSSCC, decode: decodeSSCC}},
+	{SGTIN, epcSchemeCodec{keyAI: "01", fields: 3, escaped: []int{2}, encode: encodeSGTIN("21"), decode: decodeSGTIN("21")}},
+	{UPUI, epcSchemeCodec{keyAI: "01", fields: 3, escaped: []int{2}, encode: encodeSGTIN("235"), decode: decodeSGTIN("235")}},
+	{SGLN, epcSchemeCodec{keyAI: "414", fields: 3, escaped: []int{2}, encode: encodeSGLN, decode: decodeSGLN}},
+	{PGLN, epcSchemeCodec{keyAI: "417", fields: 2, encode: encodeGLN("417"), decode: decodeGLN("417")}},
+	{GRAI, epcSchemeCodec{keyAI: "8003", fields: 3, escaped: []int{2}, encode: encodeGRAI, decode: decodeGRAI}},
+	{GIAI, epcSchemeCodec{keyAI: "8004", fields: 2, escaped: []int{1}, encode: encodeGIAI, decode: decodeGIAI}},
+	{GSRN, epcSchemeCodec{keyAI: "8018", fields: 2, encode: encodeGSRN("8018"), decode: decodeGSRN("8018")}},
+	{GSRNP, epcSchemeCodec{keyAI: "8017", fields: 2, encode: encodeGSRN("8017"), decode: decodeGSRN("8017")}},
+	{GDTI, epcSchemeCodec{keyAI: "253", fields: 3, escaped: []int{2}, encode: encodeSerialisedGLNLike("253"), decode: decodeSerialisedGLNLike("253")}},
+	{SGCN, epcSchemeCodec{keyAI: "255", fields: 3, encode: encodeSerialisedGLNLike("255"), decode: decodeSerialisedGLNLike("255")}},
+	{CPI, epcSchemeCodec{keyAI: "8010", fields: 3, escaped: []int{1}, encode: encodeCPI, decode: decodeCPI}},
+	{ITIP, epcSchemeCodec{keyAI: "8006", fields: 5, escaped: []int{4}, encode: encodeITIP, decode: decodeITIP}},
+}
+
+// ToEPCURI converts the GS1 keys of the message into an EPC pure identity URI as defined by the GS1 EPC Tag Data
+// Standard, e.g. (01)09526064055028(21)ABC/1 becomes `urn:epc:id:sgtin:952606.0405502.ABC%2F1` for a GS1 Company
+// Prefix of length 6. The gcpLength is required, as the length of the GS1 Company Prefix cannot be derived from the
+// key itself.
+func (d Message) ToEPCURI(gcpLength int) (string, error) {
+	if gcpLength < minGCPLength || gcpLength > maxGCPLength {
+		return "", fmt.Errorf("invalid GS1 Company Prefix length %d: must be between %d and %d", gcpLength, minGCPLength, maxGCPLength)
+	}
+
+	var firstErr error
+	for _, s := range epcSchemes {
+		if _, ok := d.element(s.codec.keyAI); !ok {
+			continue
+		}
+		fields, err := s.codec.encode(d, gcpLength)
+		if err != nil {
+			// Try the next scheme sharing the same key, e.g. UPUI after SGTIN.
+			if firstErr == nil {
+				firstErr = fmt.Errorf("error encoding %s: %w", s.scheme, err)
+			}
+			continue
+		}
+		for _, i := range s.codec.escaped {
+			fields[i] = escapeEPCURIComponent(fields[i])
+		}
+		return epcPureIdentityPrefix + string(s.scheme) + ":" + strings.Join(fields, "."), nil
+	}
+
+	if firstErr != nil {
+		return "", firstErr
+	}
+	return "", errors.New("message does not contain a GS1 key representable as EPC")
+}
+
+// ParseEPCURI parses an EPC pure identity URI such as `urn:epc:id:sscc:952606.41234567890` and returns the
+// [Message] carrying the corresponding element strings. AIs are looked up using the [DefaultRegistry] unless another
+// registry is passed using [WithRegistry] or [WithAtomicRegistry].
+func ParseEPCURI(uri string, opts ...Option) (d Message, _ error) {
+	if !strings.HasPrefix(uri, epcPureIdentityPrefix) {
+		return d, fmt.Errorf("invalid EPC URI: must begin with %q", epcPureIdentityPrefix)
+	}
+	scheme, body, ok := strings.Cut(uri[len(epcPureIdentityPrefix):], ":")
+	if !ok {
+		return d, errors.New("invalid EPC URI: missing scheme")
+	}
+
+	codec, ok := lookupEPCScheme(EPCScheme(scheme))
+	if !ok {
+		return d, fmt.Errorf("unsupported EPC scheme: %s", scheme)
+	}
+
+	// The last field is a serial or extension of CSET 82, which may contain unescaped dots.
+	fields := strings.SplitN(body, ".", codec.fields)
+	if len(fields) != codec.fields {
+		return d, fmt.Errorf("invalid EPC URI: scheme %s requires %d fields, got %d", scheme, codec.fields, len(fields))
+	}
+	for _, i := range codec.escaped {
+		unescaped, err := unescapeEPCURIComponent(fields[i])
+		if err != nil {
+			return d, fmt.Errorf("invalid EPC URI: %w", err)
+		}
+		fields[i] = unescaped
+	}
+
+	elements, err := codec.decode(fields)
+	if err == nil {
+		elements, err = resolveRegistry(opts).rebind(elements)
+	}
+	if err != nil {
+		return d, fmt.Errorf("error decoding %s: %w", scheme, err)
+	}
+
+	d.SyntaxType = EPCPureIdentityURI
+	d.Elements = elements
+	return d, nil
+}
+
+func lookupEPCScheme(scheme EPCScheme) (epcSchemeCodec, bool) {
+	for _, s := range epcSchemes {
+		if s.scheme == scheme {
+			return s.codec, true
+		}
+	}
+	return epcSchemeCodec{}, false
+}
+
+// numericElement returns the data of the AI if it is present and consists of exactly length digits.
+func (d Message) numericElement(ai string, length int) (string, error) {
+	el, ok := d.element(ai)
+	if !ok {
+		return "", fmt.Errorf("missing AI (%s)", ai)
+	}
+	if len(el.DataField) != length || !isNumeric(el.DataField) {
+		return "", fmt.Errorf("AI (%s) must consist of %d digits", ai, length)
+	}
+	return el.DataField, nil
+}
+
+// serialElement returns the non-empty data of the AI.
+func (d Message) serialElement(ai string) (string, error) {
+	el, ok := d.element(ai)
+	if !ok || el.DataField == "" {
+		return "", fmt.Errorf("missing AI (%s)", ai)
+	}
+	return el.DataField, nil
+}
+
+func encodeSSCC(m Message, gcpLength int) ([]string, error) {
+	sscc, err := m.numericElement("00", 18)
+	if err != nil {
+		return nil, err
+	}
+	return []string{sscc[1 : 1+gcpLength], sscc[:1] + sscc[1+gcpLength:17]}, nil
+}
+
+func decodeSSCC(fields []string) ([]ElementString, error) {
+	if err := checkEPCNumericFields(17, fields...); err != nil {
+		return nil, err
+	}
+	sscc := fields[1][:1] + fields[0] + fields[1][1:]
+	return []ElementString{NewElementString(AIRegistry["00"], withCheckDigit(sscc))}, nil
+}
+
+// encodeSGTIN encodes (01) together with the given serial AI, which is (21) for SGTIN and (235) for UPUI.
+func encodeSGTIN(serialAI string) func(Message, int) ([]string, error) {
+	return func(m Message, gcpLength int) ([]string, error) {
+		gtin, err := m.numericElement("01", 14)
+		if err != nil {
+			return nil, err
+		}
+		serial, err := m.serialElement(serialAI)
+		if err != nil {
+			return nil, err
+		}
+		return []string{gtin[1 : 1+gcpLength], gtin[:1] + gtin[1+gcpLength:13], serial}, nil
+	}
+}
+
+func decodeSGTIN(serialAI string) func([]string) ([]ElementString, error) {
+	return func(fields []string) ([]ElementString, error) {
+		if err := checkEPCNumericFields(13, fields[:2]...); err != nil {
+			return nil, err
+		}
+		if err := checkEPCSerial(fields[2]); err != nil {
+			return nil, err
+		}
+		gtin := fields[1][:1] + fields[0] + fields[1][1:]
+		return []ElementString{
+			NewElementString(AIRegistry["01"], withCheckDigit(gtin)),
+			NewElementString(AIRegistry[serialAI], fields[2]),
+		}, nil
+	}
+}
+
+func encodeSGLN(m Message, gcpLength int) ([]string, error) {
+	gln, err := m.numericElement("414", 13)
+	if err != nil {
+		return nil, err
+	}
+	// An absent extension is expressed as a single zero, see TDS §6.3.3.
+	extension := "0"
+	if el, ok := m.element("254"); ok && el.DataField != "" {
+		extension = el.DataField
+	}
+	return []string{gln[:gcpLength], gln[gcpLength:12], extension}, nil
+}
+
+func decodeSGLN(fields []string) ([]ElementString, error) {
+	if err := checkEPCNumericFields(12, fields[:2]...); err != nil {
+		return nil, err
+	}
+	if err := checkEPCSerial(fields[2]); err != nil {
+		return nil, err
+	}
+	elements := []ElementString{NewElementString(AIRegistry["414"], withCheckDigit(fields[0]+fields[1]))}
+	if fields[2] != "0" {
+		elements = append(elements, NewElementString(AIRegistry["254"], fields[2]))
+	}
+	return elements, nil
+}
+
+// encodeGLN encodes a plain GLN, e.g. (417) for PGLN.
+func encodeGLN(ai string) func(Message, int) ([]string, error) {
+	return func(m Message, gcpLength int) ([]string, error) {
+		gln, err := m.numericElement(ai, 13)
+		if err != nil {
+			return nil, err
+		}
+		return []string{gln[:gcpLength], gln[gcpLength:12]}, nil
+	}
+}
+
+func decodeGLN(ai string) func([]string) ([]ElementString, error) {
+	return func(fields []string) ([]ElementString, error) {
+		if err := checkEPCNumericFields(12, fields...); err != nil {
+			return nil, err
+		}
+		return []ElementString{NewElementString(AIRegistry[ai], withCheckDigit(fields[0]+fields[1]))}, nil
+	}
+}
+
+func encodeGRAI(m Message, gcpLength int) ([]string, error) {
+	el, ok := m.element("8003")
+	if !ok {
+		return nil, errors.New("missing AI (8003)")
+	}
+	// The data field of (8003) is a leading zero, the GRAI-13 and the optional serial component.
+	grai := el.DataField
+	if len(grai) <= 14 || grai[0] != '0' || !isNumeric(grai[:14]) {
+		return nil, errors.New("AI (8003) must consist of a zero, 13 digits and a serial component")
+	}
+	return []string{grai[1 : 1+gcpLength], grai[1+gcpLength : 13], grai[14:]}, nil
+}
+
+func decodeGRAI(fields []string) ([]ElementString, error) {
+	if err := checkEPCNumericFields(12, fields[:2]...); err != nil {
+		return nil, err
+	}
+	if err := checkEPCSerial(fields[2]); err != nil {
+		return nil, err
+	}
+	grai := "0" + withCheckDigit(fields[0]+fields[1]) + fields[2]
+	return []ElementString{NewElementString(AIRegistry["8003"], grai)}, nil
+}
+
+func encodeGIAI(m Message, gcpLength int) ([]string, error) {
+	giai, err := m.serialElement("8004")
+	if err != nil {
+		return nil, err
+	}
+	if len(giai) <= gcpLength || !isNumeric(giai[:gcpLength]) {
+		return nil, errors.New("AI (8004) must start with the GS1 Company Prefix followed by an asset reference")
+	}
+	return []string{giai[:gcpLength], giai[gcpLength:]}, nil
+}
+
+func decodeGIAI(fields []string) ([]ElementString, error) {
+	if err := checkEPCCompanyPrefix(fields[0]); err != nil {
+		return nil, err
+	}
+	if err := checkEPCSerial(fields[1]); err != nil {
+		return nil, err
+	}
+	return []ElementString{NewElementString(AIRegistry["8004"], fields[0]+fields[1])}, nil
+}
+
+// encodeGSRN encodes a GSRN either of a recipient (8018) or a provider (8017).
+func encodeGSRN(ai string) func(Message, int) ([]string, error) {
+	return func(m Message, gcpLength int) ([]string, error) {
+		gsrn, err := m.numericElement(ai, 18)
+		if err != nil {
+			return nil, err
+		}
+		return []string{gsrn[:gcpLength], gsrn[gcpLength:17]}, nil
+	}
+}
+
+func decodeGSRN(ai string) func([]string) ([]ElementString, error) {
+	return func(fields []string) ([]ElementString, error) {
+		if err := checkEPCNumericFields(17, fields...); err != nil {
+			return nil, err
+		}
+		return []ElementString{NewElementString(AIRegistry[ai], withCheckDigit(fields[0]+fields[1]))}, nil
+	}
+}
+
+// encodeSerialisedGLNLike encodes keys structured as 13 digits followed by a serial component, which applies to the
+// GDTI (253) and the GCN (255).
+func encodeSerialisedGLNLike(ai string) func(Message, int) ([]string, error) {
+	return func(m Message, gcpLength int) ([]string, error) {
+		key, err := m.serialElement(ai)
+		if err != nil {
+			return nil, err
+		}
+		if len(key) <= 13 || !isNumeric(key[:13]) {
+			return nil, fmt.Errorf("AI (%s) must consist of 13 digits and a serial component", ai)
+		}
+		return []string{key[:gcpLength], key[gcpLength:12], key[13:]}, nil
+	}
+}
+
+func decodeSerialisedGLNLike(ai string) func([]string) ([]ElementString, error) {
+	return func(fields []string) ([]ElementString, error) {
+		if err := checkEPCNumericFields(12, fields[:2]...); err != nil {
+			return nil, err
+		}
+		if err := checkEPCSerial(fields[2]); err != nil {
+			return nil, err
+		}
+		return []ElementString{NewElementString(AIRegistry[ai], withCheckDigit(fields[0]+fields[1])+fields[2])}, nil
+	}
+}
+
+func encodeCPI(m Message, gcpLength int) ([]string, error) {
+	cpi, err := m.serialElement("8010")
+	if err != nil {
+		return nil, err
+	}
+	serial := m.data("8011")
+	if serial == "" || !isNumeric(serial) {
+		return nil, errors.New("missing numeric AI (8011)")
+	}
+	if len(cpi) <= gcpLength || !isNumeric(cpi[:gcpLength]) {
+		return nil, errors.New("AI (8010) must start with the GS1 Company Prefix followed by a component reference")
+	}
+	return []string{cpi[:gcpLength], cpi[gcpLength:], serial}, nil
+}
+
+func decodeCPI(fields []string) ([]ElementString, error) {
+	if err := checkEPCCompanyPrefix(fields[0]); err != nil {
+		return nil, err
+	}
+	if fields[1] == "" || strings.Trim(fields[1], "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ#-/") != "" {
+		return nil, errors.New("component reference must consist of digits, upper case letters, '#', '-' and '/'")
+	}
+	if fields[2] == "" || !isNumeric(fields[2]) {
+		return nil, errors.New("CPI serial must be numeric")
+	}
+	return []ElementString{
+		NewElementString(AIRegistry["8010"], fields[0]+fields[1]),
+		NewElementString(AIRegistry["8011"], fields[2]),
+	}, nil
+}
+
+func encodeITIP(m Message, gcpLength int) ([]string, error) {
+	itip, err := m.numericElement("8006", 18)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := m.serialElement("21")
+	if err != nil {
+		return nil, err
+	}
+	return []string{itip[1 : 1+gcpLength], itip[:1] + itip[1+gcpLength:13], itip[14:16], itip[16:18], serial}, nil
+}
+
+func decodeITIP(fields []string) ([]ElementString, error) {
+	if err := checkEPCNumericFields(13, fields[:2]...); err != nil {
+		return nil, err
+	}
+	if len(fields[2]) != 2 || len(fields[3]) != 2 || !isNumeric(fields[2]+fields[3]) {
+		return nil, errors.New("piece and total must consist of two digits each")
+	}
+	if err := checkEPCSerial(fields[4]); err != nil {
+		return nil, err
+	}
+	gtin := withCheckDigit(fields[1][:1] + fields[0] + fields[1][1:])
+	return []ElementString{
+		NewElementString(AIRegistry["8006"], gtin+fields[2]+fields[3]),
+		NewElementString(AIRegistry["21"], fields[4]),
+	}, nil
+}
+
+// checkEPCCompanyPrefix ensures a GS1 Company Prefix taken from an EPC URI is numeric and of a valid length.
+func checkEPCCompanyPrefix(gcp string) error {
+	if len(gcp) < minGCPLength || len(gcp) > maxGCPLength || !isNumeric(gcp) {
+		return fmt.Errorf("invalid GS1 Company Prefix %q", gcp)
+	}
+	return nil
+}
+
+// checkEPCNumericFields ensures that the first field is a GS1 Company Prefix and that all fields are numeric and
+// together consist of length digits. The reference following a 12 digit GS1 Company Prefix may be empty, e.g. the
+// location reference of an SGLN.
+func checkEPCNumericFields(length int, fields ...string) error {
+	if err := checkEPCCompanyPrefix(fields[0]); err != nil {
+		return err
+	}
+	total := 0
+	for _, field := range fields {
+		if !isNumeric(field) {
+			return fmt.Errorf("field %q must be numeric", field)
+		}
+		total += len(field)
+	}
+	if total != length {
+		return fmt.Errorf("fields %v must consist of %d digits, got %d", fields, length, total)
+	}
+	return nil
+}
+
+// checkEPCSerial ensures the serial component is made of characters from GS1 AI encodable character set 82.
+func checkEPCSerial(serial string) error {
+	if serial == "" {
+		return errors.New("missing serial component")
+	}
+	if !isCSET82(serial) {
+		return fmt.Errorf("serial %q contains characters outside of CSET 82", serial)
+	}
+	return nil
+}
+
+// epcURIUnreservedChars are the CSET 82 characters that can be used in EPC URIs without escaping, see TDS Table A-1.
+const epcURIUnreservedChars = "!'()*+,-.:;=_"
+
+// escapeEPCURIComponent escapes characters as required by the TDS Table A-1, e.g. '/' is escaped as %2F.
+func escapeEPCURIComponent(s string) string {
+	builder := strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isAlphanumeric(c) || strings.IndexByte(epcURIUnreservedChars, c) >= 0 {
+			builder.WriteByte(c)
+		} else {
+			fmt.Fprintf(&builder, "%%%02X", c)
+		}
+	}
+	return builder.String()
+}
+
+// unescapeEPCURIComponent reverses [escapeEPCURIComponent].
+func unescapeEPCURIComponent(s string) (string, error) {
+	builder := strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '%' {
+			builder.WriteByte(c)
+			continue
+		}
+		if i+2 >= len(s) || !isHexDigit(s[i+1]) || !isHexDigit(s[i+2]) {
+			return "", fmt.Errorf("invalid escape sequence in %q", s)
+		}
+		builder.WriteByte(hexValue(s[i+1])<<4 | hexValue(s[i+2]))
+		i += 2
+	}
+	return builder.String(), nil
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'A' && c <= 'F') || (c >= 'a' && c <= 'f')
+}
+
+func hexValue(c byte) byte {
+	switch {
+	case c >= 'a':
+		return c - 'a' + 10
+	case c >= 'A':
+		return c - 'A' + 10
+	default:
+		return c - '0'
+	}
+}
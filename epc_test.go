@@ -0,0 +1,277 @@
+package gs1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMessage_ToEPCURI(t *testing.T) {
+	tests := []struct {
+		name      string
+		elements  []ElementString
+		gcpLength int
+		want      string
+		wantErr   bool
+	}{
+		{
+			name: "GTIN with serial SHOULD convert to SGTIN",
+			elements: []ElementString{
+				NewElementString(AI01, "80614141123458"),
+				NewElementString(AI21, "6789"),
+			},
+			gcpLength: 7,
+			want:      "urn:epc:id:sgtin:0614141.812345.6789",
+		},
+		{
+			name: "SGTIN serial with reserved characters SHOULD be escaped",
+			elements: []ElementString{
+				NewElementString(AI01, "09526064055028"),
+				NewElementString(AI17, "250521"),
+				NewElementString(AI21, "AB/1%\"?"),
+			},
+			gcpLength: 6,
+			want:      "urn:epc:id:sgtin:952606.0405502.AB%2F1%25%22%3F",
+		},
+		{
+			name:      "GTIN without serial SHOULD return an error",
+			elements:  []ElementString{NewElementString(AI01, "80614141123458")},
+			gcpLength: 7,
+			wantErr:   true,
+		},
+		{
+			name: "GTIN with TPX SHOULD convert to UPUI",
+			elements: []ElementString{
+				NewElementString(AI01, "80614141123458"),
+				NewElementString(AI235, "51qIgY)<&Jp3*j7'SDB"),
+			},
+			gcpLength: 7,
+			want:      "urn:epc:id:upui:0614141.812345.51qIgY)%3C%26Jp3*j7'SDB",
+		},
+		{
+			name:      "SSCC SHOULD convert",
+			elements:  []ElementString{NewElementString(AI00, "106141411234567897")},
+			gcpLength: 7,
+			want:      "urn:epc:id:sscc:0614141.1123456789",
+		},
+		{
+			name: "GLN with extension SHOULD convert to SGLN",
+			elements: []ElementString{
+				NewElementString(AI414, "0614141123452"),
+				NewElementString(AI254, "5678"),
+			},
+			gcpLength: 7,
+			want:      "urn:epc:id:sgln:0614141.12345.5678",
+		},
+		{
+			name:      "GLN without extension SHOULD convert to SGLN with zero extension",
+			elements:  []ElementString{NewElementString(AI414, "0614141123452")},
+			gcpLength: 7,
+			want:      "urn:epc:id:sgln:0614141.12345.0",
+		},
+		{
+			name:      "GRAI with serial SHOULD convert",
+			elements:  []ElementString{NewElementString(AI8003, "00614141123452400")},
+			gcpLength: 7,
+			want:      "urn:epc:id:grai:0614141.12345.400",
+		},
+		{
+			name:      "GRAI without serial SHOULD return an error",
+			elements:  []ElementString{NewElementString(AI8003, "00614141123452")},
+			gcpLength: 7,
+			wantErr:   true,
+		},
+		{
+			name:      "GIAI SHOULD convert",
+			elements:  []ElementString{NewElementString(AI8004, "061414112345400")},
+			gcpLength: 7,
+			want:      "urn:epc:id:giai:0614141.12345400",
+		},
+		{
+			name:      "GSRN SHOULD convert",
+			elements:  []ElementString{NewElementString(AI8018, "061414112345678902")},
+			gcpLength: 7,
+			want:      "urn:epc:id:gsrn:0614141.1234567890",
+		},
+		{
+			name:      "GDTI SHOULD convert",
+			elements:  []ElementString{NewElementString(AI253, "0614141123452400")},
+			gcpLength: 7,
+			want:      "urn:epc:id:gdti:0614141.12345.400",
+		},
+		{
+			name: "ITIP SHOULD convert",
+			elements: []ElementString{
+				NewElementString(AI8006, "806141411234580102"),
+				NewElementString(AI21, "981"),
+			},
+			gcpLength: 7,
+			want:      "urn:epc:id:itip:0614141.812345.01.02.981",
+		},
+		{
+			name:      "Invalid GS1 Company Prefix length SHOULD return an error",
+			elements:  []ElementString{NewElementString(AI00, "106141411234567897")},
+			gcpLength: 13,
+			wantErr:   true,
+		},
+		{
+			name:      "Message without GS1 key SHOULD return an error",
+			elements:  []ElementString{NewElementString(AI10, "ABC")},
+			gcpLength: 7,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Message{Elements: tt.elements}.ToEPCURI(tt.gcpLength)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ToEPCURI() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ToEPCURI() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseEPCURI(t *testing.T) {
+	tests := []struct {
+		name    string
+		uri     string
+		want    []ElementString
+		wantErr bool
+	}{
+		{
+			name: "SGTIN SHOULD parse",
+			uri:  "urn:epc:id:sgtin:0614141.812345.6789",
+			want: []ElementString{
+				NewElementString(AI01, "80614141123458"),
+				NewElementString(AI21, "6789"),
+			},
+		},
+		{
+			name: "SGTIN with escaped serial SHOULD parse",
+			uri:  "urn:epc:id:sgtin:952606.0405502.AB%2F1%25%22%3F",
+			want: []ElementString{
+				NewElementString(AI01, "09526064055028"),
+				NewElementString(AI21, "AB/1%\"?"),
+			},
+		},
+		{
+			name: "SSCC SHOULD parse",
+			uri:  "urn:epc:id:sscc:0614141.1123456789",
+			want: []ElementString{NewElementString(AI00, "106141411234567897")},
+		},
+		{
+			name: "SGLN with extension SHOULD parse into (414) and (254)",
+			uri:  "urn:epc:id:sgln:0614141.12345.5678",
+			want: []ElementString{
+				NewElementString(AI414, "0614141123452"),
+				NewElementString(AI254, "5678"),
+			},
+		},
+		{
+			name: "SGLN with zero extension SHOULD parse into (414) only",
+			uri:  "urn:epc:id:sgln:0614141.12345.0",
+			want: []ElementString{NewElementString(AI414, "0614141123452")},
+		},
+		{
+			name: "SGLN with 12 digit GS1 Company Prefix SHOULD parse with empty location reference",
+			uri:  "urn:epc:id:sgln:061414112345..0",
+			want: []ElementString{NewElementString(AI414, "0614141123452")},
+		},
+		{
+			name: "GRAI SHOULD parse",
+			uri:  "urn:epc:id:grai:0614141.12345.400",
+			want: []ElementString{NewElementString(AI8003, "00614141123452400")},
+		},
+		{
+			name: "CPI SHOULD parse",
+			uri:  "urn:epc:id:cpi:0614141.123ABC%2F.123456789",
+			want: []ElementString{
+				NewElementString(AI8010, "0614141123ABC/"),
+				NewElementString(AI8011, "123456789"),
+			},
+		},
+		{
+			name:    "Unknown scheme SHOULD return an error",
+			uri:     "urn:epc:id:foo:0614141.1",
+			wantErr: true,
+		},
+		{
+			name:    "Wrong amount of fields SHOULD return an error",
+			uri:     "urn:epc:id:sgtin:0614141.812345",
+			wantErr: true,
+		},
+		{
+			name:    "Wrong total length of numeric fields SHOULD return an error",
+			uri:     "urn:epc:id:sgtin:0614141.8123456.1",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid escape sequence SHOULD return an error",
+			uri:     "urn:epc:id:sgtin:0614141.812345.A%2",
+			wantErr: true,
+		},
+		{
+			name:    "Tag URI SHOULD return an error",
+			uri:     "urn:epc:tag:sgtin-96:3.0614141.812345.6789",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEPCURI(tt.uri)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseEPCURI() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			want := Message{SyntaxType: EPCPureIdentityURI, Elements: tt.want}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ParseEPCURI() got = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestEPCURI_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		elements  []ElementString
+		gcpLength int
+	}{
+		{
+			name:      "SGTIN with dots in the serial SHOULD round-trip",
+			elements:  []ElementString{NewElementString(AI01, "09526064055028"), NewElementString(AI21, "A.B")},
+			gcpLength: 7,
+		},
+		{
+			name:      "GIAI with dots in the asset reference SHOULD round-trip",
+			elements:  []ElementString{NewElementString(AI8004, "0614141.A.1")},
+			gcpLength: 7,
+		},
+		{
+			name:      "SGLN of a 12 digit GS1 Company Prefix SHOULD round-trip",
+			elements:  []ElementString{NewElementString(AI414, "0614141123452"), NewElementString(AI254, "5678")},
+			gcpLength: 12,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri, err := Message{Elements: tt.elements}.ToEPCURI(tt.gcpLength)
+			if err != nil {
+				t.Fatalf("ToEPCURI() error = %v", err)
+			}
+			got, err := ParseEPCURI(uri)
+			if err != nil {
+				t.Fatalf("ParseEPCURI(%s) error = %v", uri, err)
+			}
+			if !reflect.DeepEqual(got.Elements, tt.elements) {
+				t.Errorf("ParseEPCURI(%s) = %v, want %v", uri, got.Elements, tt.elements)
+			}
+		})
+	}
+}
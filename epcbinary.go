@@ -0,0 +1,391 @@
+package gs1
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// EPCBinaryScheme names an EPC binary encoding scheme of the GS1 EPC Tag Data Standard (TDS), e.g. `sgtin-96`.
+type EPCBinaryScheme string
+
+const (
+	SGTIN96  EPCBinaryScheme = "sgtin-96"
+	SGTIN198 EPCBinaryScheme = "sgtin-198"
+	SSCC96   EPCBinaryScheme = "sscc-96"
+	SGLN96   EPCBinaryScheme = "sgln-96"
+	SGLN195  EPCBinaryScheme = "sgln-195"
+	GRAI96   EPCBinaryScheme = "grai-96"
+	GRAI170  EPCBinaryScheme = "grai-170"
+	GIAI96   EPCBinaryScheme = "giai-96"
+	GIAI202  EPCBinaryScheme = "giai-202"
+	GSRN96   EPCBinaryScheme = "gsrn-96"
+	GSRNP96  EPCBinaryScheme = "gsrnp-96"
+	GDTI96   EPCBinaryScheme = "gdti-96"
+	GDTI174  EPCBinaryScheme = "gdti-174"
+	SGCN96   EPCBinaryScheme = "sgcn-96"
+	ITIP110  EPCBinaryScheme = "itip-110"
+	ITIP212  EPCBinaryScheme = "itip-212"
+)
+
+// EPCTag describes the content of the EPC memory bank of an RFID tag.
+type EPCTag struct {
+	// Scheme is the binary encoding scheme denoted by the header.
+	Scheme EPCBinaryScheme
+	// Filter is the filter value between 0 and 7 that enables an RFID reader to select tags of a certain type, e.g.
+	// 1 for point of sale trade items.
+	Filter int
+	// GCPLength is the length of the GS1 Company Prefix as denoted by the partition value. It is zero for the + schemes,
+	// as they do not encode the GS1 Company Prefix length.
+	GCPLength int
+	// Message carries the element strings of the encoded GS1 key.
+	Message Message
+}
+
+// epcFieldEncoding is the way a single EPC field is encoded, see TDS §14.3.
+type epcFieldEncoding int
+
+const (
+	// epcInteger encodes a decimal number without leading zeros.
+	epcInteger epcFieldEncoding = iota
+	// epcPaddedInteger encodes a decimal number of a fixed amount of digits including leading zeros.
+	epcPaddedInteger
+	// epcString encodes characters as 7-bit ISO 646 values followed by zero bits.
+	epcString
+	// epcNumericString encodes digits including leading zeros by prepending a one digit.
+	epcNumericString
+	// epcReserved denotes zero bits that are not mapped to an EPC URI field.
+	epcReserved
+)
+
+type epcBinaryField struct {
+	encoding epcFieldEncoding
+	bits     int
+	digits   int // digits is the amount of digits of an epcPaddedInteger or the maximum length of an epcString
+}
+
+// epcPartition is a row of a partition table, see e.g. TDS Table 14-2.
+type epcPartition struct {
+	gcpBits, gcpDigits int
+	refBits, refDigits int
+}
+
+var (
+	// epcPartitionsSGTIN applies to the SGTIN and ITIP.
+	epcPartitionsSGTIN = []epcPartition{
+		{40, 12, 4, 1}, {37, 11, 7, 2}, {34, 10, 10, 3}, {30, 9, 14, 4}, {27, 8, 17, 5}, {24, 7, 20, 6}, {20, 6, 24, 7},
+	}
+	// epcPartitionsSSCC applies to the SSCC, GSRN and GSRNP.
+	epcPartitionsSSCC = []epcPartition{
+		{40, 12, 18, 5}, {37, 11, 21, 6}, {34, 10, 24, 7}, {30, 9, 28, 8}, {27, 8, 31, 9}, {24, 7, 34, 10}, {20, 6, 38, 11},
+	}
+	// epcPartitionsSGLN applies to the SGLN, GRAI, GDTI and SGCN.
+	epcPartitionsSGLN = []epcPartition{
+		{40, 12, 1, 0}, {37, 11, 4, 1}, {34, 10, 7, 2}, {30, 9, 11, 3}, {27, 8, 14, 4}, {24, 7, 17, 5}, {20, 6, 21, 6},
+	}
+	epcPartitionsGIAI96 = []epcPartition{
+		{40, 12, 42, 13}, {37, 11, 45, 14}, {34, 10, 48, 15}, {30, 9, 52, 16}, {27, 8, 55, 17}, {24, 7, 58, 18}, {20, 6, 62, 19},
+	}
+	epcPartitionsGIAI202 = []epcPartition{
+		{40, 12, 148, 18}, {37, 11, 151, 19}, {34, 10, 154, 20}, {30, 9, 158, 21}, {27, 8, 161, 22}, {24, 7, 164, 23}, {20, 6, 168, 24},
+	}
+)
+
+// epcBinaryLayout describes the bits following header and filter value. The partitioned fields map to the GS1 Company
+// Prefix and the subsequent field of the EPC URI, the remaining fields map to the following EPC URI fields.
+type epcBinaryLayout struct {
+	header      byte
+	scheme      EPCScheme
+	partitions  []epcPartition
+	refEncoding epcFieldEncoding
+	fields      []epcBinaryField
+}
+
+var epcBinaryLayouts = map[EPCBinaryScheme]epcBinaryLayout{
+	SGTIN96:  {0x30, SGTIN, epcPartitionsSGTIN, epcPaddedInteger, []epcBinaryField{{encoding: epcInteger, bits: 38}}},
+	SGTIN198: {0x36, SGTIN, epcPartitionsSGTIN, epcPaddedInteger, []epcBinaryField{{encoding: epcString, bits: 140}}},
+	SSCC96:   {0x31, SSCC, epcPartitionsSSCC, epcPaddedInteger, []epcBinaryField{{encoding: epcReserved, bits: 24}}},
+	SGLN96:   {0x32, SGLN, epcPartitionsSGLN, epcPaddedInteger, []epcBinaryField{{encoding: epcInteger, bits: 41}}},
+	SGLN195:  {0x39, SGLN, epcPartitionsSGLN, epcPaddedInteger, []epcBinaryField{{encoding: epcString, bits: 140}}},
+	GRAI96:   {0x33, GRAI, epcPartitionsSGLN, epcPaddedInteger, []epcBinaryField{{encoding: epcInteger, bits: 38}}},
+	GRAI170:  {0x37, GRAI, epcPartitionsSGLN, epcPaddedInteger, []epcBinaryField{{encoding: epcString, bits: 112}}},
+	GIAI96:   {0x34, GIAI, epcPartitionsGIAI96, epcInteger, nil},
+	GIAI202:  {0x38, GIAI, epcPartitionsGIAI202, epcString, nil},
+	GSRN96:   {0x2D, GSRN, epcPartitionsSSCC, epcPaddedInteger, []epcBinaryField{{encoding: epcReserved, bits: 24}}},
+	GSRNP96:  {0x2E, GSRNP, epcPartitionsSSCC, epcPaddedInteger, []epcBinaryField{{encoding: epcReserved, bits: 24}}},
+	GDTI96:   {0x2C, GDTI, epcPartitionsSGLN, epcPaddedInteger, []epcBinaryField{{encoding: epcInteger, bits: 41}}},
+	GDTI174:  {0x3E, GDTI, epcPartitionsSGLN, epcPaddedInteger, []epcBinaryField{{encoding: epcString, bits: 119}}},
+	SGCN96:   {0x3F, SGCN, epcPartitionsSGLN, epcPaddedInteger, []epcBinaryField{{encoding: epcNumericString, bits: 41}}},
+	ITIP110: {0x40, ITIP, epcPartitionsSGTIN, epcPaddedInteger, []epcBinaryField{
+		{encoding: epcPaddedInteger, bits: 7, digits: 2},
+		{encoding: epcPaddedInteger, bits: 7, digits: 2},
+		{encoding: epcInteger, bits: 38},
+	}},
+	ITIP212: {0x41, ITIP, epcPartitionsSGTIN, epcPaddedInteger, []epcBinaryField{
+		{encoding: epcPaddedInteger, bits: 7, digits: 2},
+		{encoding: epcPaddedInteger, bits: 7, digits: 2},
+		{encoding: epcString, bits: 140},
+	}},
+}
+
+const (
+	epcHeaderBits    = 8
+	epcFilterBits    = 3
+	epcPartitionBits = 3
+	// epcWordBits is the size of a memory word of a Gen2 RFID tag. Encodings are padded to full words.
+	epcWordBits  = 16
+	maxEPCFilter = 7
+)
+
+// ToEPCBinary encodes the GS1 key of the message into the given EPC binary scheme as written into the EPC memory bank
+// of an RFID tag. The result is padded with zero bits to a multiple of 16 bits. The + schemes of TDS 2.0, e.g.
+// [SGTINPlus], ignore gcpLength and append all elements not being part of the EPC as AIDC data, which allows to
+// encode a message parsed by [ParseDigitalLink] as a whole. The AIDC data is encoded as specified by the
+// [DefaultRegistry] unless another registry is passed using [WithRegistry] or [WithAtomicRegistry].
+func (d Message) ToEPCBinary(scheme EPCBinaryScheme, filter int, gcpLength int, opts ...Option) ([]byte, error) {
+	if filter < 0 || filter > maxEPCFilter {
+		return nil, fmt.Errorf("invalid filter value %d: must be between 0 and %d", filter, maxEPCFilter)
+	}
+	if plusLayout, ok := epcPlusLayouts[scheme]; ok {
+		return d.encodeEPCPlus(resolveRegistry(opts), scheme, plusLayout, filter)
+	}
+	layout, ok := epcBinaryLayouts[scheme]
+	if !ok {
+		return nil, fmt.Errorf("unsupported EPC binary scheme: %s", scheme)
+	}
+	if gcpLength < minGCPLength || gcpLength > maxGCPLength {
+		return nil, fmt.Errorf("invalid GS1 Company Prefix length %d: must be between %d and %d", gcpLength, minGCPLength, maxGCPLength)
+	}
+
+	codec, _ := lookupEPCScheme(layout.scheme)
+	if _, ok := d.element(codec.keyAI); !ok {
+		return nil, fmt.Errorf("error encoding %s: missing AI (%s)", scheme, codec.keyAI)
+	}
+	fields, err := codec.encode(d, gcpLength)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding %s: %w", scheme, err)
+	}
+
+	partitionValue := maxGCPLength - gcpLength
+	partition := layout.partitions[partitionValue]
+
+	w := bitWriter{}
+	w.writeUint(uint64(layout.header), epcHeaderBits)
+	w.writeUint(uint64(filter), epcFilterBits)
+	w.writeUint(uint64(partitionValue), epcPartitionBits)
+	if err := writeEPCField(&w, fields[0], epcBinaryField{epcPaddedInteger, partition.gcpBits, partition.gcpDigits}); err != nil {
+		return nil, fmt.Errorf("error encoding %s: %w", scheme, err)
+	}
+	refField := epcBinaryField{layout.refEncoding, partition.refBits, partition.refDigits}
+	if err := writeEPCField(&w, fields[1], refField); err != nil {
+		return nil, fmt.Errorf("error encoding %s: %w", scheme, err)
+	}
+	uriFields := fields[2:]
+	for _, field := range layout.fields {
+		if field.encoding == epcReserved {
+			w.writeUint(0, field.bits)
+			continue
+		}
+		if err := writeEPCField(&w, uriFields[0], field); err != nil {
+			return nil, fmt.Errorf("error encoding %s: %w", scheme, err)
+		}
+		uriFields = uriFields[1:]
+	}
+	w.padTo(epcWordBits)
+
+	return w.data, nil
+}
+
+// ToEPCHex is like [Message.ToEPCBinary] but returns upper case hexadecimal characters.
+func (d Message) ToEPCHex(scheme EPCBinaryScheme, filter int, gcpLength int, opts ...Option) (string, error) {
+	data, err := d.ToEPCBinary(scheme, filter, gcpLength, opts...)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(hex.EncodeToString(data)), nil
+}
+
+// DecodeEPCBinary decodes the content of an EPC memory bank. The scheme is detected by the header. Additional bits
+// following the encoding, e.g. word padding, are ignored. AIDC data of the + schemes is returned as part of the
+// message, use [Message.AsDigitalLink] to obtain the corresponding GS1 Digital Link URI. AIs are looked up using the
+// [DefaultRegistry] unless another registry is passed using [WithRegistry] or [WithAtomicRegistry].
+func DecodeEPCBinary(data []byte, opts ...Option) (tag EPCTag, _ error) {
+	registry := resolveRegistry(opts)
+	r := bitReader{data: data}
+	header, err := r.readUint(epcHeaderBits)
+	if err != nil {
+		return tag, errors.New("error decoding EPC: missing header")
+	}
+
+	for scheme, l := range epcPlusLayouts {
+		if uint64(l.header) == header {
+			tag.Scheme = scheme
+			return decodeEPCPlus(registry, &r, tag, l)
+		}
+	}
+	var layout epcBinaryLayout
+	for scheme, l := range epcBinaryLayouts {
+		if uint64(l.header) == header {
+			tag.Scheme = scheme
+			layout = l
+		}
+	}
+	if tag.Scheme == "" {
+		return tag, fmt.Errorf("error decoding EPC: unsupported header 0x%02X", header)
+	}
+
+	filter, err := r.readUint(epcFilterBits)
+	if err != nil {
+		return tag, fmt.Errorf("error decoding %s: %w", tag.Scheme, err)
+	}
+	tag.Filter = int(filter)
+	partitionValue, err := r.readUint(epcPartitionBits)
+	if err != nil {
+		return tag, fmt.Errorf("error decoding %s: %w", tag.Scheme, err)
+	}
+	if int(partitionValue) >= len(layout.partitions) {
+		return tag, fmt.Errorf("error decoding %s: invalid partition value %d", tag.Scheme, partitionValue)
+	}
+	partition := layout.partitions[partitionValue]
+	tag.GCPLength = partition.gcpDigits
+
+	gcp, err := readEPCField(&r, epcBinaryField{epcPaddedInteger, partition.gcpBits, partition.gcpDigits})
+	if err != nil {
+		return tag, fmt.Errorf("error decoding %s: %w", tag.Scheme, err)
+	}
+	ref, err := readEPCField(&r, epcBinaryField{layout.refEncoding, partition.refBits, partition.refDigits})
+	if err != nil {
+		return tag, fmt.Errorf("error decoding %s: %w", tag.Scheme, err)
+	}
+	fields := []string{gcp, ref}
+	for _, field := range layout.fields {
+		value, err := readEPCField(&r, field)
+		if err != nil {
+			return tag, fmt.Errorf("error decoding %s: %w", tag.Scheme, err)
+		}
+		if field.encoding != epcReserved {
+			fields = append(fields, value)
+		}
+	}
+
+	codec, _ := lookupEPCScheme(layout.scheme)
+	elements, err := codec.decode(fields)
+	if err == nil {
+		elements, err = registry.rebind(elements)
+	}
+	if err != nil {
+		return tag, fmt.Errorf("error decoding %s: %w", tag.Scheme, err)
+	}
+	tag.Message = Message{SyntaxType: EPCBinaryEncoding, Elements: elements}
+
+	return tag, nil
+}
+
+// DecodeEPCHex is like [DecodeEPCBinary] but accepts hexadecimal characters.
+func DecodeEPCHex(s string, opts ...Option) (EPCTag, error) {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		return EPCTag{}, fmt.Errorf("error decoding EPC hex: %w", err)
+	}
+	return DecodeEPCBinary(data, opts...)
+}
+
+func writeEPCField(w *bitWriter, value string, field epcBinaryField) error {
+	switch field.encoding {
+	case epcInteger, epcPaddedInteger, epcNumericString:
+		if !isNumeric(value) {
+			return fmt.Errorf("value %q must be numeric", value)
+		}
+		switch field.encoding {
+		case epcInteger:
+			if value == "" || (len(value) > 1 && value[0] == '0') {
+				return fmt.Errorf("value %q must be an integer without leading zeros", value)
+			}
+		case epcPaddedInteger:
+			if len(value) != field.digits {
+				return fmt.Errorf("value %q must consist of %d digits", value, field.digits)
+			}
+		case epcNumericString:
+			value = "1" + value
+		}
+		if value == "" {
+			w.writeUint(0, field.bits)
+			return nil
+		}
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || (field.bits < 64 && n >= 1<<uint(field.bits)) {
+			return fmt.Errorf("value %q exceeds %d bits", value, field.bits)
+		}
+		w.writeUint(n, field.bits)
+	case epcString:
+		maxChars := field.bits / 7
+		if field.digits > 0 {
+			maxChars = field.digits
+		}
+		if len(value) > maxChars {
+			return fmt.Errorf("value %q exceeds %d characters", value, maxChars)
+		}
+		if !isCSET82(value) {
+			return fmt.Errorf("value %q contains characters outside of CSET 82", value)
+		}
+		for i := 0; i < len(value); i++ {
+			w.writeUint(uint64(value[i]), 7)
+		}
+		w.writeUint(0, field.bits-len(value)*7)
+	}
+	return nil
+}
+
+func readEPCField(r *bitReader, field epcBinaryField) (string, error) {
+	switch field.encoding {
+	case epcString:
+		builder := strings.Builder{}
+		terminated := false
+		for i := 0; i < field.bits/7; i++ {
+			c, err := r.readUint(7)
+			if err != nil {
+				return "", err
+			}
+			if c == 0 {
+				terminated = true
+			} else if terminated {
+				return "", errors.New("invalid string: characters following the terminating zero")
+			} else {
+				builder.WriteByte(byte(c))
+			}
+		}
+		if _, err := r.readUint(field.bits % 7); err != nil {
+			return "", err
+		}
+		return builder.String(), nil
+	default:
+		n, err := r.readUint(field.bits)
+		if err != nil {
+			return "", err
+		}
+		switch field.encoding {
+		case epcPaddedInteger:
+			if field.digits == 0 {
+				if n != 0 {
+					return "", errors.New("invalid field: expected zero bits")
+				}
+				return "", nil
+			}
+			if n >= uint64(math.Pow10(field.digits)) {
+				return "", fmt.Errorf("invalid field: %d exceeds %d digits", n, field.digits)
+			}
+			return fmt.Sprintf("%0*d", field.digits, n), nil
+		case epcNumericString:
+			value := strconv.FormatUint(n, 10)
+			if value[0] != '1' {
+				return "", errors.New("invalid numeric string: missing leading one digit")
+			}
+			return value[1:], nil
+		default:
+			return strconv.FormatUint(n, 10), nil
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package gs1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMessage_ToEPCHex(t *testing.T) {
+	type args struct {
+		scheme    EPCBinaryScheme
+		filter    int
+		gcpLength int
+	}
+	tests := []struct {
+		name     string
+		elements []ElementString
+		args     args
+		want     string
+		wantErr  bool
+	}{
+		{
+			name: "SGTIN-96 SHOULD encode",
+			elements: []ElementString{
+				NewElementString(AI01, "80614141123458"),
+				NewElementString(AI21, "6789"),
+			},
+			args: args{SGTIN96, 3, 7},
+			want: "3074257BF7194E4000001A85",
+		},
+		{
+			name:     "SSCC-96 SHOULD encode",
+			elements: []ElementString{NewElementString(AI00, "106141411234567897")},
+			args:     args{SSCC96, 3, 7},
+			want:     "3174257BF442F69715000000",
+		},
+		{
+			name: "SGTIN-198 SHOULD encode alphanumeric serial and pad to full words",
+			elements: []ElementString{
+				NewElementString(AI01, "80614141123458"),
+				NewElementString(AI21, "A"),
+			},
+			args: args{SGTIN198, 3, 7},
+			want: "3674257BF7194E60800000000000000000000000000000000000",
+		},
+		{
+			name: "SGTIN-96 with leading zero in serial SHOULD return an error",
+			elements: []ElementString{
+				NewElementString(AI01, "80614141123458"),
+				NewElementString(AI21, "0123"),
+			},
+			args:    args{SGTIN96, 3, 7},
+			wantErr: true,
+		},
+		{
+			name: "SGTIN-96 with alphanumeric serial SHOULD return an error",
+			elements: []ElementString{
+				NewElementString(AI01, "80614141123458"),
+				NewElementString(AI21, "ABC"),
+			},
+			args:    args{SGTIN96, 3, 7},
+			wantErr: true,
+		},
+		{
+			name: "SGTIN-198 with serial longer than 20 characters SHOULD return an error",
+			elements: []ElementString{
+				NewElementString(AI01, "80614141123458"),
+				NewElementString(AI21, "ABCDEFGHIJKLMNOPQRSTU"),
+			},
+			args:    args{SGTIN198, 3, 7},
+			wantErr: true,
+		},
+		{
+			name:     "Filter value out of range SHOULD return an error",
+			elements: []ElementString{NewElementString(AI00, "106141411234567897")},
+			args:     args{SSCC96, 8, 7},
+			wantErr:  true,
+		},
+		{
+			name:     "Scheme not matching the GS1 key SHOULD return an error",
+			elements: []ElementString{NewElementString(AI00, "106141411234567897")},
+			args:     args{SGTIN96, 3, 7},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Message{Elements: tt.elements}.ToEPCHex(tt.args.scheme, tt.args.filter, tt.args.gcpLength)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ToEPCHex() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ToEPCHex() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeEPCHex(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		want    EPCTag
+		wantErr bool
+	}{
+		{
+			name: "SGTIN-96 SHOULD decode",
+			hex:  "3074257BF7194E4000001A85",
+			want: EPCTag{
+				Scheme:    SGTIN96,
+				Filter:    3,
+				GCPLength: 7,
+				Message: Message{
+					SyntaxType: EPCBinaryEncoding,
+					Elements: []ElementString{
+						NewElementString(AI01, "80614141123458"),
+						NewElementString(AI21, "6789"),
+					},
+				},
+			},
+		},
+		{
+			name: "SSCC-96 SHOULD decode",
+			hex:  "3174257BF442F69715000000",
+			want: EPCTag{
+				Scheme:    SSCC96,
+				Filter:    3,
+				GCPLength: 7,
+				Message: Message{
+					SyntaxType: EPCBinaryEncoding,
+					Elements:   []ElementString{NewElementString(AI00, "106141411234567897")},
+				},
+			},
+		},
+		{
+			name:    "Unknown header SHOULD return an error",
+			hex:     "FF74257BF7194E4000001A85",
+			wantErr: true,
+		},
+		{
+			name:    "Truncated data SHOULD return an error",
+			hex:     "3074257BF719",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid partition value SHOULD return an error",
+			hex:     "307C257BF7194E4000001A85",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid hex characters SHOULD return an error",
+			hex:     "30XX",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeEPCHex(tt.hex)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DecodeEPCHex() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeEPCHex() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEPCBinary_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		scheme    EPCBinaryScheme
+		gcpLength int
+		elements  []ElementString
+	}{
+		{"SGTIN-198", SGTIN198, 7, []ElementString{NewElementString(AI01, "80614141123458"), NewElementString(AI21, "Ab/1%\"?")}},
+		{"SGLN-96 without extension", SGLN96, 12, []ElementString{NewElementString(AI414, "0614141123452")}},
+		{"SGLN-96", SGLN96, 7, []ElementString{NewElementString(AI414, "0614141123452"), NewElementString(AI254, "5678")}},
+		{"SGLN-195", SGLN195, 7, []ElementString{NewElementString(AI414, "0614141123452"), NewElementString(AI254, "0ab")}},
+		{"GRAI-96", GRAI96, 7, []ElementString{NewElementString(AI8003, "00614141123452400")}},
+		{"GRAI-170", GRAI170, 7, []ElementString{NewElementString(AI8003, "00614141123452X-1")}},
+		{"GIAI-96", GIAI96, 7, []ElementString{NewElementString(AI8004, "061414112345400")}},
+		{"GIAI-202", GIAI202, 6, []ElementString{NewElementString(AI8004, "061414ASSET/12")}},
+		{"GSRN-96", GSRN96, 7, []ElementString{NewElementString(AI8018, "061414112345678902")}},
+		{"GDTI-96", GDTI96, 7, []ElementString{NewElementString(AI253, "0614141123452400")}},
+		{"GDTI-174", GDTI174, 7, []ElementString{NewElementString(AI253, "0614141123452Doc-1")}},
+		{"SGCN-96 with leading zeros", SGCN96, 12, []ElementString{NewElementString(AI255, "06141411234520042")}},
+		{"ITIP-110", ITIP110, 7, []ElementString{NewElementString(AI8006, "806141411234580102"), NewElementString(AI21, "981")}},
+		{"ITIP-212", ITIP212, 7, []ElementString{NewElementString(AI8006, "806141411234580102"), NewElementString(AI21, "A981")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" SHOULD round trip", func(t *testing.T) {
+			data, err := Message{Elements: tt.elements}.ToEPCBinary(tt.scheme, 1, tt.gcpLength)
+			if err != nil {
+				t.Fatalf("ToEPCBinary() error = %v", err)
+			}
+			if len(data)%2 != 0 {
+				t.Errorf("ToEPCBinary() returned %d bytes, want multiple of 16 bits", len(data))
+			}
+			tag, err := DecodeEPCBinary(data)
+			if err != nil {
+				t.Fatalf("DecodeEPCBinary() error = %v", err)
+			}
+			if tag.Scheme != tt.scheme || tag.Filter != 1 || tag.GCPLength != tt.gcpLength {
+				t.Errorf("DecodeEPCBinary() got = %v, want scheme %s, filter 1 and GCP length %d", tag, tt.scheme, tt.gcpLength)
+			}
+			if !reflect.DeepEqual(tag.Message.Elements, tt.elements) {
+				t.Errorf("DecodeEPCBinary() got = %v, want %v", tag.Message.Elements, tt.elements)
+			}
+		})
+	}
+}
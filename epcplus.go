@@ -0,0 +1,395 @@
+package gs1
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"slices"
+	"strings"
+)
+
+// EPC binary schemes introduced with TDS 2.0. They encode GS1 keys AI by AI without a partition table, so that they
+// map one to one onto GS1 Digital Link URIs, and may carry additional AIDC data following the EPC.
+const (
+	SGTINPlus EPCBinaryScheme = "sgtin+"
+	SSCCPlus  EPCBinaryScheme = "sscc+"
+	SGLNPlus  EPCBinaryScheme = "sgln+"
+	GRAIPlus  EPCBinaryScheme = "grai+"
+	GIAIPlus  EPCBinaryScheme = "giai+"
+	GSRNPlus  EPCBinaryScheme = "gsrn+"
+	GSRNPPlus EPCBinaryScheme = "gsrnp+"
+	GDTIPlus  EPCBinaryScheme = "gdti+"
+	SGCNPlus  EPCBinaryScheme = "sgcn+"
+	ITIPPlus  EPCBinaryScheme = "itip+"
+)
+
+// epcPlusKey is an AI encoded as part of the EPC of a + scheme.
+type epcPlusKey struct {
+	ai string
+	// optional keys are encoded as empty value if absent, e.g. the GLN extension (254) of an SGLN+.
+	optional bool
+}
+
+type epcPlusLayout struct {
+	header byte
+	keys   []epcPlusKey
+}
+
+var epcPlusLayouts = map[EPCBinaryScheme]epcPlusLayout{
+	SGTINPlus: {0xF7, []epcPlusKey{{ai: "01"}, {ai: "21"}}},
+	SSCCPlus:  {0xF9, []epcPlusKey{{ai: "00"}}},
+	SGLNPlus:  {0xF2, []epcPlusKey{{ai: "414"}, {ai: "254", optional: true}}},
+	GRAIPlus:  {0xF1, []epcPlusKey{{ai: "8003"}}},
+	GIAIPlus:  {0xFA, []epcPlusKey{{ai: "8004"}}},
+	GSRNPlus:  {0xF5, []epcPlusKey{{ai: "8018"}}},
+	GSRNPPlus: {0xF4, []epcPlusKey{{ai: "8017"}}},
+	GDTIPlus:  {0xF6, []epcPlusKey{{ai: "253"}}},
+	SGCNPlus:  {0xF8, []epcPlusKey{{ai: "255"}}},
+	ITIPPlus:  {0xF0, []epcPlusKey{{ai: "8006"}, {ai: "21"}}},
+}
+
+// aidcEncoding is the encoding indicator of a variable length value.
+type aidcEncoding uint64
+
+const (
+	aidcInteger   aidcEncoding = iota // digits encoded as a single binary number
+	aidcUpperHex                      // 0-9 and A-F with four bits per character
+	aidcLowerHex                      // 0-9 and a-f with four bits per character
+	aidcBase64URL                     // the file safe base64 alphabet with six bits per character
+	aidcASCII                         // 7-bit ISO 646 characters
+)
+
+const (
+	aidcToggleBits            = 1
+	aidcEncodingIndicatorBits = 3
+	bcdBits                   = 4
+	base64URLAlphabet         = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	upperHexAlphabet          = "0123456789ABCDEF"
+	lowerHexAlphabet          = "0123456789abcdef"
+)
+
+// encodeEPCPlus encodes the message as + scheme. Elements not being part of the EPC are appended as AIDC data and
+// the AIDC data toggle is set accordingly.
+func (d Message) encodeEPCPlus(registry *Registry, scheme EPCBinaryScheme, layout epcPlusLayout, filter int) ([]byte, error) {
+	var keys []ElementString
+	for _, key := range layout.keys {
+		el, ok := d.element(key.ai)
+		if !ok && !key.optional {
+			return nil, fmt.Errorf("error encoding %s: missing AI (%s)", scheme, key.ai)
+		}
+		ai, err := registry.lookup(key.ai)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding %s: %w", scheme, err)
+		}
+		keys = append(keys, NewElementString(ai, el.DataField))
+	}
+	var aidcData []ElementString
+	for _, el := range d.Elements {
+		if !slices.ContainsFunc(layout.keys, func(key epcPlusKey) bool { return key.ai == el.AI }) {
+			aidcData = append(aidcData, el)
+		}
+	}
+
+	w := bitWriter{}
+	w.writeUint(uint64(layout.header), epcHeaderBits)
+	if len(aidcData) > 0 {
+		w.writeUint(1, aidcToggleBits)
+	} else {
+		w.writeUint(0, aidcToggleBits)
+	}
+	w.writeUint(uint64(filter), epcFilterBits)
+	for _, el := range keys {
+		if err := writeAIDCValue(registry, &w, el); err != nil {
+			return nil, fmt.Errorf("error encoding %s: %w", scheme, err)
+		}
+	}
+	for _, el := range aidcData {
+		ai, err := registry.lookup(el.AI)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding %s: %w", scheme, err)
+		}
+		el = NewElementString(ai, el.DataField)
+		for i := 0; i < len(el.AI); i++ {
+			w.writeUint(uint64(el.AI[i]-'0'), bcdBits)
+		}
+		if err := writeAIDCValue(registry, &w, el); err != nil {
+			return nil, fmt.Errorf("error encoding %s: %w", scheme, err)
+		}
+	}
+	w.padTo(epcWordBits)
+
+	return w.data, nil
+}
+
+// decodeEPCPlus decodes the bits following the header of a + scheme.
+func decodeEPCPlus(registry *Registry, r *bitReader, tag EPCTag, layout epcPlusLayout) (EPCTag, error) {
+	toggle, err := r.readUint(aidcToggleBits)
+	if err != nil {
+		return tag, fmt.Errorf("error decoding %s: %w", tag.Scheme, err)
+	}
+	filter, err := r.readUint(epcFilterBits)
+	if err != nil {
+		return tag, fmt.Errorf("error decoding %s: %w", tag.Scheme, err)
+	}
+	tag.Filter = int(filter)
+	tag.Message.SyntaxType = EPCBinaryEncoding
+
+	for _, key := range layout.keys {
+		ai, err := registry.lookup(key.ai)
+		if err != nil {
+			return tag, fmt.Errorf("error decoding %s: %w", tag.Scheme, err)
+		}
+		value, err := readAIDCValue(registry, r, ai)
+		if err != nil {
+			return tag, fmt.Errorf("error decoding %s: AI (%s): %w", tag.Scheme, key.ai, err)
+		}
+		if value == "" && key.optional {
+			continue
+		}
+		tag.Message.Elements = append(tag.Message.Elements, NewElementString(ai, value))
+	}
+
+	// The AIDC data ends with the encoding, only zero bits padding the last memory word may follow.
+	for toggle == 1 && !aidcPaddingRemaining(registry, *r) {
+		ai, err := readAIDCCode(registry, r)
+		if err != nil {
+			return tag, fmt.Errorf("error decoding %s: %w", tag.Scheme, err)
+		}
+		value, err := readAIDCValue(registry, r, ai)
+		if err != nil {
+			return tag, fmt.Errorf("error decoding %s: AI (%s): %w", tag.Scheme, ai.AI, err)
+		}
+		tag.Message.Elements = append(tag.Message.Elements, NewElementString(ai, value))
+	}
+
+	return tag, nil
+}
+
+// aidcPaddingRemaining reports whether the unread bits only pad the last memory word. Zero bits forming a complete
+// element followed by less than a word of padding, e.g. (00) 000000000000000000, are AIDC data.
+func aidcPaddingRemaining(registry *Registry, r bitReader) bool {
+	if !r.onlyZerosRemaining() {
+		return false
+	}
+	ai, err := readAIDCCode(registry, &r)
+	if err != nil {
+		return true
+	}
+	if _, err := readAIDCValue(registry, &r, ai); err != nil {
+		return true
+	}
+	return r.remaining() >= epcWordBits
+}
+
+// readAIDCCode reads an AI encoded as four bits per digit. The AI's length is detected using the registry.
+func readAIDCCode(registry *Registry, r *bitReader) (ApplicationIdentifier, error) {
+	code := strings.Builder{}
+	for code.Len() < 4 {
+		digit, err := r.readUint(bcdBits)
+		if err != nil {
+			return ApplicationIdentifier{}, err
+		}
+		if digit > 9 {
+			return ApplicationIdentifier{}, fmt.Errorf("invalid AI digit %d", digit)
+		}
+		code.WriteByte(byte('0' + digit))
+		if ai, ok := registry.Lookup(code.String()); ok && code.Len() >= minAILength {
+			return ai, nil
+		}
+	}
+	return ApplicationIdentifier{}, fmt.Errorf("unknown AI %s", code.String())
+}
+
+// aidcValueLayout splits an AI's data into a leading fixed length numeric part and a variable length remainder.
+func aidcValueLayout(registry *Registry, ai ApplicationIdentifier) (fixedDigits int, maxVariable int, _ error) {
+	components, ok := registry.Components(ai.AI)
+	if !ok {
+		return 0, 0, fmt.Errorf("unknown AI: %s", ai.AI)
+	}
+	i := 0
+	for ; i < len(components) && components[i].isFixedNumeric(); i++ {
+		fixedDigits += components[i].MaxLength
+	}
+	for ; i < len(components); i++ {
+		maxVariable += components[i].MaxLength
+	}
+	return fixedDigits, maxVariable, nil
+}
+
+// writeAIDCValue writes the data of the element. A leading fixed length numeric part is encoded with four bits per
+// digit, the remainder as a variable length value consisting of encoding indicator, length indicator and characters.
+func writeAIDCValue(registry *Registry, w *bitWriter, el ElementString) error {
+	fixedDigits, maxVariable, err := aidcValueLayout(registry, el.ApplicationIdentifier)
+	if err != nil {
+		return err
+	}
+	value := el.DataField
+	if len(value) < fixedDigits || !isNumeric(value[:fixedDigits]) {
+		return fmt.Errorf("AI (%s) must start with %d digits", el.AI, fixedDigits)
+	}
+	for i := 0; i < fixedDigits; i++ {
+		w.writeUint(uint64(value[i]-'0'), bcdBits)
+	}
+	value = value[fixedDigits:]
+	if maxVariable == 0 {
+		if value != "" {
+			return fmt.Errorf("AI (%s) must consist of %d digits", el.AI, fixedDigits)
+		}
+		return nil
+	}
+	if len(value) > maxVariable {
+		return fmt.Errorf("AI (%s) exceeds %d characters", el.AI, fixedDigits+maxVariable)
+	}
+
+	encoding := selectAIDCEncoding(value)
+	w.writeUint(uint64(encoding), aidcEncodingIndicatorBits)
+	w.writeUint(uint64(len(value)), bits.Len(uint(maxVariable)))
+	switch encoding {
+	case aidcInteger:
+		n, _ := new(big.Int).SetString("0"+value, 10)
+		writeBigInt(w, n, integerBits(len(value)))
+	case aidcUpperHex:
+		writeAlphabet(w, value, upperHexAlphabet, 4)
+	case aidcLowerHex:
+		writeAlphabet(w, value, lowerHexAlphabet, 4)
+	case aidcBase64URL:
+		writeAlphabet(w, value, base64URLAlphabet, 6)
+	case aidcASCII:
+		for i := 0; i < len(value); i++ {
+			if value[i] > 0x7F {
+				return fmt.Errorf("AI (%s) contains non-ASCII characters", el.AI)
+			}
+			w.writeUint(uint64(value[i]), 7)
+		}
+	}
+	return nil
+}
+
+func readAIDCValue(registry *Registry, r *bitReader, ai ApplicationIdentifier) (string, error) {
+	fixedDigits, maxVariable, err := aidcValueLayout(registry, ai)
+	if err != nil {
+		return "", err
+	}
+	builder := strings.Builder{}
+	for i := 0; i < fixedDigits; i++ {
+		digit, err := r.readUint(bcdBits)
+		if err != nil {
+			return "", err
+		}
+		if digit > 9 {
+			return "", fmt.Errorf("invalid digit %d", digit)
+		}
+		builder.WriteByte(byte('0' + digit))
+	}
+	if maxVariable == 0 {
+		return builder.String(), nil
+	}
+
+	encoding, err := r.readUint(aidcEncodingIndicatorBits)
+	if err != nil {
+		return "", err
+	}
+	length, err := r.readUint(bits.Len(uint(maxVariable)))
+	if err != nil {
+		return "", err
+	}
+	if length > uint64(maxVariable) {
+		return "", fmt.Errorf("length %d exceeds %d characters", length, maxVariable)
+	}
+	n := int(length)
+	switch aidcEncoding(encoding) {
+	case aidcInteger:
+		v, err := readBigInt(r, integerBits(n))
+		if err != nil {
+			return "", err
+		}
+		if n == 0 {
+			break
+		}
+		digits := v.String()
+		if len(digits) > n {
+			return "", fmt.Errorf("integer %s exceeds %d digits", digits, n)
+		}
+		builder.WriteString(strings.Repeat("0", n-len(digits)) + digits)
+	case aidcUpperHex:
+		err = readAlphabet(r, &builder, n, upperHexAlphabet, 4)
+	case aidcLowerHex:
+		err = readAlphabet(r, &builder, n, lowerHexAlphabet, 4)
+	case aidcBase64URL:
+		err = readAlphabet(r, &builder, n, base64URLAlphabet, 6)
+	case aidcASCII:
+		for i := 0; i < n && err == nil; i++ {
+			var c uint64
+			c, err = r.readUint(7)
+			builder.WriteByte(byte(c))
+		}
+	default:
+		return "", fmt.Errorf("unsupported encoding indicator %d", encoding)
+	}
+	if err != nil {
+		return "", err
+	}
+	return builder.String(), nil
+}
+
+// selectAIDCEncoding chooses the most compact encoding able to represent the value.
+func selectAIDCEncoding(value string) aidcEncoding {
+	switch {
+	case isNumeric(value):
+		return aidcInteger
+	case strings.Trim(value, upperHexAlphabet) == "":
+		return aidcUpperHex
+	case strings.Trim(value, lowerHexAlphabet) == "":
+		return aidcLowerHex
+	case strings.Trim(value, base64URLAlphabet) == "":
+		return aidcBase64URL
+	default:
+		return aidcASCII
+	}
+}
+
+// integerBits returns the amount of bits required to encode any number of the given amount of digits.
+func integerBits(digits int) int {
+	maxValue := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+	return maxValue.Sub(maxValue, big.NewInt(1)).BitLen()
+}
+
+func writeBigInt(w *bitWriter, n *big.Int, length int) {
+	for i := length - 1; i >= 0; i-- {
+		w.writeBit(n.Bit(i) == 1)
+	}
+}
+
+func readBigInt(r *bitReader, length int) (*big.Int, error) {
+	n := new(big.Int)
+	for i := 0; i < length; i++ {
+		bit, err := r.readUint(1)
+		if err != nil {
+			return nil, err
+		}
+		n.Lsh(n, 1)
+		n.SetBit(n, 0, uint(bit))
+	}
+	return n, nil
+}
+
+func writeAlphabet(w *bitWriter, value string, alphabet string, bitsPerChar int) {
+	for i := 0; i < len(value); i++ {
+		w.writeUint(uint64(strings.IndexByte(alphabet, value[i])), bitsPerChar)
+	}
+}
+
+func readAlphabet(r *bitReader, builder *strings.Builder, n int, alphabet string, bitsPerChar int) error {
+	for i := 0; i < n; i++ {
+		idx, err := r.readUint(bitsPerChar)
+		if err != nil {
+			return err
+		}
+		if int(idx) >= len(alphabet) {
+			return errors.New("invalid character index")
+		}
+		builder.WriteByte(alphabet[idx])
+	}
+	return nil
+}
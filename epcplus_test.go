@@ -0,0 +1,242 @@
+package gs1
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestEPCPlus_DigitalLinkRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		scheme EPCBinaryScheme
+		uri    string
+	}{
+		{"SGTIN+ with AIDC data", SGTINPlus, "https://id.gs1.org/01/09526064055028/10/ABC%2F1/21/12345?17=250521&3103=000195"},
+		{"SGTIN+ without AIDC data", SGTINPlus, "https://id.gs1.org/01/09526064055028/21/ab-cd_EF"},
+		{"SSCC+", SSCCPlus, "https://id.gs1.org/00/106141411234567897?403=ROUTE%201"},
+		{"SGLN+ without extension", SGLNPlus, "https://id.gs1.org/414/0614141123452"},
+		{"SGLN+ with extension", SGLNPlus, "https://id.gs1.org/414/0614141123452/254/00ab"},
+		{"GRAI+ with serial", GRAIPlus, "https://id.gs1.org/8003/00614141123452ABC"},
+		{"GIAI+", GIAIPlus, "https://id.gs1.org/8004/0614141ASSET-9"},
+		{"GDTI+ without serial", GDTIPlus, "https://id.gs1.org/253/0614141123452"},
+		{"ITIP+", ITIPPlus, "https://id.gs1.org/8006/806141411234580102/21/981"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" SHOULD round trip", func(t *testing.T) {
+			msg, err := ParseDigitalLink(tt.uri)
+			if err != nil {
+				t.Fatalf("ParseDigitalLink() error = %v", err)
+			}
+			data, err := msg.ToEPCBinary(tt.scheme, 1, 0)
+			if err != nil {
+				t.Fatalf("ToEPCBinary() error = %v", err)
+			}
+			tag, err := DecodeEPCBinary(data)
+			if err != nil {
+				t.Fatalf("DecodeEPCBinary() error = %v", err)
+			}
+			if tag.Scheme != tt.scheme || tag.Filter != 1 {
+				t.Errorf("DecodeEPCBinary() got scheme %s and filter %d, want %s and 1", tag.Scheme, tag.Filter, tt.scheme)
+			}
+			got, err := tag.Message.AsDigitalLink("")
+			if err != nil {
+				t.Fatalf("AsDigitalLink() error = %v", err)
+			}
+			if got != tt.uri {
+				t.Errorf("AsDigitalLink() got = %v, want %v", got, tt.uri)
+			}
+		})
+	}
+}
+
+func TestMessage_ToEPCBinary_Plus(t *testing.T) {
+	tests := []struct {
+		name     string
+		elements []ElementString
+		scheme   EPCBinaryScheme
+		wantHex  string
+		wantErr  bool
+	}{
+		{
+			name: "SGTIN+ without AIDC data SHOULD clear the toggle bit",
+			elements: []ElementString{
+				NewElementString(AI01, "09526064055028"),
+				NewElementString(AI21, "1"),
+			},
+			scheme: SGTINPlus,
+			wantHex: epcFields(
+				"1111 0111", // header F7
+				"0",         // AIDC toggle
+				"001",       // filter
+				"0000 1001 0101 0010 0110 0000 0110 0100 0000 0101 0101 0000 0010 1000", // GTIN
+				"000", "00001", "0001", // serial: integer, length 1, 1 in 4 bits
+			),
+		},
+		{
+			name: "SGTIN+ with AIDC data SHOULD set the toggle bit and append the AIs",
+			elements: []ElementString{
+				NewElementString(AI01, "09526064055028"),
+				NewElementString(AI21, "12345"),
+				NewElementString(AI17, "250521"),
+			},
+			scheme: SGTINPlus,
+			wantHex: epcFields(
+				"1111 0111", // header F7
+				"1",         // AIDC toggle
+				"001",       // filter
+				"0000 1001 0101 0010 0110 0000 0110 0100 0000 0101 0101 0000 0010 1000", // GTIN
+				"000", "00101", "000 1100 0000 1110 01", // serial: integer, length 5, 12345 in 17 bits
+				"0001 0111", "0010 0101 0000 0101 0010 0001", // AIDC data: AI (17) and 250521 as BCD
+			),
+		},
+		{
+			name: "SSCC+ with AIDC data SHOULD encode alphanumeric values as hexadecimal",
+			elements: []ElementString{
+				NewElementString(AI00, "106141411234567897"),
+				NewElementString(AI10, "ABC1"),
+			},
+			scheme: SSCCPlus,
+			wantHex: epcFields(
+				"1111 1001", // header F9
+				"1",         // AIDC toggle
+				"001",       // filter
+				"0001 0000 0110 0001 0100 0001 0100 0001 0001 0010 0011 0100 0101 0110 0111 1000 1001 0111", // SSCC
+				"0001 0000", "001", "00100", "1010 1011 1100 0001", // AIDC data: AI (10), upper case hex, length 4, ABC1
+			),
+		},
+		{
+			name:     "SGLN+ without extension SHOULD encode an empty extension",
+			elements: []ElementString{NewElementString(AI414, "0614141123452")},
+			scheme:   SGLNPlus,
+			wantHex: epcFields(
+				"1111 0010", // header F2
+				"0",         // AIDC toggle
+				"001",       // filter
+				"0000 0110 0001 0100 0001 0100 0001 0001 0010 0011 0100 0101 0010", // GLN
+				"000", "00000", // extension: integer, length 0
+			),
+		},
+		{
+			name: "SGLN+ with extension SHOULD encode it as variable length value",
+			elements: []ElementString{
+				NewElementString(AI414, "0614141123452"),
+				NewElementString(AI254, "00ab"),
+			},
+			scheme: SGLNPlus,
+			wantHex: epcFields(
+				"1111 0010", // header F2
+				"0",         // AIDC toggle
+				"001",       // filter
+				"0000 0110 0001 0100 0001 0100 0001 0001 0010 0011 0100 0101 0010", // GLN
+				"010", "00100", "0000 0000 1010 1011", // extension: lower case hex, length 4, 00ab
+			),
+		},
+		{
+			name:     "SGTIN+ without serial SHOULD return an error",
+			elements: []ElementString{NewElementString(AI01, "09526064055028")},
+			scheme:   SGTINPlus,
+			wantErr:  true,
+		},
+		{
+			name:     "Non-numeric fixed length key SHOULD return an error",
+			elements: []ElementString{NewElementString(AI00, "1061414112345678AB")},
+			scheme:   SSCCPlus,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Message{Elements: tt.elements}.ToEPCHex(tt.scheme, 1, 0)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ToEPCHex() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.wantHex {
+				t.Errorf("ToEPCHex() got = %v, want %v", got, tt.wantHex)
+			}
+		})
+	}
+}
+
+func TestDecodeEPCBinary_PlusAIDCData(t *testing.T) {
+	tests := []struct {
+		name     string
+		scheme   EPCBinaryScheme
+		elements []ElementString
+	}{
+		{
+			name:   "Numeric and ASCII AIDC data SHOULD round trip",
+			scheme: SSCCPlus,
+			elements: []ElementString{
+				NewElementString(AI00, "106141411234567897"),
+				NewElementString(AI3300, "001234"),
+				NewElementString(AI4308, "+49 (0)30 123"),
+			},
+		},
+		{
+			name:   "Final AIDC value of zeros SHOULD round trip",
+			scheme: SGTINPlus,
+			elements: []ElementString{
+				NewElementString(AI01, "09526064055028"),
+				NewElementString(AI21, "1"),
+				NewElementString(AI3103, "000000"),
+			},
+		},
+		{
+			name:   "Final AIDC element of zero bits only SHOULD NOT be taken for padding",
+			scheme: SGTINPlus,
+			elements: []ElementString{
+				NewElementString(AI01, "09526064055028"),
+				NewElementString(AI21, "1"),
+				NewElementString(AI00, "000000000000000000"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Message{Elements: tt.elements}.ToEPCBinary(tt.scheme, 2, 0)
+			if err != nil {
+				t.Fatalf("ToEPCBinary() error = %v", err)
+			}
+			tag, err := DecodeEPCBinary(data)
+			if err != nil {
+				t.Fatalf("DecodeEPCBinary() error = %v", err)
+			}
+			if !reflect.DeepEqual(tag.Message.Elements, tt.elements) {
+				t.Errorf("DecodeEPCBinary() got = %v, want %v", tag.Message.Elements, tt.elements)
+			}
+		})
+	}
+}
+
+func TestDecodeEPCBinary_PlusPadding(t *testing.T) {
+	// SGTIN+ with AIDC data (17) 250521 followed by six words of unused memory, which hold enough zero bits for an
+	// element (00) followed by a full word and must not be decoded as such.
+	data := epcFields("1111 0111", "1", "001",
+		"0000 1001 0101 0010 0110 0000 0110 0100 0000 0101 0101 0000 0010 1000", "000", "00001", "0001",
+		"0001 0111", "0010 0101 0000 0101 0010 0001", strings.Repeat("0", 6*epcWordBits))
+	tag, err := DecodeEPCHex(data)
+	if err != nil {
+		t.Fatalf("DecodeEPCHex() error = %v", err)
+	}
+	if got, want := tag.Message.AsElementString(), "(01)09526064055028(21)1(17)250521"; got != want {
+		t.Errorf("DecodeEPCHex() got = %v, want %v", got, want)
+	}
+}
+
+// epcFields concatenates binary fields, ignoring spaces, pads them to a full memory word and returns the upper case
+// hexadecimal encoding.
+func epcFields(fields ...string) string {
+	bitString := strings.ReplaceAll(strings.Join(fields, ""), " ", "")
+	for len(bitString)%epcWordBits != 0 {
+		bitString += "0"
+	}
+	builder := strings.Builder{}
+	for i := 0; i < len(bitString); i += 4 {
+		n, _ := strconv.ParseUint(bitString[i:i+4], 2, 4)
+		builder.WriteString(strings.ToUpper(strconv.FormatUint(n, 16)))
+	}
+	return builder.String()
+}
@@ -6,18 +6,27 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"slices"
 	"strconv"
 	"strings"
 )
 
 // ApplicationIdentifierSpec is an intermediate representation of the parsed AI description.
 type ApplicationIdentifierSpec struct {
-	AI            string
-	Flags         string
-	Specification []string
-	Attributes    []string
-	Title         string
+	AI            string   `json:"ai"`
+	Flags         string   `json:"flags"`
+	Specification []string `json:"specification"`
+	Attributes    []string `json:"attributes"`
+	Title         string   `json:"title"`
+	// Line is the line of the Syntax Dictionary the AI is described in. It is 0 if unknown.
+	Line int `json:"line,omitempty"`
+}
+
+// SyntaxDictionary is the content of a GS1 Syntax Dictionary file.
+type SyntaxDictionary struct {
+	// Release is taken from the `Release:` header of the file, e.g. "2025-01-30". Unreleased files may declare
+	// "UNSET".
+	Release string
+	AIs     []ApplicationIdentifierSpec
 }
 
 // DownloadSyntaxDictionary downloads the most recent GS1 Syntax Dictionary from
@@ -42,66 +51,159 @@ func DownloadSyntaxDictionary(release string) (bytes.Buffer, error) {
 }
 
 // ParseSyntaxDictionary reads in the format of the GS1 Syntax Dictionary published here
-// https://github.com/gs1/gs1-syntax-dictionary.
+// https://github.com/gs1/gs1-syntax-dictionary. Each entry is checked for duplicate AIs, malformed ranges, unknown
+// flags and invalid specifications. All issues found are returned as [*SyntaxDictionaryError]. Use
+// [ReadSyntaxDictionary] to check references between the AIs as well.
 func ParseSyntaxDictionary(r io.Reader) ([]ApplicationIdentifierSpec, error) {
-	var entries []ApplicationIdentifierSpec
+	dictionary, err := parseSyntaxDictionary(r)
+	if err != nil {
+		return nil, err
+	}
+	return dictionary.AIs, nil
+}
+
+// ReadSyntaxDictionary is like [ParseSyntaxDictionary], but additionally extracts the release and ensures the
+// `req`, `ex` and `dlpkey` attributes only reference AIs defined by the dictionary, see [ValidateSyntaxDictionary].
+func ReadSyntaxDictionary(r io.Reader) (SyntaxDictionary, error) {
+	dictionary, err := parseSyntaxDictionary(r)
+	if err != nil {
+		return SyntaxDictionary{}, err
+	}
+	if err := ValidateSyntaxDictionary(dictionary.AIs); err != nil {
+		return SyntaxDictionary{}, err
+	}
+	return dictionary, nil
+}
+
+func parseSyntaxDictionary(r io.Reader) (SyntaxDictionary, error) {
+	var dictionary SyntaxDictionary
+	var issues syntaxDictionaryIssues
+	firstLines := map[string]int{}
 	scanner := bufio.NewScanner(r)
 
-	for scanner.Scan() {
+	for lineNo := 1; scanner.Scan(); lineNo++ {
 		line := strings.TrimSpace(scanner.Text())
-		if line == "" || strings.HasPrefix(line, "#") || len(line) == 0 {
-			continue // skip comments or blank lines
+		if strings.HasPrefix(line, "#") {
+			if release, ok := strings.CutPrefix(strings.TrimSpace(line[1:]), releaseHeader); ok && dictionary.Release == "" {
+				dictionary.Release = strings.TrimSpace(release)
+			}
+			continue // skip comments
+		}
+		if line == "" {
+			continue // skip blank lines
 		}
 
-		columns := getColumns(line)
-		entry := ApplicationIdentifierSpec{}
-
-		for _, column := range columns {
-			if slices.Contains([]rune("0123456789"), rune(column[0])) {
-				entry.AI = column
-			} else if slices.Contains([]rune("*!?\"$%&'()+,-./:;<=>@[\\]^_`{|}~"), rune(column[0])) {
-				entry.Flags = strings.ReplaceAll(column, " ", "")
-			} else if slices.Contains([]rune("NXYZ"), rune(column[0])) {
-				entry.Specification = strings.Split(column, ",")
-			} else if strings.HasPrefix(column, "# ") {
-				entry.Title = column[2:]
-			} else {
-				entry.Attributes = strings.Split(column, " ")
-			}
+		entry := parseSyntaxDictionaryEntry(line, lineNo, &issues)
+		if entry.AI == "" {
+			continue
 		}
+		checkSpecification(entry, &issues)
 
-		// That's a specification spanning several AIs
-		if strings.Contains(entry.AI, "-") {
-			aiRange := strings.Split(entry.AI, "-")
-			startAI, err := strconv.Atoi(aiRange[0])
-			if err != nil {
-				return nil, fmt.Errorf("error parsing start AI range %s: %w", entry.AI, err)
-			}
-			stopAI, err := strconv.Atoi(aiRange[1])
-			if err != nil {
-				return nil, fmt.Errorf("error parsing stop AI range %s: %w", entry.AI, err)
+		ais, err := expandAIRange(entry.AI)
+		if err != nil {
+			issues.add(lineNo, entry.AI, err.Error())
+			continue
+		}
+		for _, ai := range ais {
+			if first, exists := firstLines[ai]; exists {
+				issues.add(lineNo, ai, fmt.Sprintf("duplicate AI, first defined in line %d", first))
+				continue
 			}
+			firstLines[ai] = lineNo
+			expanded := entry
+			expanded.AI = ai
+			dictionary.AIs = append(dictionary.AIs, expanded)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return SyntaxDictionary{}, err
+	}
+	if err := issues.err(); err != nil {
+		return SyntaxDictionary{}, err
+	}
+	return dictionary, nil
+}
+
+// parseSyntaxDictionaryEntry assigns the columns of a line in the order AI, flags, specification, attributes and
+// title. Columns violating this order are reported.
+func parseSyntaxDictionaryEntry(line string, lineNo int, issues *syntaxDictionaryIssues) ApplicationIdentifierSpec {
+	entry := ApplicationIdentifierSpec{Line: lineNo}
+	// The title follows the first '#', which is not allocated to flags, specifications or attributes.
+	if idx := strings.IndexByte(line, '#'); idx != -1 {
+		entry.Title = strings.TrimSpace(line[idx+1:])
+		line = strings.TrimSpace(line[:idx])
+	}
+	if line == "" {
+		issues.add(lineNo, "", "missing AI")
+		return ApplicationIdentifierSpec{}
+	}
 
-			for i := startAI; i <= stopAI; i++ {
-				strconvAI := strconv.Itoa(i)
-				entries = append(entries, ApplicationIdentifierSpec{
-					AI:            strconvAI,
-					Flags:         entry.Flags,
-					Specification: entry.Specification,
-					Attributes:    entry.Attributes,
-					Title:         entry.Title,
-				})
+	columns := getColumns(line)
+	if !strings.ContainsRune("0123456789", rune(columns[0][0])) {
+		issues.add(lineNo, "", fmt.Sprintf("expected AI, got %q", columns[0]))
+		return ApplicationIdentifierSpec{}
+	}
+	entry.AI = columns[0]
+	columns = columns[1:]
+
+	if len(columns) > 0 && isFlagsColumn(columns[0]) {
+		entry.Flags = strings.ReplaceAll(columns[0], " ", "")
+		columns = columns[1:]
+		for _, flag := range entry.Flags {
+			if !strings.ContainsRune(definedFlags, flag) {
+				issues.add(lineNo, entry.AI, fmt.Sprintf("unknown flag %q", flag))
 			}
-		} else {
-			entries = append(entries, entry)
 		}
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, err
+	if len(columns) == 0 || !strings.ContainsRune("NXYZ[", rune(columns[0][0])) {
+		issues.add(lineNo, entry.AI, "missing specification")
+		return entry
+	}
+	entry.Specification = strings.Split(columns[0], ",")
+	columns = columns[1:]
+
+	for _, column := range columns {
+		entry.Attributes = append(entry.Attributes, strings.Split(column, " ")...)
+	}
+	return entry
+}
+
+// isFlagsColumn reports whether the column solely consists of flag characters and spaces.
+func isFlagsColumn(column string) bool {
+	for _, c := range column {
+		if c != ' ' && !strings.ContainsRune(flagCharacters, c) {
+			return false
+		}
 	}
+	return true
+}
 
-	return entries, nil
+// expandAIRange returns the AIs of a solitary AI or a range of AIs, e.g. `91-99`.
+func expandAIRange(ai string) ([]string, error) {
+	start, stop, isRange := strings.Cut(ai, "-")
+	if !isRange {
+		if !isValidAICode(ai) {
+			return nil, fmt.Errorf("invalid AI %q: must consist of %d to %d digits", ai, minAILength, maxAILength)
+		}
+		return []string{ai}, nil
+	}
+
+	if !isValidAICode(start) || !isValidAICode(stop) || len(start) != len(stop) {
+		return nil, fmt.Errorf("malformed AI range %q: bounds must be AIs of the same length", ai)
+	}
+	startAI, _ := strconv.Atoi(start)
+	stopAI, _ := strconv.Atoi(stop)
+	if startAI >= stopAI {
+		return nil, fmt.Errorf("malformed AI range %q: start must be less than stop", ai)
+	}
+
+	var ais []string
+	for i := startAI; i <= stopAI; i++ {
+		ais = append(ais, fmt.Sprintf("%0*d", len(start), i))
+	}
+	return ais, nil
 }
 
 // getColumns is a custom parser that detects columns which are separated by two spaces.
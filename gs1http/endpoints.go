@@ -0,0 +1,151 @@
+package gs1http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+// Target syntaxes of the convert endpoint.
+const (
+	TargetElementString  = "element"
+	TargetBarcodeMessage = "barcode"
+	TargetScanData       = "scan"
+	TargetDigitalLink    = "dl"
+	TargetHRI            = "hri"
+)
+
+// DataRequest is the request of the parse, validate and check digit endpoints.
+type DataRequest struct {
+	Data string `json:"data"`
+}
+
+// ParseResponse is the response of the parse endpoint.
+type ParseResponse struct {
+	Syntax        gs1.MessageSyntaxType `json:"syntax"`
+	Symbology     string                `json:"symbology,omitempty"`
+	Elements      gs1.Message           `json:"elements"`
+	ElementString string                `json:"elementString"`
+	HRI           []string              `json:"hri"`
+}
+
+// ValidateResponse is the response of the validate endpoint. Data violating the GS1 Syntax Dictionary is reported
+// with Valid set to false and the issues found; only data that cannot be parsed results in an error.
+type ValidateResponse struct {
+	Valid  bool                  `json:"valid"`
+	Issues []gs1.ValidationIssue `json:"issues"`
+}
+
+// ConvertRequest is the request of the convert endpoint. Symbology is used for the target scan data and defaults to
+// ]d2, Domain is used for the target Digital Link and defaults to [gs1.CanonicalPrefix].
+type ConvertRequest struct {
+	Data      string `json:"data"`
+	To        string `json:"to"`
+	Symbology string `json:"symbology,omitempty"`
+	Domain    string `json:"domain,omitempty"`
+}
+
+// ConvertResponse is the response of the convert endpoint. Barcode messages and scan data contain the FNC1
+// character itself.
+type ConvertResponse struct {
+	Result string `json:"result"`
+}
+
+// CheckDigitResponse is the response of the check digit endpoint.
+type CheckDigitResponse struct {
+	CheckDigit string `json:"checkDigit"`
+	Result     string `json:"result"`
+}
+
+func (h *Handler) parseData(data string) (gs1.Message, error) {
+	if data == "" {
+		return gs1.Message{}, newAPIError(http.StatusBadRequest, CodeInvalidRequest, errors.New("data is required"))
+	}
+	msg, err := gs1.ParseAny(data, h.parseOpts...)
+	if err != nil {
+		return gs1.Message{}, newAPIError(http.StatusUnprocessableEntity, CodeParseError, err)
+	}
+	return msg, nil
+}
+
+func (h *Handler) parse(req DataRequest) (ParseResponse, error) {
+	msg, err := h.parseData(req.Data)
+	if err != nil {
+		return ParseResponse{}, err
+	}
+	resp := ParseResponse{
+		Syntax:        msg.SyntaxType,
+		Elements:      msg,
+		ElementString: msg.AsElementString(),
+		HRI:           msg.HRI(),
+	}
+	if msg.SyntaxType == gs1.BarcodeMessageScanData {
+		resp.Symbology = msg.Symbology.String()
+	}
+	return resp, nil
+}
+
+func (h *Handler) validate(req DataRequest) (ValidateResponse, error) {
+	msg, err := h.parseData(req.Data)
+	if err != nil {
+		return ValidateResponse{}, err
+	}
+	resp := ValidateResponse{Valid: true, Issues: []gs1.ValidationIssue{}}
+	if err := msg.Validate(h.parseOpts...); err != nil {
+		var validationErr *gs1.ValidationError
+		if !errors.As(err, &validationErr) {
+			return ValidateResponse{}, err
+		}
+		resp.Valid = false
+		resp.Issues = validationErr.Issues
+	}
+	return resp, nil
+}
+
+func (h *Handler) convert(req ConvertRequest) (ConvertResponse, error) {
+	msg, err := h.parseData(req.Data)
+	if err != nil {
+		return ConvertResponse{}, err
+	}
+	var result string
+	switch req.To {
+	case TargetElementString:
+		result = msg.AsElementString()
+	case TargetBarcodeMessage:
+		result = msg.AsBarcodeMessage()
+	case TargetScanData:
+		symbology := gs1.SymbologyIdentifier{Type: gs1.GS1DataMatrix, Mode: 2}
+		if req.Symbology != "" {
+			symbology, err = gs1.ParseSymbologyIdentifier(req.Symbology)
+		}
+		if err != nil {
+			return ConvertResponse{}, newAPIError(http.StatusBadRequest, CodeInvalidRequest, err)
+		}
+		result = msg.AsScanData(symbology)
+	case TargetDigitalLink:
+		domain := req.Domain
+		if domain == "" {
+			domain = gs1.CanonicalPrefix
+		}
+		if result, err = msg.AsDigitalLink(domain, h.parseOpts...); err != nil {
+			return ConvertResponse{}, newAPIError(http.StatusUnprocessableEntity, CodeConversionError, err)
+		}
+	case TargetHRI:
+		result = strings.Join(msg.HRI(), " ")
+	default:
+		return ConvertResponse{}, newAPIError(http.StatusBadRequest, CodeInvalidRequest,
+			fmt.Errorf("unsupported target syntax %q", req.To))
+	}
+	return ConvertResponse{Result: result}, nil
+}
+
+func (h *Handler) checkDigit(req DataRequest) (CheckDigitResponse, error) {
+	digit, err := gs1.CheckDigit(req.Data)
+	if err != nil {
+		return CheckDigitResponse{}, newAPIError(http.StatusBadRequest, CodeInvalidRequest, err)
+	}
+	return CheckDigitResponse{CheckDigit: string(digit), Result: req.Data + string(digit)}, nil
+}
@@ -0,0 +1,179 @@
+// Package gs1http exposes parsing, validation and conversion of GS1 data as JSON endpoints of a [net/http] handler:
+//
+//	POST /parse        {"data": "..."}
+//	POST /validate     {"data": "..."}
+//	POST /convert      {"data": "...", "to": "element|barcode|scan|dl|hri", "symbology": "]d2", "domain": "..."}
+//	POST /check-digit  {"data": "0952606405502"}
+//
+// Data of any syntax supported by [gs1.ParseAny] is accepted. Request bodies must be JSON and are limited to
+// [DefaultMaxRequestBytes] unless configured otherwise. Errors are reported as [ErrorResponse].
+package gs1http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+// DefaultMaxRequestBytes is the default limit of request bodies.
+const DefaultMaxRequestBytes = 64 << 10
+
+// Error codes of [ErrorResponse].
+const (
+	CodeInvalidRequest       = "invalid_request"
+	CodeUnsupportedMediaType = "unsupported_media_type"
+	CodeRequestTooLarge      = "request_too_large"
+	CodeParseError           = "parse_error"
+	CodeConversionError      = "conversion_error"
+	CodeNotFound             = "not_found"
+	CodeMethodNotAllowed     = "method_not_allowed"
+	CodeInternalError        = "internal_error"
+)
+
+// Handler serves the GS1 endpoints. Use [New] to create a handler.
+type Handler struct {
+	mux             *http.ServeMux
+	maxRequestBytes int64
+	parseOpts       []gs1.Option
+}
+
+// Option configures a [Handler].
+type Option func(*Handler)
+
+// WithMaxRequestBytes limits the size of request bodies. Larger requests are rejected with status 413.
+func WithMaxRequestBytes(n int64) Option {
+	return func(h *Handler) {
+		h.maxRequestBytes = n
+	}
+}
+
+// WithParseOptions passes options to the parser and validator, e.g. [gs1.WithRegistry].
+func WithParseOptions(opts ...gs1.Option) Option {
+	return func(h *Handler) {
+		h.parseOpts = append(h.parseOpts, opts...)
+	}
+}
+
+// New creates a handler serving the GS1 endpoints relative to its root. Use [http.StripPrefix] to mount it below
+// another path.
+func New(opts ...Option) *Handler {
+	h := &Handler{
+		mux:             http.NewServeMux(),
+		maxRequestBytes: DefaultMaxRequestBytes,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	h.mux.HandleFunc("POST /parse", handle(h, h.parse))
+	h.mux.HandleFunc("POST /validate", handle(h, h.validate))
+	h.mux.HandleFunc("POST /convert", handle(h, h.convert))
+	h.mux.HandleFunc("POST /check-digit", handle(h, h.checkDigit))
+	for _, path := range []string{"/parse", "/validate", "/convert", "/check-digit"} {
+		h.mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Allow", http.MethodPost)
+			writeError(w, newAPIError(http.StatusMethodNotAllowed, CodeMethodNotAllowed,
+				fmt.Errorf("method %s not allowed, use POST", r.Method)))
+		})
+	}
+	h.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, newAPIError(http.StatusNotFound, CodeNotFound, fmt.Errorf("no endpoint at %s", r.URL.Path)))
+	})
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// ErrorResponse is the body of all error responses.
+type ErrorResponse struct {
+	Error ErrorDetail `json:"error"`
+}
+
+// ErrorDetail describes an error by a stable code, e.g. [CodeParseError], and a message. Issues are set if the data
+// violates the GS1 Syntax Dictionary.
+type ErrorDetail struct {
+	Code    string                `json:"code"`
+	Message string                `json:"message"`
+	Issues  []gs1.ValidationIssue `json:"issues,omitempty"`
+}
+
+// apiError is an error reported to the client with the given status.
+type apiError struct {
+	status int
+	detail ErrorDetail
+}
+
+func (e *apiError) Error() string {
+	return e.detail.Message
+}
+
+func newAPIError(status int, code string, err error) *apiError {
+	detail := ErrorDetail{Code: code, Message: err.Error()}
+	var validationErr *gs1.ValidationError
+	if errors.As(err, &validationErr) {
+		detail.Issues = validationErr.Issues
+	}
+	return &apiError{status: status, detail: detail}
+}
+
+// handle decodes the JSON request into Req, calls fn and encodes its response or error.
+func handle[Req any, Resp any](h *Handler, fn func(Req) (Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req Req
+		if err := h.decode(w, r, &req); err != nil {
+			writeError(w, err)
+			return
+		}
+		resp, err := fn(req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+// decode reads the JSON body limited to the maximum request size. Unknown fields are rejected.
+func (h *Handler) decode(w http.ResponseWriter, r *http.Request, v any) error {
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || mediaType != "application/json" {
+			return newAPIError(http.StatusUnsupportedMediaType, CodeUnsupportedMediaType,
+				fmt.Errorf("unsupported content type %q, use application/json", contentType))
+		}
+	}
+
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, h.maxRequestBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return newAPIError(http.StatusRequestEntityTooLarge, CodeRequestTooLarge,
+				fmt.Errorf("request body exceeds %d bytes", maxBytesErr.Limit))
+		}
+		return newAPIError(http.StatusBadRequest, CodeInvalidRequest, fmt.Errorf("invalid JSON request: %w", err))
+	}
+	if dec.More() {
+		return newAPIError(http.StatusBadRequest, CodeInvalidRequest, errors.New("request body must be a single JSON object"))
+	}
+	return nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var e *apiError
+	if !errors.As(err, &e) {
+		e = newAPIError(http.StatusInternalServerError, CodeInternalError, err)
+	}
+	writeJSON(w, e.status, ErrorResponse{Error: e.detail})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,186 @@
+package gs1http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		want       string
+	}{
+		{
+			name:       "Parse SHOULD detect scan data",
+			path:       "/parse",
+			body:       `{"data":"]d201095260640550281725052110ABC123"}`,
+			wantStatus: http.StatusOK,
+			want:       `{"syntax":"BarcodeMessageScanData","symbology":"]d2","elements":{"01":"09526064055028","17":"250521","10":"ABC123"},"elementString":"(01)09526064055028(17)250521(10)ABC123","hri":["(01) 09526064055028","(17) 250521","(10) ABC123"]}`,
+		},
+		{
+			name:       "Parse SHOULD detect Digital Link URIs",
+			path:       "/parse",
+			body:       `{"data":"https://id.gs1.org/01/09526064055028/10/ABC123"}`,
+			wantStatus: http.StatusOK,
+			want:       `{"syntax":"DigitalLinkURI","elements":{"01":"09526064055028","10":"ABC123"},"elementString":"(01)09526064055028(10)ABC123","hri":["(01) 09526064055028","(10) ABC123"]}`,
+		},
+		{
+			name:       "Parse error SHOULD be reported as structured error",
+			path:       "/parse",
+			body:       `{"data":"no gs1 data"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			want:       `{"error":{"code":"parse_error","message":"unsupported data syntax"}}`,
+		},
+		{
+			name:       "Missing data SHOULD be rejected",
+			path:       "/parse",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			want:       `{"error":{"code":"invalid_request","message":"data is required"}}`,
+		},
+		{
+			name:       "Valid data SHOULD be reported valid",
+			path:       "/validate",
+			body:       `{"data":"(01)09526064055028(10)ABC123"}`,
+			wantStatus: http.StatusOK,
+			want:       `{"valid":true,"issues":[]}`,
+		},
+		{
+			name:       "Invalid data SHOULD be reported with issues",
+			path:       "/validate",
+			body:       `{"data":"(01)09526064055029"}`,
+			wantStatus: http.StatusOK,
+			want:       `{"valid":false,"issues":[{"ai":"01","message":"csum: wrong check digit 9, want 8"}]}`,
+		},
+		{
+			name:       "Convert SHOULD create Digital Link URIs",
+			path:       "/convert",
+			body:       `{"data":"(01)09526064055028(10)ABC123","to":"dl","domain":"https://example.com"}`,
+			wantStatus: http.StatusOK,
+			want:       `{"result":"https://example.com/01/09526064055028/10/ABC123"}`,
+		},
+		{
+			name:       "Convert SHOULD create scan data",
+			path:       "/convert",
+			body:       `{"data":"(01)09526064055028(10)ABC123(21)1","to":"scan","symbology":"]Q3"}`,
+			wantStatus: http.StatusOK,
+			want:       `{"result":"]Q3010952606405502810ABC123\u001d211"}`,
+		},
+		{
+			name:       "Convert SHOULD reject unknown targets",
+			path:       "/convert",
+			body:       `{"data":"(01)09526064055028","to":"pdf"}`,
+			wantStatus: http.StatusBadRequest,
+			want:       `{"error":{"code":"invalid_request","message":"unsupported target syntax \"pdf\""}}`,
+		},
+		{
+			name:       "Check digit SHOULD be calculated",
+			path:       "/check-digit",
+			body:       `{"data":"0952606405502"}`,
+			wantStatus: http.StatusOK,
+			want:       `{"checkDigit":"8","result":"09526064055028"}`,
+		},
+		{
+			name:       "Unknown fields SHOULD be rejected",
+			path:       "/check-digit",
+			body:       `{"digits":"0952606405502"}`,
+			wantStatus: http.StatusBadRequest,
+			want:       `{"error":{"code":"invalid_request","message":"invalid JSON request: json: unknown field \"digits\""}}`,
+		},
+		{
+			name:       "Other methods SHOULD be rejected",
+			method:     http.MethodGet,
+			path:       "/parse",
+			wantStatus: http.StatusMethodNotAllowed,
+			want:       `{"error":{"code":"method_not_allowed","message":"method GET not allowed, use POST"}}`,
+		},
+		{
+			name:       "Unknown paths SHOULD be reported",
+			path:       "/encode",
+			body:       `{}`,
+			wantStatus: http.StatusNotFound,
+			want:       `{"error":{"code":"not_found","message":"no endpoint at /encode"}}`,
+		},
+	}
+	handler := New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := tt.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tt.want {
+				t.Errorf("body = %s, want %s", got, tt.want)
+			}
+			if got := rec.Header().Get("Content-Type"); got != "application/json" {
+				t.Errorf("Content-Type = %s, want application/json", got)
+			}
+		})
+	}
+}
+
+func TestHandler_MaxRequestBytes(t *testing.T) {
+	server := httptest.NewServer(New(WithMaxRequestBytes(32)))
+	defer server.Close()
+
+	body := `{"data":"(01)09526064055028(10)` + strings.Repeat("A", 20) + `"}`
+	resp, err := http.Post(server.URL+"/parse", "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var got ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	want := ErrorResponse{Error: ErrorDetail{Code: CodeRequestTooLarge, Message: "request body exceeds 32 bytes"}}
+	if resp.StatusCode != http.StatusRequestEntityTooLarge || !reflect.DeepEqual(got, want) {
+		t.Errorf("response = %d %+v, want %d %+v", resp.StatusCode, got, http.StatusRequestEntityTooLarge, want)
+	}
+}
+
+func TestHandler_UnsupportedMediaType(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/parse", strings.NewReader("data=(01)09526064055028"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	New().ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusUnsupportedMediaType)
+	}
+}
+
+func TestHandler_WithParseOptions(t *testing.T) {
+	registry, err := gs1.NewRegistry("test", []gs1.ApplicationIdentifierSpec{
+		{AI: "01", Flags: "*?", Specification: []string{"N14", "csum"}, Title: "GTIN"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := New(WithParseOptions(gs1.WithRegistry(registry)))
+
+	req := httptest.NewRequest(http.MethodPost, "/parse", strings.NewReader(`{"data":"(01)09526064055028(10)ABC"}`))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d, want %d as AI (10) is unknown to the registry", rec.Code, http.StatusUnprocessableEntity)
+	}
+}
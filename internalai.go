@@ -0,0 +1,124 @@
+package gs1
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// internalAIs are the AIs whose structure is defined by the issuing company rather than by GS1: company internal
+// information (91-99), additional product identification (240) and customer part numbers (241).
+var internalAIs = []string{"240", "241", "91", "92", "93", "94", "95", "96", "97", "98", "99"}
+
+// charsetSubsets lists for each character set the character sets whose characters are fully contained in it.
+var charsetSubsets = map[byte]string{
+	'N': "N",
+	'Y': "NY",
+	'Z': "NZ",
+	'X': "NXZ",
+}
+
+// IsInternal reports whether the structure of the AI is defined by the issuing company, see [Registry.WithInternalAIs].
+func (ai ApplicationIdentifier) IsInternal() bool {
+	return slices.Contains(internalAIs, ai.AI)
+}
+
+// WithInternalAIs returns a copy of the registry in which the given internal AIs are replaced by a company-specific
+// definition, e.g. `91  N4 X..20  # BATCH LINE` to define the first four characters of AI (91) as numeric line
+// number. Elements parsed using the returned registry carry the override, so its linters and title apply to them.
+//
+// Only internal AIs can be overridden. An override must stay within the definition of GS1, i.e. use a subset of its
+// character set and not exceed its maximum length, so that the data remains valid for trading partners unaware of
+// the override. Empty flags, attributes or titles are inherited from the registry.
+func (r *Registry) WithInternalAIs(specs []ApplicationIdentifierSpec) (*Registry, error) {
+	overridden := &Registry{
+		release: r.release,
+		ais:     maps.Clone(r.ais),
+	}
+	seen := map[string]bool{}
+	for _, spec := range specs {
+		if seen[spec.AI] {
+			return nil, fmt.Errorf("duplicate override of AI (%s)", spec.AI)
+		}
+		seen[spec.AI] = true
+
+		base, err := r.lookup(spec.AI)
+		if err != nil {
+			return nil, fmt.Errorf("cannot override AI (%s): %w", spec.AI, err)
+		}
+		ai, err := overrideInternalAI(base, spec)
+		if err != nil {
+			return nil, fmt.Errorf("cannot override AI (%s): %w", spec.AI, err)
+		}
+		overridden.ais[ai.AI] = ai
+	}
+	if err := overridden.derive(); err != nil {
+		return nil, err
+	}
+	return overridden, nil
+}
+
+// LoadInternalAIs reads internal AI overrides in GS1 Syntax Dictionary line format, as parsed by
+// [ParseSyntaxDictionary], and applies them using [Registry.WithInternalAIs].
+func (r *Registry) LoadInternalAIs(rd io.Reader) (*Registry, error) {
+	specs, err := ParseSyntaxDictionary(rd)
+	if err != nil {
+		return nil, fmt.Errorf("error reading internal AIs: %w", err)
+	}
+	return r.WithInternalAIs(specs)
+}
+
+// overrideInternalAI applies the spec to the internal AI base.
+func overrideInternalAI(base ApplicationIdentifier, spec ApplicationIdentifierSpec) (ApplicationIdentifier, error) {
+	if !base.IsInternal() {
+		return ApplicationIdentifier{}, fmt.Errorf("AI (%s) is not an internal AI", base.AI)
+	}
+
+	ai := ApplicationIdentifier{
+		AI:            base.AI,
+		Flags:         spec.Flags,
+		Specification: slices.Clone(spec.Specification),
+		Attributes:    slices.Clone(spec.Attributes),
+		Title:         spec.Title,
+	}
+	if ai.Flags == "" {
+		ai.Flags = base.Flags
+	}
+	if strings.ContainsRune(ai.Flags, requiresFNC1Flag) {
+		// Parsers of trading partners rely on the FNC1 separator, as internal AIs are not of predefined length.
+		return ApplicationIdentifier{}, fmt.Errorf("flag %q is not allowed for internal AIs", requiresFNC1Flag)
+	}
+	if len(ai.Attributes) == 0 {
+		ai.Attributes = slices.Clone(base.Attributes)
+	}
+	if ai.Title == "" {
+		ai.Title = base.Title
+	}
+
+	baseComponents, err := base.Components()
+	if err != nil {
+		return ApplicationIdentifier{}, err
+	}
+	if len(baseComponents) != 1 {
+		return ApplicationIdentifier{}, fmt.Errorf("AI (%s) must consist of a single component", base.AI)
+	}
+	limit := baseComponents[0]
+
+	components, err := ai.Components()
+	if err != nil {
+		return ApplicationIdentifier{}, err
+	}
+	maxLength := 0
+	for _, c := range components {
+		if !strings.ContainsRune(charsetSubsets[limit.Charset], rune(c.Charset)) {
+			return ApplicationIdentifier{}, fmt.Errorf("character set %c is not a subset of %c", c.Charset, limit.Charset)
+		}
+		maxLength += c.MaxLength
+	}
+	if maxLength > limit.MaxLength {
+		return ApplicationIdentifier{}, fmt.Errorf("maximum length %d exceeds %d", maxLength, limit.MaxLength)
+	}
+	return ai, nil
+}
@@ -0,0 +1,106 @@
+package gs1
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRegistry_WithInternalAIs(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides string
+		want      ApplicationIdentifier
+		wantErr   string
+	}{
+		{
+			name:      "Override of AI 91 SHOULD replace specification and title",
+			overrides: "91  N4 X..20,nozeroprefix  # BATCH LINE\n",
+			want: ApplicationIdentifier{
+				AI:            "91",
+				Flags:         "?",
+				Specification: []string{"N4 X..20", "nozeroprefix"},
+				Attributes:    []string{},
+				Title:         "BATCH LINE",
+			},
+		},
+		{
+			name:      "Empty attributes and title SHOULD be inherited",
+			overrides: "240  ?  X..10\n",
+			want: ApplicationIdentifier{
+				AI:            "240",
+				Flags:         "?",
+				Specification: []string{"X..10"},
+				Attributes:    []string{"req=01,02,8006,8026"},
+				Title:         "ADDITIONAL ID",
+			},
+		},
+		{
+			name:      "Override of a non-internal AI SHOULD return an error",
+			overrides: "10  X..20  # BATCH\n",
+			wantErr:   "AI (10) is not an internal AI",
+		},
+		{
+			name:      "Override exceeding the maximum length SHOULD return an error",
+			overrides: "241  N10 X..21  # PART\n",
+			wantErr:   "maximum length 31 exceeds 30",
+		},
+		{
+			name:      "Override narrowing CSET 82 to CSET 39 SHOULD return an error as # is not in CSET 82",
+			overrides: "91  Y..20  # PART\n",
+			wantErr:   "character set Y is not a subset of X",
+		},
+		{
+			name:      "Override using an unknown component type SHOULD return an error",
+			overrides: "92  N4 Q..20  # PART\n",
+			wantErr:   "invalid component type",
+		},
+		{
+			name:      "Override with predefined length flag SHOULD return an error",
+			overrides: "93  *?  N10  # PART\n",
+			wantErr:   "flag '*' is not allowed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := DefaultRegistry().LoadInternalAIs(strings.NewReader(tt.overrides))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadInternalAIs() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadInternalAIs() error = %v", err)
+			}
+			got, _ := r.Lookup(tt.want.AI)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lookup(%s) = %#v, want %#v", tt.want.AI, got, tt.want)
+			}
+			if original, _ := DefaultRegistry().Lookup(tt.want.AI); reflect.DeepEqual(original, got) {
+				t.Error("WithInternalAIs() SHOULD NOT modify the original registry")
+			}
+		})
+	}
+}
+
+func TestRegistry_WithInternalAIs_Duplicate(t *testing.T) {
+	specs := []ApplicationIdentifierSpec{{AI: "94", Specification: []string{"N10"}}, {AI: "94", Specification: []string{"N12"}}}
+	if _, err := DefaultRegistry().WithInternalAIs(specs); err == nil || !strings.Contains(err.Error(), "duplicate override of AI (94)") {
+		t.Errorf("WithInternalAIs() error = %v, want duplicate override", err)
+	}
+}
+
+func TestRegistry_WithInternalAIs_Parse(t *testing.T) {
+	r, err := DefaultRegistry().LoadInternalAIs(strings.NewReader("91  N4 X..20  # BATCH LINE\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := ParseMessage("]C101095260640550289100121234", WithRegistry(r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := msg.Elements[1]; got.AI != "91" || got.Title != "BATCH LINE" || got.DataField != "00121234" {
+		t.Errorf("ParseMessage() element = %v, want (91)00121234 titled BATCH LINE", got)
+	}
+}
@@ -0,0 +1,290 @@
+package gs1
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// jsonSchemaDialect is the JSON Schema dialect used by [NewJSONSchema]. It is also the dialect of OpenAPI 3.1.
+	jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+	// openAPIVersion is the OpenAPI version of documents created by [NewOpenAPIComponents].
+	openAPIVersion = "3.1.0"
+	// MessageSchemaName is the name of the message schema within the OpenAPI components.
+	MessageSchemaName = "GS1Message"
+)
+
+// JSONSchema is a JSON Schema document or subschema, limited to the keywords required to describe GS1 messages.
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	ID                   string                 `json:"$id,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Comment              string                 `json:"$comment,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	MinProperties        int                    `json:"minProperties,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	DependentSchemas     map[string]*JSONSchema `json:"dependentSchemas,omitempty"`
+	AllOf                []*JSONSchema          `json:"allOf,omitempty"`
+	AnyOf                []*JSONSchema          `json:"anyOf,omitempty"`
+	Not                  *JSONSchema            `json:"not,omitempty"`
+	Defs                 map[string]*JSONSchema `json:"$defs,omitempty"`
+}
+
+// OpenAPIDocument is an OpenAPI document only declaring components, to be referenced by API contracts.
+type OpenAPIDocument struct {
+	OpenAPI    string            `json:"openapi"`
+	Info       OpenAPIInfo       `json:"info"`
+	Components OpenAPIComponents `json:"components"`
+}
+
+// OpenAPIInfo is the metadata of an [OpenAPIDocument].
+type OpenAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// OpenAPIComponents holds the reusable schemas of an [OpenAPIDocument].
+type OpenAPIComponents struct {
+	Schemas map[string]*JSONSchema `json:"schemas"`
+}
+
+// NewJSONSchema creates a JSON Schema for messages in the compact JSON form of [Message.MarshalJSON], e.g.
+// {"01":"09526064055028","17":"250521"}. Every AI is described by a pattern derived from its specification components
+// and its title. The `req` and `ex` attributes are expressed as dependent schemas. Linters, e.g. check digits, cannot
+// be expressed and are not validated.
+func NewJSONSchema(release string, specs []ApplicationIdentifierSpec) (*JSONSchema, error) {
+	message, ais, err := newMessageSchema(release, specs, "#/$defs/")
+	if err != nil {
+		return nil, err
+	}
+	message.Schema = jsonSchemaDialect
+	message.Defs = ais
+	return message, nil
+}
+
+// NewOpenAPIComponents creates an OpenAPI document declaring the message schema of [NewJSONSchema] as component
+// [MessageSchemaName] and every AI as component, e.g. `AI01`.
+func NewOpenAPIComponents(release string, specs []ApplicationIdentifierSpec) (*OpenAPIDocument, error) {
+	message, ais, err := newMessageSchema(release, specs, "#/components/schemas/")
+	if err != nil {
+		return nil, err
+	}
+	ais[MessageSchemaName] = message
+	return &OpenAPIDocument{
+		OpenAPI:    openAPIVersion,
+		Info:       OpenAPIInfo{Title: message.Title, Version: release},
+		Components: OpenAPIComponents{Schemas: ais},
+	}, nil
+}
+
+// newMessageSchema creates the message schema referencing the AI schemas by refPrefix and the AI schemas keyed by
+// their definition name.
+func newMessageSchema(release string, specs []ApplicationIdentifierSpec, refPrefix string) (*JSONSchema, map[string]*JSONSchema, error) {
+	defined := make(map[string]int, len(specs))
+	for _, spec := range specs {
+		defined[spec.AI] = spec.Line
+	}
+
+	additional := false
+	message := &JSONSchema{
+		Title:                "GS1 message",
+		Description:          "GS1 element strings keyed by AI",
+		Type:                 "object",
+		MinProperties:        1,
+		Properties:           make(map[string]*JSONSchema, len(specs)),
+		AdditionalProperties: &additional,
+		DependentSchemas:     map[string]*JSONSchema{},
+	}
+	if release != "" {
+		message.Title += " (Syntax Dictionary release " + release + ")"
+	}
+
+	ais := make(map[string]*JSONSchema, len(specs))
+	for _, spec := range specs {
+		schema, err := newAISchema(spec)
+		if err != nil {
+			return nil, nil, err
+		}
+		ais[aiSchemaName(spec.AI)] = schema
+		message.Properties[spec.AI] = &JSONSchema{Ref: refPrefix + aiSchemaName(spec.AI)}
+
+		if constraint := associationSchema(spec, defined); constraint != nil {
+			message.DependentSchemas[spec.AI] = constraint
+		}
+	}
+	return message, ais, nil
+}
+
+func aiSchemaName(ai string) string {
+	return "AI" + ai
+}
+
+// newAISchema creates the string schema of a single AI.
+func newAISchema(spec ApplicationIdentifierSpec) (*JSONSchema, error) {
+	components, err := ApplicationIdentifier{AI: spec.AI, Specification: spec.Specification}.Components()
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := componentsPattern(components)
+	if err != nil {
+		return nil, fmt.Errorf("AI (%s): %w", spec.AI, err)
+	}
+	return &JSONSchema{
+		Title:       spec.Title,
+		Description: fmt.Sprintf("AI (%s) %s", spec.AI, spec.Title),
+		Comment:     "Specification: " + strings.Join(spec.Specification, ","),
+		Type:        "string",
+		Pattern:     pattern,
+	}, nil
+}
+
+// componentsPattern creates an anchored regular expression matching the character sets and lengths of the
+// components, e.g. `^[0-9]{14}$` for N14.
+func componentsPattern(components []SpecComponent) (string, error) {
+	builder := strings.Builder{}
+	builder.WriteByte('^')
+	for _, c := range components {
+		class, err := charsetClass(c.Charset)
+		if err != nil {
+			return "", err
+		}
+		quantifier := "{" + strconv.Itoa(c.MaxLength) + "}"
+		if c.MinLength != c.MaxLength {
+			quantifier = "{" + strconv.Itoa(c.MinLength) + "," + strconv.Itoa(c.MaxLength) + "}"
+		}
+		if c.Optional {
+			builder.WriteString("(?:" + class + quantifier + ")?")
+		} else {
+			builder.WriteString(class + quantifier)
+		}
+	}
+	builder.WriteByte('$')
+	return builder.String(), nil
+}
+
+// charsetClass returns a regular expression character class of the character set N, X, Y or Z. Consecutive characters
+// are merged into ranges.
+func charsetClass(charset byte) (string, error) {
+	var alphabet string
+	switch charset {
+	case 'N':
+		return "[0-9]", nil
+	case 'X':
+		alphabet = cset82
+	case 'Y':
+		alphabet = cset39
+	case 'Z':
+		alphabet = base64URLAlphabet
+	default:
+		return "", fmt.Errorf("unknown character set %c", charset)
+	}
+
+	chars := []byte(alphabet)
+	slices.Sort(chars)
+	builder := strings.Builder{}
+	builder.WriteByte('[')
+	for i := 0; i < len(chars); {
+		j := i
+		for j+1 < len(chars) && chars[j+1] == chars[j]+1 {
+			j++
+		}
+		writeClassChar(&builder, chars[i])
+		if j-i >= 2 {
+			builder.WriteByte('-')
+		}
+		if j > i {
+			writeClassChar(&builder, chars[j])
+		}
+		i = j + 1
+	}
+	builder.WriteByte(']')
+	return builder.String(), nil
+}
+
+func writeClassChar(builder *strings.Builder, c byte) {
+	if strings.IndexByte(`\]^-[`, c) >= 0 {
+		builder.WriteByte('\\')
+	}
+	builder.WriteByte(c)
+}
+
+// associationSchema expresses the `req` and `ex` attributes of the AI as schema applied if the AI is present. AI
+// patterns, e.g. `35nn`, are expanded to the defined AIs. An AI never excludes itself. It returns nil if the AI has no
+// associations.
+func associationSchema(spec ApplicationIdentifierSpec, defined map[string]int) *JSONSchema {
+	var constraints []*JSONSchema
+	for _, attr := range spec.Attributes {
+		key, value, _ := strings.Cut(attr, "=")
+		switch key {
+		case "req":
+			var alternatives []*JSONSchema
+			for _, group := range strings.Split(value, ",") {
+				alternatives = append(alternatives, requiredGroupSchema(group, spec.AI, defined))
+			}
+			constraints = append(constraints, anyOf(alternatives))
+		case "ex":
+			var excluded []*JSONSchema
+			for _, group := range strings.Split(value, ",") {
+				excluded = append(excluded, requiredGroupSchema(group, spec.AI, defined))
+			}
+			constraints = append(constraints, &JSONSchema{Not: anyOf(excluded)})
+		}
+	}
+	switch len(constraints) {
+	case 0:
+		return nil
+	case 1:
+		return constraints[0]
+	default:
+		return &JSONSchema{AllOf: constraints}
+	}
+}
+
+// requiredGroupSchema requires all AI patterns of a `+` separated group, e.g. `8018+7259`.
+func requiredGroupSchema(group, self string, defined map[string]int) *JSONSchema {
+	var all []*JSONSchema
+	for _, pattern := range strings.Split(group, "+") {
+		var candidates []*JSONSchema
+		for _, ai := range expandAIPattern(pattern, defined) {
+			if ai != self {
+				candidates = append(candidates, &JSONSchema{Required: []string{ai}})
+			}
+		}
+		all = append(all, anyOf(candidates))
+	}
+	if len(all) == 1 {
+		return all[0]
+	}
+	return &JSONSchema{AllOf: all}
+}
+
+// expandAIPattern returns the defined AIs matching the AI pattern in ascending order.
+func expandAIPattern(pattern string, defined map[string]int) []string {
+	var ais []string
+	for ai := range defined {
+		if matchesAnyAI(pattern, map[string]int{ai: 0}) {
+			ais = append(ais, ai)
+		}
+	}
+	slices.Sort(ais)
+	return ais
+}
+
+// anyOf combines the alternatives. Without alternatives, the schema cannot be satisfied.
+func anyOf(alternatives []*JSONSchema) *JSONSchema {
+	switch len(alternatives) {
+	case 0:
+		return &JSONSchema{Not: &JSONSchema{}}
+	case 1:
+		return alternatives[0]
+	default:
+		return &JSONSchema{AnyOf: alternatives}
+	}
+}
@@ -0,0 +1,124 @@
+package gs1
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestCharsetClass(t *testing.T) {
+	for charset, alphabet := range map[byte]string{'N': "0123456789", 'X': cset82, 'Y': cset39, 'Z': base64URLAlphabet} {
+		class, err := charsetClass(charset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		re := regexp.MustCompile("^" + class + "$")
+		for c := byte(0x20); c < 0x7f; c++ {
+			want := false
+			for i := 0; i < len(alphabet); i++ {
+				want = want || alphabet[i] == c
+			}
+			if got := re.MatchString(string(c)); got != want {
+				t.Errorf("class %s of %c matches %q = %v, want %v", class, charset, c, got, want)
+			}
+		}
+	}
+}
+
+func TestNewJSONSchema(t *testing.T) {
+	specs := []ApplicationIdentifierSpec{
+		{AI: "01", Flags: "*?", Specification: []string{"N14", "csum"}, Title: "GTIN"},
+		{AI: "02", Flags: "*", Specification: []string{"N14", "csum"}, Attributes: []string{"req=00", "ex=01"}, Title: "CONTENT"},
+		{AI: "00", Flags: "*", Specification: []string{"N18", "csum"}, Title: "SSCC"},
+		{AI: "10", Specification: []string{"X..20"}, Attributes: []string{"req=01,02"}, Title: "BATCH/LOT"},
+		{AI: "3100", Flags: "*", Specification: []string{"N6"}, Attributes: []string{"req=01", "ex=310n"}, Title: "NET WEIGHT (kg)"},
+		{AI: "3101", Flags: "*", Specification: []string{"N6"}, Attributes: []string{"req=01", "ex=310n"}, Title: "NET WEIGHT (kg)"},
+		{AI: "8010", Specification: []string{"Y..30"}, Title: "CPID"},
+		{AI: "8030", Specification: []string{"Z..90"}, Title: "DIGSIG"},
+		{AI: "7007", Specification: []string{"N6", "yymmdd [N6]", "yymmdd"}, Title: "HARVEST DATE"},
+	}
+	schema, err := NewJSONSchema("2025-01-30", specs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	patterns := map[string]string{
+		"01":   `^[0-9]{14}$`,
+		"10":   `^[!"%-?A-Z_a-z]{1,20}$`,
+		"8010": `^[#\-/-9A-Z]{1,30}$`,
+		"7007": `^[0-9]{6}(?:[0-9]{6})?$`,
+	}
+	for ai, want := range patterns {
+		if got := schema.Defs["AI"+ai].Pattern; got != want {
+			t.Errorf("pattern of AI (%s) = %s, want %s", ai, got, want)
+		}
+	}
+	if got := schema.Properties["10"].Ref; got != "#/$defs/AI10" {
+		t.Errorf("reference of AI (10) = %s", got)
+	}
+
+	wantDependents := map[string]string{
+		"02":   `{"allOf":[{"required":["00"]},{"not":{"required":["01"]}}]}`,
+		"10":   `{"anyOf":[{"required":["01"]},{"required":["02"]}]}`,
+		"3100": `{"allOf":[{"required":["01"]},{"not":{"required":["3101"]}}]}`,
+	}
+	for ai, want := range wantDependents {
+		got, err := json.Marshal(schema.DependentSchemas[ai])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("dependent schema of AI (%s) = %s, want %s", ai, got, want)
+		}
+	}
+	if _, ok := schema.DependentSchemas["01"]; ok {
+		t.Errorf("AI (01) SHOULD NOT have a dependent schema")
+	}
+}
+
+func TestNewOpenAPIComponents(t *testing.T) {
+	specs := []ApplicationIdentifierSpec{
+		{AI: "01", Flags: "*?", Specification: []string{"N14", "csum"}, Title: "GTIN"},
+	}
+	doc, err := NewOpenAPIComponents("2025-01-30", specs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.OpenAPI != openAPIVersion || doc.Info.Version != "2025-01-30" {
+		t.Errorf("unexpected document header %+v", doc)
+	}
+	var names []string
+	for name := range doc.Components.Schemas {
+		names = append(names, name)
+	}
+	if len(names) != 2 || doc.Components.Schemas[MessageSchemaName] == nil || doc.Components.Schemas["AI01"] == nil {
+		t.Errorf("schemas = %v, want %s and AI01", names, MessageSchemaName)
+	}
+	want := &JSONSchema{Ref: "#/components/schemas/AI01"}
+	if got := doc.Components.Schemas[MessageSchemaName].Properties["01"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("reference = %+v, want %+v", got, want)
+	}
+}
+
+func TestNewJSONSchema_SyntaxDictionary(t *testing.T) {
+	data, err := os.Open("./testdata/gs1-syntax-dictionary-2025-01-30.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer data.Close()
+	dictionary, err := ReadSyntaxDictionary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := NewJSONSchema(dictionary.Release, dictionary.AIs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for ai, def := range schema.Defs {
+		if _, err := regexp.Compile(def.Pattern); err != nil {
+			t.Errorf("%s: %v", ai, err)
+		}
+	}
+}
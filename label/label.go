@@ -0,0 +1,294 @@
+// Package label composes GS1 Logistic Labels, the labels of logistic units identified by an SSCC, as defined in the
+// GS1 Logistic Label Guideline. A message is partitioned into the sections of the carrier, the customer and the
+// supplier. Each section holds free text, the text block of data titles and values and the GS1-128 symbols of its
+// elements:
+//
+//	l, err := label.New(msg, label.WithFreeText(label.Supplier, "ACME Corp.", "Springfield"))
+//	err = l.SVG(w)
+//
+// The SSCC is carried by the bottom symbol of the label.
+package label
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/adippel/gs1engine-go"
+	"github.com/adippel/gs1engine-go/barcode"
+)
+
+// Party is the party a section of the label is intended for.
+type Party int
+
+const (
+	// Carrier is the section of transport information, e.g. ship to postal code (420) or routing code (403).
+	Carrier Party = iota + 1
+	// Customer is the section of order information, e.g. the customer's purchase order number (400).
+	Customer
+	// Supplier is the section of product information, e.g. content (02), count (37) and batch (10), and the SSCC.
+	Supplier
+)
+
+func (p Party) String() string {
+	switch p {
+	case Carrier:
+		return "carrier"
+	case Customer:
+		return "customer"
+	case Supplier:
+		return "supplier"
+	}
+	return fmt.Sprintf("Party(%d)", int(p))
+}
+
+// Label is the layout of a GS1 Logistic Label.
+type Label struct {
+	// WidthMM and HeightMM are the size of the label in millimetres.
+	WidthMM, HeightMM float64
+	// XDimensionMM is the module width of the GS1-128 symbols in millimetres.
+	XDimensionMM float64
+	// BarHeightMM is the height of the GS1-128 symbols in millimetres.
+	BarHeightMM float64
+	// Sections are the sections holding content from top to bottom: carrier, customer and supplier.
+	Sections []Section
+}
+
+// Section is the part of the label holding the data of a party.
+type Section struct {
+	Party Party
+	// FreeText are lines of text, e.g. addresses, printed at the top of the section.
+	FreeText []string
+	// Text is the text block of the elements of the section.
+	Text []Text
+	// Symbols are the GS1-128 symbols carrying the elements of the section, printed at the bottom of the section.
+	Symbols []Symbol
+}
+
+// Text is the data title and the value of an element within the text block, e.g. BEST BEFORE or BEST BY 31.12.26.
+type Text struct {
+	AI, Title, Value string
+}
+
+// Symbol is a GS1-128 symbol of the label.
+type Symbol struct {
+	Message gs1.Message
+	Linear  *barcode.Linear
+}
+
+// options are the options of [New].
+type options struct {
+	width, height float64
+	xdim          float64
+	barHeight     float64
+	freeText      map[Party][]string
+}
+
+// Option configures [New].
+type Option func(*options)
+
+// WithSize sets the size of the label in millimetres. The default is A5, 148 x 210 mm, holding sections of all three
+// parties. Labels of the supplier section only commonly use A6, 105 x 148 mm.
+func WithSize(widthMM, heightMM float64) Option {
+	return func(o *options) {
+		o.width, o.height = widthMM, heightMM
+	}
+}
+
+// WithXDimension sets the module width of the GS1-128 symbols in millimetres, from 0.495 to 1.016 mm as specified for
+// logistic units. The default is 0.495 mm.
+func WithXDimension(mm float64) Option {
+	return func(o *options) {
+		o.xdim = mm
+	}
+}
+
+// WithBarHeight sets the height of the GS1-128 symbols in millimetres, at least 31.75 mm. The default is 32 mm.
+func WithBarHeight(mm float64) Option {
+	return func(o *options) {
+		o.barHeight = mm
+	}
+}
+
+// WithFreeText adds lines of free text, e.g. the address of the party, at the top of the section of the party.
+func WithFreeText(party Party, lines ...string) Option {
+	return func(o *options) {
+		o.freeText[party] = append(o.freeText[party], lines...)
+	}
+}
+
+// sectionAIs are the AIs printed in the carrier and customer sections. All other AIs are printed in the supplier
+// section.
+var sectionAIs = map[string]Party{
+	"401": Carrier, "402": Carrier, "403": Carrier, "410": Carrier, "420": Carrier, "421": Carrier,
+	"400": Customer, "413": Customer,
+}
+
+// PartyOf returns the party of the section holding the AI. The ship to and return to AIs (4300) to (4333) are
+// printed in the carrier section.
+func PartyOf(ai string) Party {
+	if party, ok := sectionAIs[ai]; ok {
+		return party
+	}
+	if len(ai) == 4 && strings.HasPrefix(ai, "43") {
+		return Carrier
+	}
+	return Supplier
+}
+
+// New validates the message and composes the label. The message must contain AI (00). The elements are partitioned
+// into sections by [PartyOf]. Within each section, elements of predefined length are placed before the others and
+// the elements are packed into as few GS1-128 symbols as possible, respecting the limits of 48 data characters and
+// of the symbol width. The SSCC is placed alone in the bottom symbol.
+func New(msg gs1.Message, opts ...Option) (*Label, error) {
+	o := &options{width: 148, height: 210, xdim: 0.495, barHeight: 32, freeText: map[Party][]string{}}
+	for _, opt := range opts {
+		opt(o)
+	}
+	switch {
+	case o.width <= 0 || o.height <= 0:
+		return nil, fmt.Errorf("invalid label size %g x %g mm: must be positive", o.width, o.height)
+	case o.xdim < 0.495 || o.xdim > 1.016:
+		return nil, fmt.Errorf("invalid X-dimension %g mm: must be within 0.495 and 1.016 mm", o.xdim)
+	case o.barHeight < 31.75:
+		return nil, fmt.Errorf("invalid bar height %g mm: must be at least 31.75 mm", o.barHeight)
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	var sscc gs1.ElementString
+	elements := map[Party][]gs1.ElementString{}
+	for _, el := range msg.Elements {
+		if el.AI == "00" && sscc.AI == "" {
+			sscc = el
+			continue
+		}
+		party := PartyOf(el.AI)
+		elements[party] = append(elements[party], el)
+	}
+	if sscc.AI == "" {
+		return nil, errors.New("message has no AI (00) for the SSCC")
+	}
+
+	l := &Label{WidthMM: o.width, HeightMM: o.height, XDimensionMM: o.xdim, BarHeightMM: o.barHeight}
+	for _, party := range []Party{Carrier, Customer, Supplier} {
+		s := Section{Party: party, FreeText: o.freeText[party]}
+		els := elements[party]
+		if party == Supplier {
+			s.Text = append(s.Text, newText(sscc))
+		}
+		for _, el := range els {
+			s.Text = append(s.Text, newText(el))
+		}
+		symbols, err := l.symbols(els)
+		if err != nil {
+			return nil, fmt.Errorf("%s section: %w", party, err)
+		}
+		s.Symbols = symbols
+		if party == Supplier {
+			symbol, err := l.symbol([]gs1.ElementString{sscc})
+			if err != nil {
+				return nil, err
+			}
+			s.Symbols = append(s.Symbols, *symbol)
+		}
+		if len(s.FreeText) > 0 || len(s.Text) > 0 {
+			l.Sections = append(l.Sections, s)
+		}
+	}
+	if height := l.place().height; height > l.HeightMM {
+		return nil, fmt.Errorf("label content of %.1f mm exceeds the label height of %g mm", height, l.HeightMM)
+	}
+	return l, nil
+}
+
+// symbols packs the elements into GS1-128 symbols. Elements of predefined length come first, so FNC1 separators are
+// only needed between the remaining elements.
+func (l *Label) symbols(elements []gs1.ElementString) ([]Symbol, error) {
+	ordered := make([]gs1.ElementString, 0, len(elements))
+	for _, fixed := range []bool{true, false} {
+		for _, el := range elements {
+			if el.IsFixedLength() == fixed {
+				ordered = append(ordered, el)
+			}
+		}
+	}
+
+	var symbols []Symbol
+	var current []gs1.ElementString
+	for _, el := range ordered {
+		if len(current) > 0 {
+			if _, err := l.symbol(append(current[:len(current):len(current)], el)); err == nil {
+				current = append(current, el)
+				continue
+			}
+			symbol, err := l.symbol(current)
+			if err != nil {
+				return nil, err
+			}
+			symbols = append(symbols, *symbol)
+		}
+		current = []gs1.ElementString{el}
+	}
+	if len(current) > 0 {
+		symbol, err := l.symbol(current)
+		if err != nil {
+			return nil, err
+		}
+		symbols = append(symbols, *symbol)
+	}
+	return symbols, nil
+}
+
+// symbol encodes the elements as GS1-128 symbol fitting the width of the label.
+func (l *Label) symbol(elements []gs1.ElementString) (*Symbol, error) {
+	msg := gs1.Message{Elements: elements}
+	linear, err := barcode.EncodeGS1128(msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := linear.CheckWidth(l.XDimensionMM); err != nil {
+		return nil, err
+	}
+	if width, available := linear.WidthMM(l.XDimensionMM), l.WidthMM-2*marginMM; width > available {
+		return nil, fmt.Errorf("%w: symbol of %s is %.1f mm wide, exceeding the %.1f mm available on the label",
+			barcode.ErrDataTooLong, msg.AsElementString(), width, available)
+	}
+	return &Symbol{Message: msg, Linear: linear}, nil
+}
+
+// newText returns the text block entry of the element. Dates are printed as DD.MM.YY, measures with their decimal
+// point.
+func newText(el gs1.ElementString) Text {
+	t := Text{AI: el.AI, Title: el.Title, Value: el.DataField}
+	if t.Title == "" {
+		t.Title = "AI (" + el.AI + ")"
+	}
+	switch {
+	case isDateAI(el.AI):
+		if date, err := gs1.ParseDate(el.DataField); err == nil {
+			t.Value = date.Format("02.01.06")
+		}
+	case len(el.AI) == 4 && el.AI >= "3100" && el.AI <= "3699" && len(el.DataField) == 6:
+		if decimals := int(el.AI[3] - '0'); decimals > 0 {
+			t.Value = strings.TrimLeft(el.DataField[:6-decimals], "0")
+			if t.Value == "" {
+				t.Value = "0"
+			}
+			t.Value += "." + el.DataField[6-decimals:]
+		} else {
+			t.Value = strings.TrimLeft(el.DataField[:5], "0") + el.DataField[5:]
+		}
+	}
+	return t
+}
+
+// isDateAI reports whether the AI holds a date of the format YYMMDD.
+func isDateAI(ai string) bool {
+	switch ai {
+	case "11", "12", "13", "15", "16", "17":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package label
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+func mustParse(t *testing.T, data string) gs1.Message {
+	t.Helper()
+	msg, err := gs1.ParseElementString(data)
+	if err != nil {
+		t.Fatalf("ParseElementString(%s) error = %v", data, err)
+	}
+	return msg
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		opts        []Option
+		wantParties []Party
+		wantSymbols [][]string
+	}{
+		{
+			name:        "SSCC SHOULD be carried alone by the bottom symbol",
+			data:        "(00)106141411234567897",
+			opts:        []Option{WithSize(105, 148)},
+			wantParties: []Party{Supplier},
+			wantSymbols: [][]string{{"(00)106141411234567897"}},
+		},
+		{
+			name:        "elements of predefined length SHOULD precede the others",
+			data:        "(00)106141411234567897(02)04006381333931(37)20(15)261231",
+			wantParties: []Party{Supplier},
+			wantSymbols: [][]string{{"(02)04006381333931(15)261231(37)20", "(00)106141411234567897"}},
+		},
+		{
+			name:        "elements exceeding 48 data characters SHOULD be split into symbols",
+			data:        "(00)106141411234567897(02)04006381333931(37)20(15)261231(3301)001234(10)ABC123",
+			wantParties: []Party{Supplier},
+			wantSymbols: [][]string{{"(02)04006381333931(15)261231(3301)001234(37)20", "(10)ABC123",
+				"(00)106141411234567897"}},
+		},
+		{
+			name:        "elements SHOULD be split by the width of the label",
+			data:        "(00)106141411234567897(02)04006381333931(37)20(10)ABC123",
+			opts:        []Option{WithSize(105, 210)},
+			wantParties: []Party{Supplier},
+			wantSymbols: [][]string{{"(02)04006381333931(37)20", "(10)ABC123", "(00)106141411234567897"}},
+		},
+		{
+			name:        "carrier and customer elements SHOULD be printed in their sections",
+			data:        "(00)106141411234567897(400)PO12345(420)12345",
+			wantParties: []Party{Carrier, Customer, Supplier},
+			wantSymbols: [][]string{{"(420)12345"}, {"(400)PO12345"}, {"(00)106141411234567897"}},
+		},
+		{
+			name:        "free text SHOULD add a section without symbols",
+			data:        "(00)106141411234567897",
+			opts:        []Option{WithFreeText(Carrier, "ACME Logistics")},
+			wantParties: []Party{Carrier, Supplier},
+			wantSymbols: [][]string{nil, {"(00)106141411234567897"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := New(mustParse(t, tt.data), tt.opts...)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			var parties []Party
+			var symbols [][]string
+			for _, s := range l.Sections {
+				parties = append(parties, s.Party)
+				var section []string
+				for _, symbol := range s.Symbols {
+					section = append(section, symbol.Message.AsElementString())
+				}
+				symbols = append(symbols, section)
+			}
+			if !reflect.DeepEqual(parties, tt.wantParties) || !reflect.DeepEqual(symbols, tt.wantSymbols) {
+				t.Errorf("New() = %v with symbols %q, want %v with symbols %q", parties, symbols, tt.wantParties,
+					tt.wantSymbols)
+			}
+		})
+	}
+}
+
+func TestNew_Text(t *testing.T) {
+	l, err := New(mustParse(t, "(00)106141411234567897(02)04006381333931(37)20(15)261231(3301)001234(3100)000042"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	want := []Text{
+		{AI: "00", Title: "SSCC", Value: "106141411234567897"},
+		{AI: "02", Title: "CONTENT", Value: "04006381333931"},
+		{AI: "37", Title: "COUNT", Value: "20"},
+		{AI: "15", Title: "BEST BEFORE or BEST BY", Value: "31.12.26"},
+		{AI: "3301", Title: "GROSS WEIGHT (kg)", Value: "123.4"},
+		{AI: "3100", Title: "NET WEIGHT (kg)", Value: "42"},
+	}
+	if got := l.Sections[0].Text; !reflect.DeepEqual(got, want) {
+		t.Errorf("New() text = %v, want %v", got, want)
+	}
+}
+
+func TestNew_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    []Option
+		wantErr string
+	}{
+		{name: "missing SSCC SHOULD be rejected", data: "(400)PO12345", wantErr: "no AI (00)"},
+		{name: "invalid message SHOULD be rejected", data: "(00)106141411234567897(02)04006381333931",
+			wantErr: "37"},
+		{name: "small X-dimension SHOULD be rejected", data: "(00)106141411234567897",
+			opts: []Option{WithXDimension(0.25)}, wantErr: "invalid X-dimension"},
+		{name: "low bars SHOULD be rejected", data: "(00)106141411234567897", opts: []Option{WithBarHeight(20)},
+			wantErr: "invalid bar height"},
+		{name: "content exceeding the label SHOULD be rejected",
+			data:    "(00)106141411234567897(02)04006381333931(37)20(10)ABC(400)PO1(420)12345",
+			opts:    []Option{WithSize(105, 148)},
+			wantErr: "exceeds the label height"},
+		{name: "SSCC exceeding the label width SHOULD be rejected", data: "(00)106141411234567897",
+			opts: []Option{WithSize(60, 148)}, wantErr: "exceeding the"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(mustParse(t, tt.data), tt.opts...)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("New() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPartyOf(t *testing.T) {
+	tests := []struct {
+		ai   string
+		want Party
+	}{
+		{ai: "420", want: Carrier}, {ai: "4302", want: Carrier}, {ai: "400", want: Customer},
+		{ai: "00", want: Supplier}, {ai: "10", want: Supplier}, {ai: "43", want: Supplier},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ai+" SHOULD be printed in the "+tt.want.String()+" section", func(t *testing.T) {
+			if got := PartyOf(tt.ai); got != tt.want {
+				t.Errorf("PartyOf() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package label
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Dimensions of the layout in millimetres.
+const (
+	marginMM         = 4
+	freeTextSizeMM   = 3.5
+	freeTextLineMM   = 4.5
+	titleSizeMM      = 2.5
+	valueSizeMM      = 4
+	textLineMM       = 6
+	hriSizeMM        = 3
+	hriLineMM        = 5
+	symbolGapMM      = 1
+	sectionPadMM     = 2
+	separatorWidthMM = 0.3
+)
+
+// placement is the label placed into text, rectangles and symbols.
+type placement struct {
+	texts   []placedText
+	rects   []placedRect
+	symbols []placedSymbol
+	// height is the height of the content including the margins.
+	height float64
+}
+
+// placedText is a line of text. Y is the baseline.
+type placedText struct {
+	x, y, size float64
+	centered   bool
+	text       string
+}
+
+type placedRect struct {
+	x, y, width, height float64
+}
+
+type placedSymbol struct {
+	x, y   float64
+	symbol Symbol
+}
+
+// place places the sections from top to bottom. Sections are separated by horizontal lines.
+func (l *Label) place() *placement {
+	p := &placement{}
+	y := float64(marginMM)
+	valueX := marginMM + 0.4*(l.WidthMM-2*marginMM)
+	for i, s := range l.Sections {
+		if i > 0 {
+			p.rects = append(p.rects, placedRect{0, y, l.WidthMM, separatorWidthMM})
+			y += sectionPadMM
+		}
+		for _, line := range s.FreeText {
+			p.texts = append(p.texts, placedText{x: marginMM, y: y + freeTextSizeMM, size: freeTextSizeMM, text: line})
+			y += freeTextLineMM
+		}
+		for _, t := range s.Text {
+			p.texts = append(p.texts,
+				placedText{x: marginMM, y: y + valueSizeMM, size: titleSizeMM, text: t.Title},
+				placedText{x: valueX, y: y + valueSizeMM, size: valueSizeMM, text: t.Value})
+			y += textLineMM
+		}
+		for _, symbol := range s.Symbols {
+			y += symbolGapMM
+			p.symbols = append(p.symbols, placedSymbol{
+				x:      (l.WidthMM - symbol.Linear.WidthMM(l.XDimensionMM)) / 2,
+				y:      y,
+				symbol: symbol,
+			})
+			y += l.BarHeightMM
+			p.texts = append(p.texts, placedText{x: l.WidthMM / 2, y: y + symbolGapMM + hriSizeMM, size: hriSizeMM,
+				centered: true, text: strings.Join(symbol.Linear.HRI, " ")})
+			y += hriLineMM
+		}
+		y += sectionPadMM
+	}
+	p.height = y + marginMM
+	return p
+}
+
+// SVG writes the label as SVG image using millimetres as user units. Text is set in a sans-serif font.
+func (l *Label) SVG(w io.Writer) error {
+	p := l.place()
+	bw := bufio.NewWriter(w)
+	width, height := formatFloat(l.WidthMM), formatFloat(l.HeightMM)
+	fmt.Fprintf(bw, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+		`<svg xmlns="http://www.w3.org/2000/svg" version="1.1" width="%smm" height="%smm" viewBox="0 0 %s %s">`+"\n",
+		width, height, width, height)
+	fmt.Fprintf(bw, `<rect width="100%%" height="100%%" fill="#fff"/>`+"\n")
+	for _, r := range p.rects {
+		fmt.Fprintf(bw, `<rect x="%s" y="%s" width="%s" height="%s"/>`+"\n", formatFloat(r.x), formatFloat(r.y),
+			formatFloat(r.width), formatFloat(r.height))
+	}
+	for _, s := range p.symbols {
+		fmt.Fprintf(bw, `<path d="`)
+		for _, run := range runs(s.symbol.Linear.Modules) {
+			fmt.Fprintf(bw, "M%s %sh%sv%sh-%sz", formatFloat(s.x+float64(run[0])*l.XDimensionMM), formatFloat(s.y),
+				formatFloat(float64(run[1])*l.XDimensionMM), formatFloat(l.BarHeightMM),
+				formatFloat(float64(run[1])*l.XDimensionMM))
+		}
+		fmt.Fprintf(bw, "\"/>\n")
+	}
+	for _, t := range p.texts {
+		anchor := ""
+		if t.centered {
+			anchor = ` text-anchor="middle"`
+		}
+		fmt.Fprintf(bw, `<text x="%s" y="%s" font-family="Helvetica, Arial, sans-serif" font-size="%s"%s>%s</text>`+"\n",
+			formatFloat(t.x), formatFloat(t.y), formatFloat(t.size), anchor, html.EscapeString(t.text))
+	}
+	fmt.Fprintf(bw, "</svg>\n")
+	return bw.Flush()
+}
+
+// Image renders the label at the given resolution in dots per inch, e.g. 203 or 300 of common label printers. Bars
+// are rounded to whole pixels per module, text is set in a built-in 5x7 bitmap font.
+func (l *Label) Image(dpi int) image.Image {
+	scale := float64(dpi) / 25.4
+	px := func(mm float64) int {
+		return int(math.Round(mm * scale))
+	}
+	img := image.NewGray(image.Rect(0, 0, px(l.WidthMM), px(l.HeightMM)))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+	fill := func(x, y, width, height int) {
+		for dy := range height {
+			for dx := range width {
+				img.SetGray(x+dx, y+dy, color.Gray{})
+			}
+		}
+	}
+
+	p := l.place()
+	for _, r := range p.rects {
+		fill(px(r.x), px(r.y), px(r.width), max(px(r.height), 1))
+	}
+	module := max(px(l.XDimensionMM), 1)
+	for _, s := range p.symbols {
+		width := len(s.symbol.Linear.Modules) * module
+		x := (img.Bounds().Dx() - width) / 2
+		for _, run := range runs(s.symbol.Linear.Modules) {
+			fill(x+run[0]*module, px(s.y), run[1]*module, px(l.BarHeightMM))
+		}
+	}
+	for _, t := range p.texts {
+		// The glyphs are 7 of 8 rows of a character cell high, the cell is scaled to the font size.
+		size := max(int(math.Round(t.size*scale/8)), 1)
+		x := px(t.x)
+		if t.centered {
+			x -= len(t.text) * 6 * size / 2
+		}
+		y := px(t.y) - 7*size
+		for i := range len(t.text) {
+			glyph := fontGlyph(t.text[i])
+			for col, bits := range glyph {
+				for row := range 7 {
+					if bits&(1<<row) != 0 {
+						fill(x+(i*6+col)*size, y+row*size, size, size)
+					}
+				}
+			}
+		}
+	}
+	return img
+}
+
+// PNG writes the label as PNG image at the given resolution in dots per inch.
+func (l *Label) PNG(w io.Writer, dpi int) error {
+	if dpi <= 0 {
+		return fmt.Errorf("invalid resolution %d dpi: must be positive", dpi)
+	}
+	return png.Encode(w, l.Image(dpi))
+}
+
+// runs returns the start and length of the runs of dark modules.
+func runs(modules []bool) [][2]int {
+	var result [][2]int
+	for i := 0; i < len(modules); {
+		if !modules[i] {
+			i++
+			continue
+		}
+		start := i
+		for i < len(modules) && modules[i] {
+			i++
+		}
+		result = append(result, [2]int{start, i - start})
+	}
+	return result
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(math.Round(f*1000)/1000, 'f', -1, 64)
+}
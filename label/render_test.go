@@ -0,0 +1,70 @@
+package label
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestLabel_SVG(t *testing.T) {
+	l, err := New(mustParse(t, "(00)106141411234567897(02)04006381333931(37)20(420)12345"),
+		WithFreeText(Supplier, `ACME "Corp" & Sons`))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	var buf bytes.Buffer
+	if err := l.SVG(&buf); err != nil {
+		t.Fatalf("SVG() error = %v", err)
+	}
+	svg := buf.String()
+	for _, want := range []string{
+		`width="148mm" height="210mm" viewBox="0 0 148 210"`,
+		`<rect x="0" y="`,
+		`>ACME &#34;Corp&#34; &amp; Sons</text>`,
+		`>SHIP TO POST</text>`,
+		`>CONTENT</text>`,
+		`text-anchor="middle">(00) 106141411234567897</text>`,
+	} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("SVG() = %s, want to contain %s", svg, want)
+		}
+	}
+	if got := strings.Count(svg, "<path "); got != 3 {
+		t.Errorf("SVG() has %d symbols, want 3", got)
+	}
+}
+
+func TestLabel_PNG(t *testing.T) {
+	l, err := New(mustParse(t, "(00)106141411234567897"), WithSize(105, 148))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	var buf bytes.Buffer
+	if err := l.PNG(&buf, 203); err != nil {
+		t.Fatalf("PNG() error = %v", err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+	if got := img.Bounds().Size(); got.X != 839 || got.Y != 1183 {
+		t.Errorf("PNG() size = %v, want 839x1183", got)
+	}
+
+	// The symbol starts with the 2 module wide bar of the start character after the quiet zone, at 4 pixels per
+	// module.
+	symbol := l.Sections[0].Symbols[0].Linear
+	x := (839-4*symbol.Width())/2 + 4*symbol.QuietZone
+	y := int((marginMM + textLineMM + symbolGapMM + l.BarHeightMM/2) * 203 / 25.4)
+	for dx, want := range []bool{false, true, true, true, true, true, true, true, true, false} {
+		if dark := color.GrayModel.Convert(img.At(x-1+dx, y)).(color.Gray).Y == 0; dark != want {
+			t.Errorf("PNG() pixel %d, %d dark = %t, want %t", x-1+dx, y, dark, want)
+		}
+	}
+
+	if err := l.PNG(&buf, 0); err == nil {
+		t.Errorf("PNG() SHOULD reject a resolution of 0 dpi")
+	}
+}
@@ -0,0 +1,265 @@
+package gs1
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// minKeyPrefixLength is the shortest GS1 Company Prefix accepted by the linters `key` and `keyoff1`. Unlike the TDS,
+// GS1 keys may start with 4-digit prefixes.
+const minKeyPrefixLength = 4
+
+// cset32 is the character set of the check character pair of `csumalpha` as defined in GS1 General Specification
+// v25.0, chapter 7.9.5.
+const cset32 = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+// csumAlphaWeights are the primes weighting the characters of `csumalpha` data, the last one belongs to the rightmost
+// character preceding the check character pair.
+var csumAlphaWeights = []int{83, 79, 73, 71, 67, 61, 59, 53, 47, 43, 41, 37, 31, 29, 23, 19, 17, 13, 11, 7, 5, 3, 2}
+
+// linter checks the data of a single specification component.
+type linter func(data string) error
+
+// linters are the linters of the GS1 Syntax Dictionary that can be applied without external code lists. Linters
+// missing here, e.g. `iso3166` or `couponcode`, are not applied.
+var linters = map[string]linter{
+	"csum":          lintCheckDigit,
+	"csumalpha":     lintCheckCharacterPair,
+	"key":           lintKey(0),
+	"keyoff1":       lintKey(1),
+	"yymmdd":        lintDate(false),
+	"yymmd0":        lintDate(true),
+	"yyyymmdd":      lintFullDate,
+	"hhmi":          lintTime,
+	"hh":            lintRange(0, 23),
+	"mi":            lintRange(0, 59),
+	"ss":            lintRange(0, 59),
+	"yesno":         lintOneOf("0", "1"),
+	"winding":       lintOneOf("0", "1", "9"),
+	"iso5218":       lintOneOf("0", "1", "2", "9"),
+	"zero":          lintOneOf("0"),
+	"nonzero":       lintNonZero,
+	"nozeroprefix":  lintNoZeroPrefix,
+	"hasnondigit":   lintHasNonDigit,
+	"hyphen":        lintHyphen,
+	"pieceoftotal":  lintPieceOfTotal,
+	"posinseqslash": lintPositionInSequence,
+	"latitude":      lintRange(0, 1800000000),
+	"longitude":     lintRange(0, 3600000000),
+	"mediatype":     lintMediaType,
+	"importeridx":   lintImporterIndex,
+	"pcenc":         lintPercentEncoding,
+	"iban":          lintIBAN,
+}
+
+func lintCheckDigit(data string) error {
+	if !isNumeric(data) || len(data) < 2 {
+		return errors.New("check digit requires numeric data")
+	}
+	if want := checkDigit(data[:len(data)-1]); data[len(data)-1] != want {
+		return fmt.Errorf("wrong check digit %c, want %c", data[len(data)-1], want)
+	}
+	return nil
+}
+
+// lintCheckCharacterPair checks the check character pair as defined in GS1 General Specification v25.0, chapter 7.9.5.
+func lintCheckCharacterPair(data string) error {
+	if len(data) < 2 || len(data)-2 > len(csumAlphaWeights) {
+		return errors.New("invalid length for check character pair")
+	}
+	weights := csumAlphaWeights[len(csumAlphaWeights)-(len(data)-2):]
+	sum := 0
+	for i := 0; i < len(data)-2; i++ {
+		pos := strings.IndexByte(cset82, data[i])
+		if pos < 0 {
+			return fmt.Errorf("invalid character %q", data[i])
+		}
+		sum += pos * weights[i]
+	}
+	sum %= 1021
+	want := string([]byte{cset32[sum>>5], cset32[sum&31]})
+	if got := data[len(data)-2:]; got != want {
+		return fmt.Errorf("wrong check character pair %s, want %s", got, want)
+	}
+	return nil
+}
+
+// lintKey checks that the key starts with a numeric GS1 Company Prefix at the given offset.
+func lintKey(offset int) linter {
+	return func(data string) error {
+		if len(data) < offset+minKeyPrefixLength || !isNumeric(data[offset:offset+minKeyPrefixLength]) {
+			return errors.New("key must start with a GS1 Company Prefix")
+		}
+		return nil
+	}
+}
+
+func lintDate(allowDay00 bool) linter {
+	return func(data string) error {
+		if !allowDay00 && len(data) == 6 && data[4:] == "00" {
+			return errors.New("day 00 is not allowed")
+		}
+		_, err := ParseDate(data)
+		return err
+	}
+}
+
+func lintFullDate(data string) error {
+	if _, err := time.Parse("20060102", data); err != nil || !isNumeric(data) {
+		return fmt.Errorf("invalid date %q", data)
+	}
+	return nil
+}
+
+func lintTime(data string) error {
+	if len(data) != 4 {
+		return errors.New("time must consist of 4 digits")
+	}
+	if err := lintRange(0, 23)(data[:2]); err != nil {
+		return fmt.Errorf("invalid hour: %w", err)
+	}
+	if err := lintRange(0, 59)(data[2:]); err != nil {
+		return fmt.Errorf("invalid minute: %w", err)
+	}
+	return nil
+}
+
+// lintRange checks that the numeric data is within the range.
+func lintRange(lower, upper int) linter {
+	return func(data string) error {
+		n, err := strconv.Atoi(data)
+		if err != nil || !isNumeric(data) {
+			return errors.New("value must be numeric")
+		}
+		if n < lower || n > upper {
+			return fmt.Errorf("value %s is outside of range %d to %d", data, lower, upper)
+		}
+		return nil
+	}
+}
+
+func lintOneOf(values ...string) linter {
+	return func(data string) error {
+		for _, v := range values {
+			if data == v {
+				return nil
+			}
+		}
+		return fmt.Errorf("value %q must be one of %s", data, strings.Join(values, ", "))
+	}
+}
+
+func lintNonZero(data string) error {
+	if strings.Trim(data, "0") == "" {
+		return errors.New("value must not be zero")
+	}
+	return nil
+}
+
+func lintNoZeroPrefix(data string) error {
+	if len(data) > 1 && data[0] == '0' {
+		return errors.New("value must not start with zero")
+	}
+	return nil
+}
+
+func lintHasNonDigit(data string) error {
+	if isNumeric(data) {
+		return errors.New("value must contain a non-digit character")
+	}
+	return nil
+}
+
+func lintHyphen(data string) error {
+	if strings.Trim(data, "-") != "" {
+		return errors.New("value must consist of hyphens")
+	}
+	return nil
+}
+
+// lintPieceOfTotal checks that the first half of the data is a piece number not exceeding the total in the second
+// half.
+func lintPieceOfTotal(data string) error {
+	if len(data)%2 != 0 || !isNumeric(data) {
+		return errors.New("piece of total must consist of an even number of digits")
+	}
+	piece, _ := strconv.Atoi(data[:len(data)/2])
+	total, _ := strconv.Atoi(data[len(data)/2:])
+	if piece == 0 || total == 0 || piece > total {
+		return fmt.Errorf("invalid piece %d of total %d", piece, total)
+	}
+	return nil
+}
+
+// lintPositionInSequence checks data in the form `position/total`, e.g. `1/3`.
+func lintPositionInSequence(data string) error {
+	position, total, ok := strings.Cut(data, "/")
+	if !ok || !isNumeric(position) || !isNumeric(total) || position == "" || total == "" {
+		return errors.New("position in sequence must be in the form position/total")
+	}
+	if position[0] == '0' || total[0] == '0' {
+		return errors.New("position in sequence must not have leading zeros")
+	}
+	p, _ := strconv.Atoi(position)
+	t, _ := strconv.Atoi(total)
+	if p > t {
+		return fmt.Errorf("invalid position %d of total %d", p, t)
+	}
+	return nil
+}
+
+func lintMediaType(data string) error {
+	n, err := strconv.Atoi(data)
+	if err != nil || len(data) != 2 || !(n >= 1 && n <= 10 || n >= 80) {
+		return fmt.Errorf("unknown media type %q", data)
+	}
+	return nil
+}
+
+func lintImporterIndex(data string) error {
+	if len(data) != 1 || !strings.Contains(base64URLAlphabet, data) {
+		return errors.New("importer index must be a single character of 0-9, A-Z, a-z, - and _")
+	}
+	return nil
+}
+
+func lintPercentEncoding(data string) error {
+	for i := 0; i < len(data); i++ {
+		if data[i] != '%' {
+			continue
+		}
+		if i+2 >= len(data) || !isHexDigit(data[i+1]) || !isHexDigit(data[i+2]) {
+			return errors.New("invalid percent encoding")
+		}
+	}
+	return nil
+}
+
+// lintIBAN checks the structure and the checksum of an International Bank Account Number.
+func lintIBAN(data string) error {
+	if len(data) < 5 {
+		return errors.New("IBAN must consist of at least 5 characters")
+	}
+	rearranged := data[4:] + data[:4]
+	digits := strings.Builder{}
+	for i := 0; i < len(rearranged); i++ {
+		c := rearranged[i]
+		switch {
+		case c >= '0' && c <= '9':
+			digits.WriteByte(c)
+		case c >= 'A' && c <= 'Z':
+			digits.WriteString(strconv.Itoa(int(c-'A') + 10))
+		default:
+			return fmt.Errorf("invalid IBAN character %q", c)
+		}
+	}
+	n, _ := new(big.Int).SetString(digits.String(), 10)
+	if new(big.Int).Mod(n, big.NewInt(97)).Int64() != 1 {
+		return errors.New("wrong IBAN checksum")
+	}
+	return nil
+}
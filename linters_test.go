@@ -0,0 +1,55 @@
+package gs1
+
+import "testing"
+
+func TestLinters(t *testing.T) {
+	tests := []struct {
+		linter  string
+		data    string
+		wantErr bool
+	}{
+		{linter: "csum", data: "09526064055028"},
+		{linter: "csum", data: "09526064055027", wantErr: true},
+		{linter: "csumalpha", data: "1987654Ad4X4bL5ttr2310c2K"},
+		{linter: "csumalpha", data: "1987654Ad4X4bL5ttr2310c2L", wantErr: true},
+		{linter: "key", data: "9526064"},
+		{linter: "key", data: "95A6064", wantErr: true},
+		{linter: "keyoff1", data: "A9526"},
+		{linter: "yymmdd", data: "240229"},
+		{linter: "yymmdd", data: "250200", wantErr: true},
+		{linter: "yymmd0", data: "250200"},
+		{linter: "yymmd0", data: "250229", wantErr: true},
+		{linter: "yyyymmdd", data: "20240229"},
+		{linter: "yyyymmdd", data: "20230229", wantErr: true},
+		{linter: "hhmi", data: "2359"},
+		{linter: "hhmi", data: "2460", wantErr: true},
+		{linter: "yesno", data: "1"},
+		{linter: "yesno", data: "2", wantErr: true},
+		{linter: "nonzero", data: "000", wantErr: true},
+		{linter: "nozeroprefix", data: "0"},
+		{linter: "nozeroprefix", data: "01", wantErr: true},
+		{linter: "pieceoftotal", data: "0102"},
+		{linter: "pieceoftotal", data: "0302", wantErr: true},
+		{linter: "posinseqslash", data: "1/3"},
+		{linter: "posinseqslash", data: "4/3", wantErr: true},
+		{linter: "posinseqslash", data: "01/3", wantErr: true},
+		{linter: "latitude", data: "1800000000"},
+		{linter: "latitude", data: "1800000001", wantErr: true},
+		{linter: "pcenc", data: "A%2FB"},
+		{linter: "pcenc", data: "A%2", wantErr: true},
+		{linter: "iban", data: "GB82WEST12345698765432"},
+		{linter: "iban", data: "GB82WEST12345698765433", wantErr: true},
+		{linter: "hasnondigit", data: "123", wantErr: true},
+		{linter: "hyphen", data: "-"},
+		{linter: "importeridx", data: "_"},
+		{linter: "importeridx", data: "*", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.linter+" "+tt.data, func(t *testing.T) {
+			err := linters[tt.linter](tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s(%q) error = %v, wantErr %v", tt.linter, tt.data, err, tt.wantErr)
+			}
+		})
+	}
+}
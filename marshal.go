@@ -0,0 +1,245 @@
+package gs1
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+const (
+	// xmlAIDCDataName is the element name used for a [Message] marshaled as GS1 XML AIDC data.
+	xmlAIDCDataName = "aidcData"
+	// xmlAIName is the element name used for an [ElementString] within AIDC data.
+	xmlAIName = "ai"
+	// xmlAICodeAttrName is the attribute carrying the AI of an [ElementString].
+	xmlAICodeAttrName = "code"
+)
+
+// MarshalText returns the element string syntax of the element, e.g. (01)09526064055028.
+func (ai ElementString) MarshalText() ([]byte, error) {
+	return []byte(ai.String()), nil
+}
+
+// UnmarshalText parses a single element in element string syntax, e.g. (01)09526064055028.
+func (ai *ElementString) UnmarshalText(text []byte) error {
+	msg, err := ParseElementString(string(text))
+	if err != nil {
+		return err
+	}
+	if len(msg.Elements) != 1 {
+		return fmt.Errorf("expected a single element string, got %d", len(msg.Elements))
+	}
+	*ai = msg.Elements[0]
+	return nil
+}
+
+// MarshalJSON returns the element as JSON object with a single member keyed by the AI, e.g. {"01":"09526064055028"}.
+func (ai ElementString) MarshalJSON() ([]byte, error) {
+	buf := bytes.Buffer{}
+	if err := writeJSONMember(&buf, ai); err != nil {
+		return nil, err
+	}
+	return append(append([]byte{'{'}, buf.Bytes()...), '}'), nil
+}
+
+// UnmarshalJSON parses a JSON object with a single member keyed by the AI, e.g. {"01":"09526064055028"}.
+func (ai *ElementString) UnmarshalJSON(data []byte) error {
+	elements, err := unmarshalJSONObject(data)
+	if err != nil {
+		return err
+	}
+	if len(elements) != 1 {
+		return fmt.Errorf("expected a single element string, got %d", len(elements))
+	}
+	*ai = elements[0]
+	return nil
+}
+
+// MarshalXML encodes the element as `<ai code="01">09526064055028</ai>`.
+func (ai ElementString) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: xmlAIName}
+	start.Attr = []xml.Attr{{Name: xml.Name{Local: xmlAICodeAttrName}, Value: ai.AI}}
+	return e.EncodeElement(ai.DataField, start)
+}
+
+// UnmarshalXML decodes an element as encoded by [ElementString.MarshalXML].
+func (ai *ElementString) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var code string
+	for _, attr := range start.Attr {
+		if attr.Name.Local == xmlAICodeAttrName {
+			code = attr.Value
+		}
+	}
+	var data string
+	if err := d.DecodeElement(&data, &start); err != nil {
+		return err
+	}
+	aiInfo, err := DefaultRegistry().lookup(code)
+	if err != nil {
+		return err
+	}
+	*ai = NewElementString(aiInfo, data)
+	return nil
+}
+
+// MarshalText returns the message in element string syntax, see [Message.AsElementString].
+func (d Message) MarshalText() ([]byte, error) {
+	return []byte(d.AsElementString()), nil
+}
+
+// UnmarshalText parses the message from element string syntax using [ParseElementString].
+func (d *Message) UnmarshalText(text []byte) error {
+	msg, err := ParseElementString(string(text))
+	if err != nil {
+		return err
+	}
+	*d = msg
+	return nil
+}
+
+// MarshalJSON returns the elements of the message as compact JSON object keyed by AI and ordered as in the message,
+// e.g. {"01":"09526064055028","17":"250521"}. If an AI occurs more than once, the ordered-array form
+// [{"01":"09526064055028"},{"17":"250521"}] is used instead, as JSON object members should be unique. Symbology and
+// syntax type are not marshaled.
+func (d Message) MarshalJSON() ([]byte, error) {
+	if d.hasRepeatedAI() {
+		elements := d.Elements
+		if elements == nil {
+			elements = []ElementString{}
+		}
+		return json.Marshal(elements)
+	}
+
+	buf := bytes.Buffer{}
+	buf.WriteByte('{')
+	for i, el := range d.Elements {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeJSONMember(&buf, el); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON parses both the compact object and the ordered-array form as produced by [Message.MarshalJSON]. The
+// order of the elements is preserved. By convention, JSON null leaves the message unchanged.
+func (d *Message) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if string(trimmed) == "null" {
+		return nil
+	}
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var elements []ElementString
+		if err := json.Unmarshal(trimmed, &elements); err != nil {
+			return err
+		}
+		d.Elements = elements
+		return nil
+	}
+	elements, err := unmarshalJSONObject(trimmed)
+	if err != nil {
+		return err
+	}
+	d.Elements = elements
+	return nil
+}
+
+// MarshalXML encodes the message as GS1 XML AIDC data, e.g.
+// `<aidcData><ai code="01">09526064055028</ai><ai code="17">250521</ai></aidcData>`. The element name of the
+// message is kept if it is defined by a struct field, otherwise `aidcData` is used.
+func (d Message) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if start.Name.Local == "" || start.Name.Local == "Message" {
+		start.Name = xml.Name{Local: xmlAIDCDataName}
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, el := range d.Elements {
+		if err := e.Encode(el); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML decodes a message as encoded by [Message.MarshalXML].
+func (d *Message) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var aidcData struct {
+		Elements []ElementString `xml:"ai"`
+	}
+	if err := dec.DecodeElement(&aidcData, &start); err != nil {
+		return err
+	}
+	d.Elements = aidcData.Elements
+	return nil
+}
+
+func (d Message) hasRepeatedAI() bool {
+	seen := make(map[string]bool, len(d.Elements))
+	for _, el := range d.Elements {
+		if seen[el.AI] {
+			return true
+		}
+		seen[el.AI] = true
+	}
+	return false
+}
+
+// writeJSONMember writes the element as `"AI":"data"`.
+func writeJSONMember(buf *bytes.Buffer, el ElementString) error {
+	key, err := json.Marshal(el.AI)
+	if err != nil {
+		return err
+	}
+	value, err := json.Marshal(el.DataField)
+	if err != nil {
+		return err
+	}
+	buf.Write(key)
+	buf.WriteByte(':')
+	buf.Write(value)
+	return nil
+}
+
+// unmarshalJSONObject reads a JSON object keyed by AI while preserving the order of its members.
+func unmarshalJSONObject(data []byte) ([]ElementString, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, errors.New("expected JSON object keyed by AI")
+	}
+
+	var elements []ElementString
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		code := tok.(string)
+		var value string
+		if err := dec.Decode(&value); err != nil {
+			return nil, fmt.Errorf("invalid value of AI %s: %w", code, err)
+		}
+		ai, err := DefaultRegistry().lookup(code)
+		if err != nil {
+			return nil, err
+		}
+		if slices.ContainsFunc(elements, func(el ElementString) bool { return el.AI == code }) {
+			return nil, fmt.Errorf("duplicate AI: %s", code)
+		}
+		elements = append(elements, NewElementString(ai, value))
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return elements, nil
+}
@@ -0,0 +1,185 @@
+package gs1
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+func TestMessage_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		elements []ElementString
+		want     string
+	}{
+		{
+			name: "Message SHOULD marshal to compact object keeping the element order",
+			elements: []ElementString{
+				NewElementString(AI17, "250521"),
+				NewElementString(AI01, "09526064055028"),
+				NewElementString(AI10, "AB\"C"),
+			},
+			want: `{"17":"250521","01":"09526064055028","10":"AB\"C"}`,
+		},
+		{
+			name: "Message with repeated AI SHOULD marshal to ordered array",
+			elements: []ElementString{
+				NewElementString(AI01, "09526064055028"),
+				NewElementString(AI01, "09526064055028"),
+			},
+			want: `[{"01":"09526064055028"},{"01":"09526064055028"}]`,
+		},
+		{
+			name: "Empty message SHOULD marshal to empty object",
+			want: `{}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(Message{Elements: tt.elements})
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessage_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []ElementString
+		wantErr bool
+	}{
+		{
+			name: "Compact object SHOULD unmarshal keeping the member order",
+			data: `{"17":"250521","01":"09526064055028"}`,
+			want: []ElementString{
+				NewElementString(AI17, "250521"),
+				NewElementString(AI01, "09526064055028"),
+			},
+		},
+		{
+			name: "Ordered array SHOULD unmarshal",
+			data: ` [{"01":"09526064055028"},{"21":"ABC"}]`,
+			want: []ElementString{
+				NewElementString(AI01, "09526064055028"),
+				NewElementString(AI21, "ABC"),
+			},
+		},
+		{
+			name:    "Unknown AI SHOULD return an error",
+			data:    `{"0000":"123"}`,
+			wantErr: true,
+		},
+		{
+			name:    "Non-string value SHOULD return an error",
+			data:    `{"01":9526064055028}`,
+			wantErr: true,
+		},
+		{
+			name:    "Array element with several members SHOULD return an error",
+			data:    `[{"01":"09526064055028","21":"ABC"}]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Message
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got.Elements, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", got.Elements, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessage_UnmarshalJSON_Null(t *testing.T) {
+	var got struct {
+		M Message `json:"m"`
+	}
+	got.M.Elements = []ElementString{NewElementString(AI01, "09526064055028")}
+	if err := json.Unmarshal([]byte(`{"m":null}`), &got); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if len(got.M.Elements) != 1 {
+		t.Errorf("UnmarshalJSON() of null SHOULD leave the message unchanged, got %v", got.M.Elements)
+	}
+}
+
+func TestElementString_MarshalJSON(t *testing.T) {
+	got, err := json.Marshal(NewElementString(AI01, "09526064055028"))
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if want := `{"01":"09526064055028"}`; string(got) != want {
+		t.Errorf("MarshalJSON() got = %s, want %s", got, want)
+	}
+}
+
+func TestMessage_TextRoundTrip(t *testing.T) {
+	msg := Message{Elements: []ElementString{
+		NewElementString(AI01, "09526064055028"),
+		NewElementString(AI10, "ABC123"),
+	}}
+
+	text, err := msg.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v", err)
+	}
+	if want := "(01)09526064055028(10)ABC123"; string(text) != want {
+		t.Errorf("MarshalText() got = %s, want %s", text, want)
+	}
+
+	var got Message
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if !reflect.DeepEqual(got.Elements, msg.Elements) {
+		t.Errorf("UnmarshalText() got = %v, want %v", got.Elements, msg.Elements)
+	}
+
+	var el ElementString
+	if err := el.UnmarshalText([]byte("(01)09526064055028(10)ABC123")); err == nil {
+		t.Errorf("ElementString.UnmarshalText() with two elements SHOULD return an error")
+	}
+	if err := el.UnmarshalText([]byte("(10)ABC123")); err != nil || !reflect.DeepEqual(el, NewElementString(AI10, "ABC123")) {
+		t.Errorf("ElementString.UnmarshalText() got = %v, error = %v", el, err)
+	}
+}
+
+func TestMessage_XML(t *testing.T) {
+	msg := Message{Elements: []ElementString{
+		NewElementString(AI01, "09526064055028"),
+		NewElementString(AI10, "A<B"),
+	}}
+	want := `<aidcData><ai code="01">09526064055028</ai><ai code="10">A&lt;B</ai></aidcData>`
+
+	got, err := xml.Marshal(msg)
+	if err != nil {
+		t.Fatalf("MarshalXML() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("MarshalXML() got = %s, want %s", got, want)
+	}
+
+	var decoded Message
+	if err := xml.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("UnmarshalXML() error = %v", err)
+	}
+	if !reflect.DeepEqual(decoded.Elements, msg.Elements) {
+		t.Errorf("UnmarshalXML() got = %v, want %v", decoded.Elements, msg.Elements)
+	}
+
+	if err := xml.Unmarshal([]byte(`<aidcData><ai code="0000">1</ai></aidcData>`), &decoded); err == nil {
+		t.Errorf("UnmarshalXML() with unknown AI SHOULD return an error")
+	}
+}
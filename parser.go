@@ -3,7 +3,7 @@ package gs1
 import (
 	"errors"
 	"fmt"
-	"strconv"
+	"slices"
 	"strings"
 )
 
@@ -16,49 +16,77 @@ const (
 var fnc1Visuals = []string{"^", "{GS}"}
 
 // ParseMessage detects the type of encoding used in msg and decodes the [Message] by dispatching to the more
-// specialized parsers [ParseBarcodeMessage] and [ParseElementString].
-// Plain syntax data is not parsed, as it is not AI-based.
-func ParseMessage(msg string) (d Message, _ error) {
+// specialized parsers [ParseBarcodeMessage], [ParseEANUPC], [ParseITF14] and [ParseElementString].
+// Plain syntax data is not parsed, as it is not AI-based. AIs are looked up using the [DefaultRegistry] unless
+// another registry is passed using [WithRegistry] or [WithAtomicRegistry].
+func ParseMessage(msg string, opts ...Option) (d Message, _ error) {
 	if len(msg) == 0 {
 		return d, errors.New("message is empty")
 	}
 	firstChar := msg[0]
 
 	switch firstChar {
-	case symbologyFlag, fnc1:
-		return ParseBarcodeMessage(msg)
+	case symbologyFlag:
+		switch {
+		case strings.HasPrefix(msg, string(symbologyFlag)+string(EANUPC)):
+			return ParseEANUPC(msg, opts...)
+		case strings.HasPrefix(msg, string(symbologyFlag)+string(ITF)):
+			return ParseITF14(msg, opts...)
+		}
+		return ParseBarcodeMessage(msg, opts...)
+	case fnc1:
+		return ParseBarcodeMessage(msg, opts...)
 	case '(':
-		return ParseElementString(msg)
+		return ParseElementString(msg, opts...)
 	}
 	for _, visualFNC1 := range fnc1Visuals {
 		if strings.Contains(msg, visualFNC1) {
-			return ParseBarcodeMessage(msg)
+			return ParseBarcodeMessage(msg, opts...)
 		}
 	}
 
 	return d, errors.New("unsupported data syntax")
 }
 
+// ParseAny detects GS1 Digital Link URIs and EPC pure identity URIs in addition to the syntaxes detected by
+// [ParseMessage] and dispatches to the respective parser.
+func ParseAny(data string, opts ...Option) (Message, error) {
+	switch {
+	case strings.HasPrefix(data, "http://"), strings.HasPrefix(data, "https://"):
+		return ParseDigitalLink(data, opts...)
+	case strings.HasPrefix(data, epcPureIdentityPrefix):
+		return ParseEPCURI(data, opts...)
+	}
+	return ParseMessage(data, opts...)
+}
+
 // ParseBarcodeMessage supports `Barcode message format` and `Barcode message scan data`. It supports group
 // separation with FNC1 as well as its literal variants '^' and '{GS}'. Examples for messages are
-// “^01095260640550281725052110ABC123^21456DEF` and `]d201095260640550281725052110ABC123{GS}21456DEF`.
-func ParseBarcodeMessage(msg string) (d Message, _ error) {
+// “^01095260640550281725052110ABC123^21456DEF` and `]d201095260640550281725052110ABC123{GS}21456DEF`. Scan data
+// must start with a known symbology identifier carrying GS1 AI data, e.g. ]C1, ]e0, ]d2, ]Q3 or ]J1, see
+// [SymbologyIdentifier.Info].
+func ParseBarcodeMessage(msg string, opts ...Option) (d Message, _ error) {
+	registry := resolveRegistry(opts)
 	// Clean the input string
-	for _, visualFNC1 := range fnc1Visuals {
-		msg = strings.ReplaceAll(msg, visualFNC1, string(fnc1))
-	}
+	msg = replaceFNC1Substitutes(msg)
 	if len(msg) > 0 && msg[0] == fnc1 {
 		msg = msg[1:] // Remove leading FNC1
 	}
 
 	// Read and remove symbology identifier if present (e.g., ]C1)
-	if strings.HasPrefix(msg, string(symbologyFlag)) && len(msg) > 3 {
-		symbologyMode, err := strconv.Atoi(msg[2:3])
+	if strings.HasPrefix(msg, string(symbologyFlag)) {
+		symbology, err := ParseSymbologyIdentifier(msg[:min(3, len(msg))])
 		if err != nil {
-			return d, fmt.Errorf("error parsing symbology mode: %w", err)
+			return d, err
 		}
-		d.Symbology.Type = SymbologyType(msg[1])
-		d.Symbology.Mode = symbologyMode
+		if info, ok := symbology.Info(); ok && info.Content != AIData {
+			return d, fmt.Errorf("symbology identifier %s (%s) carries %s, not GS1 AI data", symbology, info.Name,
+				info.Content)
+		}
+		if err := symbology.ValidateContent(msg[3:]); err != nil {
+			return d, err
+		}
+		d.Symbology = symbology
 		d.SyntaxType = BarcodeMessageScanData
 		msg = msg[3:]
 	} else {
@@ -67,7 +95,7 @@ func ParseBarcodeMessage(msg string) (d Message, _ error) {
 
 	subStr := msg[:]
 	for len(subStr) > 0 {
-		aiInfo, exists := detectAICode(subStr)
+		aiInfo, exists := registry.detectAICode(subStr)
 
 		if !exists {
 			return Message{}, errors.New("error detecting valid AI code in message")
@@ -109,26 +137,18 @@ func ParseBarcodeMessage(msg string) (d Message, _ error) {
 	return d, nil
 }
 
-func detectAICode(msg string) (ApplicationIdentifier, bool) {
-	if len(msg) < 2 {
-		return ApplicationIdentifier{}, false
-	}
-	if len(msg) > 4 {
-		ai, ok := AIRegistry[msg[:4]]
-		if ok {
-			return ai, ok
-		}
-	}
-	ai, ok := AIRegistry[msg[:2]]
-	if ok {
-		return ai, ok
+// replaceFNC1Substitutes replaces the visual substitutes in fnc1Visuals and any additional substitutes by FNC1.
+func replaceFNC1Substitutes(msg string, substitutes ...string) string {
+	for _, substitute := range slices.Concat(fnc1Visuals, substitutes) {
+		msg = strings.ReplaceAll(msg, substitute, string(fnc1))
 	}
-	return ApplicationIdentifier{}, false
+	return msg
 }
 
 // ParseElementString parses GS1 messages using the element string syntax. Example GS1 message compliant to this
 // is `(01)09526064055028(17)250521(10)ABC123(21)456DEF`.
-func ParseElementString(msg string) (d Message, _ error) {
+func ParseElementString(msg string, opts ...Option) (d Message, _ error) {
+	registry := resolveRegistry(opts)
 	if !strings.HasPrefix(msg, "(") {
 		return d, errors.New("invalid syntax: element string syntax must begin with '('")
 	}
@@ -137,9 +157,12 @@ func ParseElementString(msg string) (d Message, _ error) {
 	for len(subStr) > 0 {
 		aiIDStart := strings.IndexRune(subStr, '(')
 		aiIDEnd := strings.IndexRune(subStr, ')')
+		if aiIDEnd < aiIDStart {
+			return Message{}, errors.New("invalid syntax: AI must be enclosed in parentheses")
+		}
 
 		aiID := subStr[aiIDStart+1 : aiIDEnd]
-		ai, ok := AIRegistry[aiID]
+		ai, ok := registry.Lookup(aiID)
 		if !ok {
 			return Message{}, fmt.Errorf("unkown AI: %s", aiID)
 		}
@@ -53,6 +53,11 @@ func TestParseElementStringSyntax(t *testing.T) {
 			args:    args{"(01)123456()66666"},
 			wantErr: true,
 		},
+		{
+			name:    "Unterminated AI code parenthesis SHOULD return an error",
+			args:    args{"(10)A(B"},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -127,6 +132,21 @@ func TestParseBarcodeMessage(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "Message with an unknown symbology identifier SHOULD fail",
+			args:    args{"]Y00109526064055028"},
+			wantErr: true,
+		},
+		{
+			name:    "Message with a symbology identifier of plain data SHOULD fail",
+			args:    args{"]Q10109526064055028"},
+			wantErr: true,
+		},
+		{
+			name:    "Message with a symbology identifier only SHOULD fail",
+			args:    args{"]C1"},
+			wantErr: true,
+		},
 		{
 			name: "Messages with 4-digit AIs SHOULD parse",
 			args: args{"356412345621123456"},
@@ -199,6 +219,20 @@ func TestParseDataMessage(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ITF-14 scan data SHOULD parse",
+			args: args{"]I114012345678908"},
+			wantD: Message{
+				SyntaxType: BarcodeMessageScanData,
+				Elements: []ElementString{
+					NewElementString(AI01, "14012345678908"),
+				},
+				Symbology: SymbologyIdentifier{
+					Type: ITF,
+					Mode: 1,
+				},
+			},
+		},
 		{
 			name:    "Digital-Link URI Syntax SHOULD return error",
 			args:    args{"https://example.com/01/09526064055028"},
@@ -218,3 +252,27 @@ func TestParseDataMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestParseAny(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want MessageSyntaxType
+	}{
+		{name: "Digital Link URI SHOULD be detected", data: "https://id.gs1.org/01/09526064055028", want: DigitalLinkURI},
+		{name: "EPC URI SHOULD be detected", data: "urn:epc:id:sgtin:952606.0405502.ABC", want: EPCPureIdentityURI},
+		{name: "Element string SHOULD be detected", data: "(01)09526064055028", want: ElementStringSyntax},
+		{name: "Scan data SHOULD be detected", data: "]C10109526064055028", want: BarcodeMessageScanData},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAny(tt.data)
+			if err != nil {
+				t.Fatalf("ParseAny() error = %v", err)
+			}
+			if got.SyntaxType != tt.want || got.data("01") != "09526064055028" {
+				t.Errorf("ParseAny() = %+v, want syntax %s with GTIN 09526064055028", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package printer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/adippel/gs1engine-go"
+	"github.com/adippel/gs1engine-go/barcode"
+)
+
+// eplFont is a resident font of EPL2 printers at 203 dpi.
+type eplFont struct {
+	id            int
+	height, width int // height and width including the space between characters in dots
+}
+
+var eplFonts = [...]eplFont{{1, 12, 10}, {2, 16, 12}, {3, 20, 14}, {4, 24, 16}, {5, 48, 34}}
+
+// EPL returns the EPL2 commands printing the message as symbol at the position of the options followed by its HRI
+// lines. The commands neither clear the image buffer by N nor print the label by P.
+//
+// All symbologies are encoded by package barcode and sent as GW graphic, so FNC1 is encoded the same as in the other
+// outputs of this module. The HRI is printed in the largest resident font not higher than the font height of the
+// options.
+func EPL(msg gs1.Message, symbology barcode.Symbology, opts ...Option) (string, error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return "", err
+	}
+	if err := checkPrintable(msg, ""); err != nil {
+		return "", err
+	}
+	s, err := encode(msg, symbology, o)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	bytesPerRow := (s.width + 7) / 8
+	fmt.Fprintf(&sb, "GW%d,%d,%d,%d,", o.x, o.y, bytesPerRow, s.height)
+	for row := range s.height {
+		// EPL2 prints cleared bits.
+		sb.Write(s.row(row, false))
+	}
+	sb.WriteByte('\n')
+
+	if !o.noHRI {
+		font := eplFonts[0]
+		for _, f := range eplFonts {
+			if f.height <= o.fontHeight {
+				font = f
+			}
+		}
+		lines := hriLines(msg, (s.width-2*s.quietZone)/font.width)
+		for i, line := range lines {
+			y := o.y + s.height + font.height/4 + i*font.height*5/4
+			line = strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(line)
+			fmt.Fprintf(&sb, "A%d,%d,0,%d,1,1,N,\"%s\"\n", o.x+s.quietZone, y, font.id, line)
+		}
+	}
+	return sb.String(), nil
+}
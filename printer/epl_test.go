@@ -0,0 +1,68 @@
+package printer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/adippel/gs1engine-go/barcode"
+)
+
+func TestEPL(t *testing.T) {
+	msg := mustParse(t, "(01)04006381333931")
+	got, err := EPL(msg, barcode.DataBarOmni, WithPosition(10, 20), WithFontHeight(16))
+	if err != nil {
+		t.Fatalf("EPL() error = %v", err)
+	}
+	linear, err := barcode.EncodeDataBarOmni(msg)
+	if err != nil {
+		t.Fatalf("EncodeDataBarOmni() error = %v", err)
+	}
+
+	bytesPerRow, height := (2*linear.Width()+7)/8, 2*barcode.DataBarOmniHeight
+	header := fmt.Sprintf("GW10,20,%d,%d,", bytesPerRow, height)
+	if !strings.HasPrefix(got, header) {
+		t.Fatalf("EPL() = %q, want prefix %q", got, header)
+	}
+	data := got[len(header) : len(header)+bytesPerRow*height]
+	for y := range height {
+		for x := range 8 * bytesPerRow {
+			dark := data[y*bytesPerRow+x/8]&(0x80>>(x%8)) == 0
+			if want := x < 2*linear.Width() && linear.Modules[x/2]; dark != want {
+				t.Fatalf("EPL() graphic dot %d, %d dark = %t, want %t", x, y, dark, want)
+			}
+		}
+	}
+	if want := "\nA10,90,0,2,1,1,N,\"(01) 04006381333931\"\n"; got[len(header)+bytesPerRow*height:] != want {
+		t.Errorf("EPL() HRI = %q, want %q", got[len(header)+bytesPerRow*height:], want)
+	}
+}
+
+func TestEPL_HRI(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		fontHeight int
+		want       []string
+	}{
+		{name: "quotes SHOULD be escaped", data: "(01)04006381333931(10)A\"B", fontHeight: 12,
+			want: []string{`A20,103,0,1,1,1,N,"(01) 04006381333931  (10) A\"B"`}},
+		{name: "elements exceeding the symbol width SHOULD be printed on separate lines",
+			data: "(01)04006381333931(10)ABC", fontHeight: 48,
+			want: []string{`A20,112,0,5,1,1,N,"(01) 04006381333931"`, `A20,172,0,5,1,1,N,"(10) ABC"`}},
+		{name: "font heights below the smallest font SHOULD use the smallest font", data: "(10)ABC", fontHeight: 5,
+			want: []string{`A20,103,0,1,1,1,N,"(10) ABC"`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EPL(mustParse(t, tt.data), barcode.GS1128, WithFontHeight(tt.fontHeight))
+			if err != nil {
+				t.Fatalf("EPL() error = %v", err)
+			}
+			lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+			if hri := lines[len(lines)-len(tt.want):]; strings.Join(hri, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("EPL() HRI = %q, want %q", hri, tt.want)
+			}
+		})
+	}
+}
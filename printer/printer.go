@@ -0,0 +1,248 @@
+// Package printer converts GS1 messages into commands of label printer languages, ZPL II and EPL2. The output
+// consists of the commands printing a symbol at a position of the label followed by its human readable
+// interpretation (HRI), so several symbols and texts can be combined into one label:
+//
+//	zpl, err := printer.ZPL(msg, barcode.GS1128, printer.WithPosition(50, 100))
+//	fmt.Fprintf(w, "^XA\n%s^XZ\n", zpl)
+//
+// GS1-128 and GS1 DataMatrix are printed by the native ZPL commands with FNC1 sent as invocation or escape
+// sequence, see [ZPL]. All other symbols, and all symbols in EPL2, are encoded by package barcode and sent as
+// graphics, so the encodation does not depend on the printer firmware. Symbols are selected by [barcode.Symbology];
+// GS1 DotCode and GS1 Digital Link URIs are not printed.
+package printer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/adippel/gs1engine-go"
+	"github.com/adippel/gs1engine-go/barcode"
+)
+
+// options are the options of [ZPL] and [EPL].
+type options struct {
+	x, y       int
+	module     int
+	barHeight  int
+	fontHeight int
+	noHRI      bool
+	shape      barcode.DataMatrixShape
+	level      barcode.QRLevel
+	segments   int
+}
+
+// Option configures [ZPL] and [EPL].
+type Option func(*options)
+
+// WithPosition sets the top left corner of the symbol including its quiet zone in dots. The default is 0, 0.
+func WithPosition(x, y int) Option {
+	return func(o *options) {
+		o.x, o.y = x, y
+	}
+}
+
+// WithModuleWidth sets the X-dimension, the width of the narrowest bar or of a square module, in dots. The default
+// is 2 dots, i.e. 0.25 mm at 203 dpi.
+func WithModuleWidth(dots int) Option {
+	return func(o *options) {
+		o.module = dots
+	}
+}
+
+// WithBarHeight sets the height of linear symbols in dots. The default is 100 dots for GS1-128 and the minimum height
+// of the symbology for GS1 DataBar Omnidirectional, Limited and Expanded.
+func WithBarHeight(dots int) Option {
+	return func(o *options) {
+		o.barHeight = dots
+	}
+}
+
+// WithFontHeight sets the height of the HRI characters in dots. The default is 20 dots.
+func WithFontHeight(dots int) Option {
+	return func(o *options) {
+		o.fontHeight = dots
+	}
+}
+
+// WithoutHRI omits the human readable interpretation below the symbol.
+func WithoutHRI() Option {
+	return func(o *options) {
+		o.noHRI = true
+	}
+}
+
+// WithDataMatrixShape restricts GS1 DataMatrix symbols to the given shape. Symbols are square by default.
+func WithDataMatrixShape(shape barcode.DataMatrixShape) Option {
+	return func(o *options) {
+		o.shape = shape
+	}
+}
+
+// WithQRLevel sets the error correction level of GS1 QR Code symbols. The default is [barcode.QRLevelM].
+func WithQRLevel(level barcode.QRLevel) Option {
+	return func(o *options) {
+		o.level = level
+	}
+}
+
+// WithSegments sets the amount of segments per row of GS1 DataBar Expanded Stacked symbols, an even number from 2
+// to 22. The default is 4.
+func WithSegments(segments int) Option {
+	return func(o *options) {
+		o.segments = segments
+	}
+}
+
+// newOptions returns the options with defaults applied.
+func newOptions(opts []Option) (*options, error) {
+	o := &options{module: 2, fontHeight: 20, level: barcode.QRLevelM, segments: 4}
+	for _, opt := range opts {
+		opt(o)
+	}
+	switch {
+	case o.x < 0 || o.y < 0:
+		return nil, fmt.Errorf("invalid position %d, %d: must not be negative", o.x, o.y)
+	case o.module < 1:
+		return nil, fmt.Errorf("invalid module width %d dots: must be positive", o.module)
+	case o.barHeight < 0:
+		return nil, fmt.Errorf("invalid bar height %d dots: must be positive", o.barHeight)
+	case o.fontHeight < 1:
+		return nil, fmt.Errorf("invalid font height %d dots: must be positive", o.fontHeight)
+	}
+	return o, nil
+}
+
+// bitmap is a monochrome image of dots. Dark dots are true.
+type bitmap struct {
+	width, height int
+	dots          []bool
+}
+
+// at reports whether the dot in column x and row y is dark.
+func (b *bitmap) at(x, y int) bool {
+	return b.dots[y*b.width+x]
+}
+
+// row returns the dots of row y packed into bytes, most significant bit first. The bits of dark dots are set if
+// dark is true, the bits of light dots otherwise. Bits after the last dot are light.
+func (b *bitmap) row(y int, dark bool) []byte {
+	row := make([]byte, (b.width+7)/8)
+	for x := range 8 * len(row) {
+		if (x < b.width && b.at(x, y)) == dark {
+			row[x/8] |= 0x80 >> (x % 8)
+		}
+	}
+	return row
+}
+
+// symbol is a symbol encoded by package barcode, rendered as bitmap of dots including the quiet zones.
+type symbol struct {
+	bitmap
+	// quietZone is the width of the quiet zone in dots.
+	quietZone int
+}
+
+// encode encodes the message in the symbology and renders it with the module width and bar height of the options.
+func encode(msg gs1.Message, symbology barcode.Symbology, o *options) (*symbol, error) {
+	var (
+		linear *barcode.Linear
+		matrix *barcode.Matrix
+		err    error
+	)
+	height := o.barHeight
+	switch symbology {
+	case barcode.GS1128:
+		linear, err = barcode.EncodeGS1128(msg)
+		if height == 0 {
+			height = 100
+		}
+	case barcode.DataMatrix:
+		matrix, err = barcode.EncodeDataMatrix(msg, barcode.WithDataMatrixShape(o.shape))
+	case barcode.GS1QR:
+		matrix, err = barcode.EncodeGS1QR(msg, barcode.WithQRLevel(o.level))
+	case barcode.DataBarOmni:
+		linear, err = barcode.EncodeDataBarOmni(msg)
+		if height == 0 {
+			height = barcode.DataBarOmniHeight * o.module
+		}
+	case barcode.DataBarStacked:
+		matrix, err = barcode.EncodeDataBarStacked(msg)
+	case barcode.DataBarStackedOmni:
+		matrix, err = barcode.EncodeDataBarStackedOmni(msg)
+	case barcode.DataBarLimited:
+		linear, err = barcode.EncodeDataBarLimited(msg)
+		if height == 0 {
+			height = barcode.DataBarLimitedHeight * o.module
+		}
+	case barcode.DataBarExpanded:
+		linear, err = barcode.EncodeDataBarExpanded(msg)
+		if height == 0 {
+			height = barcode.DataBarExpandedHeight * o.module
+		}
+	case barcode.DataBarExpandedStacked:
+		matrix, err = barcode.EncodeDataBarExpandedStacked(msg, o.segments)
+	default:
+		return nil, fmt.Errorf("unsupported symbology %s", symbology)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if linear != nil {
+		s := &symbol{
+			bitmap:    bitmap{width: linear.Width() * o.module, height: height},
+			quietZone: linear.QuietZone * o.module,
+		}
+		s.dots = make([]bool, s.width*s.height)
+		for y := range s.height {
+			for x := range s.width {
+				s.dots[y*s.width+x] = linear.Modules[x/o.module]
+			}
+		}
+		return s, nil
+	}
+	q := matrix.QuietZone
+	s := &symbol{
+		bitmap: bitmap{
+			width:  (matrix.Width() + 2*q) * o.module,
+			height: (matrix.Height() + 2*q) * o.module,
+		},
+		quietZone: q * o.module,
+	}
+	s.dots = make([]bool, s.width*s.height)
+	for y := range s.height {
+		for x := range s.width {
+			s.dots[y*s.width+x] = matrix.At(x/o.module-q, y/o.module-q)
+		}
+	}
+	return s, nil
+}
+
+// hriLines returns the HRI of the message packed into lines of at most maxChars characters. Elements are not split,
+// a line holds at least one element.
+func hriLines(msg gs1.Message, maxChars int) []string {
+	var lines []string
+	for _, el := range msg.HRI() {
+		if n := len(lines); n > 0 && len(lines[n-1])+2+len(el) <= maxChars {
+			lines[n-1] += "  " + el
+		} else {
+			lines = append(lines, el)
+		}
+	}
+	return lines
+}
+
+// checkPrintable returns an error if a data field of the message contains characters other than printable ASCII or
+// one of the characters in reserved, which have a special meaning in the printer language. Data fields of messages
+// validated against the GS1 character sets are printable.
+func checkPrintable(msg gs1.Message, reserved string) error {
+	for _, el := range msg.Elements {
+		for i := range len(el.DataField) {
+			if c := el.DataField[i]; c < 0x20 || c > 0x7e || strings.IndexByte(reserved, c) >= 0 {
+				return fmt.Errorf("invalid character %q at position %d of AI (%s): cannot be sent to the printer", c, i,
+					el.AI)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package printer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestHRILines(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		maxChars int
+		want     []string
+	}{
+		{name: "elements SHOULD share a line if they fit", data: "(01)04006381333931(10)ABC", maxChars: 40,
+			want: []string{"(01) 04006381333931  (10) ABC"}},
+		{name: "elements SHOULD be moved to the next line", data: "(01)04006381333931(10)ABC(21)1", maxChars: 28,
+			want: []string{"(01) 04006381333931", "(10) ABC  (21) 1"}},
+		{name: "elements exceeding the line SHOULD not be split", data: "(01)04006381333931", maxChars: 5,
+			want: []string{"(01) 04006381333931"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hriLines(mustParse(t, tt.data), tt.maxChars); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("hriLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewOptions_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		opt     Option
+		wantErr string
+	}{
+		{name: "negative position SHOULD be rejected", opt: WithPosition(-1, 0), wantErr: "invalid position"},
+		{name: "negative bar height SHOULD be rejected", opt: WithBarHeight(-1), wantErr: "invalid bar height"},
+		{name: "zero font height SHOULD be rejected", opt: WithFontHeight(0), wantErr: "invalid font height"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newOptions([]Option{tt.opt})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("newOptions() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
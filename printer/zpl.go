@@ -0,0 +1,143 @@
+package printer
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/adippel/gs1engine-go"
+	"github.com/adippel/gs1engine-go/barcode"
+)
+
+// zplDataMatrixEscape is the escape character of ^BX. It is not part of the GS1 character sets, so data never needs
+// to be escaped.
+const zplDataMatrixEscape = '`'
+
+// ZPL returns the ZPL II commands printing the message as symbol at the position of the options followed by its HRI
+// lines. The commands are not enclosed by ^XA and ^XZ.
+//
+// GS1-128 is printed by ^BC in mode N. The code sets chosen by [barcode.GS1128Codewords] are sent as invocation
+// codes, e.g. >; for start code C, >6 for code B and >8 for FNC1, so the printer neither chooses code sets nor
+// interprets FNC1 on its own. The check character is added by the printer. GS1 DataMatrix is printed by ^BX in the
+// size chosen by [barcode.EncodeDataMatrix] with ` as escape character, FNC1 is sent as `1. All other symbologies
+// are sent as ^GFA graphic field.
+//
+// The data must not contain ^, ~ and `, which is the case for data fields in the GS1 character sets.
+func ZPL(msg gs1.Message, symbology barcode.Symbology, opts ...Option) (string, error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return "", err
+	}
+	if err := checkPrintable(msg, "^~`"); err != nil {
+		return "", err
+	}
+	s, err := encode(msg, symbology, o)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	switch symbology {
+	case barcode.GS1128:
+		codewords, err := barcode.GS1128Codewords(msg)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&sb, "^FO%d,%d^BY%d^BCN,%d,N,N,N,N^FD%s^FS\n", o.x+s.quietZone, o.y, o.module, s.height,
+			zplCode128(codewords))
+	case barcode.DataMatrix:
+		rows, columns := (s.height-2*s.quietZone)/o.module, (s.width-2*s.quietZone)/o.module
+		aspect := 1
+		if rows != columns {
+			aspect = 2
+		}
+		data := strings.ReplaceAll(msg.AsBarcodeMessage(), "\x1d", string(zplDataMatrixEscape)+"1")
+		fmt.Fprintf(&sb, "^FO%d,%d^BXN,%d,200,%d,%d,,%c,%d^FD%c1%s^FS\n", o.x+s.quietZone, o.y+s.quietZone, o.module,
+			columns, rows, zplDataMatrixEscape, aspect, zplDataMatrixEscape, data)
+	default:
+		zplGraphic(&sb, o.x, o.y, &s.bitmap)
+	}
+
+	if !o.noHRI {
+		charWidth := o.fontHeight * 3 / 5
+		lines := hriLines(msg, (s.width-2*s.quietZone)/max(charWidth, 1))
+		for i, line := range lines {
+			y := o.y + s.height + o.fontHeight/4 + i*o.fontHeight*5/4
+			fmt.Fprintf(&sb, "^FO%d,%d^A0N,%d,%d^FD%s^FS\n", o.x+s.quietZone, y, o.fontHeight, o.fontHeight, line)
+		}
+	}
+	return sb.String(), nil
+}
+
+// zplCode128 returns the field data of ^BC in mode N sending the Code 128 symbol characters without check and stop
+// character. Start characters, code set switches, shifts and FNC1 are sent as invocation codes, code set C as pairs
+// of digits and code sets A and B as characters, where >, ^ and ~ are sent as invocation codes as well.
+func zplCode128(codewords []int) string {
+	const (
+		codeShift = 98
+		codeC     = 99
+		codeB     = 100
+		codeA     = 101
+		fnc1      = 102
+		startA    = 103
+	)
+	var sb strings.Builder
+	set, shifted := 'B', false
+	for _, cw := range codewords[:len(codewords)-2] {
+		current := set
+		if shifted {
+			current = 'A' + 'B' - set
+			shifted = false
+		}
+		switch {
+		case cw >= startA:
+			set = 'A' + rune(cw-startA)
+			sb.WriteString([]string{">9", ">:", ">;"}[cw-startA])
+		case cw == fnc1:
+			sb.WriteString(">8")
+		case current == 'C' && cw < codeB:
+			fmt.Fprintf(&sb, "%02d", cw)
+		case cw == codeC:
+			set = 'C'
+			sb.WriteString(">5")
+		case cw == codeB && current != 'B':
+			set = 'B'
+			sb.WriteString(">6")
+		case cw == codeA && current != 'A':
+			set = 'A'
+			sb.WriteString(">7")
+		case cw == codeShift:
+			shifted = true
+			sb.WriteString(">4")
+		default:
+			switch c := byte(cw + ' '); c {
+			case '>':
+				sb.WriteString(">0")
+			case '^':
+				sb.WriteString("><")
+			case '~':
+				sb.WriteString(">=")
+			default:
+				sb.WriteByte(c)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// zplGraphic writes the bitmap as ^GFA graphic field at x, y. Rows repeating the previous row are compressed to :.
+func zplGraphic(sb *strings.Builder, x, y int, b *bitmap) {
+	bytesPerRow := (b.width + 7) / 8
+	fmt.Fprintf(sb, "^FO%d,%d^GFA,%d,%d,%d,", x, y, bytesPerRow*b.height, bytesPerRow*b.height, bytesPerRow)
+	var previous []byte
+	for row := range b.height {
+		dots := b.row(row, true)
+		if bytes.Equal(dots, previous) {
+			sb.WriteByte(':')
+		} else {
+			fmt.Fprintf(sb, "%X", dots)
+		}
+		previous = dots
+	}
+	sb.WriteString("^FS\n")
+}
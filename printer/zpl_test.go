@@ -0,0 +1,209 @@
+package printer
+
+import (
+	"encoding/hex"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/adippel/gs1engine-go"
+	"github.com/adippel/gs1engine-go/barcode"
+)
+
+func mustParse(t *testing.T, data string) gs1.Message {
+	t.Helper()
+	msg, err := gs1.ParseElementString(data)
+	if err != nil {
+		t.Fatalf("ParseElementString(%s) error = %v", data, err)
+	}
+	return msg
+}
+
+func TestZPL(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		symbology barcode.Symbology
+		opts      []Option
+		want      string
+	}{
+		{
+			name:      "GS1-128 SHOULD send code sets and FNC1 as invocation codes",
+			data:      "(01)04006381333931(10)ABC123",
+			symbology: barcode.GS1128,
+			want: "^FO20,0^BY2^BCN,100,N,N,N,N^FD>;>8010400638133393110>6ABC123^FS\n" +
+				"^FO20,105^A0N,20,20^FD(01) 04006381333931  (10) ABC123^FS\n",
+		},
+		{
+			name:      "GS1-128 SHOULD separate AIs by FNC1 and escape >",
+			data:      "(21)1234(10)A>B",
+			symbology: barcode.GS1128,
+			opts:      []Option{WithPosition(50, 100), WithModuleWidth(3), WithBarHeight(160), WithoutHRI()},
+			want:      "^FO80,100^BY3^BCN,160,N,N,N,N^FD>;>8211234>810>6A>0B^FS\n",
+		},
+		{
+			name:      "GS1 barcode.DataMatrix SHOULD send FNC1 as escape sequence",
+			data:      "(21)1234(10)A>B",
+			symbology: barcode.DataMatrix,
+			opts:      []Option{WithFontHeight(30)},
+			want: "^FO2,2^BXN,2,200,16,16,,`,1^FD`1211234`110A>B^FS\n" +
+				"^FO2,43^A0N,30,30^FD(21) 1234^FS\n" +
+				"^FO2,80^A0N,30,30^FD(10) A>B^FS\n",
+		},
+		{
+			name:      "rectangular GS1 barcode.DataMatrix SHOULD have aspect ratio 2",
+			data:      "(01)04006381333931",
+			symbology: barcode.DataMatrix,
+			opts:      []Option{WithDataMatrixShape(barcode.DataMatrixRectangular), WithModuleWidth(4), WithoutHRI()},
+			want:      "^FO4,4^BXN,4,200,32,8,,`,2^FD`10104006381333931^FS\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ZPL(mustParse(t, tt.data), tt.symbology, tt.opts...)
+			if err != nil {
+				t.Fatalf("ZPL() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ZPL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZPL_Graphic(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		symbology barcode.Symbology
+		encode    func(gs1.Message) (*barcode.Matrix, error)
+	}{
+		{name: "GS1 QR Code SHOULD be sent as graphic", data: "(01)04006381333931(10)ABC123", symbology: barcode.GS1QR,
+			encode: func(msg gs1.Message) (*barcode.Matrix, error) { return barcode.EncodeGS1QR(msg) }},
+		{name: "GS1 DataBar Stacked SHOULD be sent as graphic", data: "(01)04006381333931",
+			symbology: barcode.DataBarStacked, encode: barcode.EncodeDataBarStacked},
+		{name: "GS1 DataBar Expanded Stacked SHOULD be sent as graphic", data: "(01)98898765432106(3202)012345(15)991231",
+			symbology: barcode.DataBarExpandedStacked, encode: func(msg gs1.Message) (*barcode.Matrix, error) {
+				return barcode.EncodeDataBarExpandedStacked(msg, 4)
+			}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := mustParse(t, tt.data)
+			got, err := ZPL(msg, tt.symbology, WithPosition(10, 20), WithModuleWidth(3), WithoutHRI())
+			if err != nil {
+				t.Fatalf("ZPL() error = %v", err)
+			}
+			m, err := tt.encode(msg)
+			if err != nil {
+				t.Fatalf("encode() error = %v", err)
+			}
+			q := m.QuietZone
+			want := bitmap{width: 3 * (m.Width() + 2*q), height: 3 * (m.Height() + 2*q)}
+			for y := range want.height {
+				for x := range want.width {
+					want.dots = append(want.dots, m.At(x/3-q, y/3-q))
+				}
+			}
+			checkGraphic(t, got, "^FO10,20", &want)
+		})
+	}
+}
+
+func TestZPL_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		msg       gs1.Message
+		symbology barcode.Symbology
+		opts      []Option
+		wantErr   string
+	}{
+		{name: "ZPL command prefix SHOULD be rejected", msg: gs1.Message{Elements: []gs1.ElementString{
+			gs1.NewElementString(gs1.AI10, "A^B")}}, symbology: barcode.GS1128, wantErr: "invalid character '^'"},
+		{name: "DataMatrix escape character SHOULD be rejected", msg: gs1.Message{Elements: []gs1.ElementString{
+			gs1.NewElementString(gs1.AI10, "A`B")}}, symbology: barcode.DataMatrix, wantErr: "invalid character '`'"},
+		{name: "invalid symbology SHOULD be rejected", msg: gs1.Message{Elements: []gs1.ElementString{
+			gs1.NewElementString(gs1.AI10, "A")}}, symbology: 0, wantErr: "unsupported symbology Symbology(0)"},
+		{name: "GS1 DotCode SHOULD be rejected", msg: gs1.Message{Elements: []gs1.ElementString{
+			gs1.NewElementString(gs1.AI01, "04006381333931")}}, symbology: barcode.DotCode,
+			wantErr: "unsupported symbology GS1 DotCode"},
+		{name: "invalid module width SHOULD be rejected", msg: gs1.Message{Elements: []gs1.ElementString{
+			gs1.NewElementString(gs1.AI10, "A")}}, symbology: barcode.GS1128, opts: []Option{WithModuleWidth(0)},
+			wantErr: "invalid module width"},
+		{name: "messages not fitting the symbology SHOULD be rejected", msg: gs1.Message{Elements: []gs1.ElementString{
+			gs1.NewElementString(gs1.AI10, "A")}}, symbology: barcode.DataBarOmni, wantErr: "AI (01)"},
+		{name: "GTIN not fitting GS1 DataBar Limited SHOULD be rejected", msg: gs1.Message{Elements: []gs1.ElementString{
+			gs1.NewElementString(gs1.AI01, "24012345678905")}}, symbology: barcode.DataBarLimited,
+			wantErr: "indicator digit 0 or 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ZPL(tt.msg, tt.symbology, tt.opts...)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ZPL() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestZPLCode128(t *testing.T) {
+	tests := []struct {
+		name      string
+		codewords []int
+		want      string
+	}{
+		{name: "shift SHOULD apply to a single character", codewords: []int{103, 33, 98, 65, 34, 0, 106},
+			want: ">9A>4aB"},
+		{name: "code set switches SHOULD be sent as invocation codes",
+			codewords: []int{104, 102, 33, 99, 12, 100, 34, 101, 35, 99, 1, 0, 106}, want: ">:>8A>512>6B>7C>501"},
+		{name: "characters reserved by ZPL SHOULD be sent as invocation codes", codewords: []int{104, 30, 62, 94, 0, 106},
+			want: ">:>0><>="},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := zplCode128(tt.codewords); got != tt.want {
+				t.Errorf("zplCode128() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// checkGraphic checks that the commands hold a ^GFA graphic field at the given ^FO position holding the bitmap.
+func checkGraphic(t *testing.T, commands, position string, want *bitmap) {
+	t.Helper()
+	match := regexp.MustCompile(`^\^FO\d+,\d+\^GFA,(\d+),(\d+),(\d+),([0-9A-F:]*)\^FS\n$`).FindStringSubmatch(commands)
+	if match == nil || !strings.HasPrefix(commands, position+"^GFA") {
+		t.Fatalf("ZPL() = %q, want graphic field at %s", commands, position)
+	}
+	bytesPerRow, _ := strconv.Atoi(match[3])
+	if total, _ := strconv.Atoi(match[1]); bytesPerRow != (want.width+7)/8 || total != bytesPerRow*want.height ||
+		match[1] != match[2] {
+		t.Fatalf("ZPL() graphic of %s bytes with %d bytes per row, want %d rows of %d bytes", match[1],
+			bytesPerRow, want.height, (want.width+7)/8)
+	}
+	var rows [][]byte
+	for data := match[4]; data != ""; {
+		if data[0] == ':' {
+			rows = append(rows, rows[len(rows)-1])
+			data = data[1:]
+			continue
+		}
+		row, err := hex.DecodeString(data[:2*bytesPerRow])
+		if err != nil {
+			t.Fatalf("ZPL() graphic data error = %v", err)
+		}
+		rows = append(rows, row)
+		data = data[2*bytesPerRow:]
+	}
+	if len(rows) != want.height {
+		t.Fatalf("ZPL() graphic has %d rows, want %d", len(rows), want.height)
+	}
+	for y, row := range rows {
+		for x := range want.width {
+			if dark := row[x/8]&(0x80>>(x%8)) != 0; dark != want.at(x, y) {
+				t.Fatalf("ZPL() graphic dot %d, %d dark = %t, want %t", x, y, dark, want.at(x, y))
+			}
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package gs1
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	minAILength = 2
+	maxAILength = 4
+)
+
+// Registry is a set of [ApplicationIdentifier] descriptions as published by one release of the GS1 Syntax
+// Dictionary. A Registry is immutable once created and thus safe for concurrent use. Registries of different
+// releases can be used side by side, e.g. to validate data against the release certified by a trading partner.
+// Use [NewRegistry] to create a Registry from the output of [ParseSyntaxDictionary] or [DefaultRegistry] for the
+// generated [AIRegistry].
+type Registry struct {
+	release string
+	ais     map[string]ApplicationIdentifier
+	// components and dlSpecs are derived from ais once, so they are not parsed again for every message.
+	components map[string][]SpecComponent
+	dlSpecs    map[string]DigitalLinkSpec
+}
+
+// defaultRegistry wraps a copy of the generated AIRegistry, so later modifications of the map do not affect it. The
+// specification components and Digital Link specs are precomputed by the generator as well.
+var defaultRegistry = sync.OnceValue(func() *Registry {
+	return &Registry{
+		release:    SyntaxDictionaryRelease,
+		ais:        maps.Clone(AIRegistry),
+		components: aiComponents,
+		dlSpecs:    aiDigitalLinkSpecs,
+	}
+})
+
+// DefaultRegistry returns the [Registry] of the generated [AIRegistry] for [SyntaxDictionaryRelease]. It is used
+// whenever no other registry is passed using [WithRegistry] or [WithAtomicRegistry].
+func DefaultRegistry() *Registry {
+	return defaultRegistry()
+}
+
+// NewRegistry creates a [Registry] from the given AI descriptions, e.g. as returned by [ParseSyntaxDictionary]. The
+// release names the Syntax Dictionary release, e.g. "2025-01-30", and may be empty. Duplicate AIs and GS1 Digital Link
+// key qualifiers referencing AIs missing from specs or invalid specifications are rejected.
+func NewRegistry(release string, specs []ApplicationIdentifierSpec) (*Registry, error) {
+	r := &Registry{
+		release: release,
+		ais:     make(map[string]ApplicationIdentifier, len(specs)),
+	}
+	for _, spec := range specs {
+		if !isValidAICode(spec.AI) {
+			return nil, fmt.Errorf("invalid AI %q: must consist of %d to %d digits", spec.AI, minAILength, maxAILength)
+		}
+		if _, exists := r.ais[spec.AI]; exists {
+			return nil, fmt.Errorf("duplicate AI (%s)", spec.AI)
+		}
+		r.ais[spec.AI] = ApplicationIdentifier{
+			AI:            spec.AI,
+			Flags:         spec.Flags,
+			Specification: slices.Clone(spec.Specification),
+			Attributes:    slices.Clone(spec.Attributes),
+			Title:         spec.Title,
+		}
+	}
+	if err := r.derive(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// derive computes the specification components and Digital Link specs of all AIs.
+func (r *Registry) derive() error {
+	r.components = make(map[string][]SpecComponent, len(r.ais))
+	r.dlSpecs = make(map[string]DigitalLinkSpec, len(r.ais))
+	for code, ai := range r.ais {
+		components, err := ai.Components()
+		if err != nil {
+			return err
+		}
+		dlSpec, err := r.DigitalLinkSpec(ai)
+		if err != nil {
+			return err
+		}
+		r.components[code] = components
+		r.dlSpecs[code] = dlSpec
+	}
+	return nil
+}
+
+// Release returns the Syntax Dictionary release the registry was created from. It is empty if unknown.
+func (r *Registry) Release() string {
+	return r.release
+}
+
+// Len returns the number of AIs within the registry.
+func (r *Registry) Len() int {
+	return len(r.ais)
+}
+
+// Lookup returns the [ApplicationIdentifier] of the given AI code, e.g. "01".
+func (r *Registry) Lookup(code string) (ApplicationIdentifier, bool) {
+	ai, ok := r.ais[code]
+	return ai, ok
+}
+
+// Components returns the specification components of the given AI code.
+func (r *Registry) Components(code string) ([]SpecComponent, bool) {
+	components, ok := r.components[code]
+	return components, ok
+}
+
+// All returns an iterator over all AIs of the registry, ordered by AI.
+func (r *Registry) All() iter.Seq[ApplicationIdentifier] {
+	return func(yield func(ApplicationIdentifier) bool) {
+		for _, code := range slices.Sorted(maps.Keys(r.ais)) {
+			if !yield(r.ais[code]) {
+				return
+			}
+		}
+	}
+}
+
+// DigitalLinkSpec extracts the [DigitalLinkSpec] of the AI, resolving its key qualifiers using the registry. An error
+// is returned if a key qualifier is missing from the registry.
+func (r *Registry) DigitalLinkSpec(ai ApplicationIdentifier) (d DigitalLinkSpec, _ error) {
+	if !strings.ContainsRune(ai.Flags, isValidDLAttrFlag) {
+		return d, nil
+	}
+	d.IsValidDataAttribute = true
+	allowedQualifiers, ok := ai.getAttribute(dlPrimaryKeyAttrName)
+	if !ok {
+		return d, nil
+	}
+	d.IsValidPrimaryKey = true
+	if allowedQualifiers == "" {
+		return d, nil
+	}
+
+	var errs []error
+	for _, sequence := range strings.Split(allowedQualifiers, string(pairingORSeparator)) {
+		var seqAIs []ApplicationIdentifier
+		for _, code := range strings.Split(sequence, ",") {
+			qualifier, ok := r.ais[code]
+			if !ok {
+				errs = append(errs, fmt.Errorf("AI (%s) declares unknown key qualifier (%s)", ai.AI, code))
+				continue
+			}
+			seqAIs = append(seqAIs, qualifier)
+		}
+		d.AllowedQualifiers = append(d.AllowedQualifiers, seqAIs)
+	}
+	return d, errors.Join(errs...)
+}
+
+// digitalLinkSpecOf returns the [DigitalLinkSpec] of the AI code. Unknown AIs are neither primary keys nor data
+// attributes.
+func (r *Registry) digitalLinkSpecOf(code string) DigitalLinkSpec {
+	return r.dlSpecs[code]
+}
+
+// detectAICode returns the AI the message starts with. AIs are prefix-free, so at most one AI matches.
+func (r *Registry) detectAICode(msg string) (ApplicationIdentifier, bool) {
+	for length := minAILength; length <= maxAILength && length <= len(msg); length++ {
+		if ai, ok := r.ais[msg[:length]]; ok {
+			return ai, true
+		}
+	}
+	return ApplicationIdentifier{}, false
+}
+
+// lookup is like [Registry.Lookup] but returns an error mentioning the release for unknown AIs.
+func (r *Registry) lookup(code string) (ApplicationIdentifier, error) {
+	ai, ok := r.ais[code]
+	if !ok {
+		if r.release != "" {
+			return ApplicationIdentifier{}, fmt.Errorf("unknown AI: %s (release %s)", code, r.release)
+		}
+		return ApplicationIdentifier{}, fmt.Errorf("unknown AI: %s", code)
+	}
+	return ai, nil
+}
+
+// rebind replaces the descriptions of the elements with the ones of the registry.
+func (r *Registry) rebind(elements []ElementString) ([]ElementString, error) {
+	for i, el := range elements {
+		ai, err := r.lookup(el.AI)
+		if err != nil {
+			return nil, err
+		}
+		elements[i].ApplicationIdentifier = ai
+	}
+	return elements, nil
+}
+
+// AtomicRegistry holds a [Registry] that can be replaced at any time, e.g. to hot reload a new Syntax Dictionary
+// release without restarting. Operations configured using [WithAtomicRegistry] load the registry once when they
+// start, so each operation sees a consistent registry. The zero value holds the [DefaultRegistry].
+type AtomicRegistry struct {
+	p atomic.Pointer[Registry]
+}
+
+// NewAtomicRegistry returns an [AtomicRegistry] holding r.
+func NewAtomicRegistry(r *Registry) *AtomicRegistry {
+	a := &AtomicRegistry{}
+	a.Store(r)
+	return a
+}
+
+// Load returns the current registry.
+func (a *AtomicRegistry) Load() *Registry {
+	if r := a.p.Load(); r != nil {
+		return r
+	}
+	return DefaultRegistry()
+}
+
+// Store replaces the current registry. Passing nil restores the [DefaultRegistry].
+func (a *AtomicRegistry) Store(r *Registry) {
+	a.p.Store(r)
+}
+
+// Option configures parsers and encoders of this package.
+type Option func(*options)
+
+type options struct {
+	registry func() *Registry
+}
+
+// WithRegistry uses r to look up AIs instead of the [DefaultRegistry].
+func WithRegistry(r *Registry) Option {
+	return func(o *options) {
+		o.registry = func() *Registry { return r }
+	}
+}
+
+// WithAtomicRegistry uses the registry currently held by a to look up AIs.
+func WithAtomicRegistry(a *AtomicRegistry) Option {
+	return func(o *options) {
+		o.registry = a.Load
+	}
+}
+
+// resolveRegistry applies opts and returns the registry to use for a single operation.
+func resolveRegistry(opts []Option) *Registry {
+	o := options{registry: DefaultRegistry}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if r := o.registry(); r != nil {
+		return r
+	}
+	return DefaultRegistry()
+}
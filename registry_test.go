@@ -0,0 +1,230 @@
+package gs1
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const testRegistryDictionary = `
+00         *?  N18,csum,keyoff1                 dlpkey                                           # SSCC
+01         *?  N14,csum,keyoff1                 ex=255,37 dlpkey=22,10,21|235                    # GTIN
+10          ?  X..20                            req=01,02,8006,8026                              # BATCH/LOT
+21          ?  X..20                            req=01,03,8006 ex=235                            # SERIAL
+22          ?  X..20                            req=01                                           # CPV
+235         ?  X..28                            req=01                                           # TPX
+414        *?  N13,csum,key                     dlpkey=254|7040                                  # LOC No.
+254         ?  X..20                            req=414                                          # GLN EXTENSION COMPONENT
+7040        ?  N1,X1,X1,X1,importeridx          req=414                                          # UIC+EXT
+`
+
+func mustTestRegistry(t *testing.T, release, dictionary string) *Registry {
+	t.Helper()
+	specs, err := ParseSyntaxDictionary(strings.NewReader(dictionary))
+	if err != nil {
+		t.Fatalf("ParseSyntaxDictionary() error = %v", err)
+	}
+	r, err := NewRegistry(release, specs)
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+	return r
+}
+
+func TestNewRegistry(t *testing.T) {
+	tests := []struct {
+		name       string
+		dictionary string
+		specs      []ApplicationIdentifierSpec
+		wantErr    string
+	}{
+		{
+			name:       "Valid dictionary SHOULD create a registry",
+			dictionary: testRegistryDictionary,
+		},
+		{
+			name:    "Duplicate AIs SHOULD return an error",
+			specs:   []ApplicationIdentifierSpec{{AI: "10", Specification: []string{"X..20"}}, {AI: "10", Specification: []string{"X..20"}}},
+			wantErr: "duplicate AI (10)",
+		},
+		{
+			name:       "Key qualifiers referencing missing AIs SHOULD return an error",
+			dictionary: "01  *?  N14,csum,keyoff1  dlpkey=22,10  # GTIN\n10  ?  X..20  # BATCH/LOT\n",
+			wantErr:    "AI (01) declares unknown key qualifier (22)",
+		},
+		{
+			name:    "Non-numeric AIs SHOULD return an error",
+			specs:   []ApplicationIdentifierSpec{{AI: "1A", Specification: []string{"X..20"}}},
+			wantErr: `invalid AI "1A"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			specs := tt.specs
+			if tt.dictionary != "" {
+				var err error
+				specs, err = ParseSyntaxDictionary(strings.NewReader(tt.dictionary))
+				if err != nil {
+					t.Fatalf("ParseSyntaxDictionary() error = %v", err)
+				}
+			}
+			_, err := NewRegistry("test", specs)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("NewRegistry() error = %v, want none", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("NewRegistry() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewRegistry_SyntaxDictionaryRelease(t *testing.T) {
+	data, err := os.ReadFile("testdata/gs1-syntax-dictionary-2025-01-30.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	specs, err := ParseSyntaxDictionary(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewRegistry("2025-01-30", specs)
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+	if r.Release() != "2025-01-30" {
+		t.Errorf("Release() = %s, want 2025-01-30", r.Release())
+	}
+	if r.Len() != DefaultRegistry().Len() {
+		t.Errorf("Len() = %d, want %d as the default registry", r.Len(), DefaultRegistry().Len())
+	}
+	for ai := range DefaultRegistry().All() {
+		if _, ok := r.Lookup(ai.AI); !ok {
+			t.Errorf("Lookup(%s) failed, want AI as in the default registry", ai.AI)
+		}
+	}
+}
+
+func TestRegistry_All(t *testing.T) {
+	r := mustTestRegistry(t, "test", testRegistryDictionary)
+	var got []string
+	for ai := range r.All() {
+		got = append(got, ai.AI)
+	}
+	want := []string{"00", "01", "10", "21", "22", "235", "254", "414", "7040"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+}
+
+func TestRegistry_DigitalLinkSpec(t *testing.T) {
+	r := mustTestRegistry(t, "test", testRegistryDictionary)
+	ai01, _ := r.Lookup("01")
+	got, err := r.DigitalLinkSpec(ai01)
+	if err != nil {
+		t.Fatalf("DigitalLinkSpec() error = %v", err)
+	}
+	if !got.IsValidPrimaryKey || len(got.AllowedQualifiers) != 2 || len(got.AllowedQualifiers[0]) != 3 {
+		t.Errorf("DigitalLinkSpec() = %v, want primary key with qualifiers 22,10,21|235", got)
+	}
+
+	unknown := ApplicationIdentifier{AI: "8006", Flags: "*?", Attributes: []string{"dlpkey=22,10,21"}}
+	if _, err := r.DigitalLinkSpec(unknown); err != nil {
+		t.Errorf("DigitalLinkSpec() error = %v, want none as all qualifiers are known", err)
+	}
+	unknown.Attributes = []string{"dlpkey=22,10,21,99"}
+	if _, err := r.DigitalLinkSpec(unknown); err == nil {
+		t.Error("DigitalLinkSpec() expected error for unknown key qualifier")
+	}
+}
+
+func TestWithRegistry(t *testing.T) {
+	r := mustTestRegistry(t, "partner", testRegistryDictionary)
+	ai01, _ := r.Lookup("01")
+	ai414, _ := r.Lookup("414")
+	ai7040, _ := r.Lookup("7040")
+
+	tests := []struct {
+		name    string
+		parse   func(opts ...Option) (Message, error)
+		want    []ElementString
+		wantErr bool
+	}{
+		{
+			name:  "Barcode message SHOULD be parsed using the registry",
+			parse: func(opts ...Option) (Message, error) { return ParseBarcodeMessage("^0109526064055028", opts...) },
+			want:  []ElementString{NewElementString(ai01, "09526064055028")},
+		},
+		{
+			name:  "Three and four digit AIs SHOULD be detected in barcode messages",
+			parse: func(opts ...Option) (Message, error) { return ParseMessage("]d241495260640000017040 1AB", opts...) },
+			want:  []ElementString{NewElementString(ai414, "9526064000001"), NewElementString(ai7040, " 1AB")},
+		},
+		{
+			name: "AIs missing from the registry SHOULD return an error",
+			parse: func(opts ...Option) (Message, error) {
+				return ParseElementString("(01)09526064055028(17)250521", opts...)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Digital Link URI SHOULD be parsed using the registry",
+			parse: func(opts ...Option) (Message, error) {
+				return ParseDigitalLink("https://id.gs1.org/01/09526064055028", opts...)
+			},
+			want: []ElementString{NewElementString(ai01, "09526064055028")},
+		},
+		{
+			name:    "EPC URI with AIs missing from the registry SHOULD return an error",
+			parse:   func(opts ...Option) (Message, error) { return ParseEPCURI("urn:epc:id:grai:952606.40123.ABC", opts...) },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse(WithRegistry(r))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got.Elements, tt.want) {
+				t.Errorf("got %v, want %v", got.Elements, tt.want)
+			}
+		})
+	}
+}
+
+func TestAtomicRegistry(t *testing.T) {
+	partner := mustTestRegistry(t, "partner", testRegistryDictionary)
+	a := &AtomicRegistry{}
+	if a.Load() != DefaultRegistry() {
+		t.Error("Load() of the zero value SHOULD return the default registry")
+	}
+
+	const msg = "(01)09526064055028(17)250521"
+	if _, err := ParseMessage(msg, WithAtomicRegistry(a)); err != nil {
+		t.Errorf("ParseMessage() error = %v using the default registry", err)
+	}
+
+	a.Store(partner)
+	if _, err := ParseMessage(msg, WithAtomicRegistry(a)); err == nil {
+		t.Error("ParseMessage() SHOULD fail after swapping to a registry without AI (17)")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				a.Store(partner)
+			} else {
+				a.Store(nil)
+			}
+			_, _ = ParseMessage("(01)09526064055028", WithAtomicRegistry(a))
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,23 @@
+package resolver
+
+import "net/http"
+
+// descriptionPath is the path of the resolver description file.
+const descriptionPath = "/.well-known/gs1resolver"
+
+// description is the resolver description file as defined by the GS1-Conformant Resolver standard.
+type description struct {
+	Name                        string   `json:"name"`
+	ResolverRoot                string   `json:"resolverRoot"`
+	SupportedPrimaryKeys        []string `json:"supportedPrimaryKeys"`
+	LinkTypeDefaultCanBeLinkset bool     `json:"linkTypeDefaultCanBeLinkset"`
+}
+
+func (s *Resolver) description(r *http.Request) description {
+	return description{
+		Name:                        s.name,
+		ResolverRoot:                baseURL(r),
+		SupportedPrimaryKeys:        []string{"all"},
+		LinkTypeDefaultCanBeLinkset: false,
+	}
+}
@@ -0,0 +1,62 @@
+package resolver
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// languageRange is a language range of the Accept-Language header with its quality.
+type languageRange struct {
+	tag     string
+	quality float64
+}
+
+// parseAcceptLanguage returns the acceptable language ranges ordered by descending quality.
+func parseAcceptLanguage(header string) []languageRange {
+	var ranges []languageRange
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		if quality > 0 {
+			ranges = append(ranges, languageRange{tag: strings.ToLower(tag), quality: quality})
+		}
+	}
+	slices.SortStableFunc(ranges, func(a, b languageRange) int {
+		return cmp.Compare(b.quality, a.quality)
+	})
+	return ranges
+}
+
+// negotiateLanguage returns the first link matching the most preferred language range. A range matches a link
+// language equal to it or starting with it, e.g. `de` matches `de-CH`, and the primary language of a range is
+// tried after the range itself, e.g. `de` for `de-AT`. Without a match, the first link is returned.
+func negotiateLanguage(links []Link, acceptLanguage string) Link {
+	for _, r := range parseAcceptLanguage(acceptLanguage) {
+		if r.tag == "*" {
+			return links[0]
+		}
+		primary, _, _ := strings.Cut(r.tag, "-")
+		for _, tag := range []string{r.tag, primary} {
+			for _, link := range links {
+				for _, language := range link.Languages {
+					language = strings.ToLower(language)
+					if language == tag || strings.HasPrefix(language, tag+"-") {
+						return link
+					}
+				}
+			}
+		}
+	}
+	return links[0]
+}
@@ -0,0 +1,71 @@
+package resolver
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const (
+	// gs1VocabularyPrefix is the compact prefix of link types of the GS1 Web Vocabulary, e.g. `gs1:pip`.
+	gs1VocabularyPrefix = "gs1:"
+	// gs1Vocabulary is the namespace of the GS1 Web Vocabulary.
+	gs1Vocabulary = "https://gs1.org/voc/"
+	// DefaultLinkType marks the link to redirect to if no link type is requested.
+	DefaultLinkType = gs1VocabularyPrefix + "defaultLink"
+	// linkTypeAll requests the linkset instead of a redirect.
+	linkTypeAll = "all"
+	// linksetMediaType is the media type of linksets as defined in RFC 9264.
+	linksetMediaType = "application/linkset+json"
+)
+
+// expandLinkType returns the absolute URI of the link type, e.g. https://gs1.org/voc/pip for `gs1:pip`.
+func expandLinkType(linkType string) string {
+	if name, ok := strings.CutPrefix(linkType, gs1VocabularyPrefix); ok {
+		return gs1Vocabulary + name
+	}
+	return linkType
+}
+
+// Linkset is a linkset document as defined in RFC 9264.
+type Linkset struct {
+	Linkset []LinkContext `json:"linkset"`
+}
+
+// LinkContext holds the target links of an anchor keyed by the absolute link relation type.
+type LinkContext struct {
+	Anchor string
+	Links  map[string][]LinkTarget
+}
+
+// LinkTarget is a single target within a [LinkContext].
+type LinkTarget struct {
+	Href      string   `json:"href"`
+	Title     string   `json:"title,omitempty"`
+	Languages []string `json:"hreflang,omitempty"`
+	MediaType string   `json:"type,omitempty"`
+}
+
+// MarshalJSON inlines the link relation types next to the anchor as required by RFC 9264.
+func (c LinkContext) MarshalJSON() ([]byte, error) {
+	members := make(map[string]any, len(c.Links)+1)
+	for rel, targets := range c.Links {
+		members[rel] = targets
+	}
+	members["anchor"] = c.Anchor
+	return json.Marshal(members)
+}
+
+// newLinkset creates the linkset of the entry anchored at the given URI.
+func newLinkset(anchor string, entry Entry) Linkset {
+	context := LinkContext{Anchor: anchor, Links: map[string][]LinkTarget{}}
+	for _, link := range entry.Links {
+		rel := expandLinkType(link.LinkType)
+		context.Links[rel] = append(context.Links[rel], LinkTarget{
+			Href:      link.Href,
+			Title:     link.Title,
+			Languages: link.Languages,
+			MediaType: link.MediaType,
+		})
+	}
+	return Linkset{Linkset: []LinkContext{context}}
+}
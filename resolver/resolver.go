@@ -0,0 +1,208 @@
+// Package resolver implements a GS1-Conformant Resolver on [net/http] as defined in
+// https://ref.gs1.org/standards/resolver/. Requests carry a GS1 Digital Link URI path, e.g.
+// `/01/09526064055028/10/ABC123`, which is redirected to the link of the requested link type:
+//
+//   - Without `linkType` parameter, the link of type [DefaultLinkType] is used.
+//   - With `linkType=all` or an `Accept: application/linkset+json` header, all links are returned as RFC 9264 linkset.
+//   - If no entry exists for the key qualifiers, they are removed one by one from the end, e.g. serial, then lot, then
+//     the GTIN alone.
+//   - Among links of the same type, the language is negotiated using the Accept-Language header.
+//
+// Links are looked up in a [Store], e.g. [MemoryStore] or [FileStore].
+package resolver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/adippel/gs1engine-go"
+)
+
+// linkTypeParam is the query parameter requesting a link type.
+const linkTypeParam = "linkType"
+
+// Resolver serves GS1 Digital Link URIs. Use [New] to create a resolver.
+type Resolver struct {
+	store     Store
+	name      string
+	parseOpts []gs1.Option
+}
+
+// Option configures a [Resolver].
+type Option func(*Resolver)
+
+// WithName sets the name of the resolver published in its description file.
+func WithName(name string) Option {
+	return func(r *Resolver) {
+		r.name = name
+	}
+}
+
+// WithParseOptions passes options to the Digital Link parser, e.g. [gs1.WithRegistry].
+func WithParseOptions(opts ...gs1.Option) Option {
+	return func(r *Resolver) {
+		r.parseOpts = append(r.parseOpts, opts...)
+	}
+}
+
+// New creates a resolver looking up links in the store.
+func New(store Store, opts ...Option) *Resolver {
+	r := &Resolver{store: store, name: "GS1 resolver"}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// NormaliseKey parses the Digital Link URI path, e.g. `/01/9526064055028/10/ABC123`, and returns the path of its
+// primary key and key qualifiers in canonical form, e.g. `/01/09526064055028/10/ABC123`.
+func NormaliseKey(path string) (string, error) {
+	msg, err := gs1.ParseDigitalLink(gs1.CanonicalPrefix + "/" + strings.TrimPrefix(path, "/"))
+	if err != nil {
+		return "", err
+	}
+	return keyOf(msg, nil)
+}
+
+// keyOf returns the path of the primary key and the key qualifiers of the message.
+func keyOf(msg gs1.Message, opts []gs1.Option) (string, error) {
+	uri, err := msg.AsDigitalLink(gs1.CanonicalPrefix, opts...)
+	if err != nil {
+		return "", err
+	}
+	path, _, _ := strings.Cut(strings.TrimPrefix(uri, gs1.CanonicalPrefix), "?")
+	return path, nil
+}
+
+// fallbackKeys returns the key followed by the keys with key qualifiers removed one by one from the end.
+func fallbackKeys(key string) []string {
+	segments := strings.Split(strings.TrimPrefix(key, "/"), "/")
+	var keys []string
+	for n := len(segments); n >= 2; n -= 2 {
+		keys = append(keys, "/"+strings.Join(segments[:n], "/"))
+	}
+	return keys
+}
+
+func (s *Resolver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("method %s not allowed", r.Method))
+		return
+	}
+	if r.URL.Path == descriptionPath {
+		writeJSON(w, http.StatusOK, "application/json", s.description(r))
+		return
+	}
+
+	msg, err := gs1.ParseDigitalLink(baseURL(r)+r.URL.EscapedPath(), s.parseOpts...)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	key, err := keyOf(msg, s.parseOpts)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var entry Entry
+	found := false
+	for _, candidate := range fallbackKeys(key) {
+		if entry, found, err = s.store.Lookup(r.Context(), candidate); err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		} else if found {
+			break
+		}
+	}
+	if !found {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("no links for %s", key))
+		return
+	}
+
+	linksetURL := baseURL(r) + key + "?" + linkTypeParam + "=" + linkTypeAll
+	w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="linkset"; type="%s"`, linksetURL, linksetMediaType))
+
+	query := r.URL.Query()
+	linkType := query.Get(linkTypeParam)
+	if linkType == linkTypeAll || (linkType == "" && acceptsLinkset(r)) {
+		writeJSON(w, http.StatusOK, linksetMediaType, newLinkset(baseURL(r)+entry.Key, entry))
+		return
+	}
+
+	link, ok := selectLink(entry.Links, linkType, r.Header.Get("Accept-Language"))
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("no link of type %s for %s", linkType, key))
+		return
+	}
+	query.Del(linkTypeParam)
+	w.Header().Set("Vary", "Accept, Accept-Language")
+	http.Redirect(w, r, withQuery(link.Href, query), http.StatusTemporaryRedirect)
+}
+
+// selectLink selects the link of the requested type, falling back to the default link, and negotiates the language.
+func selectLink(links []Link, linkType, acceptLanguage string) (Link, bool) {
+	var candidates []Link
+	if linkType != "" {
+		for _, link := range links {
+			if expandLinkType(link.LinkType) == expandLinkType(linkType) {
+				candidates = append(candidates, link)
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		for _, link := range links {
+			if expandLinkType(link.LinkType) == expandLinkType(DefaultLinkType) {
+				candidates = append(candidates, link)
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return Link{}, false
+	}
+	return negotiateLanguage(candidates, acceptLanguage), true
+}
+
+// withQuery passes the query parameters of the request, e.g. data attributes, on to the target.
+func withQuery(href string, query url.Values) string {
+	if len(query) == 0 {
+		return href
+	}
+	separator := "?"
+	if strings.Contains(href, "?") {
+		separator = "&"
+	}
+	return href + separator + query.Encode()
+}
+
+func acceptsLinkset(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), linksetMediaType)
+}
+
+// baseURL returns the scheme and host of the request.
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+// errorResponse is the body of error responses.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, "application/json", errorResponse{Error: message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, contentType string, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
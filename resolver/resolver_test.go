@@ -0,0 +1,226 @@
+package resolver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func newTestStore(t *testing.T) *MemoryStore {
+	t.Helper()
+	store, err := NewMemoryStore(
+		Entry{Key: "/01/9526064055028", Links: []Link{
+			{Href: "https://example.com/product", LinkType: DefaultLinkType, Languages: []string{"en"}},
+			{Href: "https://example.com/produkt", LinkType: DefaultLinkType, Languages: []string{"de-CH"}},
+			{Href: "https://example.com/pip", LinkType: "gs1:pip", Title: "Product information", MediaType: "text/html"},
+		}},
+		Entry{Key: "/01/09526064055028/10/ABC", Links: []Link{
+			{Href: "https://example.com/recall", LinkType: "gs1:recallStatus"},
+			{Href: "https://example.com/lot", LinkType: DefaultLinkType},
+		}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+func TestResolver_Redirect(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		acceptLanguage string
+		wantStatus     int
+		wantLocation   string
+	}{
+		{
+			name:         "Default link SHOULD be used without link type",
+			target:       "/01/09526064055028",
+			wantStatus:   http.StatusTemporaryRedirect,
+			wantLocation: "https://example.com/product",
+		},
+		{
+			name:         "Requested link type SHOULD be used",
+			target:       "/01/09526064055028?linkType=gs1:pip",
+			wantStatus:   http.StatusTemporaryRedirect,
+			wantLocation: "https://example.com/pip",
+		},
+		{
+			name:         "Absolute link type SHOULD match the compact form",
+			target:       "/01/09526064055028?linkType=https://gs1.org/voc/pip",
+			wantStatus:   http.StatusTemporaryRedirect,
+			wantLocation: "https://example.com/pip",
+		},
+		{
+			name:         "Unknown link type SHOULD fall back to the default link",
+			target:       "/01/09526064055028?linkType=gs1:certificationInfo",
+			wantStatus:   http.StatusTemporaryRedirect,
+			wantLocation: "https://example.com/product",
+		},
+		{
+			name:           "Language SHOULD be negotiated",
+			target:         "/01/09526064055028",
+			acceptLanguage: "fr;q=0.9, de;q=0.8, en;q=0.5",
+			wantStatus:     http.StatusTemporaryRedirect,
+			wantLocation:   "https://example.com/produkt",
+		},
+		{
+			name:         "Lot SHOULD be resolved by its own entry",
+			target:       "/01/09526064055028/10/ABC?linkType=gs1:recallStatus",
+			wantStatus:   http.StatusTemporaryRedirect,
+			wantLocation: "https://example.com/recall",
+		},
+		{
+			name:         "Serial SHOULD fall back to the lot",
+			target:       "/01/09526064055028/10/ABC/21/123",
+			wantStatus:   http.StatusTemporaryRedirect,
+			wantLocation: "https://example.com/lot",
+		},
+		{
+			name:         "Unknown lot SHOULD fall back to the GTIN",
+			target:       "/01/09526064055028/10/XYZ/21/123",
+			wantStatus:   http.StatusTemporaryRedirect,
+			wantLocation: "https://example.com/product",
+		},
+		{
+			name:         "Data attributes SHOULD be passed on",
+			target:       "/01/09526064055028?17=250521",
+			wantStatus:   http.StatusTemporaryRedirect,
+			wantLocation: "https://example.com/product?17=250521",
+		},
+		{
+			name:       "Unknown GTIN SHOULD not be found",
+			target:     "/01/09506000134352",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Invalid Digital Link SHOULD be rejected",
+			target:     "/foo/bar",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	resolver := New(newTestStore(t))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			rec := httptest.NewRecorder()
+			resolver.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if got := rec.Header().Get("Location"); got != tt.wantLocation {
+				t.Errorf("Location = %s, want %s", got, tt.wantLocation)
+			}
+		})
+	}
+}
+
+func TestResolver_Linkset(t *testing.T) {
+	resolver := New(newTestStore(t))
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodGet, "https://resolver.example.com/01/09526064055028?linkType=all", nil),
+		func() *http.Request {
+			req := httptest.NewRequest(http.MethodGet, "https://resolver.example.com/01/09526064055028", nil)
+			req.Header.Set("Accept", "application/linkset+json")
+			return req
+		}(),
+	} {
+		rec := httptest.NewRecorder()
+		resolver.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != linksetMediaType {
+			t.Fatalf("response = %d %s, want 200 %s", rec.Code, rec.Header().Get("Content-Type"), linksetMediaType)
+		}
+
+		var got map[string][]map[string]any
+		if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+			t.Fatal(err)
+		}
+		context := got["linkset"][0]
+		if context["anchor"] != "https://resolver.example.com/01/09526064055028" {
+			t.Errorf("anchor = %v", context["anchor"])
+		}
+		wantPIP := []any{map[string]any{"href": "https://example.com/pip", "title": "Product information", "type": "text/html"}}
+		if !reflect.DeepEqual(context["https://gs1.org/voc/pip"], wantPIP) {
+			t.Errorf("pip = %v, want %v", context["https://gs1.org/voc/pip"], wantPIP)
+		}
+		if defaults, _ := context["https://gs1.org/voc/defaultLink"].([]any); len(defaults) != 2 {
+			t.Errorf("defaultLink = %v, want 2 links", context["https://gs1.org/voc/defaultLink"])
+		}
+	}
+}
+
+func TestResolver_Description(t *testing.T) {
+	rec := httptest.NewRecorder()
+	New(newTestStore(t), WithName("Example")).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/.well-known/gs1resolver", nil))
+	var got description
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "Example" || got.ResolverRoot != "http://example.com" {
+		t.Errorf("description = %+v", got)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "links.json")
+	write := func(data string) {
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(`[{"key":"/01/9526064055028","links":[{"href":"https://example.com/a","linkType":"gs1:defaultLink"}]}]`)
+	store, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry, ok, _ := store.Lookup(t.Context(), "/01/09526064055028"); !ok || entry.Links[0].Href != "https://example.com/a" {
+		t.Errorf("Lookup() = %+v, %v", entry, ok)
+	}
+
+	write(`[{"key":"/01/9526064055028","links":[{"href":"https://example.com/b","linkType":"gs1:defaultLink"}]}]`)
+	if err := store.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if entry, _, _ := store.Lookup(t.Context(), "/01/09526064055028"); entry.Links[0].Href != "https://example.com/b" {
+		t.Errorf("Lookup() after reload = %+v", entry)
+	}
+
+	write(`[{"key":"/99/1","links":[]}]`)
+	if err := store.Reload(); err == nil {
+		t.Error("Reload() SHOULD reject invalid keys")
+	}
+	if _, ok, _ := store.Lookup(t.Context(), "/01/09526064055028"); !ok {
+		t.Error("Reload() SHOULD keep the previous entries on error")
+	}
+}
+
+func TestNegotiateLanguage(t *testing.T) {
+	links := []Link{
+		{Href: "en", Languages: []string{"en"}},
+		{Href: "de", Languages: []string{"de"}},
+		{Href: "fr-CA", Languages: []string{"fr-CA"}},
+	}
+	tests := map[string]string{
+		"":                   "en",
+		"de":                 "de",
+		"de-AT":              "de",
+		"fr":                 "fr-CA",
+		"es, de;q=0.1":       "de",
+		"de;q=0.5, fr;q=0.9": "fr-CA",
+		"de;q=0, it":         "en",
+		"*":                  "en",
+	}
+	for header, want := range tests {
+		if got := negotiateLanguage(links, header); got.Href != want {
+			t.Errorf("negotiateLanguage(%q) = %s, want %s", header, got.Href, want)
+		}
+	}
+}
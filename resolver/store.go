@@ -0,0 +1,128 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Link is a target a GS1 Digital Link resolves to.
+type Link struct {
+	// Href is the target URL.
+	Href string `json:"href"`
+	// LinkType is the link relation type, either a term of the GS1 Web Vocabulary such as `gs1:pip` or an absolute
+	// URI. Use [DefaultLinkType] to mark the default link.
+	LinkType string `json:"linkType"`
+	Title    string `json:"title,omitempty"`
+	// Languages are the languages of the target, e.g. `en` or `de-CH`.
+	Languages []string `json:"hreflang,omitempty"`
+	// MediaType is the media type of the target, e.g. `text/html`.
+	MediaType string `json:"type,omitempty"`
+}
+
+// Entry holds the links of a single identifier.
+type Entry struct {
+	// Key is the path of the Digital Link URI consisting of the primary key and its key qualifiers, e.g.
+	// `/01/09526064055028/10/ABC123`. Keys are normalised when stored, e.g. GTIN-13 to GTIN-14.
+	Key   string `json:"key"`
+	Links []Link `json:"links"`
+}
+
+// Store looks up the entry of a normalised key. Implementations must be safe for concurrent use.
+type Store interface {
+	// Lookup returns the entry of the key. It returns false if no entry exists.
+	Lookup(ctx context.Context, key string) (Entry, bool, error)
+}
+
+// MemoryStore keeps entries in memory. Use [NewMemoryStore] to create a store.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	entries map[string]Entry
+}
+
+// NewMemoryStore creates a store holding the given entries. Entries replace earlier entries of the same key.
+func NewMemoryStore(entries ...Entry) (*MemoryStore, error) {
+	s := &MemoryStore{entries: make(map[string]Entry, len(entries))}
+	for _, entry := range entries {
+		if err := s.Put(entry); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Put adds the entry after normalising its key, replacing an existing entry of the same key.
+func (s *MemoryStore) Put(entry Entry) error {
+	key, err := NormaliseKey(entry.Key)
+	if err != nil {
+		return err
+	}
+	entry.Key = key
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = entry
+	return nil
+}
+
+// Delete removes the entry of the key.
+func (s *MemoryStore) Delete(key string) error {
+	key, err := NormaliseKey(key)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+	return nil
+}
+
+func (s *MemoryStore) Lookup(_ context.Context, key string) (Entry, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	entry, ok := s.entries[key]
+	return entry, ok, nil
+}
+
+// replace swaps all entries at once.
+func (s *MemoryStore) replace(other *MemoryStore) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = other.entries
+}
+
+// FileStore serves the entries of a JSON file containing an array of [Entry]. Use [NewFileStore] to create a store.
+type FileStore struct {
+	path string
+	MemoryStore
+}
+
+// NewFileStore reads the entries from the JSON file.
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{path: path, MemoryStore: MemoryStore{entries: map[string]Entry{}}}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Reload reads the file again and replaces all entries. On error, the previous entries are kept.
+func (s *FileStore) Reload() error {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return err
+	}
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("error parsing %s: %w", s.path, err)
+	}
+	loaded, err := NewMemoryStore(entries...)
+	if err != nil {
+		return fmt.Errorf("error loading %s: %w", s.path, err)
+	}
+	s.replace(loaded)
+	return nil
+}
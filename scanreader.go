@@ -0,0 +1,244 @@
+package gs1
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strings"
+)
+
+const (
+	stx = '\x02' // stx starts a record framed by STX/ETX
+	etx = '\x03' // etx terminates a record framed by STX/ETX
+)
+
+// ScanError is returned by [ScanReader] if a single record cannot be parsed. Reading continues with the next record.
+type ScanError struct {
+	// Record is the 1-based index of the record within the stream.
+	Record int
+	// Data is the record as received, before FNC1 substitutes are normalised.
+	Data string
+	Err  error
+}
+
+func (e *ScanError) Error() string {
+	return fmt.Sprintf("record %d: %v", e.Record, e.Err)
+}
+
+func (e *ScanError) Unwrap() error {
+	return e.Err
+}
+
+// ScanOption configures a [ScanReader].
+type ScanOption func(*ScanReader)
+
+// WithFNC1Substitutes adds substitutes a scanner uses in place of the FNC1 character, e.g. a configurable key such as
+// "<GS>" or "~". They extend the visual substitutes '^' and "{GS}" recognised by [ParseBarcodeMessage].
+func WithFNC1Substitutes(substitutes ...string) ScanOption {
+	return func(r *ScanReader) {
+		r.fnc1Substitutes = append(r.fnc1Substitutes, substitutes...)
+	}
+}
+
+// WithParseOptions passes opts to the parsers used for each record, e.g. [WithAtomicRegistry] to pick up a reloaded
+// registry while reading.
+func WithParseOptions(opts ...Option) ScanOption {
+	return func(r *ScanReader) {
+		r.parseOpts = append(r.parseOpts, opts...)
+	}
+}
+
+// ScanReader reads GS1 messages from a byte stream as delivered by keyboard-wedge or serial scanners. Records are
+// terminated by CR, LF or CRLF, or framed by STX and ETX. Empty records are skipped.
+//
+// A missing leading FNC1 is tolerated. In records without FNC1 separators, the data of the last AI without
+// predefined length extends to the end of the record and may have swallowed AIs whose separator the scanner dropped.
+// If its data splits into valid elements of predefined length, e.g. `10ABC17251231`, the record is re-split into
+// batch ABC and expiry date 251231. If the data splits into further AIs without predefined length, e.g. `10ABC21XYZ`,
+// the separator cannot be recovered and the record results in a [*ScanError] naming the swallowing AI. Scanners
+// should be configured to transmit FNC1, or a substitute set by [WithFNC1Substitutes].
+type ScanReader struct {
+	r               *bufio.Reader
+	fnc1Substitutes []string
+	parseOpts       []Option
+	record          int
+}
+
+// NewScanReader returns a [ScanReader] reading from r.
+func NewScanReader(r io.Reader, opts ...ScanOption) *ScanReader {
+	sr := &ScanReader{
+		r: bufio.NewReader(r),
+	}
+	for _, opt := range opts {
+		opt(sr)
+	}
+	return sr
+}
+
+// Read returns the next message. A record that cannot be parsed results in a [*ScanError]. At the end of the stream,
+// Read returns [io.EOF].
+func (sr *ScanReader) Read() (Message, error) {
+	data, err := sr.readRecord()
+	if err != nil {
+		return Message{}, err
+	}
+	sr.record++
+
+	msg, err := sr.parseRecord(data)
+	if err != nil {
+		return Message{}, &ScanError{Record: sr.record, Data: data, Err: err}
+	}
+	return msg, nil
+}
+
+// All returns an iterator over all messages of the stream. Records that cannot be parsed yield a [*ScanError]
+// without stopping the iteration, any other error is yielded once and ends the iteration.
+func (sr *ScanReader) All() iter.Seq2[Message, error] {
+	return func(yield func(Message, error) bool) {
+		for {
+			msg, err := sr.Read()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			var scanErr *ScanError
+			if err != nil && !errors.As(err, &scanErr) {
+				yield(Message{}, err)
+				return
+			}
+			if !yield(msg, err) {
+				return
+			}
+		}
+	}
+}
+
+// readRecord returns the next non-empty record without its framing.
+func (sr *ScanReader) readRecord() (string, error) {
+	builder := strings.Builder{}
+	framed := false
+	for {
+		c, err := sr.r.ReadByte()
+		if errors.Is(err, io.EOF) {
+			if builder.Len() > 0 {
+				return builder.String(), nil
+			}
+			return "", io.EOF
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch {
+		case c == stx:
+			// A new frame discards any unterminated data preceding it.
+			builder.Reset()
+			framed = true
+		case framed && c == etx:
+			framed = false
+			if builder.Len() > 0 {
+				return builder.String(), nil
+			}
+		case !framed && (c == '\r' || c == '\n'):
+			if c == '\r' {
+				if next, err := sr.r.Peek(1); err == nil && next[0] == '\n' {
+					_, _ = sr.r.ReadByte()
+				}
+			}
+			if builder.Len() > 0 {
+				return builder.String(), nil
+			}
+		default:
+			builder.WriteByte(c)
+		}
+	}
+}
+
+// parseRecord normalises the FNC1 substitutes and parses the record. Records starting with an AI are treated as
+// barcode message, as keyboard-wedge scanners commonly drop the leading FNC1 and the symbology identifier. GS1
+// Digital Link URIs are supported with and without symbology identifier, e.g. `]Q1https://id.gs1.org/01/...`.
+func (sr *ScanReader) parseRecord(data string) (Message, error) {
+	if uri, symbology, ok := cutDigitalLinkScanData(data); ok {
+		msg, err := ParseDigitalLink(uri, sr.parseOpts...)
+		msg.Symbology = symbology
+		return msg, err
+	}
+	data = replaceFNC1Substitutes(data, sr.fnc1Substitutes...)
+	var msg Message
+	var err error
+	if data[0] >= '0' && data[0] <= '9' {
+		msg, err = ParseBarcodeMessage(data, sr.parseOpts...)
+	} else {
+		msg, err = ParseMessage(data, sr.parseOpts...)
+	}
+	if err != nil || msg.SyntaxType == ElementStringSyntax {
+		return msg, err
+	}
+	if strings.IndexRune(strings.TrimPrefix(data, string(fnc1)), fnc1) == -1 {
+		return resplitLastElement(msg, resolveRegistry(sr.parseOpts))
+	}
+	return msg, nil
+}
+
+// resplitLastElement recovers FNC1 separators dropped before AIs of predefined length from the last element of msg.
+// The data of the last element is split at the first position where both parts are valid data. If the remainder
+// contains an AI without predefined length, the split is ambiguous and an error is returned.
+func resplitLastElement(msg Message, registry *Registry) (Message, error) {
+	last := msg.Elements[len(msg.Elements)-1]
+	if last.IsFixedLength() {
+		return msg, nil
+	}
+	components, _ := registry.Components(last.AI)
+	for i := 1; i < len(last.DataField); i++ {
+		if validateData(components, last.DataField[:i]) != nil {
+			continue
+		}
+		rest, err := ParseBarcodeMessage(last.DataField[i:], WithRegistry(registry))
+		if err != nil || !validElements(rest.Elements, registry) {
+			continue
+		}
+		for _, el := range rest.Elements {
+			if !el.IsFixedLength() {
+				return Message{}, fmt.Errorf("FNC1 missing: data %q of AI (%s) may contain AI (%s)",
+					last.DataField, last.AI, el.AI)
+			}
+		}
+		msg.Elements = slices.Concat(msg.Elements[:len(msg.Elements)-1],
+			[]ElementString{NewElementString(last.ApplicationIdentifier, last.DataField[:i])}, rest.Elements)
+		return msg, nil
+	}
+	return msg, nil
+}
+
+// validElements checks the data of each element against the specification of its AI.
+func validElements(elements []ElementString, registry *Registry) bool {
+	for _, el := range elements {
+		components, ok := registry.Components(el.AI)
+		if !ok || validateData(components, el.DataField) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// cutDigitalLinkScanData returns the URI of scan data carrying a GS1 Digital Link URI with an optional symbology
+// identifier. The symbology identifier must allow Digital Link URIs, e.g. ]Q1.
+func cutDigitalLinkScanData(data string) (string, SymbologyIdentifier, bool) {
+	var symbology SymbologyIdentifier
+	if len(data) > 3 && data[0] == symbologyFlag {
+		var err error
+		if symbology, err = ParseSymbologyIdentifier(data[:3]); err != nil {
+			return "", SymbologyIdentifier{}, false
+		}
+		if info, ok := symbology.Info(); !ok || !info.DigitalLink {
+			return "", SymbologyIdentifier{}, false
+		}
+		data = data[3:]
+	}
+	if isURI(data) {
+		return data, symbology, true
+	}
+	return "", SymbologyIdentifier{}, false
+}
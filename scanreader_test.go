@@ -0,0 +1,178 @@
+package gs1
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestScanReader_All(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		opts    []ScanOption
+		want    []Message
+		wantErr []int // wantErr lists the 1-based records expected to fail
+	}{
+		{
+			name:  "Records terminated by CR, LF and CRLF SHOULD be framed",
+			input: "]C1010952606405502810ABC\r^0109526064055028\n(01)09526064055028\r\n\n",
+			want: []Message{
+				{
+					Symbology:  SymbologyIdentifier{Type: GS1128, Mode: 1},
+					SyntaxType: BarcodeMessageScanData,
+					Elements:   []ElementString{NewElementString(AI01, "09526064055028"), NewElementString(AI10, "ABC")},
+				},
+				{SyntaxType: BarcodeMessageFormat, Elements: []ElementString{NewElementString(AI01, "09526064055028")}},
+				{SyntaxType: ElementStringSyntax, Elements: []ElementString{NewElementString(AI01, "09526064055028")}},
+			},
+		},
+		{
+			name:  "Records framed by STX and ETX SHOULD be framed ignoring line breaks",
+			input: "\x02]d2010952606405502810ABC\x1d21XYZ\x03\r\n\x0201095260640550281712345\x03",
+			want: []Message{
+				{
+					Symbology:  SymbologyIdentifier{Type: GS1DataMatrix, Mode: 2},
+					SyntaxType: BarcodeMessageScanData,
+					Elements: []ElementString{
+						NewElementString(AI01, "09526064055028"),
+						NewElementString(AI10, "ABC"),
+						NewElementString(AI21, "XYZ"),
+					},
+				},
+				{SyntaxType: BarcodeMessageFormat, Elements: nil},
+			},
+			wantErr: []int{2},
+		},
+		{
+			name:  "Configured FNC1 substitutes SHOULD be normalised",
+			input: "010952606405502810ABC~21XYZ\n",
+			opts:  []ScanOption{WithFNC1Substitutes("~")},
+			want: []Message{
+				{
+					SyntaxType: BarcodeMessageFormat,
+					Elements: []ElementString{
+						NewElementString(AI01, "09526064055028"),
+						NewElementString(AI10, "ABC"),
+						NewElementString(AI21, "XYZ"),
+					},
+				},
+			},
+		},
+		{
+			name:  "FNC1 dropped before AIs of predefined length SHOULD be recovered",
+			input: "010952606405502810ABC17251231\n]C1010952606405502810ABC123\n",
+			want: []Message{
+				{
+					SyntaxType: BarcodeMessageFormat,
+					Elements: []ElementString{
+						NewElementString(AI01, "09526064055028"),
+						NewElementString(AI10, "ABC"),
+						NewElementString(AI17, "251231"),
+					},
+				},
+				{
+					Symbology:  SymbologyIdentifier{Type: GS1128, Mode: 1},
+					SyntaxType: BarcodeMessageScanData,
+					Elements:   []ElementString{NewElementString(AI01, "09526064055028"), NewElementString(AI10, "ABC123")},
+				},
+			},
+		},
+		{
+			name:  "FNC1 dropped before AIs without predefined length SHOULD yield an error",
+			input: "010952606405502810ABC21XYZ\n^0109526064055028",
+			want: []Message{
+				{},
+				{SyntaxType: BarcodeMessageFormat, Elements: []ElementString{NewElementString(AI01, "09526064055028")}},
+			},
+			wantErr: []int{1},
+		},
+		{
+			name:  "Digital Link URI with symbology identifier SHOULD parse",
+			input: "]Q1https://id.gs1.org/01/09526064055028/10/ABC\n",
+			want: []Message{
+				{
+					Symbology:  SymbologyIdentifier{Type: GS1QRCode, Mode: 1},
+					SyntaxType: DigitalLinkURI,
+					Elements:   []ElementString{NewElementString(AI01, "09526064055028"), NewElementString(AI10, "ABC")},
+				},
+			},
+		},
+		{
+			name:  "Unparseable record SHOULD yield an error and continue",
+			input: "garbage\n^0109526064055028",
+			want: []Message{
+				{},
+				{SyntaxType: BarcodeMessageFormat, Elements: []ElementString{NewElementString(AI01, "09526064055028")}},
+			},
+			wantErr: []int{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Message
+			var gotErr []int
+			for msg, err := range NewScanReader(strings.NewReader(tt.input), tt.opts...).All() {
+				var scanErr *ScanError
+				if errors.As(err, &scanErr) {
+					gotErr = append(gotErr, scanErr.Record)
+					got = append(got, Message{})
+					continue
+				}
+				if err != nil {
+					t.Fatalf("All() unexpected error = %v", err)
+				}
+				got = append(got, msg)
+			}
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("All() failed records = %v, want %v", gotErr, tt.wantErr)
+			}
+			for i := range tt.want {
+				if slices.Contains(tt.wantErr, i+1) {
+					continue
+				}
+				if i >= len(got) || !reflect.DeepEqual(got[i], tt.want[i]) {
+					t.Errorf("All() got = %v, want %v", got, tt.want)
+					break
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("All() got %d records, want %d", len(got), len(tt.want))
+			}
+		})
+	}
+}
+
+func TestScanReader_Read_DroppedFNC1(t *testing.T) {
+	_, err := NewScanReader(strings.NewReader("010952606405502810ABC21XYZ")).Read()
+	want := `record 1: FNC1 missing: data "ABC21XYZ" of AI (10) may contain AI (21)`
+	if err == nil || err.Error() != want {
+		t.Errorf("Read() error = %v, want %s", err, want)
+	}
+}
+
+func TestScanReader_Read(t *testing.T) {
+	sr := NewScanReader(iotest.OneByteReader(strings.NewReader("^0109526064055028")))
+	msg, err := sr.Read()
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if want := "(01)09526064055028"; msg.AsElementString() != want {
+		t.Errorf("Read() got = %v, want %v", msg.AsElementString(), want)
+	}
+	if _, err := sr.Read(); !errors.Is(err, io.EOF) {
+		t.Errorf("Read() at end of stream error = %v, want io.EOF", err)
+	}
+
+	readErr := errors.New("device disconnected")
+	sr = NewScanReader(iotest.ErrReader(readErr))
+	for _, err := range sr.All() {
+		if !errors.Is(err, readErr) {
+			t.Errorf("All() error = %v, want %v", err, readErr)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package gs1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SpecComponent is a single component of an AI's specification, e.g. `N13,csum` or `[X..17]`.
+type SpecComponent struct {
+	// Charset is the character set of the component: N (numeric), X (CSET 82), Y (CSET 39) or Z (base64url).
+	Charset byte
+	// MinLength is the minimum length of the component. It equals MaxLength for fixed length components.
+	MinLength int
+	MaxLength int
+	// Optional components may be omitted once the data is exhausted.
+	Optional bool
+	// Linters are the names of the syntax tests applied to the component, e.g. `csum`.
+	Linters []string
+}
+
+// isFixedNumeric reports whether the component always consists of a fixed amount of digits.
+func (c SpecComponent) isFixedNumeric() bool {
+	return c.Charset == 'N' && c.MinLength == c.MaxLength && !c.Optional
+}
+
+// Components splits the AI's specification into its components. The Specification of an AI is stored split by
+// commas, which mixes component types and linters, e.g. "N14", "csum N4", "pieceoftotal" for two components.
+func (ai ApplicationIdentifier) Components() ([]SpecComponent, error) {
+	spec := strings.Join(ai.Specification, ",")
+	var components []SpecComponent
+	for _, field := range strings.Fields(spec) {
+		component, err := parseSpecComponent(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid specification of AI (%s): %w", ai.AI, err)
+		}
+		components = append(components, component)
+	}
+	if len(components) == 0 {
+		return nil, fmt.Errorf("invalid specification of AI (%s): no components", ai.AI)
+	}
+	return components, nil
+}
+
+// parseSpecComponent parses a component in the form `Type[,linter...]`, where Type may be enclosed in brackets to
+// mark it optional.
+func parseSpecComponent(s string) (c SpecComponent, _ error) {
+	typ, linters, _ := strings.Cut(s, ",")
+	if strings.HasPrefix(typ, "[") {
+		if !strings.HasSuffix(typ, "]") {
+			return c, fmt.Errorf("unterminated optional component %q", s)
+		}
+		typ = typ[1 : len(typ)-1]
+		c.Optional = true
+	}
+	if linters != "" {
+		c.Linters = strings.Split(linters, ",")
+	}
+	if len(typ) < 2 || !strings.ContainsRune("NXYZ", rune(typ[0])) {
+		return c, fmt.Errorf("invalid component type %q", typ)
+	}
+	c.Charset = typ[0]
+
+	lengthSpec := typ[1:]
+	variable := strings.HasPrefix(lengthSpec, "..")
+	length, err := strconv.Atoi(strings.TrimPrefix(lengthSpec, ".."))
+	if err != nil || length <= 0 {
+		return c, fmt.Errorf("invalid component length %q", typ)
+	}
+	c.MaxLength = length
+	c.MinLength = length
+	if variable {
+		c.MinLength = 1
+	}
+	return c, nil
+}
+
+// String returns the component type as written in the GS1 Syntax Dictionary, e.g. `N13`, `X..20` or `[N6]`.
+func (c SpecComponent) String() string {
+	s := string(c.Charset)
+	if c.MinLength != c.MaxLength {
+		s += ".."
+	}
+	s += strconv.Itoa(c.MaxLength)
+	if c.Optional {
+		return "[" + s + "]"
+	}
+	return s
+}
@@ -0,0 +1,147 @@
+package gs1
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SQLFormat selects the syntax a [Message] is stored with in a database column.
+type SQLFormat int
+
+const (
+	// SQLElementString stores messages in element string syntax, e.g. (01)09526064055028(10)ABC123.
+	SQLElementString SQLFormat = iota
+	// SQLDigitalLink stores messages as GS1 Digital Link URI, e.g. https://id.gs1.org/01/09526064055028/10/ABC123.
+	SQLDigitalLink
+)
+
+// Value implements [driver.Valuer] and stores the message in element string syntax. Use [SQLMessage] to store
+// messages as GS1 Digital Link URI. Messages that cannot be parsed again, e.g. empty messages, are rejected, so that
+// rows never contain unparseable data. Use [database/sql.Null] for nullable columns.
+func (d Message) Value() (driver.Value, error) {
+	return SQLMessage{Message: d}.Value()
+}
+
+// Scan implements [database/sql.Scanner] and parses the message from a string or byte slice using [ParseMessage].
+// GS1 Digital Link URIs are parsed using [ParseDigitalLink].
+func (d *Message) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case nil:
+		return errors.New("error scanning message: value is NULL")
+	default:
+		return fmt.Errorf("error scanning message: unsupported type %T", src)
+	}
+
+	msg, err := parseStoredMessage(s)
+	if err != nil {
+		return fmt.Errorf("error scanning message: %w", err)
+	}
+	*d = msg
+	return nil
+}
+
+func parseStoredMessage(s string) (Message, error) {
+	if strings.HasPrefix(s, "https://") || strings.HasPrefix(s, "http://") {
+		return ParseDigitalLink(s)
+	}
+	return ParseMessage(s)
+}
+
+// SQLMessage stores a [Message] in a database column using the configured Format. Scanning accepts all formats
+// regardless of the configured Format.
+type SQLMessage struct {
+	Message
+	// Format is the syntax used to store the message.
+	Format SQLFormat
+	// Domain is used for [SQLDigitalLink]. If empty, [CanonicalPrefix] is used.
+	Domain string
+}
+
+// Value implements [driver.Valuer].
+func (m SQLMessage) Value() (driver.Value, error) {
+	if len(m.Elements) == 0 {
+		return nil, errors.New("error storing message: message is empty")
+	}
+
+	var s string
+	switch m.Format {
+	case SQLElementString:
+		s = m.AsElementString()
+	case SQLDigitalLink:
+		var err error
+		s, err = m.AsDigitalLink(m.Domain)
+		if err != nil {
+			return nil, fmt.Errorf("error storing message: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("error storing message: unsupported format %d", m.Format)
+	}
+
+	// Parse the stored representation once more to guarantee it can be read back.
+	if _, err := parseStoredMessage(s); err != nil {
+		return nil, fmt.Errorf("error storing message: %w", err)
+	}
+	return s, nil
+}
+
+// Scan implements [database/sql.Scanner].
+func (m *SQLMessage) Scan(src any) error {
+	return m.Message.Scan(src)
+}
+
+// GTIN is a Global Trade Item Number normalised to 14 digits, as carried by AI (01). Use [ParseGTIN] to create a
+// valid GTIN.
+type GTIN string
+
+// ParseGTIN validates a GTIN-8, GTIN-12, GTIN-13 or GTIN-14 including its check digit and returns it normalised to
+// 14 digits.
+func ParseGTIN(s string) (GTIN, error) {
+	gtin, err := normaliseGTIN(s)
+	if err != nil {
+		return "", err
+	}
+	if checkDigit(gtin[:13]) != gtin[13] {
+		return "", fmt.Errorf("invalid GTIN %s: wrong check digit", s)
+	}
+	return GTIN(gtin), nil
+}
+
+// Value implements [driver.Valuer] and stores the GTIN-14. Invalid GTINs are rejected.
+func (g GTIN) Value() (driver.Value, error) {
+	gtin, err := ParseGTIN(string(g))
+	if err != nil {
+		return nil, fmt.Errorf("error storing GTIN: %w", err)
+	}
+	return string(gtin), nil
+}
+
+// Scan implements [database/sql.Scanner] and validates the GTIN using [ParseGTIN].
+func (g *GTIN) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case int64:
+		if v < 0 || v > 99999999999999 {
+			return fmt.Errorf("error scanning GTIN: integer %d out of range of 14 digits", v)
+		}
+		s = fmt.Sprintf("%014d", v)
+	default:
+		return fmt.Errorf("error scanning GTIN: unsupported type %T", src)
+	}
+	gtin, err := ParseGTIN(s)
+	if err != nil {
+		return fmt.Errorf("error scanning GTIN: %w", err)
+	}
+	*g = gtin
+	return nil
+}
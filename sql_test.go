@@ -0,0 +1,203 @@
+package gs1
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// stubConnector is a minimal database/sql driver keeping the values of a single column in memory. Any statement with
+// an argument inserts the argument, any statement without arguments selects all values.
+type stubConnector struct {
+	mu     sync.Mutex
+	values []driver.Value
+}
+
+func (c *stubConnector) Connect(context.Context) (driver.Conn, error) { return stubConn{c}, nil }
+func (c *stubConnector) Driver() driver.Driver                        { return nil }
+
+type stubConn struct{ c *stubConnector }
+
+func (conn stubConn) Prepare(query string) (driver.Stmt, error) { return stubStmt{conn.c, query}, nil }
+func (conn stubConn) Close() error                              { return nil }
+func (conn stubConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+type stubStmt struct {
+	c     *stubConnector
+	query string
+}
+
+func (s stubStmt) Close() error { return nil }
+func (s stubStmt) NumInput() int {
+	if s.query == "INSERT" {
+		return 1
+	}
+	return 0
+}
+
+func (s stubStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+	s.c.values = append(s.c.values, args[0])
+	return driver.RowsAffected(1), nil
+}
+
+func (s stubStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+	return &stubRows{values: append([]driver.Value(nil), s.c.values...)}, nil
+}
+
+type stubRows struct {
+	values []driver.Value
+	i      int
+}
+
+func (r *stubRows) Columns() []string { return []string{"message"} }
+func (r *stubRows) Close() error      { return nil }
+func (r *stubRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.values) {
+		return io.EOF
+	}
+	dest[0] = r.values[r.i]
+	r.i++
+	return nil
+}
+
+func TestMessage_SQLRoundTrip(t *testing.T) {
+	msg := Message{Elements: []ElementString{
+		NewElementString(AI01, "09526064055028"),
+		NewElementString(AI17, "250521"),
+		NewElementString(AI10, "ABC123"),
+	}}
+	tests := []struct {
+		name       string
+		value      any
+		wantStored string
+		wantSyntax MessageSyntaxType
+		// wantElements differ from the stored message for Digital Link URIs, as key qualifiers precede attributes.
+		wantElements []ElementString
+	}{
+		{
+			name:         "Message SHOULD be stored as element string",
+			value:        msg,
+			wantStored:   "(01)09526064055028(17)250521(10)ABC123",
+			wantSyntax:   ElementStringSyntax,
+			wantElements: msg.Elements,
+		},
+		{
+			name:       "SQLMessage with Digital Link format SHOULD be stored as Digital Link URI",
+			value:      SQLMessage{Message: msg, Format: SQLDigitalLink, Domain: "https://example.com"},
+			wantStored: "https://example.com/01/09526064055028/10/ABC123?17=250521",
+			wantSyntax: DigitalLinkURI,
+			wantElements: []ElementString{
+				NewElementString(AI01, "09526064055028"),
+				NewElementString(AI10, "ABC123"),
+				NewElementString(AI17, "250521"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connector := &stubConnector{}
+			db := sql.OpenDB(connector)
+			defer db.Close()
+
+			if _, err := db.Exec("INSERT", tt.value); err != nil {
+				t.Fatalf("Exec() error = %v", err)
+			}
+			if stored := connector.values[0]; stored != tt.wantStored {
+				t.Errorf("stored value = %v, want %v", stored, tt.wantStored)
+			}
+
+			var got Message
+			if err := db.QueryRow("SELECT").Scan(&got); err != nil {
+				t.Fatalf("Scan() error = %v", err)
+			}
+			if got.SyntaxType != tt.wantSyntax {
+				t.Errorf("Scan() syntax type = %v, want %v", got.SyntaxType, tt.wantSyntax)
+			}
+			if !reflect.DeepEqual(got.Elements, tt.wantElements) {
+				t.Errorf("Scan() got = %v, want %v", got.Elements, tt.wantElements)
+			}
+		})
+	}
+}
+
+func TestMessage_SQLRejectsInvalidData(t *testing.T) {
+	connector := &stubConnector{}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	if _, err := db.Exec("INSERT", Message{}); err == nil {
+		t.Errorf("Exec() with empty message SHOULD return an error")
+	}
+	unparseable := Message{Elements: []ElementString{NewElementString(AI10, "A(B")}}
+	if _, err := db.Exec("INSERT", unparseable); err == nil {
+		t.Errorf("Exec() with message that cannot be parsed again SHOULD return an error")
+	}
+	if len(connector.values) != 0 {
+		t.Errorf("invalid messages SHOULD NOT be stored, got %v", connector.values)
+	}
+
+	connector.values = []driver.Value{"not GS1 data", nil}
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var msg Message
+		if err := rows.Scan(&msg); err == nil {
+			t.Errorf("Scan() of invalid data SHOULD return an error")
+		}
+	}
+
+	var nullable sql.Null[Message]
+	if err := nullable.Scan(nil); err != nil || nullable.Valid {
+		t.Errorf("sql.Null[Message] SHOULD scan NULL, got valid = %v, error = %v", nullable.Valid, err)
+	}
+}
+
+func TestGTIN_SQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		want    GTIN
+		wantErr bool
+	}{
+		{"GTIN-13 SHOULD be normalised", "9526064055028", "09526064055028", false},
+		{"GTIN-14 as bytes SHOULD scan", []byte("09526064055028"), "09526064055028", false},
+		{"GTIN as integer SHOULD scan", int64(9526064055028), "09526064055028", false},
+		{"Negative integer SHOULD return an error", int64(-1), "", true},
+		{"Integer exceeding 14 digits SHOULD return an error", int64(109526064055028), "", true},
+		{"Wrong check digit SHOULD return an error", "9526064055029", "", true},
+		{"Wrong length SHOULD return an error", "952606405502", "", true},
+		{"NULL SHOULD return an error", nil, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got GTIN
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Scan() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := GTIN("123").Value(); err == nil {
+		t.Errorf("Value() of invalid GTIN SHOULD return an error")
+	}
+	if v, err := GTIN("09526064055028").Value(); err != nil || v != "09526064055028" {
+		t.Errorf("Value() got = %v, error = %v", v, err)
+	}
+}
@@ -0,0 +1,231 @@
+package gs1
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SymbologyContent is the kind of data transmitted after a symbology identifier.
+type SymbologyContent int
+
+const (
+	// PlainData is data without GS1 AIs, e.g. text or a GS1 Digital Link URI.
+	PlainData SymbologyContent = iota + 1
+	// AIData is GS1 AI data in barcode message format, i.e. the symbol starts with FNC1 in first position.
+	AIData
+	// GTINData are the digits of an EAN/UPC symbol and its add-on or of an ITF-14 symbol without AI, see
+	// [ParseEANUPC] and [ParseITF14].
+	GTINData
+)
+
+func (c SymbologyContent) String() string {
+	switch c {
+	case PlainData:
+		return "plain data"
+	case AIData:
+		return "GS1 AI data"
+	case GTINData:
+		return "GTIN data"
+	}
+	return fmt.Sprintf("SymbologyContent(%d)", int(c))
+}
+
+// SymbologyInfo describes a symbology identifier, see GS1 General Specification v25.0, chapter 5.1.3, and ISO/IEC
+// 15424.
+type SymbologyInfo struct {
+	SymbologyIdentifier
+	// Name is the name of the symbology in the mode of the identifier, e.g. GS1 DataMatrix for ]d2.
+	Name string
+	// Content is the kind of data transmitted after the identifier.
+	Content SymbologyContent
+	// DigitalLink reports whether the plain data may be a GS1 Digital Link URI.
+	DigitalLink bool
+}
+
+// symbologyTable are the symbology identifiers of ISO/IEC 15424 for the symbologies used within the GS1 system, the
+// other modes of these symbologies and further common symbologies, which carry plain data unless they support FNC1 in
+// first position. Identifiers of other symbologies and modes above 9 are unknown.
+var symbologyTable = []SymbologyInfo{
+	{SymbologyIdentifier{"A", 0}, "Code 39", PlainData, false},
+	{SymbologyIdentifier{"A", 1}, "Code 39 with check character transmitted", PlainData, false},
+	{SymbologyIdentifier{"A", 3}, "Code 39 with check character stripped", PlainData, false},
+	{SymbologyIdentifier{"A", 4}, "Code 39 Full ASCII", PlainData, false},
+	{SymbologyIdentifier{"A", 5}, "Code 39 Full ASCII with check character transmitted", PlainData, false},
+	{SymbologyIdentifier{"A", 7}, "Code 39 Full ASCII with check character stripped", PlainData, false},
+	{SymbologyIdentifier{GS1128, 0}, "Code 128", PlainData, false},
+	{SymbologyIdentifier{GS1128, 1}, "GS1-128", AIData, false},
+	{SymbologyIdentifier{GS1128, 2}, "Code 128 with FNC1 in second position", PlainData, false},
+	{SymbologyIdentifier{GS1DataMatrix, 0}, "Data Matrix ECC 000-140", PlainData, false},
+	{SymbologyIdentifier{GS1DataMatrix, 1}, "Data Matrix ECC 200", PlainData, true},
+	{SymbologyIdentifier{GS1DataMatrix, 2}, "GS1 DataMatrix", AIData, false},
+	{SymbologyIdentifier{GS1DataMatrix, 3}, "Data Matrix ECC 200 with FNC1 in second position", PlainData, false},
+	{SymbologyIdentifier{GS1DataMatrix, 4}, "Data Matrix ECC 200 with ECI", PlainData, false},
+	{SymbologyIdentifier{GS1DataMatrix, 5}, "GS1 DataMatrix with ECI", AIData, false},
+	{SymbologyIdentifier{GS1DataMatrix, 6}, "Data Matrix ECC 200 with FNC1 in second position and ECI", PlainData,
+		false},
+	{SymbologyIdentifier{GS1DataBar, 0}, "GS1 DataBar or GS1 Composite", AIData, false},
+	{SymbologyIdentifier{EANUPC, 0}, "EAN-13, UPC-A or UPC-E", GTINData, false},
+	{SymbologyIdentifier{EANUPC, 1}, "EAN/UPC 2-digit add-on", GTINData, false},
+	{SymbologyIdentifier{EANUPC, 2}, "EAN/UPC 5-digit add-on", GTINData, false},
+	{SymbologyIdentifier{EANUPC, 3}, "EAN/UPC with add-on", GTINData, false},
+	{SymbologyIdentifier{EANUPC, 4}, "EAN-8", GTINData, false},
+	{SymbologyIdentifier{"F", 0}, "Codabar", PlainData, false},
+	{SymbologyIdentifier{"F", 1}, "Codabar ABC", PlainData, false},
+	{SymbologyIdentifier{"F", 2}, "Codabar with check character transmitted", PlainData, false},
+	{SymbologyIdentifier{"F", 4}, "Codabar with check character stripped", PlainData, false},
+	{SymbologyIdentifier{"G", 0}, "Code 93", PlainData, false},
+	{SymbologyIdentifier{"H", 0}, "Code 11 with one check character transmitted", PlainData, false},
+	{SymbologyIdentifier{"H", 1}, "Code 11 with two check characters transmitted", PlainData, false},
+	{SymbologyIdentifier{"H", 3}, "Code 11 with check characters stripped", PlainData, false},
+	{SymbologyIdentifier{ITF, 0}, "Interleaved 2 of 5", PlainData, false},
+	{SymbologyIdentifier{ITF, 1}, "ITF-14", GTINData, false},
+	{SymbologyIdentifier{ITF, 3}, "Interleaved 2 of 5 with check character stripped", PlainData, false},
+	{SymbologyIdentifier{GS1DotCode, 0}, "DotCode", PlainData, false},
+	{SymbologyIdentifier{GS1DotCode, 1}, "GS1 DotCode", AIData, false},
+	{SymbologyIdentifier{"L", 0}, "PDF417", PlainData, false},
+	{SymbologyIdentifier{"L", 1}, "PDF417 with ECI", PlainData, false},
+	{SymbologyIdentifier{"L", 2}, "PDF417 in basic channel mode", PlainData, false},
+	{SymbologyIdentifier{GS1QRCode, 0}, "QR Code Model 1", PlainData, false},
+	{SymbologyIdentifier{GS1QRCode, 1}, "QR Code", PlainData, true},
+	{SymbologyIdentifier{GS1QRCode, 2}, "QR Code with ECI", PlainData, false},
+	{SymbologyIdentifier{GS1QRCode, 3}, "GS1 QR Code", AIData, false},
+	{SymbologyIdentifier{GS1QRCode, 4}, "GS1 QR Code with ECI", AIData, false},
+	{SymbologyIdentifier{GS1QRCode, 5}, "QR Code with FNC1 in second position", PlainData, false},
+	{SymbologyIdentifier{GS1QRCode, 6}, "QR Code with FNC1 in second position and ECI", PlainData, false},
+	{SymbologyIdentifier{"U", 0}, "MaxiCode mode 4 or 5", PlainData, false},
+	{SymbologyIdentifier{"U", 1}, "MaxiCode mode 2 or 3", PlainData, false},
+	{SymbologyIdentifier{"U", 2}, "MaxiCode mode 4 or 5 with ECI", PlainData, false},
+	{SymbologyIdentifier{"U", 3}, "MaxiCode mode 2 or 3 with ECI", PlainData, false},
+	{SymbologyIdentifier{"X", 0}, "Other symbology", PlainData, false},
+	{SymbologyIdentifier{"z", 0}, "Aztec Code", PlainData, false},
+	{SymbologyIdentifier{"z", 1}, "Aztec Code with FNC1 in first position", AIData, false},
+	{SymbologyIdentifier{"z", 2}, "Aztec Code with FNC1 in second position", PlainData, false},
+	{SymbologyIdentifier{"z", 3}, "Aztec Code with ECI", PlainData, false},
+	{SymbologyIdentifier{"z", 4}, "Aztec Code with FNC1 in first position and ECI", AIData, false},
+	{SymbologyIdentifier{"z", 5}, "Aztec Code with FNC1 in second position and ECI", PlainData, false},
+}
+
+// Symbologies returns the descriptions of all known symbology identifiers.
+func Symbologies() []SymbologyInfo {
+	return append([]SymbologyInfo(nil), symbologyTable...)
+}
+
+// Info returns the description of the symbology identifier and whether it is known.
+func (s SymbologyIdentifier) Info() (SymbologyInfo, bool) {
+	for _, info := range symbologyTable {
+		if info.SymbologyIdentifier == s {
+			return info, true
+		}
+	}
+	return SymbologyInfo{}, false
+}
+
+// ValidateContent checks that the data transmitted after the symbology identifier matches its content: GS1 AI data
+// must start with the digits of an AI, EAN/UPC data must be numeric. Plain data must not be empty and may be a GS1
+// Digital Link URI only for identifiers supporting them, e.g. ]d1 and ]Q1.
+func (s SymbologyIdentifier) ValidateContent(data string) error {
+	info, ok := s.Info()
+	if !ok {
+		return fmt.Errorf("unknown symbology identifier %s", s)
+	}
+	switch {
+	case data == "":
+		return fmt.Errorf("symbology identifier %s (%s) carries no data", s, info.Name)
+	case info.Content == AIData && (data[0] < '0' || data[0] > '9'):
+		return fmt.Errorf("symbology identifier %s (%s) must carry GS1 AI data starting with an AI, got %q", s,
+			info.Name, data[:min(len(data), 10)])
+	case info.Content == GTINData && !isNumeric(data):
+		return fmt.Errorf("symbology identifier %s (%s) must carry numeric data", s, info.Name)
+	case isURI(data) && !info.DigitalLink:
+		return fmt.Errorf("symbology identifier %s (%s) cannot carry a GS1 Digital Link URI", s, info.Name)
+	}
+	return nil
+}
+
+// CheckSymbology checks that the message may be carried by a symbol of the symbology identifier as required by the
+// GS1 application standards:
+//
+//   - GS1 AI data, e.g. ]C1 or ]d2, must contain a GS1 identification key, e.g. (01) or (00). Symbols printed
+//     together, e.g. on a GS1 Logistic Label, share the key and are not checked individually.
+//   - GS1 Digital Link URIs are only carried by QR Code and Data Matrix, ]Q1 and ]d1. The message must convert by
+//     [Message.AsDigitalLink].
+//   - EAN/UPC symbols carry a single GTIN (01) of 13 digits or, for ]E4, of 8 digits and the add-on as required by
+//     the mode. ITF-14, ]I1, carries a single GTIN (01).
+//
+// Identifiers carrying plain data other than Digital Link URIs, e.g. ]C0, cannot carry GS1 data.
+func (d Message) CheckSymbology(s SymbologyIdentifier, opts ...Option) error {
+	info, ok := s.Info()
+	if !ok {
+		return fmt.Errorf("unknown symbology identifier %s", s)
+	}
+	switch {
+	case info.Type == ITF && info.Content == GTINData:
+		return d.checkITF14(info)
+	case info.Content == GTINData:
+		return d.checkEANUPC(info)
+	case len(d.Elements) == 0:
+		return errors.New("message has no elements")
+	case info.DigitalLink:
+		if _, err := d.AsDigitalLink("", opts...); err != nil {
+			return fmt.Errorf("symbology identifier %s (%s) cannot carry the message: %w", s, info.Name, err)
+		}
+	case info.Content == PlainData:
+		return fmt.Errorf("symbology identifier %s (%s) cannot carry GS1 data", s, info.Name)
+	default:
+		registry := resolveRegistry(opts)
+		if !slices.ContainsFunc(d.Elements, func(el ElementString) bool {
+			return registry.digitalLinkSpecOf(el.AI).IsValidPrimaryKey
+		}) {
+			return fmt.Errorf("symbology identifier %s (%s) requires a GS1 identification key, e.g. (01) or (00)", s,
+				info.Name)
+		}
+	}
+	return nil
+}
+
+// checkEANUPC checks the message against the GTIN and add-on carried by the EAN/UPC symbology identifier.
+func (d Message) checkEANUPC(info SymbologyInfo) error {
+	var gtinPrefix string
+	addOn := false
+	switch info.Mode {
+	case 1, 2:
+		if len(d.Elements) > 0 || len(d.AddOn) != 3*info.Mode-1 {
+			return fmt.Errorf("symbology identifier %s (%s) carries a %d-digit add-on only", info.SymbologyIdentifier,
+				info.Name, 3*info.Mode-1)
+		}
+		return nil
+	case 3:
+		addOn = true
+		gtinPrefix = "0"
+	case 4:
+		gtinPrefix = "000000"
+	default:
+		gtinPrefix = "0"
+	}
+	if len(d.Elements) != 1 || d.Elements[0].AI != "01" || !strings.HasPrefix(d.Elements[0].DataField, gtinPrefix) {
+		return fmt.Errorf("symbology identifier %s (%s) carries AI (01) with a GTIN starting with %s only",
+			info.SymbologyIdentifier, info.Name, gtinPrefix)
+	}
+	switch {
+	case addOn && d.AddOn == "":
+		return fmt.Errorf("symbology identifier %s (%s) requires an add-on", info.SymbologyIdentifier, info.Name)
+	case !addOn && d.AddOn != "":
+		return fmt.Errorf("symbology identifier %s (%s) cannot carry an add-on", info.SymbologyIdentifier, info.Name)
+	}
+	return nil
+}
+
+// checkITF14 checks that the message consists of the GTIN carried by ITF-14.
+func (d Message) checkITF14(info SymbologyInfo) error {
+	if len(d.Elements) != 1 || d.Elements[0].AI != "01" || d.AddOn != "" {
+		return fmt.Errorf("symbology identifier %s (%s) carries AI (01) only", info.SymbologyIdentifier, info.Name)
+	}
+	return nil
+}
+
+// isURI reports whether the data starts with an HTTP or HTTPS scheme.
+func isURI(data string) bool {
+	return strings.HasPrefix(data, "http://") || strings.HasPrefix(data, "https://")
+}
@@ -0,0 +1,167 @@
+package gs1
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSymbologyIdentifier_Info(t *testing.T) {
+	tests := []struct {
+		name        string
+		identifier  string
+		wantOK      bool
+		wantName    string
+		wantContent SymbologyContent
+	}{
+		{name: "]C1 SHOULD be GS1-128", identifier: "]C1", wantOK: true, wantName: "GS1-128",
+			wantContent: AIData},
+		{name: "]d2 SHOULD be GS1 DataMatrix", identifier: "]d2", wantOK: true, wantName: "GS1 DataMatrix",
+			wantContent: AIData},
+		{name: "]d1 SHOULD be Data Matrix carrying plain data", identifier: "]d1", wantOK: true,
+			wantName: "Data Matrix ECC 200", wantContent: PlainData},
+		{name: "]e0 SHOULD be GS1 DataBar or GS1 Composite", identifier: "]e0", wantOK: true,
+			wantName: "GS1 DataBar or GS1 Composite", wantContent: AIData},
+		{name: "]Q3 SHOULD be GS1 QR Code", identifier: "]Q3", wantOK: true, wantName: "GS1 QR Code",
+			wantContent: AIData},
+		{name: "]E4 SHOULD be EAN-8", identifier: "]E4", wantOK: true, wantName: "EAN-8", wantContent: GTINData},
+		{name: "]I1 SHOULD be ITF-14", identifier: "]I1", wantOK: true, wantName: "ITF-14", wantContent: GTINData},
+		{name: "]L0 SHOULD be PDF417 carrying plain data", identifier: "]L0", wantOK: true, wantName: "PDF417",
+			wantContent: PlainData},
+		{name: "]z1 SHOULD be Aztec Code carrying AI data", identifier: "]z1", wantOK: true,
+			wantName: "Aztec Code with FNC1 in first position", wantContent: AIData},
+		{name: "]X0 SHOULD be other symbology", identifier: "]X0", wantOK: true, wantName: "Other symbology",
+			wantContent: PlainData},
+		{name: "]e1 SHOULD be unknown", identifier: "]e1"},
+		{name: "]Y0 SHOULD be unknown", identifier: "]Y0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ParseSymbologyIdentifier(tt.identifier)
+			if err != nil {
+				t.Fatalf("ParseSymbologyIdentifier() error = %v", err)
+			}
+			got, ok := s.Info()
+			if ok != tt.wantOK || got.Name != tt.wantName || got.Content != tt.wantContent {
+				t.Errorf("Info() = %q, %s, %t, want %q, %s, %t", got.Name, got.Content, ok, tt.wantName,
+					tt.wantContent, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestSymbologies(t *testing.T) {
+	seen := map[SymbologyIdentifier]bool{}
+	for _, info := range Symbologies() {
+		if seen[info.SymbologyIdentifier] {
+			t.Errorf("Symbologies() SHOULD list %s once", info.SymbologyIdentifier)
+		}
+		seen[info.SymbologyIdentifier] = true
+		if info.Name == "" || info.Content == 0 {
+			t.Errorf("Symbologies() SHOULD describe %s", info.SymbologyIdentifier)
+		}
+		if info.DigitalLink && info.Content != PlainData {
+			t.Errorf("Symbologies() %s SHOULD carry Digital Link URIs as plain data", info.SymbologyIdentifier)
+		}
+	}
+
+	Symbologies()[0].Name = "changed"
+	if Symbologies()[0].Name == "changed" {
+		t.Errorf("Symbologies() SHOULD return a copy of the table")
+	}
+}
+
+func TestSymbologyIdentifier_ValidateContent(t *testing.T) {
+	tests := []struct {
+		name       string
+		identifier SymbologyIdentifier
+		data       string
+		wantErr    string
+	}{
+		{name: "AI data in ]C1 SHOULD be valid", identifier: SymbologyIdentifier{GS1128, 1},
+			data: "0109526064055028"},
+		{name: "AI data in ]e0 SHOULD be valid", identifier: SymbologyIdentifier{GS1DataBar, 0},
+			data: "0109526064055028"},
+		{name: "Digital Link in ]Q1 SHOULD be valid", identifier: SymbologyIdentifier{GS1QRCode, 1},
+			data: "https://id.gs1.org/01/09526064055028"},
+		{name: "Digital Link in ]d1 SHOULD be valid", identifier: SymbologyIdentifier{GS1DataMatrix, 1},
+			data: "https://example.com/01/09526064055028"},
+		{name: "GTIN in ]E0 SHOULD be valid", identifier: SymbologyIdentifier{EANUPC, 0}, data: "9520123456788"},
+		{name: "text in ]C0 SHOULD be valid", identifier: SymbologyIdentifier{GS1128, 0}, data: "ABC-123"},
+		{name: "text in ]d2 SHOULD be rejected", identifier: SymbologyIdentifier{GS1DataMatrix, 2}, data: "ABC",
+			wantErr: "must carry GS1 AI data"},
+		{name: "Digital Link in ]Q3 SHOULD be rejected", identifier: SymbologyIdentifier{GS1QRCode, 3},
+			data: "https://id.gs1.org/01/09526064055028", wantErr: "must carry GS1 AI data"},
+		{name: "Digital Link in ]C0 SHOULD be rejected", identifier: SymbologyIdentifier{GS1128, 0},
+			data: "https://id.gs1.org/01/09526064055028", wantErr: "cannot carry a GS1 Digital Link URI"},
+		{name: "letters in ]E0 SHOULD be rejected", identifier: SymbologyIdentifier{EANUPC, 0}, data: "95201234A",
+			wantErr: "must carry numeric data"},
+		{name: "empty data SHOULD be rejected", identifier: SymbologyIdentifier{GS1128, 1},
+			wantErr: "carries no data"},
+		{name: "numeric data in ]I1 SHOULD be valid", identifier: SymbologyIdentifier{ITF, 1}, data: "14012345678908"},
+		{name: "text in ]A0 SHOULD be valid", identifier: SymbologyIdentifier{"A", 0}, data: "ABC-123"},
+		{name: "unknown identifier SHOULD be rejected", identifier: SymbologyIdentifier{"Y", 0}, data: "01",
+			wantErr: "unknown symbology identifier ]Y0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.identifier.ValidateContent(tt.data)
+			if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil ||
+				!strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("ValidateContent() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMessage_CheckSymbology(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		identifier string
+		wantErr    string
+	}{
+		{name: "GTIN in ]C1 SHOULD be valid", data: "]C10109526064055028", identifier: "]C1"},
+		{name: "SSCC in ]d2 SHOULD be valid", data: "]d200106141411234567897", identifier: "]d2"},
+		{name: "GTIN in ]Q1 SHOULD be valid as Digital Link", data: "]C10109526064055028", identifier: "]Q1"},
+		{name: "GTIN-13 in ]E0 SHOULD be valid", data: "]E09520123456788", identifier: "]E0"},
+		{name: "GTIN-8 in ]E4 SHOULD be valid", data: "]E495012346", identifier: "]E4"},
+		{name: "add-on in ]E3 SHOULD be valid", data: "]E3952012345678812", identifier: "]E3"},
+		{name: "data without key SHOULD be rejected", data: "]C110ABC123", identifier: "]C1",
+			wantErr: "requires a GS1 identification key"},
+		{name: "GS1 data in ]C0 SHOULD be rejected", data: "]C10109526064055028", identifier: "]C0",
+			wantErr: "cannot carry GS1 data"},
+		{name: "data without primary key SHOULD be rejected in ]Q1", data: "]C110ABC123", identifier: "]Q1",
+			wantErr: "Digital Link primary key"},
+		{name: "GTIN-13 in ]E4 SHOULD be rejected", data: "]E09520123456788", identifier: "]E4",
+			wantErr: "starting with 000000"},
+		{name: "add-on in ]E0 SHOULD be rejected", data: "]E3952012345678812", identifier: "]E0",
+			wantErr: "cannot carry an add-on"},
+		{name: "GTIN in ]E3 SHOULD require an add-on", data: "]E09520123456788", identifier: "]E3",
+			wantErr: "requires an add-on"},
+		{name: "GTIN in ]I1 SHOULD be valid", data: "]I114012345678908", identifier: "]I1"},
+		{name: "GTIN in ]z1 SHOULD be valid", data: "]z10109526064055028", identifier: "]z1"},
+		{name: "GTIN with batch in ]I1 SHOULD be rejected", data: "(01)14012345678908(10)ABC", identifier: "]I1",
+			wantErr: "carries AI (01) only"},
+		{name: "GS1 data in ]L0 SHOULD be rejected", data: "]C10109526064055028", identifier: "]L0",
+			wantErr: "cannot carry GS1 data"},
+		{name: "unknown identifier SHOULD be rejected", data: "]C10109526064055028", identifier: "]Y0",
+			wantErr: "unknown symbology identifier"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := ParseMessage(tt.data)
+			if err != nil {
+				t.Fatalf("ParseMessage() error = %v", err)
+			}
+			s, err := ParseSymbologyIdentifier(tt.identifier)
+			if err != nil {
+				t.Fatalf("ParseSymbologyIdentifier() error = %v", err)
+			}
+			err = msg.CheckSymbology(s)
+			if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil ||
+				!strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("CheckSymbology() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package gs1
+
+import (
+	"fmt"
+	"time"
+)
+
+// gs1DateLayout is the layout of dates within AIs, e.g. the expiry date of AI (17).
+const gs1DateLayout = "060102"
+
+// newCheckedElementString creates an element string after checking the value against the AI's specification
+// components including their linters. It is used by the generated typed constructors, e.g. [NewBatchLot].
+func newCheckedElementString(ai ApplicationIdentifier, value string) (ElementString, error) {
+	components, ok := DefaultRegistry().Components(ai.AI)
+	if !ok {
+		return ElementString{}, fmt.Errorf("unknown AI: %s", ai.AI)
+	}
+	if err := validateData(components, value); err != nil {
+		return ElementString{}, fmt.Errorf("invalid data of AI (%s): %w", ai.AI, err)
+	}
+	return NewElementString(ai, value), nil
+}
+
+// lookupData returns the data of the first element using the given AI.
+func (d Message) lookupData(ai string) (string, bool) {
+	el, ok := d.element(ai)
+	return el.DataField, ok
+}
+
+// dateData returns the date of the first element using the given AI.
+func (d Message) dateData(ai string) (time.Time, bool) {
+	data, ok := d.lookupData(ai)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := ParseDate(data)
+	return t, err == nil
+}
+
+// formatGS1Date formats the date as YYMMDD.
+func formatGS1Date(t time.Time) string {
+	return t.Format(gs1DateLayout)
+}
+
+// ParseDate parses a date in the format YYMMDD as carried by date AIs, e.g. AI (17). The century is chosen as defined
+// in GS1 General Specification v25.0, chapter 7.12, relative to the current date. Day 00 denotes the last day of the
+// month.
+func ParseDate(data string) (time.Time, error) {
+	return parseGS1Date(data, time.Now())
+}
+
+// parseGS1Date parses a date in the format YYMMDD as defined in GS1 General Specification v25.0, chapter 7.12. The
+// century is chosen, so that the date lies within 49 years before or 50 years after now. Day 00 denotes the last day
+// of the month.
+func parseGS1Date(s string, now time.Time) (time.Time, error) {
+	if len(s) != len(gs1DateLayout) || !isNumeric(s) {
+		return time.Time{}, fmt.Errorf("invalid date %q: must consist of 6 digits", s)
+	}
+	yy := int(s[0]-'0')*10 + int(s[1]-'0')
+	month := time.Month(int(s[2]-'0')*10 + int(s[3]-'0'))
+	day := int(s[4]-'0')*10 + int(s[5]-'0')
+
+	century := now.Year() / 100 * 100
+	switch diff := yy - now.Year()%100; {
+	case diff >= 51:
+		century -= 100
+	case diff <= -50:
+		century += 100
+	}
+	year := century + yy
+
+	if month < time.January || month > time.December {
+		return time.Time{}, fmt.Errorf("invalid date %q: invalid month", s)
+	}
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if day == 0 {
+		day = lastDay
+	}
+	if day > lastDay {
+		return time.Time{}, fmt.Errorf("invalid date %q: invalid day", s)
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), nil
+}
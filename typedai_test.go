@@ -0,0 +1,100 @@
+package gs1
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTypedAIs(t *testing.T) {
+	msg, err := ParseBarcodeMessage("]d2" + "0109521234543213" + "17251200" + "10ABC123")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, ok := msg.BatchLot(); !ok || got != "ABC123" {
+		t.Errorf("BatchLot() = %q, %v, want %q, true", got, ok, "ABC123")
+	}
+	want := time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC)
+	if got, ok := msg.ExpiryDate(); !ok || !got.Equal(want) {
+		t.Errorf("ExpiryDate() = %v, %v, want %v, true", got, ok, want)
+	}
+	if _, ok := msg.BestBeforeDate(); ok {
+		t.Errorf("BestBeforeDate() SHOULD be missing")
+	}
+	if got := NewExpiryDate(want); got.DataField != "251231" || got.AI != ExpiryDateCode {
+		t.Errorf("NewExpiryDate() = %v, want 251231", got)
+	}
+}
+
+func TestNewCheckedElementString(t *testing.T) {
+	tests := []struct {
+		name    string
+		create  func() (ElementString, error)
+		want    string
+		wantErr bool
+	}{
+		{name: "Valid batch SHOULD be accepted", create: func() (ElementString, error) { return NewBatchLot("ABC-1") }, want: "ABC-1"},
+		{name: "Batch exceeding 20 characters SHOULD be rejected", create: func() (ElementString, error) { return NewBatchLot("123456789012345678901") }, wantErr: true},
+		{name: "Batch outside of CSET 82 SHOULD be rejected", create: func() (ElementString, error) { return NewBatchLot("ABC~") }, wantErr: true},
+		{name: "Empty batch SHOULD be rejected", create: func() (ElementString, error) { return NewBatchLot("") }, wantErr: true},
+		{name: "GTIN with wrong check digit SHOULD be rejected by its linter", create: func() (ElementString, error) {
+			return NewGTIN("09526064055029")
+		}, wantErr: true},
+		{name: "GTIN with 14 digits SHOULD be accepted", create: func() (ElementString, error) { return NewGTIN("09521234543213") }, want: "09521234543213"},
+		{name: "GTIN with 13 digits SHOULD be rejected", create: func() (ElementString, error) { return NewGTIN("9521234543213") }, wantErr: true},
+		{name: "GTIN with letters SHOULD be rejected", create: func() (ElementString, error) { return NewGTIN("0952123454321A") }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.create()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.DataField != tt.want {
+				t.Errorf("DataField = %q, want %q", got.DataField, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseGS1Date(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		date    string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "Date SHOULD be parsed", date: "250615", want: time.Date(2025, time.June, 15, 0, 0, 0, 0, time.UTC)},
+		{name: "Day 00 SHOULD be the last day of the month", date: "240200", want: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "Year 50 years ahead SHOULD be in the current century", date: "750101", want: time.Date(2075, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Year 51 years ahead SHOULD be in the previous century", date: "760101", want: time.Date(1976, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Invalid month SHOULD be rejected", date: "251301", wantErr: true},
+		{name: "Invalid day SHOULD be rejected", date: "250230", wantErr: true},
+		{name: "Non-numeric date SHOULD be rejected", date: "25AB01", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseGS1Date(tt.date, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseGS1Date() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseGS1Date() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrecomputedSpecifications(t *testing.T) {
+	for code, ai := range AIRegistry {
+		want, err := ai.Components()
+		if err != nil {
+			t.Fatalf("AI (%s): %v", code, err)
+		}
+		if got := aiComponents[code]; !reflect.DeepEqual(got, want) {
+			t.Errorf("AI (%s): precomputed components = %v, want %v", code, got, want)
+		}
+	}
+}
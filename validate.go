@@ -0,0 +1,191 @@
+package gs1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ValidationIssue is a single violation of the GS1 Syntax Dictionary found by [Message.Validate].
+type ValidationIssue struct {
+	// AI is the AI of the element violating the dictionary.
+	AI      string `json:"ai"`
+	Message string `json:"message"`
+}
+
+func (i ValidationIssue) String() string {
+	return fmt.Sprintf("AI (%s): %s", i.AI, i.Message)
+}
+
+// ValidationError lists all issues found by [Message.Validate].
+type ValidationError struct {
+	Issues []ValidationIssue
+}
+
+func (e *ValidationError) Error() string {
+	builder := strings.Builder{}
+	fmt.Fprintf(&builder, "invalid message: %d issue(s)", len(e.Issues))
+	for _, issue := range e.Issues {
+		builder.WriteString("\n\t")
+		builder.WriteString(issue.String())
+	}
+	return builder.String()
+}
+
+type validationIssues []ValidationIssue
+
+func (issues *validationIssues) add(ai string, message string) {
+	*issues = append(*issues, ValidationIssue{AI: ai, Message: message})
+}
+
+func (issues validationIssues) err() error {
+	if len(issues) == 0 {
+		return nil
+	}
+	return &ValidationError{Issues: issues}
+}
+
+// Validate checks the message against the GS1 Syntax Dictionary: the data of every element must match the character
+// sets and lengths of the AI's specification components and pass its linters, e.g. `csum` for check digits. Linters
+// requiring external code lists, e.g. `iso3166`, are not applied. The `req` and `ex` attributes must be satisfied by
+// the other elements, and repeated AIs must carry the same data. All issues found are returned as
+// [*ValidationError].
+func (d Message) Validate(opts ...Option) error {
+	registry := resolveRegistry(opts)
+	var issues validationIssues
+	if len(d.Elements) == 0 {
+		issues.add("", "message has no elements")
+	}
+
+	present := make(map[string]int, len(d.Elements))
+	for _, el := range d.Elements {
+		if data, ok := d.element(el.AI); ok && data.DataField != el.DataField {
+			issues.add(el.AI, "repeated with different data")
+		}
+		present[el.AI] = 0
+	}
+
+	checked := make(map[string]bool, len(d.Elements))
+	for _, el := range d.Elements {
+		if checked[el.AI] {
+			continue
+		}
+		checked[el.AI] = true
+
+		ai, ok := registry.Lookup(el.AI)
+		if !ok {
+			issues.add(el.AI, "unknown AI")
+			continue
+		}
+		components, _ := registry.Components(el.AI)
+		if err := validateData(components, el.DataField); err != nil {
+			issues.add(el.AI, err.Error())
+		}
+		validateAssociations(ai, present, &issues)
+	}
+	return issues.err()
+}
+
+// validateData checks the data against the components of an AI and applies their linters.
+func validateData(components []SpecComponent, data string) error {
+	parts, err := splitComponents(components, data)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for i, part := range parts {
+		for _, name := range components[i].Linters {
+			lint, ok := linters[name]
+			if !ok {
+				continue
+			}
+			if err := lint(part); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ComponentData splits the data field into the data of the AI's specification components, e.g. `0` and
+// `9526064055028` for the first components of AI (8003), and returns the components alongside. Missing optional
+// components are omitted. AIs are looked up using the [DefaultRegistry] unless another registry is passed.
+func (ai ElementString) ComponentData(opts ...Option) ([]SpecComponent, []string, error) {
+	components, ok := resolveRegistry(opts).Components(ai.AI)
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown AI: %s", ai.AI)
+	}
+	parts, err := splitComponents(components, ai.DataField)
+	if err != nil {
+		return nil, nil, err
+	}
+	return components[:len(parts)], parts, nil
+}
+
+// splitComponents splits the data into the parts of its components after checking their character sets and lengths.
+// Missing optional components are omitted.
+func splitComponents(components []SpecComponent, data string) ([]string, error) {
+	if data == "" {
+		return nil, errors.New("value is empty")
+	}
+	var parts []string
+	rest := data
+	for _, c := range components {
+		if rest == "" && c.Optional {
+			break
+		}
+		n := min(len(rest), c.MaxLength)
+		if n < c.MinLength {
+			return nil, fmt.Errorf("component %s requires at least %d characters, got %d", c, c.MinLength, n)
+		}
+		if !isInCharset(rest[:n], c.Charset) {
+			return nil, fmt.Errorf("component %s contains characters outside of character set %c", c, c.Charset)
+		}
+		parts = append(parts, rest[:n])
+		rest = rest[n:]
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("value exceeds the specification by %d characters", len(rest))
+	}
+	return parts, nil
+}
+
+// validateAssociations checks the `req` and `ex` attributes of the AI against the AIs present within the message.
+// An AI never excludes itself, e.g. AI (3100) with `ex=310n`.
+func validateAssociations(ai ApplicationIdentifier, present map[string]int, issues *validationIssues) {
+	others := make(map[string]int, len(present))
+	for code := range present {
+		if code != ai.AI {
+			others[code] = 0
+		}
+	}
+	for _, attr := range ai.Attributes {
+		key, value, _ := strings.Cut(attr, "=")
+		switch key {
+		case "req":
+			satisfied := false
+			for _, group := range strings.Split(value, ",") {
+				satisfied = satisfied || matchesAllPatterns(group, others)
+			}
+			if !satisfied {
+				issues.add(ai.AI, fmt.Sprintf("requires AI %s", strings.ReplaceAll(value, ",", " or ")))
+			}
+		case "ex":
+			for _, group := range strings.Split(value, ",") {
+				if matchesAllPatterns(group, others) {
+					issues.add(ai.AI, fmt.Sprintf("excludes AI %s", group))
+				}
+			}
+		}
+	}
+}
+
+// matchesAllPatterns reports whether every AI pattern of a `+` separated group, e.g. `8018+7259`, matches an AI.
+func matchesAllPatterns(group string, ais map[string]int) bool {
+	for _, pattern := range strings.Split(group, "+") {
+		if !matchesAnyAI(pattern, ais) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package gs1
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMessage_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        string
+		wantIssues []ValidationIssue
+	}{
+		{
+			name: "Valid message SHOULD pass",
+			msg:  "(01)09526064055028(17)250521(10)ABC123(21)456DEF",
+		},
+		{
+			name:       "Wrong check digit SHOULD be reported",
+			msg:        "(01)09526064055029",
+			wantIssues: []ValidationIssue{{AI: "01", Message: "csum: wrong check digit 9, want 8"}},
+		},
+		{
+			name:       "Invalid date SHOULD be reported",
+			msg:        "(01)09526064055028(17)251301",
+			wantIssues: []ValidationIssue{{AI: "17", Message: `yymmd0: invalid date "251301": invalid month`}},
+		},
+		{
+			name:       "Wrong length SHOULD be reported",
+			msg:        "(01)0952606405502",
+			wantIssues: []ValidationIssue{{AI: "01", Message: "component N14 requires at least 14 characters, got 13"}},
+		},
+		{
+			name:       "Missing required AI SHOULD be reported",
+			msg:        "(10)ABC123",
+			wantIssues: []ValidationIssue{{AI: "10", Message: "requires AI 01 or 02 or 03 or 8006 or 8026"}},
+		},
+		{
+			name: "Excluded AI SHOULD be reported",
+			msg:  "(01)09526064055028(3100)000100(3101)000010",
+			wantIssues: []ValidationIssue{
+				{AI: "3100", Message: "excludes AI 310n"},
+				{AI: "3101", Message: "excludes AI 310n"},
+			},
+		},
+		{
+			name:       "Repeated AI with different data SHOULD be reported",
+			msg:        "(01)09526064055028(21)A(21)B",
+			wantIssues: []ValidationIssue{{AI: "21", Message: "repeated with different data"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := ParseElementString(tt.msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = msg.Validate()
+			if tt.wantIssues == nil {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Validate() error = %v, want *ValidationError", err)
+			}
+			if !reflect.DeepEqual(validationErr.Issues, tt.wantIssues) {
+				t.Errorf("Validate() issues = %v, want %v", validationErr.Issues, tt.wantIssues)
+			}
+		})
+	}
+}
+
+func TestElementString_ComponentData(t *testing.T) {
+	components, parts, err := NewElementString(AI8003, "09526064055028ABC").ComponentData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"0", "9526064055028", "ABC"}; !reflect.DeepEqual(parts, want) {
+		t.Errorf("ComponentData() parts = %v, want %v", parts, want)
+	}
+	if len(components) != 3 || components[2].String() != "[X..16]" {
+		t.Errorf("ComponentData() components = %v", components)
+	}
+
+	_, parts, err = NewElementString(AI8003, "09526064055028").ComponentData()
+	if err != nil || len(parts) != 2 {
+		t.Errorf("ComponentData() = %v, %v, want the optional component to be omitted", parts, err)
+	}
+}